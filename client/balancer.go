@@ -31,7 +31,9 @@ func (client *Client) initBalancer(cfg *ClientConfig) (*balancer.Balancer, error
 
 	// Add chained (CONNECT proxy) servers.
 	log.Debugf("Adding %d chained servers", len(cfg.ChainedServers))
+	addrs := make(map[string]bool, len(cfg.ChainedServers))
 	for _, s := range cfg.ChainedServers {
+		addrs[s.Addr] = true
 		dialer, err := s.Dialer(cfg.DeviceID)
 		if err == nil {
 			dialers = append(dialers, dialer)
@@ -40,6 +42,9 @@ func (client *Client) initBalancer(cfg *ClientConfig) (*balancer.Balancer, error
 		}
 	}
 
+	// Stop reporting stats for servers that are no longer configured
+	retainStats(addrs)
+
 	bal := balancer.New(balancer.QualityFirst, dialers...)
 	var oldBal *balancer.Balancer
 	var ok bool
@@ -119,15 +119,18 @@ func (s *ChainedServerInfo) Dialer(deviceID string) (*balancer.Dialer, error) {
 		req.Header.Set("X-LANTERN-DEVICE-ID", deviceID)
 	}
 	d := chained.NewDialer(ccfg)
+	stats := statsFor(s.Addr)
 
 	return &balancer.Dialer{
 		Label:   label,
 		Trusted: s.Trusted,
 		DialFN: func(network, addr string) (net.Conn, error) {
 			conn, err := d.Dial(network, addr)
+			stats.dialed(err)
 			if err != nil {
 				return conn, err
 			}
+			conn = stats.countTraffic(conn)
 			conn = idletiming.Conn(conn, idleTimeout, func() {
 				log.Debugf("Proxy connection to %s via %s idle for %v, closing", addr, conn.RemoteAddr(), idleTimeout)
 				if err := conn.Close(); err != nil {
@@ -32,8 +32,32 @@ var (
 
 	addr      = eventual.NewValue()
 	socksAddr = eventual.NewValue()
+
+	// forceProxied holds a func(addr string) bool, see SetForceProxied
+	forceProxied atomic.Value
+
+	// onDetour holds a func(addr string), see OnDetour
+	onDetour atomic.Value
 )
 
+// OnDetour sets a function that's called with the address whenever detour
+// reaches a site through Lantern, usually because the site appears to be
+// blocked.
+func OnDetour(fn func(addr string)) {
+	onDetour.Store(fn)
+}
+
+// SetForceProxied sets a function that decides which addresses to always
+// proxy instead of letting detour try to reach them directly first.
+func SetForceProxied(fn func(addr string) bool) {
+	forceProxied.Store(fn)
+}
+
+func isForceProxied(addr string) bool {
+	fn, _ := forceProxied.Load().(func(addr string) bool)
+	return fn != nil && fn(addr)
+}
+
 // Client is an HTTP proxy that accepts connections from local programs and
 // proxies these via remote flashlight servers.
 type Client struct {
@@ -194,11 +218,19 @@ func (client *Client) cfg() *ClientConfig {
 }
 
 func (client *Client) proxiedDialer(orig func(network, addr string) (net.Conn, error)) func(network, addr string) (net.Conn, error) {
-	detourDialer := detour.Dialer(orig)
+	detourDialer := detour.Dialer(func(network, addr string) (net.Conn, error) {
+		conn, err := orig(network, addr)
+		if err == nil {
+			if fn, _ := onDetour.Load().(func(addr string)); fn != nil {
+				fn(addr)
+			}
+		}
+		return conn, err
+	})
 
 	return func(network, addr string) (net.Conn, error) {
 		var proxied func(network, addr string) (net.Conn, error)
-		if client.ProxyAll() {
+		if client.ProxyAll() || isForceProxied(addr) {
 			proxied = orig
 		} else {
 			proxied = detourDialer
@@ -27,6 +27,18 @@ type ClientConfig struct {
 	FrontedServers []*FrontedServerInfo
 	ChainedServers map[string]*ChainedServerInfo
 	MasqueradeSets map[string][]*fronted.Masquerade
+
+	// ProbeAddr: (optional) the host:port that we connect to through the
+	// chained servers to check that a new configuration works. Defaults to
+	// www.google.com:443.
+	ProbeAddr string
+}
+
+func (c *ClientConfig) probeAddr() string {
+	if c.ProbeAddr == "" {
+		return defaultProbeAddr
+	}
+	return c.ProbeAddr
 }
 
 // SortServers sorts the Servers array in place, ordered by host
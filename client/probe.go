@@ -0,0 +1,56 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/getlantern/balancer"
+)
+
+const (
+	// defaultProbeAddr is the address that we CONNECT to through the balancer
+	// in order to check that the configured chained servers actually work,
+	// unless the config specifies a different one.
+	defaultProbeAddr = "www.google.com:443"
+)
+
+var (
+	probeRetryInterval = 2 * time.Second
+)
+
+// Probe checks that the currently configured balancer is able to reach the
+// internet by dialing the configured ProbeAddr through it. It keeps retrying
+// until it either succeeds or the given timeout elapses, in which case it
+// returns the last error encountered.
+func (client *Client) Probe(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	cfg := client.cfg()
+	if len(cfg.ChainedServers) == 0 {
+		return fmt.Errorf("No chained servers configured")
+	}
+	bal, ok := client.bal.Get(timeout)
+	if !ok {
+		return fmt.Errorf("No balancer available after %v", timeout)
+	}
+	// See the comment in intercept() regarding the "connect" network.
+	return probe(bal.(*balancer.Balancer).Dial, "connect", cfg.probeAddr(), deadline)
+}
+
+// probe dials addr with dial until it succeeds or the deadline passes.
+func probe(dial func(network, addr string) (net.Conn, error), network string, addr string, deadline time.Time) error {
+	for {
+		conn, err := dial(network, addr)
+		if err == nil {
+			if err := conn.Close(); err != nil {
+				log.Debugf("Error closing probe connection: %v", err)
+			}
+			return nil
+		}
+		log.Debugf("Probe to %v failed: %v", addr, err)
+		if time.Now().Add(probeRetryInterval).After(deadline) {
+			return fmt.Errorf("Unable to reach %v: %v", addr, err)
+		}
+		time.Sleep(probeRetryInterval)
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeAddr(t *testing.T) {
+	assert.Equal(t, "www.google.com:443", (&ClientConfig{}).probeAddr())
+	assert.Equal(t, "probe.example.com:80", (&ClientConfig{ProbeAddr: "probe.example.com:80"}).probeAddr())
+}
+
+func TestProbe(t *testing.T) {
+	oldInterval := probeRetryInterval
+	probeRetryInterval = 10 * time.Millisecond
+	defer func() {
+		probeRetryInterval = oldInterval
+	}()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer l.Close()
+
+	attempts := 0
+	flaky := func(network, addr string) (net.Conn, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, fmt.Errorf("Connection refused")
+		}
+		return net.Dial("tcp", addr)
+	}
+	assert.NoError(t, probe(flaky, "tcp", l.Addr().String(), time.Now().Add(time.Second)), "Should retry until probe succeeds")
+	assert.Equal(t, 3, attempts)
+
+	failing := func(network, addr string) (net.Conn, error) {
+		return nil, fmt.Errorf("Connection refused")
+	}
+	err = probe(failing, "tcp", "probe.example.com:443", time.Now().Add(50*time.Millisecond))
+	if assert.Error(t, err, "Should give up after deadline") {
+		assert.Contains(t, err.Error(), "probe.example.com:443")
+	}
+}
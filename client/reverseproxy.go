@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
+	"strings"
 	"time"
 
 	"github.com/getlantern/balancer"
@@ -78,7 +79,9 @@ func (rt *headerDumpingRoundTripper) RoundTrip(req *http.Request) (resp *http.Re
 }
 
 // The errorRewritingRoundTripper writes creates an special *http.Response when
-// the roundtripper fails for some reason.
+// the roundtripper fails for some reason. The response explains the kind of
+// failure with a suitable status code, either as an error page or as JSON
+// depending on what the client accepts.
 type errorRewritingRoundTripper struct {
 	orig http.RoundTripper
 }
@@ -86,39 +89,59 @@ type errorRewritingRoundTripper struct {
 func (er *errorRewritingRoundTripper) RoundTrip(req *http.Request) (resp *http.Response, err error) {
 	res, err := er.orig.RoundTrip(req)
 	if err != nil {
-		var htmlerr []byte
+		class := status.Classify(err)
+		log.Debugf("Error accessing %v, classified as %v: %v", req.Host, class.Name, err)
 
-		// If the request has an 'Accept' header preferring HTML, or
-		// doesn't have that header at all, render the error page.
-		switch req.Header.Get("Accept") {
-		case "text/html":
-			fallthrough
-		case "application/xhtml+xml":
-			fallthrough
-		case "":
-			// It is likely we will have lots of different errors to handle but for now
-			// we will only return a ErrorAccessingPage error.  This prevents the user
-			// from getting just a blank screen.
-			htmlerr, err = status.ErrorAccessingPage(req.Host, err)
-			if err != nil {
-				log.Debugf("Got error while generating status page: %q", err)
-			}
-		default:
+		var body []byte
+		var contentType string
+		var renderErr error
+		if acceptsHTML(req) {
+			body, renderErr = status.ErrorAccessingPage(req, err)
+			contentType = "text/html; charset=utf-8"
+		} else {
 			// We know for sure that the requested resource is not HTML page,
-			// wrap the error message in http content, or http.ReverseProxy
+			// so describe the error in JSON instead.
+			body, renderErr = status.ErrorAccessingPageJSON(req, err)
+			contentType = "application/json"
+		}
+		if renderErr != nil {
+			log.Debugf("Got error while generating status page: %q", renderErr)
+			// Wrap the error message in http content, or http.ReverseProxy
 			// will response 500 Internal Server Error instead.
-			htmlerr = []byte(err.Error())
+			body = []byte(err.Error())
+			contentType = "text/plain; charset=utf-8"
 		}
 
 		res = &http.Response{
-			Body: ioutil.NopCloser(bytes.NewBuffer(htmlerr)),
+			StatusCode:    class.StatusCode,
+			Header:        http.Header{},
+			Body:          ioutil.NopCloser(bytes.NewBuffer(body)),
+			ContentLength: int64(len(body)),
 		}
-		res.StatusCode = http.StatusServiceUnavailable
+		res.Header.Set("Content-Type", contentType)
+		// Don't let the browser cache the error in place of the page
+		res.Header.Set("Cache-Control", "no-cache, no-store")
 		return res, nil
 	}
 	return res, err
 }
 
+// acceptsHTML checks whether the request's Accept header includes HTML, or
+// whether it doesn't have that header at all.
+func acceptsHTML(req *http.Request) bool {
+	accept := req.Header.Get("Accept")
+	if accept == "" {
+		return true
+	}
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType := strings.TrimSpace(strings.SplitN(mediaRange, ";", 2)[0])
+		if mediaType == "text/html" || mediaType == "application/xhtml+xml" {
+			return true
+		}
+	}
+	return false
+}
+
 // noForwardedForRoundTripper is a RoundTripper that strips out the
 // X-Forwarded-For header that was generated by the ReverseProxy. This is
 // is necessary because the Lantern config server assigns clients to proxies
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingRoundTripper struct {
+	err error
+}
+
+func (rt *failingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, rt.err
+}
+
+func TestErrorRewritingRoundTripper(t *testing.T) {
+	rt := &errorRewritingRoundTripper{&failingRoundTripper{errors.New("dial tcp 1.2.3.4:443: i/o timeout")}}
+	roundTrip := func(accept string) (*http.Response, string) {
+		req, _ := http.NewRequest("GET", "http://example.com/", nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := rt.RoundTrip(req)
+		if !assert.NoError(t, err, "Errors should be turned into responses") {
+			t.FailNow()
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		return resp, string(body)
+	}
+
+	resp, body := roundTrip("text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+	assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Contains(t, body, "Connection timed out")
+
+	resp, _ = roundTrip("")
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"), "Missing Accept should get HTML")
+
+	resp, body = roundTrip("application/json, text/plain, */*")
+	assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	var result map[string]interface{}
+	if assert.NoError(t, json.Unmarshal([]byte(body), &result)) {
+		assert.Equal(t, "timeout", result["class"])
+		assert.Equal(t, "example.com", result["server"])
+	}
+}
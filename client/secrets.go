@@ -0,0 +1,64 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/getlantern/flashlight/secret"
+)
+
+// MarshalYAML implements yaml.Marshaler, encrypting the device ID so that it's
+// not stored in plaintext.
+func (c *ClientConfig) MarshalYAML() (interface{}, error) {
+	// plainClientConfig has no methods, which avoids infinite recursion
+	type plainClientConfig ClientConfig
+	p := plainClientConfig(*c)
+	p.DeviceID = secret.MustEncrypt(c.DeviceID)
+	return &p, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, decrypting the device ID if it
+// was encrypted. It fails if the device ID can't be decrypted, for example
+// because it was encrypted with a different key, so that we never save the
+// config with a blank device ID.
+func (c *ClientConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plainClientConfig ClientConfig
+	if err := unmarshal((*plainClientConfig)(c)); err != nil {
+		return err
+	}
+	deviceID, err := secret.Decrypt(c.DeviceID)
+	if err != nil {
+		return fmt.Errorf("Unable to decrypt device ID: %v", err)
+	}
+	c.DeviceID = deviceID
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, encrypting the auth token and the
+// pinned certificate so that they're not stored in plaintext.
+func (s *ChainedServerInfo) MarshalYAML() (interface{}, error) {
+	type plainChainedServerInfo ChainedServerInfo
+	p := plainChainedServerInfo(*s)
+	p.AuthToken = secret.MustEncrypt(s.AuthToken)
+	p.Cert = secret.MustEncrypt(s.Cert)
+	return &p, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, decrypting the auth token and
+// the pinned certificate if they were encrypted. Like for the device ID, it
+// fails if they can't be decrypted.
+func (s *ChainedServerInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plainChainedServerInfo ChainedServerInfo
+	if err := unmarshal((*plainChainedServerInfo)(s)); err != nil {
+		return err
+	}
+	authToken, err := secret.Decrypt(s.AuthToken)
+	if err != nil {
+		return fmt.Errorf("Unable to decrypt auth token for %v: %v", s.Addr, err)
+	}
+	cert, err := secret.Decrypt(s.Cert)
+	if err != nil {
+		return fmt.Errorf("Unable to decrypt certificate for %v: %v", s.Addr, err)
+	}
+	s.AuthToken, s.Cert = authToken, cert
+	return nil
+}
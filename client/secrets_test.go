@@ -0,0 +1,56 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/getlantern/yaml"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/secret"
+)
+
+func TestUnmarshalWithDifferentKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	otherDir, err := ioutil.TempDir("", "secrets")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(otherDir)
+
+	if _, err := secret.Configure(otherDir); !assert.NoError(t, err) {
+		return
+	}
+	server, err := yaml.Marshal(&ChainedServerInfo{Addr: "1.2.3.4:443", AuthToken: "s3cr3t", Cert: "cert"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotContains(t, string(server), "s3cr3t", "Auth token should be encrypted")
+	clientCfg, err := yaml.Marshal(&ClientConfig{DeviceID: "device"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	decoded := &ChainedServerInfo{}
+	if assert.NoError(t, yaml.Unmarshal(server, decoded)) {
+		assert.Equal(t, "s3cr3t", decoded.AuthToken)
+		assert.Equal(t, "cert", decoded.Cert)
+	}
+
+	// Switch to a new key, as if the key file had been lost
+	if _, err := secret.Configure(dir); !assert.NoError(t, err) {
+		return
+	}
+	err = yaml.Unmarshal(server, &ChainedServerInfo{})
+	if assert.Error(t, err, "Server encrypted with different key should fail to load") {
+		assert.Contains(t, err.Error(), "Unable to decrypt auth token for 1.2.3.4:443")
+	}
+	err = yaml.Unmarshal(clientCfg, &ClientConfig{})
+	if assert.Error(t, err, "Config encrypted with different key should fail to load") {
+		assert.Contains(t, err.Error(), "Unable to decrypt device ID")
+	}
+}
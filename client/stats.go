@@ -0,0 +1,137 @@
+package client
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	statsMx     sync.Mutex
+	serverStats = make(map[string]*chainedStats)
+)
+
+// ServerStats is a snapshot of the health of and traffic through a chained
+// server.
+type ServerStats struct {
+	Addr string
+	// Dials is the number of connections dialed through the server, including
+	// failed ones.
+	Dials        int64
+	DialFailures int64
+	// ConsecFailures is the number of dials that failed since the last one
+	// that succeeded.
+	ConsecFailures int64
+	LastSuccess    time.Time
+	LastFailure    time.Time
+	LastError      string
+	BytesSent      int64
+	BytesReceived  int64
+}
+
+// chainedStats tracks ServerStats for a single chained server across
+// balancers.
+type chainedStats struct {
+	// Accessed atomically, keep at the top for alignment
+	bytesSent     int64
+	bytesReceived int64
+
+	mx    sync.Mutex
+	stats ServerStats
+}
+
+// statsFor returns the stats for the chained server at the given address.
+func statsFor(addr string) *chainedStats {
+	statsMx.Lock()
+	defer statsMx.Unlock()
+	s := serverStats[addr]
+	if s == nil {
+		s = &chainedStats{stats: ServerStats{Addr: addr}}
+		serverStats[addr] = s
+	}
+	return s
+}
+
+// retainStats forgets the stats of all chained servers that aren't in addrs.
+func retainStats(addrs map[string]bool) {
+	statsMx.Lock()
+	defer statsMx.Unlock()
+	for addr := range serverStats {
+		if !addrs[addr] {
+			delete(serverStats, addr)
+		}
+	}
+}
+
+// GetServerStats returns a snapshot of the stats of all configured chained
+// servers, sorted by address.
+func GetServerStats() []*ServerStats {
+	statsMx.Lock()
+	all := make([]*chainedStats, 0, len(serverStats))
+	for _, s := range serverStats {
+		all = append(all, s)
+	}
+	statsMx.Unlock()
+
+	result := make([]*ServerStats, 0, len(all))
+	for _, s := range all {
+		result = append(result, s.snapshot())
+	}
+	sort.Sort(byAddr(result))
+	return result
+}
+
+func (s *chainedStats) dialed(err error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.stats.Dials++
+	if err == nil {
+		s.stats.ConsecFailures = 0
+		s.stats.LastSuccess = time.Now()
+		return
+	}
+	s.stats.DialFailures++
+	s.stats.ConsecFailures++
+	s.stats.LastFailure = time.Now()
+	s.stats.LastError = err.Error()
+}
+
+func (s *chainedStats) snapshot() *ServerStats {
+	s.mx.Lock()
+	snapshot := s.stats
+	s.mx.Unlock()
+	snapshot.BytesSent = atomic.LoadInt64(&s.bytesSent)
+	snapshot.BytesReceived = atomic.LoadInt64(&s.bytesReceived)
+	return &snapshot
+}
+
+// countTraffic wraps the given connection so that the bytes sent and received
+// through it are counted.
+func (s *chainedStats) countTraffic(conn net.Conn) net.Conn {
+	return &countingConn{conn, s}
+}
+
+type countingConn struct {
+	net.Conn
+	stats *chainedStats
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	atomic.AddInt64(&c.stats.bytesReceived, int64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	atomic.AddInt64(&c.stats.bytesSent, int64(n))
+	return n, err
+}
+
+type byAddr []*ServerStats
+
+func (a byAddr) Len() int           { return len(a) }
+func (a byAddr) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byAddr) Less(i, j int) bool { return a[i].Addr < a[j].Addr }
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerStats(t *testing.T) {
+	defer retainStats(map[string]bool{})
+
+	stats := statsFor("1.2.3.4:443")
+	stats.dialed(errors.New("Connection refused"))
+	stats.dialed(errors.New("Connection refused"))
+	stats.dialed(nil)
+	stats.dialed(errors.New("Timed out"))
+
+	a, b := net.Pipe()
+	conn := stats.countTraffic(a)
+	go func() {
+		buf := make([]byte, 5)
+		if _, err := b.Read(buf); err == nil {
+			_, _ = b.Write([]byte("hi"))
+		}
+	}()
+	_, err := conn.Write([]byte("hello"))
+	assert.NoError(t, err)
+	buf := make([]byte, 2)
+	_, err = conn.Read(buf)
+	assert.NoError(t, err)
+	assert.NoError(t, conn.Close())
+
+	statsFor("5.6.7.8:443")
+	all := GetServerStats()
+	if assert.Len(t, all, 2) {
+		s := all[0]
+		assert.Equal(t, "1.2.3.4:443", s.Addr, "Should be sorted by address")
+		assert.EqualValues(t, 4, s.Dials)
+		assert.EqualValues(t, 3, s.DialFailures)
+		assert.EqualValues(t, 1, s.ConsecFailures)
+		assert.Equal(t, "Timed out", s.LastError)
+		assert.False(t, s.LastSuccess.IsZero())
+		assert.EqualValues(t, 5, s.BytesSent)
+		assert.EqualValues(t, 2, s.BytesReceived)
+	}
+
+	retainStats(map[string]bool{"5.6.7.8:443": true})
+	all = GetServerStats()
+	if assert.Len(t, all, 1) {
+		assert.Equal(t, "5.6.7.8:443", all[0].Addr)
+	}
+}
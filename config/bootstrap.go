@@ -81,10 +81,25 @@ func readSettingsFromFile(yamlPath string) (*BootstrapSettings, error) {
 
 // MakeInitialConfig save baked-in config to the file specified by configPath
 func MakeInitialConfig(configPath string) error {
+	bytes, err := readBootstrapConfig()
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(configPath, bytes, 0600)
+	if err != nil {
+		log.Errorf("Could not write bootstrap file %v", err)
+		return err
+	}
+	return nil
+}
+
+// readBootstrapConfig reads the baked-in lantern.yaml that is used as the
+// initial config on the first run of a given version.
+func readBootstrapConfig() ([]byte, error) {
 	dir, _, err := bootstrapPath(lanternYamlName)
 	if err != nil {
 		log.Errorf("Could not get bootstrap path %v", err)
-		return err
+		return nil, err
 	}
 
 	// We need to use tarfs here because the lantern.yaml needs to embedded
@@ -93,23 +108,18 @@ func MakeInitialConfig(configPath string) error {
 	fs, err := tarfs.New(Resources, dir)
 	if err != nil {
 		log.Errorf("Could not read resources? %v", err)
-		return err
+		return nil, err
 	}
 
 	// Get the yaml file from either the local file system or from an
 	// embedded resource, but ignore local file system files if they're
 	// empty.
-	bytes, err := fs.GetIgnoreLocalEmpty("lantern.yaml")
+	bytes, err := fs.GetIgnoreLocalEmpty(lanternYamlName)
 	if err != nil {
 		log.Errorf("Could not read bootstrap file %v", err)
-		return err
+		return nil, err
 	}
-	err = ioutil.WriteFile(configPath, bytes, 0644)
-	if err != nil {
-		log.Errorf("Could not write bootstrap file %v", err)
-		return err
-	}
-	return nil
+	return bytes, nil
 }
 
 func bootstrapPath(fileName string) (string, string, error) {
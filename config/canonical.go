@@ -0,0 +1,201 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// canonicalJSON encodes the given value, as produced by unmarshaling YAML or
+// JSON into an interface{}, using the JSON Canonicalization Scheme (JCS, RFC
+// 8785):
+//
+//   - no whitespace between tokens
+//   - object members sorted by the UTF-16 code units of their names
+//   - strings escaped only where JSON requires it (", \ and control
+//     characters), so unlike encoding/json, <, > and & are left alone
+//   - numbers formatted like ECMAScript's Number.prototype.toString, which
+//     means that they're treated as IEEE 754 doubles
+//
+// Map keys that aren't strings, like the booleans that YAML 1.1 makes of keys
+// like yes and no, are converted to strings with fmt.Sprint.
+func canonicalJSON(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case string:
+		return writeCanonicalString(buf, v)
+	case int:
+		return writeCanonicalNumber(buf, float64(v))
+	case int64:
+		return writeCanonicalNumber(buf, float64(v))
+	case uint64:
+		return writeCanonicalNumber(buf, float64(v))
+	case float64:
+		return writeCanonicalNumber(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, child := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, child); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		return writeCanonicalObject(buf, v)
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			name := fmt.Sprint(key)
+			if _, found := object[name]; found {
+				return fmt.Errorf("Duplicate key %q", name)
+			}
+			object[name] = child
+		}
+		return writeCanonicalObject(buf, object)
+	default:
+		return fmt.Errorf("Unsupported value of type %T", value)
+	}
+	return nil
+}
+
+func writeCanonicalObject(buf *bytes.Buffer, object map[string]interface{}) error {
+	names := make(byUTF16, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Sort(names)
+	buf.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeCanonicalString(buf, name); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		if err := writeCanonical(buf, object[name]); err != nil {
+			return fmt.Errorf("%v: %v", name, err)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func writeCanonicalString(buf *bytes.Buffer, s string) error {
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("Invalid UTF-8 in %q", s)
+	}
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+	return nil
+}
+
+func writeCanonicalNumber(buf *bytes.Buffer, f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Errorf("%v can't be represented in JSON", f)
+	}
+	buf.WriteString(formatECMAScript(f))
+	return nil
+}
+
+// formatECMAScript formats f like ECMAScript's Number.prototype.toString
+// (ECMA-262 section 7.1.12.1), which is what JCS requires.
+func formatECMAScript(f float64) string {
+	if f == 0 {
+		// Also covers negative zero
+		return "0"
+	}
+	// The shortest representation that round trips, as d.ddde±x
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	e := strings.IndexByte(s, 'e')
+	digits := strings.Replace(s[:e], ".", "", 1)
+	exp, _ := strconv.Atoi(s[e+1:])
+	// The value is 0.digits * 10^n
+	n, k := exp+1, len(digits)
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits
+	}
+	mantissa := digits[:1]
+	if k > 1 {
+		mantissa += "." + digits[1:]
+	}
+	expSign := "+"
+	if n-1 < 0 {
+		expSign = "-"
+	}
+	return sign + mantissa + "e" + expSign + strconv.Itoa(abs(n-1))
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// byUTF16 sorts strings by their UTF-16 code units, as JCS requires.
+type byUTF16 []string
+
+func (a byUTF16) Len() int      { return len(a) }
+func (a byUTF16) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byUTF16) Less(i, j int) bool {
+	x, y := utf16.Encode([]rune(a[i])), utf16.Encode([]rune(a[j]))
+	for k := 0; k < len(x) && k < len(y); k++ {
+		if x[k] != y[k] {
+			return x[k] < y[k]
+		}
+	}
+	return len(x) < len(y)
+}
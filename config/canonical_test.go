@@ -0,0 +1,56 @@
+package config
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatECMAScript(t *testing.T) {
+	// From appendix B of RFC 8785
+	for bits, expected := range map[uint64]string{
+		0x0000000000000000: "0",
+		0x8000000000000000: "0",
+		0x0000000000000001: "5e-324",
+		0x8000000000000001: "-5e-324",
+		0x7fefffffffffffff: "1.7976931348623157e+308",
+		0x4340000000000000: "9007199254740992",
+		0xc340000000000000: "-9007199254740992",
+		0x4430000000000000: "295147905179352830000",
+		0x44b52d02c7e14af5: "9.999999999999997e+22",
+		0x44b52d02c7e14af6: "1e+23",
+		0x444b1ae4d6e2ef50: "1e+21",
+		0x444b1ae4d6e2ef4f: "999999999999999900000",
+		0x3eb0c6f7a0b5ed8d: "0.000001",
+		0x3eb0c6f7a0b5ed8c: "9.999999999999997e-7",
+		0x41b3de4355555555: "333333333.3333333",
+	} {
+		assert.Equal(t, expected, formatECMAScript(math.Float64frombits(bits)), "%x", bits)
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	value := map[interface{}]interface{}{
+		"€":          "<&>",
+		"\U0001f600": []interface{}{1, int64(-2), uint64(3), 4.5, true, nil},
+		"｡":          "\"\\\b\f\n\r\t\x01\x1fé",
+		"a":          map[string]interface{}{"b": 1, "a": 2},
+		1:            "one",
+	}
+	canonical, err := canonicalJSON(value)
+	if assert.NoError(t, err) {
+		// Keys are sorted by UTF-16 code units, so U+1F600, which is encoded as
+		// the surrogate pair D83D DE00, sorts before U+FF61.
+		assert.Equal(t, `{"1":"one","a":{"a":2,"b":1},"€":"<&>","😀":[1,-2,3,4.5,true,null],"｡":"\"\\\b\f\n\r\t\u0001\u001fé"}`, string(canonical))
+	}
+
+	_, err = canonicalJSON(map[interface{}]interface{}{1: "a", "1": "b"})
+	assert.Error(t, err, "Keys that stringify the same should fail")
+	_, err = canonicalJSON(math.NaN())
+	assert.Error(t, err, "NaN should fail")
+	_, err = canonicalJSON("\xff")
+	assert.Error(t, err, "Invalid UTF-8 should fail")
+	_, err = canonicalJSON(struct{}{})
+	assert.Error(t, err, "Unsupported types should fail")
+}
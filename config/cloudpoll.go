@@ -0,0 +1,111 @@
+package config
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// maxCloudPollBackoff caps how long we wait between polls after repeated
+	// failures to fetch the cloud config.
+	maxCloudPollBackoff = 30 * time.Minute
+
+	// minCloudPollServerWait and maxCloudPollServerWait limit how long the
+	// server can ask us to wait between polls, so that a bad header can't make
+	// us hammer the server or stop updates for good.
+	minCloudPollServerWait = 30 * time.Second
+	maxCloudPollServerWait = 24 * time.Hour
+
+	// pollFailures is the number of consecutive failed polls. It's only
+	// accessed from the polling goroutine.
+	pollFailures = 0
+)
+
+// nextPollWait determines how long to wait before polling for the cloud
+// config again. If the server said how long to wait with serverWait, that's
+// what we do, within limits, which lets it tune how often clients poll.
+// Otherwise it's CloudConfigPollInterval with some jitter. On consecutive
+// failures we back off exponentially and only wait longer if the server asks
+// for it.
+func nextPollWait(serverWait time.Duration, failed bool) time.Duration {
+	if serverWait > 0 {
+		if serverWait < minCloudPollServerWait {
+			serverWait = minCloudPollServerWait
+		}
+		if serverWait > maxCloudPollServerWait {
+			serverWait = maxCloudPollServerWait
+		}
+	}
+	if failed {
+		pollFailures++
+		wait := backoff(pollFailures)
+		if serverWait > wait {
+			wait = serverWait
+		}
+		return wait
+	}
+	pollFailures = 0
+	if serverWait > 0 {
+		return serverWait
+	}
+	return jitter(CloudConfigPollInterval)
+}
+
+// backoff returns how long to wait after the given number of consecutive
+// failures, doubling CloudConfigPollInterval for each failure after the first
+// up to maxCloudPollBackoff.
+func backoff(failures int) time.Duration {
+	wait := CloudConfigPollInterval
+	for i := 1; i < failures && wait < maxCloudPollBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxCloudPollBackoff {
+		wait = maxCloudPollBackoff
+	}
+	return jitter(wait)
+}
+
+// jitter returns a random duration between d/2 and 3d/2 so that clients
+// don't all poll at the same time.
+func jitter(d time.Duration) time.Duration {
+	return time.Duration((d.Nanoseconds() / 2) + rand.Int63n(d.Nanoseconds()))
+}
+
+// maxAge returns the max-age from the Cache-Control header, or 0 if there
+// isn't one.
+func maxAge(header http.Header) time.Duration {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		if !strings.HasPrefix(directive, "max-age=") {
+			continue
+		}
+		seconds, err := strconv.Atoi(strings.Trim(directive[len("max-age="):], `"`))
+		if err != nil || seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
+
+// retryAfter returns the delay from the Retry-After header, which may either
+// be a number of seconds or an HTTP date, or 0 if there isn't one.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fetcherFunc func(req *http.Request) (*http.Response, error)
+
+func (f fetcherFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestMaxAge(t *testing.T) {
+	header := http.Header{}
+	assert.Equal(t, time.Duration(0), maxAge(header))
+	header.Set("Cache-Control", "public, max-age=600")
+	assert.Equal(t, 10*time.Minute, maxAge(header))
+	header.Set("Cache-Control", "max-age=nonsense")
+	assert.Equal(t, time.Duration(0), maxAge(header))
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC)
+	header := http.Header{}
+	assert.Equal(t, time.Duration(0), retryAfter(header, now))
+	header.Set("Retry-After", "120")
+	assert.Equal(t, 2*time.Minute, retryAfter(header, now))
+	header.Set("Retry-After", now.Add(1*time.Hour).Format(http.TimeFormat))
+	assert.Equal(t, 1*time.Hour, retryAfter(header, now))
+	header.Set("Retry-After", now.Add(-1*time.Hour).Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), retryAfter(header, now), "Dates in the past should be ignored")
+}
+
+func TestNextPollWait(t *testing.T) {
+	defer func() {
+		pollFailures = 0
+	}()
+
+	between := func(wait time.Duration, base time.Duration) bool {
+		return wait >= base/2 && wait < base*3/2
+	}
+	assert.True(t, between(nextPollWait(0, false), CloudConfigPollInterval))
+	assert.True(t, between(nextPollWait(0, true), CloudConfigPollInterval))
+	assert.True(t, between(nextPollWait(0, true), 2*CloudConfigPollInterval), "Should back off")
+	assert.True(t, between(nextPollWait(0, true), 4*CloudConfigPollInterval), "Should back off")
+	for i := 0; i < 10; i++ {
+		nextPollWait(0, true)
+	}
+	assert.True(t, between(nextPollWait(0, true), maxCloudPollBackoff), "Backoff should be capped")
+	assert.True(t, between(nextPollWait(0, false), CloudConfigPollInterval), "Success should reset backoff")
+
+	assert.Equal(t, 3*time.Hour, nextPollWait(3*time.Hour, false), "Should honor server wait")
+	assert.Equal(t, 45*time.Second, nextPollWait(45*time.Second, false), "Server should be able to speed up polling")
+	assert.Equal(t, minCloudPollServerWait, nextPollWait(time.Second, false), "Server wait should have a floor")
+	assert.Equal(t, maxCloudPollServerWait, nextPollWait(1000*time.Hour, true), "Server wait should be capped")
+	for i := 0; i < 10; i++ {
+		nextPollWait(0, true)
+	}
+	assert.True(t, between(nextPollWait(minCloudPollServerWait, true), maxCloudPollBackoff), "Server shouldn't speed up polling after failures")
+}
+
+func TestFetchCloudConfig(t *testing.T) {
+	oldCF := cf
+	defer func() {
+		cf = oldCF
+	}()
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err := gz.Write([]byte("cloudconfig: http://config/cloud.yaml.gz\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+
+	var ifNoneMatchSent string
+	cf = fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		ifNoneMatchSent = req.Header.Get(ifNoneMatch)
+		resp := &http.Response{Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+		switch ifNoneMatchSent {
+		case "":
+			resp.StatusCode = 200
+			resp.Header.Set(etag, "etag-1")
+			resp.Header.Set("Cache-Control", "max-age=300")
+			resp.Body = ioutil.NopCloser(bytes.NewReader(compressed.Bytes()))
+		case "etag-1":
+			resp.StatusCode = 304
+		default:
+			resp.StatusCode = 503
+			resp.Header.Set("Retry-After", "900")
+		}
+		return resp, nil
+	})
+
+	cfg := &Config{}
+	resp, err := cfg.fetchCloudConfig(chainedCloudConfigUrl)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "etag-1", resp.etag)
+		assert.Equal(t, 5*time.Minute, resp.wait)
+		assert.Contains(t, string(resp.bytes), "http://config/cloud.yaml.gz")
+	}
+
+	cfg.CloudConfigETags = map[string]string{chainedCloudConfigUrl: "etag-1"}
+	resp, err = cfg.fetchCloudConfig(chainedCloudConfigUrl)
+	assert.NoError(t, err)
+	assert.Equal(t, "etag-1", ifNoneMatchSent, "Should have sent persisted ETag")
+	assert.Nil(t, resp.bytes, "Unchanged config should not be returned")
+
+	cfg.CloudConfigETags[chainedCloudConfigUrl] = "etag-2"
+	resp, err = cfg.fetchCloudConfig(chainedCloudConfigUrl)
+	assert.Error(t, err)
+	assert.Equal(t, 15*time.Minute, resp.wait, "Should have honored Retry-After")
+}
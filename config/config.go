@@ -6,13 +6,10 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
-	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
-	"sort"
-	"strings"
 	"time"
 
 	"code.google.com/p/go-uuid/uuid"
@@ -26,6 +23,7 @@ import (
 	"github.com/getlantern/yamlconf"
 
 	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/secret"
 	"github.com/getlantern/flashlight/util"
 )
 
@@ -45,26 +43,37 @@ const (
 )
 
 var (
-	log                 = golog.LoggerFor("flashlight.config")
-	m                   *yamlconf.Manager
-	lastCloudConfigETag = map[string]string{}
-	r                   = regexp.MustCompile("\\d+\\.\\d+")
+	log = golog.LoggerFor("flashlight.config")
+	m   *yamlconf.Manager
+	r   = regexp.MustCompile("\\d+\\.\\d+")
+
+	// sticky is true if we ignore cloud updates, see Init
+	sticky bool
+
+	// stopWatchingEdits stops watching the config file for edits, nil if we
+	// aren't watching.
+	stopWatchingEdits func()
 
 	// Request the config via either chained servers or direct fronted servers.
-	cf = util.NewChainedAndFronted(client.Addr)
+	cf util.HTTPFetcher = util.NewChainedAndFronted(client.Addr)
 )
 
 type Config struct {
 	configDir       string
 	Version         int
+	SchemaVersion   int // Version of the layout of the config file, see migrations
 	CloudConfig     string
 	CloudConfigCA   string
 	CpuProfile      string
 	MemProfile      string
 	UpdateServerURL string
 	Client          *client.ClientConfig
-	ProxiedSites    *proxiedsites.Config // List of proxied site domains that get routed through Lantern rather than accessed directly
+	ProxiedSites    *ProxiedSitesConfig // List of proxied site domains that get routed through Lantern rather than accessed directly
 	TrustedCAs      []*CA
+
+	// CloudConfigETags are the ETags of the most recently applied cloud
+	// configs, keyed by URL, so that we don't refetch them after a restart.
+	CloudConfigETags map[string]string
 }
 
 // StartPolling starts the process of polling for new configuration files.
@@ -79,90 +88,10 @@ type CA struct {
 	Cert       string // PEM-encoded
 }
 
-func exists(file string) (os.FileInfo, bool) {
-	if fi, err := os.Stat(file); os.IsNotExist(err) {
-		log.Debugf("File does not exist at %v", file)
-		return fi, false
-	} else {
-		log.Debugf("File exists at %v", file)
-		return fi, true
-	}
-}
-
-// hasCustomChainedServer returns whether or not the config file at the specified
-// path includes a custom chained server or not.
-func hasCustomChainedServer(configPath, name string) bool {
-	if !(strings.HasPrefix(name, "lantern") && strings.HasSuffix(name, ".yaml")) {
-		log.Debugf("File name does not match")
-		return false
-	}
-	bytes, err := ioutil.ReadFile(configPath)
-	if err != nil {
-		log.Errorf("Could not read file %v", err)
-		return false
-	}
-	cfg := &Config{}
-	err = yaml.Unmarshal(bytes, cfg)
-	if err != nil {
-		log.Errorf("Could not unmarshal config %v", err)
-		return false
-	}
-
-	nc := len(cfg.Client.ChainedServers)
-
-	log.Debugf("Found %v chained servers", nc)
-	// The config will have more than one but fewer than 10 chained servers
-	// if it has been given a custom config with a custom chained server
-	// list
-	return nc > 0 && nc < 10
-}
-
-func isGoodConfig(configPath string) bool {
-	log.Debugf("Checking config path: %v", configPath)
-	fi, exists := exists(configPath)
-	return exists && hasCustomChainedServer(configPath, fi.Name())
-}
-
 func majorVersion(version string) string {
 	return r.FindString(version)
 }
 
-// useGoodOldConfig is a one-time function for using older config files in the 2.x series.
-// It returns true if the file specified by configPath is ready, false otherwise.
-func useGoodOldConfig(configDir, configPath string) bool {
-	// If we already have a config file with the latest name, use that one.
-	// Otherwise, copy the most recent config file available.
-	exists := isGoodConfig(configPath)
-	if exists {
-		log.Debugf("Using existing config")
-		return true
-	}
-
-	files, err := ioutil.ReadDir(configDir)
-	if err != nil {
-		log.Errorf("Could not read config dir: %v", err)
-		return false
-	}
-
-	for _, file := range files {
-		if file.IsDir() {
-			continue
-		}
-		name := file.Name()
-		path := filepath.Join(configDir, name)
-		if isGoodConfig(path) {
-			// Just use the old config since configs in the 2.x series haven't changed.
-			if err := os.Rename(path, configPath); err != nil {
-				log.Errorf("Could not rename file from %v to %v: %v", path, configPath, err)
-			} else {
-				log.Debugf("Copied old config at %v to %v", path, configPath)
-				return true
-			}
-		}
-	}
-	return false
-}
-
 // Init initializes the configuration system.
 //
 // version - the version of lantern
@@ -171,20 +100,36 @@ func useGoodOldConfig(configDir, configPath string) bool {
 //         to the config.
 func Init(version string, configDir string, stickyConfig bool, flags map[string]interface{}) (*Config, error) {
 	file := "lantern-" + version + ".yaml"
-	_, configPath, err := inConfigDir(configDir, file)
+	cdir, configPath, err := inConfigDir(configDir, file)
 	if err != nil {
 		log.Errorf("Could not get config path? %v", err)
 		return nil, err
 	}
-	run := isGoodConfig(configPath)
+	overrideFlags = flags
+	sticky = stickyConfig
+	cloudConfigCachePath = filepath.Join(cdir, cloudConfigCacheFile)
+	keyCreated, err := secret.Configure(cdir)
+	if err != nil {
+		log.Errorf("Unable to configure encryption of secrets, they will be stored in plaintext: %v", err)
+	}
+	run := prepareConfigFile(cdir, configPath)
 	if !run {
-		// If this is our first run of this version of Lantern, use the embedded configuration
+		// If this is our first run of Lantern, use the embedded configuration
 		// file and use it to download our custom config file on this first poll for our
 		// config.
 		if err := MakeInitialConfig(configPath); err != nil {
 			return nil, err
 		}
+		if err := migrateFile(configPath, false); err != nil {
+			log.Errorf("Unable to migrate bootstrap config: %v", err)
+		}
+	}
+	if keyCreated || !run {
+		// The file likely contains plaintext secrets, either because it predates
+		// encryption or because it was just created from the bootstrap config.
+		encryptSecrets(configPath)
 	}
+	initOrigin(configPath, stickyConfig)
 
 	m = &yamlconf.Manager{
 		FilePath: configPath,
@@ -193,7 +138,8 @@ func Init(version string, configDir string, stickyConfig bool, flags map[string]
 		},
 		PerSessionSetup: func(ycfg yamlconf.Config) error {
 			cfg := ycfg.(*Config)
-			return cfg.applyFlags(flags)
+			cfg.recordSources(configPath)
+			return cfg.applyOverrides(flags)
 		},
 		CustomPoll: func(ycfg yamlconf.Config) (mutate func(yamlconf.Config) error, waitTime time.Duration, err error) {
 			return pollForConfig(ycfg, stickyConfig)
@@ -206,6 +152,14 @@ func Init(version string, configDir string, stickyConfig bool, flags map[string]
 		log.Errorf("Error initializing config: %v", err)
 	} else {
 		cfg = initial.(*Config)
+		if stickyConfig {
+			// With sticky config, the local file is the only source of updates, so
+			// apply any edits to it without requiring a restart.
+			if stopWatchingEdits != nil {
+				stopWatchingEdits()
+			}
+			stopWatchingEdits = watchForEdits(configPath)
+		}
 	}
 	log.Debug("Returning config")
 	return cfg, err
@@ -230,23 +184,110 @@ func pollForConfig(currentCfg yamlconf.Config, stickyConfig bool) (mutate func(y
 		return mutate, waitTime, nil
 	}
 
-	if bytes, err := cfg.fetchCloudConfig(chainedCloudConfigUrl); err == nil {
-		// bytes will be nil if the config is unchanged (not modified)
-		if bytes != nil {
-			//log.Debugf("Downloaded config:\n %v", string(bytes))
-			mutate = func(ycfg yamlconf.Config) error {
-				log.Debugf("Merging cloud configuration")
-				cfg := ycfg.(*Config)
-				return cfg.updateFrom(bytes)
-			}
-		}
-	} else {
-		log.Errorf("Could not fetch cloud config %v", err)
+	url := chainedCloudConfigUrl
+	resp, err := cfg.fetchCloudConfig(url)
+	waitTime = nextPollWait(resp.wait, err != nil)
+	if err != nil {
+		log.Errorf("Could not fetch cloud config, trying again in %v: %v", waitTime, err)
 		return mutate, waitTime, err
 	}
+	cloudChecked(url)
+	// bytes will be nil if the config is unchanged (not modified)
+	if resp.bytes != nil {
+		mutate = applyCloudConfig(url, resp)
+	}
 	return mutate, waitTime, nil
 }
 
+// applyCloudConfig returns a mutator that merges the cloud config fetched from
+// the given url into the current config.
+func applyCloudConfig(url string, resp *cloudConfigResponse) func(yamlconf.Config) error {
+	return func(ycfg yamlconf.Config) error {
+		log.Debugf("Merging cloud configuration")
+		cfg := ycfg.(*Config)
+		if err := cfg.updateFrom(resp.bytes); err != nil {
+			return err
+		}
+		// Only remember the ETag once the config has been applied, so that we
+		// fetch it again if it was rejected.
+		if resp.etag != "" {
+			if cfg.CloudConfigETags == nil {
+				cfg.CloudConfigETags = make(map[string]string)
+			}
+			cfg.CloudConfigETags[url] = resp.etag
+		}
+		if resp.canonical != nil {
+			rememberCloudConfig(resp.canonical)
+		}
+		configChanged()
+		// Make sure that overrides still take precedence over the cloud
+		return cfg.applyOverrides(overrideFlags)
+	}
+}
+
+// Refresh checks for an updated cloud config right away instead of waiting
+// for the next poll.
+func Refresh() error {
+	if sticky {
+		return fmt.Errorf("Not refreshing cloud config with sticky config flag set")
+	}
+	var current *Config
+	err := Update(func(cfg *Config) error {
+		copied := *cfg
+		current = &copied
+		// Don't actually change anything
+		return errUnchanged
+	})
+	if err != errUnchanged {
+		return fmt.Errorf("Unable to get current config: %v", err)
+	}
+	if current.CloudConfig == "" {
+		return fmt.Errorf("No cloud config URL")
+	}
+
+	url := chainedCloudConfigUrl
+	resp, err := current.fetchCloudConfig(url)
+	if err != nil {
+		return err
+	}
+	cloudChecked(url)
+	if resp.bytes == nil {
+		log.Debugf("Cloud config unchanged")
+		return nil
+	}
+	return m.Update(applyCloudConfig(url, resp))
+}
+
+// encryptSecrets rewrites the config file at the given path so that any
+// plaintext secrets in it get encrypted, and makes sure that it's only
+// readable by the current user.
+func encryptSecrets(configPath string) {
+	bytes, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		log.Errorf("Unable to read config to encrypt secrets: %v", err)
+		return
+	}
+	cfg := &Config{}
+	if err := yaml.Unmarshal(bytes, cfg); err != nil {
+		log.Errorf("Unable to parse config to encrypt secrets: %v", err)
+		return
+	}
+	// Marshaling encrypts the secrets, see client.ClientConfig.MarshalYAML
+	encrypted, err := yaml.Marshal(cfg)
+	if err != nil {
+		log.Errorf("Unable to marshal config with encrypted secrets: %v", err)
+		return
+	}
+	if err := ioutil.WriteFile(configPath, encrypted, 0600); err != nil {
+		log.Errorf("Unable to write config with encrypted secrets: %v", err)
+		return
+	}
+	if err := os.Chmod(configPath, 0600); err != nil {
+		log.Errorf("Unable to restrict permissions on config: %v", err)
+	}
+	log.Debugf("Encrypted secrets in %v", configPath)
+}
+
 // Run runs the configuration system.
 func Run(updateHandler func(updated *Config)) error {
 	for {
@@ -264,11 +305,7 @@ func Update(mutate func(cfg *Config) error) error {
 }
 
 func inConfigDir(configDir string, filename string) (string, string, error) {
-	cdir := configDir
-
-	if cdir == "" {
-		cdir = appdir.General("Lantern")
-	}
+	cdir := resolveConfigDir(configDir)
 
 	log.Debugf("Using config dir %v", cdir)
 	if _, err := os.Stat(cdir); err != nil {
@@ -283,6 +320,15 @@ func inConfigDir(configDir string, filename string) (string, string, error) {
 	return cdir, filepath.Join(cdir, filename), nil
 }
 
+// resolveConfigDir returns the given config dir, or the default one if it's
+// blank, without creating it.
+func resolveConfigDir(configDir string) string {
+	if configDir == "" {
+		return appdir.General("Lantern")
+	}
+	return configDir
+}
+
 func (cfg *Config) GetTrustedCACerts() (pool *x509.CertPool, err error) {
 	certs := make([]string, 0, len(cfg.TrustedCAs))
 	for _, ca := range cfg.TrustedCAs {
@@ -305,38 +351,6 @@ func (cfg *Config) SetVersion(version int) {
 	cfg.Version = version
 }
 
-// applyFlags updates this Config from any command-line flags that were passed
-// in.
-func (updated *Config) applyFlags(flags map[string]interface{}) error {
-	if updated.Client == nil {
-		updated.Client = &client.ClientConfig{}
-	}
-
-	var visitErr error
-
-	// Visit all flags that have been set and copy to config
-	for key, value := range flags {
-		switch key {
-		// General
-		case "cloudconfig":
-			updated.CloudConfig = value.(string)
-		case "cloudconfigca":
-			updated.CloudConfigCA = value.(string)
-		case "instanceid":
-			updated.Client.DeviceID = value.(string)
-		case "cpuprofile":
-			updated.CpuProfile = value.(string)
-		case "memprofile":
-			updated.MemProfile = value.(string)
-		}
-	}
-	if visitErr != nil {
-		return visitErr
-	}
-
-	return nil
-}
-
 // ApplyDefaults implements the method from interface yamlconf.Config
 //
 // ApplyDefaults populates default values on a Config to make sure that we have
@@ -344,6 +358,10 @@ func (updated *Config) applyFlags(flags map[string]interface{}) error {
 // flashlight, this function should be updated to provide sensible defaults for
 // those settings.
 func (cfg *Config) ApplyDefaults() {
+	if cfg.SchemaVersion == 0 {
+		cfg.SchemaVersion = CurrentSchemaVersion
+	}
+
 	if cfg.UpdateServerURL == "" {
 		cfg.UpdateServerURL = "https://update.getlantern.org"
 	}
@@ -358,7 +376,7 @@ func (cfg *Config) ApplyDefaults() {
 
 	if cfg.ProxiedSites == nil {
 		log.Debugf("Adding empty proxiedsites")
-		cfg.ProxiedSites = &proxiedsites.Config{
+		cfg.ProxiedSites = &ProxiedSitesConfig{
 			Delta: &proxiedsites.Delta{
 				Additions: []string{},
 				Deletions: []string{},
@@ -372,6 +390,16 @@ func (cfg *Config) ApplyDefaults() {
 		cfg.ProxiedSites.Cloud = defaultProxiedSites
 	}
 
+	if cfg.ProxiedSites.CloudByCountry == nil || len(cfg.ProxiedSites.CloudByCountry) == 0 {
+		log.Debugf("Loading default country-specific cloud proxiedsites")
+		cfg.ProxiedSites.CloudByCountry = defaultProxiedSitesByCountry
+	}
+
+	if cfg.ProxiedSites.CloudExclusionsByCountry == nil || len(cfg.ProxiedSites.CloudExclusionsByCountry) == 0 {
+		log.Debugf("Loading default country-specific cloud proxiedsites exclusions")
+		cfg.ProxiedSites.CloudExclusionsByCountry = defaultProxiedSiteExclusionsByCountry
+	}
+
 	if cfg.TrustedCAs == nil || len(cfg.TrustedCAs) == 0 {
 		cfg.TrustedCAs = defaultTrustedCAs
 	}
@@ -460,22 +488,50 @@ func (cfg *Config) applyClientDefaults() {
 }
 
 func (cfg Config) cloudPollSleepTime() time.Duration {
-	return time.Duration((CloudConfigPollInterval.Nanoseconds() / 2) + rand.Int63n(CloudConfigPollInterval.Nanoseconds()))
+	return jitter(CloudConfigPollInterval)
+}
+
+// cloudConfigResponse is the result of fetching the cloud config.
+type cloudConfigResponse struct {
+	// bytes is the uncompressed config, or nil if it's unchanged
+	bytes []byte
+	// canonical is the canonical form of the config, see canonicalCloudConfig
+	canonical []byte
+	etag      string
+	// wait is how long the server asked us to wait before polling again, or 0
+	// if it didn't say.
+	wait time.Duration
 }
 
-func (cfg *Config) fetchCloudConfig(url string) ([]byte, error) {
+// fetchCloudConfig fetches the cloud config at the given url. If we have the
+// previously applied cloud config, the server may respond with a delta to it
+// instead of the full config. The returned response is never nil, even if
+// there was an error.
+func (cfg *Config) fetchCloudConfig(url string) (*cloudConfigResponse, error) {
+	return cfg.fetchCloudConfigFrom(url, getLastCloudConfig())
+}
+
+// fetchCloudConfigFrom fetches the cloud config at the given url, accepting a
+// delta to base if base isn't nil.
+func (cfg *Config) fetchCloudConfigFrom(url string, base []byte) (*cloudConfigResponse, error) {
+	result := &cloudConfigResponse{}
 	cb := "?" + uuid.New()
 	nocache := url + cb
 	req, err := http.NewRequest("GET", nocache, nil)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to construct request for cloud config at %s: %s", nocache, err)
+		return result, fmt.Errorf("Unable to construct request for cloud config at %s: %s", nocache, err)
 	}
-	if lastCloudConfigETag[url] != "" {
+	if lastETag := cfg.CloudConfigETags[url]; lastETag != "" {
 		// Don't bother fetching if unchanged
-		req.Header.Set(ifNoneMatch, lastCloudConfigETag[url])
+		req.Header.Set(ifNoneMatch, lastETag)
 	}
 
-	req.Header.Set("Accept", "application/x-gzip")
+	if base != nil {
+		req.Header.Set(configHash, hashCloudConfig(base))
+		req.Header.Set("Accept", "application/x-gzip, "+mergePatchType+", "+jsonPatchType)
+	} else {
+		req.Header.Set("Accept", "application/x-gzip")
+	}
 	// Prevents intermediate nodes (domain-fronters) from caching the content
 	req.Header.Set("Cache-Control", "no-cache")
 	// Set the fronted URL to lookup the config in parallel using chained and domain fronted servers.
@@ -488,7 +544,7 @@ func (cfg *Config) fetchCloudConfig(url string) ([]byte, error) {
 
 	resp, err := cf.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to fetch cloud config at %s: %s", url, err)
+		return result, fmt.Errorf("Unable to fetch cloud config at %s: %s", url, err)
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -496,58 +552,102 @@ func (cfg *Config) fetchCloudConfig(url string) ([]byte, error) {
 		}
 	}()
 
+	result.wait = maxAge(resp.Header)
 	if resp.StatusCode == 304 {
 		log.Debugf("Config unchanged in cloud")
-		return nil, nil
+		return result, nil
 	} else if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Unexpected response status: %d", resp.StatusCode)
+		if wait := retryAfter(resp.Header, time.Now()); wait > 0 {
+			result.wait = wait
+		}
+		return result, fmt.Errorf("Unexpected response status: %d", resp.StatusCode)
+	}
+
+	result.etag = resp.Header.Get(etag)
+	contentType := resp.Header.Get("Content-Type")
+	if base != nil && isDelta(contentType) {
+		delta, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return result, fmt.Errorf("Unable to read cloud config delta: %s", err)
+		}
+		canonical, err := applyDelta(base, contentType, delta, resp.Header.Get(configHash))
+		if err != nil {
+			log.Errorf("Falling back to fetching full cloud config: %v", err)
+			return cfg.fetchCloudConfigFrom(url, nil)
+		}
+		log.Debugf("Fetched cloud config delta")
+		result.bytes = yamlSafe(canonical)
+		result.canonical = canonical
+		return result, nil
 	}
 
-	lastCloudConfigETag[url] = resp.Header.Get(etag)
 	gzReader, err := gzip.NewReader(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to open gzip reader: %s", err)
+		return result, fmt.Errorf("Unable to open gzip reader: %s", err)
 	}
 	log.Debugf("Fetched cloud config")
-	return ioutil.ReadAll(gzReader)
+	result.bytes, err = ioutil.ReadAll(gzReader)
+	if err != nil {
+		return result, err
+	}
+	result.canonical, err = canonicalCloudConfig(result.bytes)
+	if err != nil {
+		// updateFrom will reject the config anyway
+		log.Debugf("Unable to canonicalize cloud config: %v", err)
+		result.canonical = nil
+	}
+	return result, nil
 }
 
 // updateFrom creates a new Config by 'merging' the given yaml into this Config.
 // The masquerade sets, the collections of servers, and the trusted CAs in the
-// update yaml  completely replace the ones in the original Config.
+// update yaml  completely replace the ones in the original Config. Updates
+// that don't pass Validate are rejected and leave the Config unchanged.
 func (updated *Config) updateFrom(updateBytes []byte) error {
 	// XXX: does this need a mutex, along with everyone that uses the config?
-	oldDeviceID := updated.Client.DeviceID
-	oldFrontedServers := updated.Client.FrontedServers
-	oldChainedServers := updated.Client.ChainedServers
-	oldMasqueradeSets := updated.Client.MasqueradeSets
-	oldTrustedCAs := updated.TrustedCAs
-	updated.Client.FrontedServers = []*client.FrontedServerInfo{}
-	updated.Client.ChainedServers = map[string]*client.ChainedServerInfo{}
-	updated.Client.MasqueradeSets = map[string][]*fronted.Masquerade{}
-	updated.TrustedCAs = []*CA{}
-	err := yaml.Unmarshal(updateBytes, updated)
+	// Merge into a copy, so that nothing changes unless the update is valid
+	merged, err := updated.deepCopy()
 	if err != nil {
-		updated.Client.FrontedServers = oldFrontedServers
-		updated.Client.ChainedServers = oldChainedServers
-		updated.Client.MasqueradeSets = oldMasqueradeSets
-		updated.TrustedCAs = oldTrustedCAs
+		return err
+	}
+	if merged.Client != nil {
+		merged.Client.FrontedServers = []*client.FrontedServerInfo{}
+		merged.Client.ChainedServers = map[string]*client.ChainedServerInfo{}
+		merged.Client.MasqueradeSets = map[string][]*fronted.Masquerade{}
+	}
+	merged.TrustedCAs = []*CA{}
+	if merged.ProxiedSites != nil {
+		merged.ProxiedSites.CloudByCountry = map[string][]string{}
+		merged.ProxiedSites.CloudExclusionsByCountry = map[string][]string{}
+	}
+	if err := yaml.Unmarshal(updateBytes, merged); err != nil {
 		return fmt.Errorf("Unable to unmarshal YAML for update: %s", err)
 	}
-	// Deduplicate global proxiedsites
-	if len(updated.ProxiedSites.Cloud) > 0 {
-		wlDomains := make(map[string]bool)
-		for _, domain := range updated.ProxiedSites.Cloud {
-			wlDomains[domain] = true
-		}
-		updated.ProxiedSites.Cloud = make([]string, 0, len(wlDomains))
-		for domain, _ := range wlDomains {
-			updated.ProxiedSites.Cloud = append(updated.ProxiedSites.Cloud, domain)
-		}
-		sort.Strings(updated.ProxiedSites.Cloud)
+	if err := merged.Validate(); err != nil {
+		return fmt.Errorf("Rejecting invalid update: %v", err)
+	}
+	// Deduplicate global and country-specific proxiedsites
+	if merged.ProxiedSites != nil {
+		merged.ProxiedSites.normalize()
 	}
 
 	// Ignore DeviceID from yaml
-	updated.Client.DeviceID = oldDeviceID
+	if merged.Client != nil && updated.Client != nil {
+		merged.Client.DeviceID = updated.Client.DeviceID
+	}
+	*updated = *merged
 	return nil
 }
+
+// deepCopy copies the Config by way of yaml, like it's saved.
+func (cfg *Config) deepCopy() (*Config, error) {
+	bytes, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal config: %v", err)
+	}
+	copied := &Config{configDir: cfg.configDir}
+	if err := yaml.Unmarshal(bytes, copied); err != nil {
+		return nil, fmt.Errorf("Unable to unmarshal config: %v", err)
+	}
+	return copied, nil
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"sync"
+
+	"github.com/evanphx/json-patch"
+	"github.com/getlantern/yaml"
+
+	"github.com/getlantern/flashlight/secret"
+)
+
+const (
+	// configHash is the header in which we send the hash of the cloud config we
+	// currently have, and in which the server sends the hash of the config that
+	// results from applying the delta it returns.
+	configHash = "X-Lantern-Config-Hash"
+
+	mergePatchType = "application/merge-patch+json" // RFC 7386
+	jsonPatchType  = "application/json-patch+json"  // RFC 6902
+
+	cloudConfigCacheFile = "cloudconfig.json"
+)
+
+var (
+	// cloudConfigCachePath is where we keep the most recently applied cloud
+	// config, which is the base to which deltas are applied.
+	cloudConfigCachePath string
+
+	// lastCloudConfig is the canonical form of the most recently applied cloud
+	// config, or nil if we don't have one.
+	lastCloudConfig   []byte
+	lastCloudConfigMx sync.Mutex
+)
+
+// canonicalCloudConfig converts the given YAML cloud config into its canonical
+// form, which is its JSON data model encoded with canonicalJSON. This is what
+// the server hashes to fill in configHash, so see "Cloud config deltas" in the
+// README before changing it.
+//
+// Note that the data model is what the YAML 1.1 parser makes of the config, so
+// an unquoted yes is hashed as true and an unquoted 010 as 8. Servers that
+// compute hashes with a different YAML parser should serve configs in which
+// such scalars are quoted.
+func canonicalCloudConfig(yamlBytes []byte) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(yamlBytes, &doc); err != nil {
+		return nil, fmt.Errorf("Unable to parse cloud config: %v", err)
+	}
+	canonical, err := canonicalJSON(doc)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to convert cloud config to JSON: %v", err)
+	}
+	return canonical, nil
+}
+
+// yamlSafe returns a copy of the given canonical cloud config in which the
+// characters that YAML doesn't allow in a document, or folds like line breaks,
+// are escaped so that updateFrom reads the same values that were hashed. Such
+// characters can only appear inside of JSON strings, where escaping them
+// doesn't change the value.
+func yamlSafe(canonical []byte) []byte {
+	var buf bytes.Buffer
+	for _, r := range string(canonical) {
+		if yamlPrintable(r) {
+			buf.WriteRune(r)
+		} else {
+			fmt.Fprintf(&buf, `\u%04x`, r)
+		}
+	}
+	return buf.Bytes()
+}
+
+// yamlPrintable returns true if the given character can appear literally in a
+// double quoted YAML scalar (see c-printable in the YAML 1.1 spec).
+func yamlPrintable(r rune) bool {
+	switch {
+	case r == '\t' || r == '\n' || r == '\r':
+		return true
+	case r == 0x85 || r == 0x2028 || r == 0x2029:
+		// Next line and line and paragraph separators are line breaks to YAML
+		return false
+	case r >= 0x20 && r <= 0x7e:
+		return true
+	case r >= 0xa0 && r <= 0xd7ff, r >= 0xe000 && r <= 0xfffd:
+		return true
+	}
+	return r >= 0x10000 && r <= 0x10ffff
+}
+
+// hashCloudConfig returns the hex encoded SHA-256 hash of the given canonical
+// cloud config.
+func hashCloudConfig(canonical []byte) string {
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])
+}
+
+// isDelta returns true if the given Content-Type is that of a delta we know
+// how to apply.
+func isDelta(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == mergePatchType || mediaType == jsonPatchType)
+}
+
+// applyDelta applies the delta with the given Content-Type to the canonical
+// cloud config in base and checks that the result has the expected hash. It
+// returns the updated config in canonical form.
+func applyDelta(base []byte, contentType string, delta []byte, expectedHash string) ([]byte, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse Content-Type %v: %v", contentType, err)
+	}
+	var patched []byte
+	switch mediaType {
+	case mergePatchType:
+		patched, err = jsonpatch.MergePatch(base, delta)
+	case jsonPatchType:
+		var patch jsonpatch.Patch
+		patch, err = jsonpatch.DecodePatch(delta)
+		if err == nil {
+			patched, err = patch.Apply(base)
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported delta type %v", mediaType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to apply %v delta: %v", mediaType, err)
+	}
+
+	// Re-encode to make sure that the result is canonical before hashing.
+	var doc interface{}
+	if err := json.Unmarshal(patched, &doc); err != nil {
+		return nil, fmt.Errorf("Delta produced invalid JSON: %v", err)
+	}
+	canonical, err := canonicalJSON(doc)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to encode patched config: %v", err)
+	}
+	if hash := hashCloudConfig(canonical); hash != expectedHash {
+		return nil, fmt.Errorf("Hash of patched config %v does not match expected hash %q", hash, expectedHash)
+	}
+	return canonical, nil
+}
+
+// getLastCloudConfig returns the canonical form of the most recently applied
+// cloud config, loading it from disk if necessary, or nil if there isn't one.
+func getLastCloudConfig() []byte {
+	lastCloudConfigMx.Lock()
+	defer lastCloudConfigMx.Unlock()
+	if lastCloudConfig == nil && cloudConfigCachePath != "" {
+		encrypted, err := ioutil.ReadFile(cloudConfigCachePath)
+		if err != nil {
+			log.Debugf("No cached cloud config to apply deltas to: %v", err)
+			return nil
+		}
+		decrypted, err := secret.Decrypt(string(encrypted))
+		if err != nil {
+			log.Errorf("Unable to decrypt cached cloud config at %v: %v", cloudConfigCachePath, err)
+			return nil
+		}
+		var doc interface{}
+		if err := json.Unmarshal([]byte(decrypted), &doc); err != nil {
+			log.Errorf("Ignoring corrupt cached cloud config at %v: %v", cloudConfigCachePath, err)
+			return nil
+		}
+		lastCloudConfig = []byte(decrypted)
+	}
+	return lastCloudConfig
+}
+
+// rememberCloudConfig records the canonical form of a newly applied cloud
+// config so that future deltas can be applied to it. Since the cloud config
+// includes the auth tokens of chained servers, the cached copy is encrypted.
+func rememberCloudConfig(canonical []byte) {
+	lastCloudConfigMx.Lock()
+	defer lastCloudConfigMx.Unlock()
+	lastCloudConfig = canonical
+	if cloudConfigCachePath == "" {
+		return
+	}
+	encrypted := secret.MustEncrypt(string(canonical))
+	if err := ioutil.WriteFile(cloudConfigCachePath, []byte(encrypted), 0600); err != nil {
+		log.Errorf("Unable to cache cloud config at %v: %v", cloudConfigCachePath, err)
+	}
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/getlantern/yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalCloudConfig(t *testing.T) {
+	a, err := canonicalCloudConfig([]byte("client:\n  minqos: 5\n  proxiedconnectports: [80, 443]\ncloudconfig: http://a\n"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	b, err := canonicalCloudConfig([]byte("cloudconfig: http://a\nclient:\n  proxiedconnectports:\n  - 80\n  - 443\n  minqos: 5\n"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `{"client":{"minqos":5,"proxiedconnectports":[80,443]},"cloudconfig":"http://a"}`, string(a))
+	assert.Equal(t, hashCloudConfig(a), hashCloudConfig(b), "Formatting should not affect hash")
+}
+
+// TestCloudConfigHashVector is the test vector for the hash documented under
+// "Cloud config deltas" in the README. If it needs to change, so does the
+// server.
+func TestCloudConfigHashVector(t *testing.T) {
+	input := `
+name: Café
+client:
+  weight: 0.5
+  proxiedsites: [example.com]
+  minqos: 5
+  huge: 1.0e+21
+cloudconfig: "https://config.getiantem.org/cloud.yaml.gz?a=1&b=<2>"
+enabled: yes
+nothing: ~
+`
+	canonical, err := canonicalCloudConfig([]byte(input))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, `{"client":{"huge":1e+21,"minqos":5,"proxiedsites":["example.com"],"weight":0.5},"cloudconfig":"https://config.getiantem.org/cloud.yaml.gz?a=1&b=<2>","enabled":true,"name":"Café","nothing":null}`, string(canonical))
+	assert.Equal(t, "2e83f56889fcd89225379a32c1d2cbbd0fbf58b727c1905f05ff649bb3561cde", hashCloudConfig(canonical))
+}
+
+func TestYAMLSafe(t *testing.T) {
+	canonical, err := canonicalJSON(map[string]interface{}{"a": "x\u2028y\u0085z\u007f"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	safe := yamlSafe(canonical)
+	assert.Equal(t, `{"a":"x\u2028y\u0085z\u007f"}`, string(safe))
+	var doc map[string]string
+	if assert.NoError(t, yaml.Unmarshal(safe, &doc)) {
+		assert.Equal(t, "x\u2028y\u0085z\u007f", doc["a"], "YAML should read what was hashed")
+	}
+}
+
+func TestApplyDelta(t *testing.T) {
+	base := []byte(`{"client":{"minqos":5,"proxiedconnectports":[80,443]},"cloudconfig":"http://a"}`)
+	expected := []byte(`{"client":{"minqos":5,"proxiedconnectports":[80,443,8080]}}`)
+	hash := hashCloudConfig(expected)
+
+	merge := []byte(`{"cloudconfig":null,"client":{"proxiedconnectports":[80,443,8080]}}`)
+	patched, err := applyDelta(base, mergePatchType, merge, hash)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(expected), string(patched))
+	}
+
+	patch := []byte(`[{"op":"remove","path":"/cloudconfig"},{"op":"add","path":"/client/proxiedconnectports/-","value":8080}]`)
+	patched, err = applyDelta(base, jsonPatchType+"; charset=utf-8", patch, hash)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(expected), string(patched))
+	}
+
+	_, err = applyDelta(base, mergePatchType, merge, hashCloudConfig(base))
+	assert.Error(t, err, "Hash mismatch should fail")
+	_, err = applyDelta(base, jsonPatchType, []byte(`[{"op":"remove","path":"/nonexistent"}]`), hash)
+	assert.Error(t, err, "Invalid patch should fail")
+}
+
+func TestFetchCloudConfigDelta(t *testing.T) {
+	oldCF := cf
+	oldPath := cloudConfigCachePath
+	defer func() {
+		cf = oldCF
+		cloudConfigCachePath = oldPath
+		lastCloudConfig = nil
+	}()
+	cloudConfigCachePath = ""
+
+	base := []byte(`{"cloudconfig":"http://a"}`)
+	full := []byte(`{"cloudconfig":"http://full"}`)
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err := gz.Write(full)
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+
+	deltaHash := hashCloudConfig([]byte(`{"cloudconfig":"http://b"}`))
+	var sentHashes []string
+	cf = fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		sentHashes = append(sentHashes, req.Header.Get(configHash))
+		resp := &http.Response{StatusCode: 200, Header: http.Header{}}
+		if req.Header.Get(configHash) == hashCloudConfig(base) {
+			resp.Header.Set("Content-Type", mergePatchType)
+			resp.Header.Set(configHash, deltaHash)
+			resp.Body = ioutil.NopCloser(bytes.NewReader([]byte(`{"cloudconfig":"http://b"}`)))
+		} else {
+			resp.Header.Set("Content-Type", "application/x-gzip")
+			resp.Body = ioutil.NopCloser(bytes.NewReader(compressed.Bytes()))
+		}
+		return resp, nil
+	})
+
+	cfg := &Config{}
+	rememberCloudConfig(base)
+	resp, err := cfg.fetchCloudConfig(chainedCloudConfigUrl)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"cloudconfig":"http://b"}`, string(resp.bytes), "Should have applied delta")
+		assert.Equal(t, []string{hashCloudConfig(base)}, sentHashes)
+	}
+
+	// Pretend that the server computed the hash differently
+	sentHashes = nil
+	deltaHash = "bogus"
+	resp, err = cfg.fetchCloudConfig(chainedCloudConfigUrl)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(full), string(resp.bytes), "Should have fallen back to full fetch")
+		assert.Equal(t, string(full), string(resp.canonical))
+		assert.Equal(t, []string{hashCloudConfig(base), ""}, sentHashes)
+	}
+}
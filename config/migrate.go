@@ -0,0 +1,277 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getlantern/yaml"
+)
+
+const (
+	// CurrentSchemaVersion is the schema version of config files written by
+	// this version of flashlight. Whenever the layout of Config changes in a way
+	// that old files can't simply be unmarshaled into, bump this and add a
+	// migration to the end of migrations.
+	CurrentSchemaVersion = 2
+
+	schemaVersionKey = "schemaversion"
+)
+
+// migration upgrades the generic YAML representation of a config file from
+// schema version to-1 to schema version to. Migrations work on the generic
+// tree rather than on Config because old files may not unmarshal into the
+// current Config at all.
+type migration struct {
+	to          int
+	description string
+	migrate     func(tree yamlTree) error
+}
+
+// migrations lists all migrations in the order in which they're applied.
+var migrations = []*migration{
+	&migration{1, "drop cloud config URLs that point at retired S3 buckets", dropRetiredCloudConfig},
+	&migration{2, "drop fronted servers using the retired cloudflare masquerade set", dropCloudflare},
+}
+
+// dropRetiredCloudConfig removes cloudconfig URLs pointing at the
+// version-specific files in the lantern_config S3 bucket, which are no longer
+// updated, so that the default cloud config URL gets used instead.
+func dropRetiredCloudConfig(tree yamlTree) error {
+	if url, ok := tree["cloudconfig"].(string); ok && strings.Contains(url, "s3.amazonaws.com/lantern_config/") {
+		log.Debugf("Dropping retired cloud config URL %v", url)
+		delete(tree, "cloudconfig")
+	}
+	return nil
+}
+
+// dropCloudflare removes the cloudflare masquerade set and any fronted
+// servers that use it. Domain fronting through cloudflare stopped working
+// long ago, and leaving these in place keeps ApplyDefaults from adding
+// working servers.
+func dropCloudflare(tree yamlTree) error {
+	client, err := tree.child("client")
+	if err != nil {
+		return err
+	}
+	if sets, ok := client["masqueradesets"].(map[interface{}]interface{}); ok {
+		delete(sets, "cloudflare")
+	}
+	servers, ok := client["frontedservers"].([]interface{})
+	if !ok {
+		return nil
+	}
+	kept := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		if s, ok := server.(map[interface{}]interface{}); ok && s["masqueradeset"] == "cloudflare" {
+			log.Debugf("Dropping fronted server %v", s["host"])
+			continue
+		}
+		kept = append(kept, server)
+	}
+	client["frontedservers"] = kept
+	return nil
+}
+
+// child returns the map at the given key, creating it if necessary.
+func (tree yamlTree) child(key string) (map[interface{}]interface{}, error) {
+	value, found := tree[key]
+	if !found || value == nil {
+		child := make(map[interface{}]interface{})
+		tree[key] = child
+		return child, nil
+	}
+	child, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected %v to be a map, got %T", key, value)
+	}
+	return child, nil
+}
+
+// schemaVersion returns the schema version of the given tree. Files written
+// before schema versions were introduced are version 0.
+func (tree yamlTree) schemaVersion() (int, error) {
+	value, found := tree[schemaVersionKey]
+	if !found {
+		return 0, nil
+	}
+	version, ok := value.(int)
+	if !ok {
+		return 0, fmt.Errorf("Invalid %v %v", schemaVersionKey, value)
+	}
+	return version, nil
+}
+
+// migrate applies all migrations newer than the tree's schema version and
+// returns the version that the tree was migrated from.
+func (tree yamlTree) migrate() (int, error) {
+	from, err := tree.schemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range migrations {
+		if m.to <= from {
+			continue
+		}
+		log.Debugf("Migrating config to schema version %d: %v", m.to, m.description)
+		if err := m.migrate(tree); err != nil {
+			return from, fmt.Errorf("Unable to migrate config to schema version %d: %v", m.to, err)
+		}
+		tree[schemaVersionKey] = m.to
+	}
+	return from, nil
+}
+
+// migrateFile upgrades the config file at the given path to the current schema
+// version in place. If backup is true, the original file is kept alongside as
+// <path>.v<N>.bak, where N is the schema version it was migrated from. Files
+// written by a newer version of flashlight are left alone.
+func migrateFile(path string, backup bool) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Unable to read config file: %v", err)
+	}
+	tree, err := parseYAMLTree(bytes)
+	if err != nil {
+		return fmt.Errorf("Unable to parse config file: %v", err)
+	}
+	version, err := tree.schemaVersion()
+	if err != nil {
+		return err
+	}
+	if version >= CurrentSchemaVersion {
+		if version > CurrentSchemaVersion {
+			log.Debugf("Config at %v has newer schema version %d, not migrating", path, version)
+		}
+		return nil
+	}
+
+	if _, err := tree.migrate(); err != nil {
+		return err
+	}
+	migrated, err := yaml.Marshal(map[interface{}]interface{}(tree))
+	if err != nil {
+		return fmt.Errorf("Unable to marshal migrated config: %v", err)
+	}
+	if backup {
+		backupPath := fmt.Sprintf("%v.v%d.bak", path, version)
+		if err := ioutil.WriteFile(backupPath, bytes, 0600); err != nil {
+			return fmt.Errorf("Unable to back up config to %v: %v", backupPath, err)
+		}
+		log.Debugf("Backed up config to %v", backupPath)
+	}
+	if err := ioutil.WriteFile(path, migrated, 0600); err != nil {
+		return fmt.Errorf("Unable to write migrated config: %v", err)
+	}
+	log.Debugf("Migrated config at %v from schema version %d to %d", path, version, CurrentSchemaVersion)
+	return nil
+}
+
+// prepareConfigFile makes sure that there's a config file in the current
+// schema at configPath. If there isn't a file there yet, the most recently
+// modified config file from a previous version of Lantern in configDir is
+// copied and migrated. It returns false if there's no usable config file, in
+// which case the caller should create one from the bootstrap config.
+func prepareConfigFile(configDir string, configPath string) bool {
+	if _, err := os.Stat(configPath); err == nil {
+		if err := migrateFile(configPath, true); err != nil {
+			// Keep the file around so that the user's settings can be recovered
+			// by hand.
+			backupPath := configPath + ".migration-failed.bak"
+			log.Errorf("Unable to migrate config at %v, moving it to %v: %v", configPath, backupPath, err)
+			if err := os.Rename(configPath, backupPath); err != nil {
+				log.Errorf("Unable to move unmigratable config to %v: %v", backupPath, err)
+			}
+			return false
+		}
+		if err := checkReadable(configPath); err != nil {
+			// Keep the unreadable file around in case the key that it was
+			// encrypted with turns up again.
+			backupPath := configPath + ".unreadable.bak"
+			log.Errorf("Unable to load config at %v, moving it to %v: %v", configPath, backupPath, err)
+			if err := os.Rename(configPath, backupPath); err != nil {
+				log.Errorf("Unable to move unreadable config to %v: %v", backupPath, err)
+			}
+			return false
+		}
+		return true
+	}
+
+	old := previousConfigFiles(configDir, filepath.Base(configPath))
+	for _, path := range old {
+		bytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Errorf("Unable to read old config at %v: %v", path, err)
+			continue
+		}
+		// Copy rather than rename so that the old version keeps working if the
+		// user downgrades.
+		if err := ioutil.WriteFile(configPath, bytes, 0600); err != nil {
+			log.Errorf("Unable to copy old config from %v to %v: %v", path, configPath, err)
+			return false
+		}
+		err = migrateFile(configPath, false)
+		if err == nil {
+			err = checkReadable(configPath)
+		}
+		if err != nil {
+			log.Errorf("Unable to use old config from %v: %v", path, err)
+			if err := os.Remove(configPath); err != nil {
+				log.Errorf("Unable to remove unusable config at %v: %v", configPath, err)
+			}
+			continue
+		}
+		log.Debugf("Using old config from %v", path)
+		return true
+	}
+	return false
+}
+
+// checkReadable checks that the config file at the given path can be loaded,
+// which in particular fails if its secrets were encrypted with a different
+// key than ours.
+func checkReadable(path string) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Unable to read config file: %v", err)
+	}
+	if err := yaml.Unmarshal(bytes, &Config{}); err != nil {
+		return fmt.Errorf("Unable to load config file: %v", err)
+	}
+	return nil
+}
+
+// previousConfigFiles returns the paths of the lantern-<version>.yaml files in
+// configDir other than current, most recently modified first.
+func previousConfigFiles(configDir string, current string) []string {
+	files, err := ioutil.ReadDir(configDir)
+	if err != nil {
+		log.Errorf("Could not read config dir: %v", err)
+		return nil
+	}
+	candidates := make([]os.FileInfo, 0, len(files))
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || name == current || name == overridesFileName {
+			continue
+		}
+		if strings.HasPrefix(name, "lantern-") && strings.HasSuffix(name, ".yaml") {
+			candidates = append(candidates, file)
+		}
+	}
+	sort.Sort(byModTimeDesc(candidates))
+	paths := make([]string, 0, len(candidates))
+	for _, file := range candidates {
+		paths = append(paths, filepath.Join(configDir, file.Name()))
+	}
+	return paths
+}
+
+type byModTimeDesc []os.FileInfo
+
+func (a byModTimeDesc) Len() int           { return len(a) }
+func (a byModTimeDesc) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byModTimeDesc) Less(i, j int) bool { return a[i].ModTime().After(a[j].ModTime()) }
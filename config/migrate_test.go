@@ -0,0 +1,206 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/getlantern/yaml"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/secret"
+)
+
+func TestMigrationsOrdered(t *testing.T) {
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.to, "Migrations should be in order without gaps")
+	}
+	assert.Equal(t, CurrentSchemaVersion, migrations[len(migrations)-1].to, "Last migration should be to the current schema version")
+}
+
+func TestMigrate160(t *testing.T) {
+	cfg, backup, original := migrateFixture(t, "test-lantern-1.6.0.yaml")
+	if cfg == nil {
+		return
+	}
+	assert.Equal(t, original, backup, "Backup should contain original file")
+	assert.Equal(t, CurrentSchemaVersion, cfg.SchemaVersion)
+	assert.Empty(t, cfg.Client.FrontedServers, "Cloudflare fronted servers should be dropped")
+	_, hasCloudflare := cfg.Client.MasqueradeSets["cloudflare"]
+	assert.False(t, hasCloudflare, "Cloudflare masquerades should be dropped")
+	if assert.Len(t, cfg.TrustedCAs, 1, "Trusted CAs should be untouched") {
+		assert.Equal(t, "GlobalSign Root CA", cfg.TrustedCAs[0].CommonName)
+	}
+}
+
+func TestMigrate200Beta3(t *testing.T) {
+	cfg, backup, original := migrateFixture(t, "test-lantern-2.0.0-beta3.yaml")
+	if cfg == nil {
+		return
+	}
+	assert.Equal(t, original, backup, "Backup should contain original file")
+	assert.Equal(t, CurrentSchemaVersion, cfg.SchemaVersion)
+	assert.Equal(t, "", cfg.CloudConfig, "Retired cloud config should be dropped")
+	assert.Empty(t, cfg.Client.FrontedServers, "Cloudflare fronted servers should be dropped")
+	_, hasCloudflare := cfg.Client.MasqueradeSets["cloudflare"]
+	assert.False(t, hasCloudflare, "Cloudflare masquerades should be dropped")
+	if assert.Len(t, cfg.Client.ChainedServers, 1, "Chained servers should be untouched") {
+		assert.Equal(t, "178.62.239.34:443", cfg.Client.ChainedServers["fallback-178.62.239.34"].Addr)
+	}
+	assert.Len(t, cfg.ProxiedSites.Cloud, 5, "Proxied sites should be untouched")
+}
+
+func TestMigrateCurrentUnchanged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "lantern-9.9.9.yaml")
+	current := []byte("schemaversion: 2\ncloudconfig: https://s3.amazonaws.com/lantern_config/custom.yaml.gz\n")
+	assert.NoError(t, ioutil.WriteFile(path, current, 0600))
+	assert.NoError(t, migrateFile(path, true))
+	migrated, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, current, migrated, "File at current schema version should be unchanged")
+	_, err = os.Stat(path + ".v2.bak")
+	assert.True(t, os.IsNotExist(err), "Should not back up file at current schema version")
+}
+
+func TestPrepareConfigFileUsesNewestOldFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name string, content string, age time.Duration) {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+		modTime := time.Now().Add(-1 * age)
+		assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	write("lantern-2.0.0.yaml", "client:\n  deviceid: older\n", 2*time.Hour)
+	write("lantern-2.0.1.yaml", "client:\n  deviceid: newer\n", 1*time.Hour)
+	write(overridesFileName, "cloudconfig: http://overrides\n", 0)
+
+	configPath := filepath.Join(dir, "lantern-2.1.0.yaml")
+	assert.True(t, prepareConfigFile(dir, configPath), "Should have found old config")
+	bytes, err := ioutil.ReadFile(configPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	cfg := &Config{}
+	assert.NoError(t, yaml.Unmarshal(bytes, cfg))
+	assert.Equal(t, "newer", cfg.Client.DeviceID, "Should have used most recent old config")
+	_, err = os.Stat(filepath.Join(dir, "lantern-2.0.1.yaml"))
+	assert.NoError(t, err, "Old config should be left in place")
+
+	emptyDir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(emptyDir)
+	assert.False(t, prepareConfigFile(emptyDir, filepath.Join(emptyDir, "lantern-2.1.0.yaml")), "No config to use")
+}
+
+func TestPrepareConfigFileEncryptedWithDifferentKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	otherDir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(otherDir)
+
+	if _, err := secret.Configure(otherDir); !assert.NoError(t, err) {
+		return
+	}
+	token, err := secret.Encrypt("token")
+	if !assert.NoError(t, err) {
+		return
+	}
+	content := fmt.Sprintf(`schemaversion: %d
+client:
+  chainedservers:
+    fallback:
+      addr: 1.2.3.4:443
+      authtoken: %v
+`, CurrentSchemaVersion, token)
+	configPath := filepath.Join(dir, "lantern-2.1.0.yaml")
+	if !assert.NoError(t, ioutil.WriteFile(configPath, []byte(content), 0600)) {
+		return
+	}
+
+	// Our own key differs from the one that the file was encrypted with
+	if _, err := secret.Configure(dir); !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, prepareConfigFile(dir, configPath), "Config encrypted with different key should be unusable")
+	_, err = os.Stat(configPath)
+	assert.True(t, os.IsNotExist(err), "Unusable config should be moved out of the way")
+	backup, err := ioutil.ReadFile(configPath + ".unreadable.bak")
+	if assert.NoError(t, err, "Unusable config should be kept") {
+		assert.Equal(t, content, string(backup), "Encrypted credentials should be kept intact")
+	}
+}
+
+func TestInitKeepsCorruptConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	corrupt := "client:\n  minqos: [1\n  # edited by hand\n"
+	configPath := filepath.Join(dir, "lantern-2.1.0.yaml")
+	if !assert.NoError(t, ioutil.WriteFile(configPath, []byte(corrupt), 0600)) {
+		return
+	}
+
+	// Init starts over from the bootstrap config, whether or not that works
+	_, _ = Init("2.1.0", dir, false, map[string]interface{}{})
+	backup, err := ioutil.ReadFile(configPath + ".migration-failed.bak")
+	if assert.NoError(t, err, "Config that can't be migrated should be kept") {
+		assert.Equal(t, corrupt, string(backup), "Config should be kept intact")
+	}
+}
+
+// migrateFixture migrates a copy of the given fixture and returns the
+// resulting config along with the contents of the backup and the original.
+func migrateFixture(t *testing.T, fixture string) (*Config, []byte, []byte) {
+	original, err := ioutil.ReadFile(fixture)
+	if !assert.NoError(t, err) {
+		return nil, nil, nil
+	}
+	dir, err := ioutil.TempDir("", "migrate")
+	if !assert.NoError(t, err) {
+		return nil, nil, nil
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "lantern-9.9.9.yaml")
+	if !assert.NoError(t, ioutil.WriteFile(path, original, 0600)) {
+		return nil, nil, nil
+	}
+	if !assert.NoError(t, migrateFile(path, true), "Unable to migrate %v", fixture) {
+		return nil, nil, nil
+	}
+	backup, err := ioutil.ReadFile(path + ".v0.bak")
+	assert.NoError(t, err, "Should have backed up %v", fixture)
+	migrated, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		return nil, nil, nil
+	}
+	cfg := &Config{}
+	if !assert.NoError(t, yaml.Unmarshal(migrated, cfg), "Migrated %v should parse", fixture) {
+		return nil, nil, nil
+	}
+	return cfg, backup, original
+}
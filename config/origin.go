@@ -0,0 +1,61 @@
+package config
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	originMx sync.RWMutex
+	origin   Origin
+)
+
+// Origin describes where the current config came from and how fresh it is.
+type Origin struct {
+	// FilePath is the path of the config file.
+	FilePath string
+	// Sticky indicates that cloud updates are ignored.
+	Sticky bool
+	// CloudURL is the URL from which the cloud config was last fetched, blank
+	// if it hasn't been fetched since Lantern started.
+	CloudURL string
+	// LastChecked is when we last successfully checked for a new cloud
+	// config, whether or not it had changed.
+	LastChecked time.Time
+	// LastChanged is when the config last changed, either through the cloud
+	// or by editing the file.
+	LastChanged time.Time
+}
+
+// CurrentOrigin returns the Origin of the current config.
+func CurrentOrigin() Origin {
+	originMx.RLock()
+	defer originMx.RUnlock()
+	return origin
+}
+
+// initOrigin records the file that the config is loaded from.
+func initOrigin(path string, stickyConfig bool) {
+	originMx.Lock()
+	defer originMx.Unlock()
+	origin = Origin{FilePath: path, Sticky: stickyConfig}
+	if fi, err := os.Stat(path); err == nil {
+		origin.LastChanged = fi.ModTime()
+	}
+}
+
+// cloudChecked records a successful check for a new cloud config at url.
+func cloudChecked(url string) {
+	originMx.Lock()
+	defer originMx.Unlock()
+	origin.CloudURL = url
+	origin.LastChecked = time.Now()
+}
+
+// configChanged records that the config just changed.
+func configChanged() {
+	originMx.Lock()
+	defer originMx.Unlock()
+	origin.LastChanged = time.Now()
+}
@@ -0,0 +1,430 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/getlantern/yaml"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+const (
+	// envPrefix is the prefix of environment variables that override config
+	// values. The remainder of the variable name is the YAML path of the field
+	// with dots replaced by underscores, e.g. FLASHLIGHT_CLIENT_MINQOS.
+	envPrefix = "FLASHLIGHT_"
+
+	// overridesFileName is the name of the optional YAML file in the config
+	// directory whose values override the persisted config.
+	overridesFileName = "lantern-overrides.yaml"
+
+	// overridesFlag is the name of the flag that points at a custom overrides
+	// file.
+	overridesFlag = "overrides"
+)
+
+// The layers from which config values can come, from lowest to highest
+// precedence.
+const (
+	SourceDefault   = "default"
+	SourceBootstrap = "bootstrap"
+	SourceFile      = "file"
+	SourceOverrides = "overrides"
+	SourceEnv       = "env"
+	SourceFlag      = "flag"
+)
+
+var (
+	// flagPaths maps command-line flags to the paths of the fields they set.
+	flagPaths = map[string]string{
+		"cloudconfig":   "cloudconfig",
+		"cloudconfigca": "cloudconfigca",
+		"instanceid":    "client.deviceid",
+		"cpuprofile":    "cpuprofile",
+		"memprofile":    "memprofile",
+	}
+
+	// environ is a variable so that tests can supply their own environment.
+	environ = os.Environ
+
+	// overrideFlags are the flags given to Init, kept so that overrides can be
+	// reapplied on top of cloud config updates.
+	overrideFlags map[string]interface{}
+
+	sources   = make(map[string]string)
+	sourcesMx sync.RWMutex
+)
+
+// field is a single addressable value within a Config, identified by its YAML
+// path (e.g. client.minqos).
+type field struct {
+	path  string
+	value reflect.Value
+}
+
+// fields lists the fields of cfg. Nested structs like Client are descended
+// into, while everything else (including maps and slices) is treated as a
+// single field. Nil nested structs are listed as a single field.
+func fields(cfg *Config) []*field {
+	var result []*field
+	var walk func(prefix string, v reflect.Value)
+	walk = func(prefix string, v reflect.Value) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name := joinPath(prefix, yamlName(t.Field(i)))
+			if name == "" {
+				continue
+			}
+			fv := v.Field(i)
+			if isStruct(fv.Type()) && !(fv.Kind() == reflect.Ptr && fv.IsNil()) {
+				walk(name, reflect.Indirect(fv))
+			} else {
+				result = append(result, &field{name, fv})
+			}
+		}
+	}
+	walk("", reflect.ValueOf(cfg).Elem())
+	return result
+}
+
+// fieldPaths lists the paths of all fields that a Config can have.
+func fieldPaths() []string {
+	var result []string
+	var walk func(prefix string, t reflect.Type)
+	walk = func(prefix string, t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			name := joinPath(prefix, yamlName(t.Field(i)))
+			if name == "" {
+				continue
+			}
+			ft := t.Field(i).Type
+			if isStruct(ft) {
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				walk(name, ft)
+			} else {
+				result = append(result, name)
+			}
+		}
+	}
+	walk("", reflect.TypeOf(Config{}))
+	return result
+}
+
+// fieldAt returns the field of cfg at the given path, or nil if there is none.
+// Nil nested structs along the path are allocated so that the field can be
+// set.
+func fieldAt(cfg *Config, path string) *field {
+	v := reflect.ValueOf(cfg).Elem()
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		var fv reflect.Value
+		t := v.Type()
+		for j := 0; j < t.NumField(); j++ {
+			if yamlName(t.Field(j)) == segment {
+				fv = v.Field(j)
+				break
+			}
+		}
+		if !fv.IsValid() {
+			return nil
+		}
+		if !isStruct(fv.Type()) {
+			if i == len(segments)-1 {
+				return &field{path, fv}
+			}
+			return nil
+		}
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			fv = fv.Elem()
+		}
+		v = fv
+	}
+	// path points at a nested struct rather than a field
+	return nil
+}
+
+// isStruct returns true for struct types and pointers to struct types, which
+// fields, fieldPaths and fieldAt descend into.
+func isStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct || (t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct)
+}
+
+func joinPath(prefix string, name string) string {
+	if name == "" || prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// yamlName returns the name under which the given struct field appears in
+// YAML, or "" if it doesn't.
+func yamlName(sf reflect.StructField) string {
+	if sf.PkgPath != "" {
+		// unexported
+		return ""
+	}
+	tag := strings.Split(sf.Tag.Get("yaml"), ",")[0]
+	if tag == "-" {
+		return ""
+	}
+	if tag != "" {
+		return tag
+	}
+	return strings.ToLower(sf.Name)
+}
+
+// setYAML replaces the value of this field with the given YAML.
+func (f *field) setYAML(b []byte) error {
+	ptr := reflect.New(f.value.Type())
+	if err := yaml.Unmarshal(b, ptr.Interface()); err != nil {
+		return fmt.Errorf("Unable to parse value for %v: %v", f.path, err)
+	}
+	f.value.Set(ptr.Elem())
+	return nil
+}
+
+// setString sets this field from a string, which is used as is for string
+// fields and parsed as YAML for everything else.
+func (f *field) setString(s string) error {
+	if f.value.Kind() == reflect.String {
+		f.value.SetString(s)
+		return nil
+	}
+	return f.setYAML([]byte(s))
+}
+
+// yamlTree is the generic representation of a YAML document.
+type yamlTree map[interface{}]interface{}
+
+func parseYAMLTree(b []byte) (yamlTree, error) {
+	// Note - we unmarshal into a plain map so that nested maps come back as
+	// plain maps too.
+	tree := make(map[interface{}]interface{})
+	if err := yaml.Unmarshal(b, &tree); err != nil {
+		return nil, err
+	}
+	return yamlTree(tree), nil
+}
+
+// lookup returns the value at the given path in the tree, if any.
+func (tree yamlTree) lookup(path string) (interface{}, bool) {
+	var current interface{} = map[interface{}]interface{}(tree)
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[interface{}]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// recordFileSources records for every config field whether it came from the
+// persisted config file, from the bootstrap config that file was originally
+// created from, or from defaults.
+func recordFileSources(persisted []byte, bootstrap []byte) {
+	persistedTree, err := parseYAMLTree(persisted)
+	if err != nil {
+		log.Debugf("Unable to parse persisted config for recording sources: %v", err)
+		persistedTree = make(yamlTree)
+	}
+	bootstrapTree, err := parseYAMLTree(bootstrap)
+	if err != nil {
+		log.Debugf("Unable to parse bootstrap config for recording sources: %v", err)
+		bootstrapTree = make(yamlTree)
+	}
+
+	sourcesMx.Lock()
+	defer sourcesMx.Unlock()
+	for _, path := range fieldPaths() {
+		pv, inPersisted := persistedTree.lookup(path)
+		if !inPersisted {
+			sources[path] = SourceDefault
+			continue
+		}
+		bv, inBootstrap := bootstrapTree.lookup(path)
+		if inBootstrap && reflect.DeepEqual(pv, bv) {
+			sources[path] = SourceBootstrap
+		} else {
+			sources[path] = SourceFile
+		}
+	}
+}
+
+// recordSources records the sources of the values loaded from the persisted
+// config file at the given path.
+func (cfg *Config) recordSources(configPath string) {
+	persisted, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		log.Debugf("Unable to read persisted config for recording sources: %v", err)
+	}
+	bootstrap, err := readBootstrapConfig()
+	if err != nil {
+		log.Debugf("Unable to read bootstrap config for recording sources: %v", err)
+	}
+	recordFileSources(persisted, bootstrap)
+}
+
+// applyOverrides applies the override file, FLASHLIGHT_* environment
+// variables and command-line flags on top of this Config, in that order, and
+// records the source of every value it sets.
+func (cfg *Config) applyOverrides(flags map[string]interface{}) error {
+	if cfg.Client == nil {
+		cfg.Client = &client.ClientConfig{}
+	}
+
+	applied := make(map[string]string)
+	if err := cfg.applyOverridesFile(flags, applied); err != nil {
+		return err
+	}
+	if err := cfg.applyEnv(applied); err != nil {
+		return err
+	}
+	if err := cfg.applyFlags(flags, applied); err != nil {
+		return err
+	}
+
+	sourcesMx.Lock()
+	for path, source := range applied {
+		sources[path] = source
+	}
+	sourcesMx.Unlock()
+	return nil
+}
+
+// applyOverridesFile applies the values in the overrides file, if there is
+// one. Every field that appears in the file replaces the corresponding field
+// in this Config wholesale, including maps like client.chainedservers.
+func (cfg *Config) applyOverridesFile(flags map[string]interface{}, applied map[string]string) error {
+	path, _ := flags[overridesFlag].(string)
+	explicit := path != ""
+	if !explicit {
+		path = filepath.Join(resolveConfigDir(cfg.configDir), overridesFileName)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil
+		}
+		return fmt.Errorf("Unable to read overrides file %v: %v", path, err)
+	}
+	tree, err := parseYAMLTree(b)
+	if err != nil {
+		return fmt.Errorf("Unable to parse overrides file %v: %v", path, err)
+	}
+
+	log.Debugf("Applying overrides from %v", path)
+	for _, fieldPath := range fieldPaths() {
+		value, found := tree.lookup(fieldPath)
+		if !found {
+			continue
+		}
+		vb, err := yaml.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("Unable to read %v from overrides file %v: %v", fieldPath, path, err)
+		}
+		if err := fieldAt(cfg, fieldPath).setYAML(vb); err != nil {
+			return fmt.Errorf("Error in overrides file %v: %v", path, err)
+		}
+		applied[fieldPath] = fmt.Sprintf("%v (%v)", SourceOverrides, path)
+	}
+	return nil
+}
+
+// applyEnv applies FLASHLIGHT_* environment variables.
+func (cfg *Config) applyEnv(applied map[string]string) error {
+	for _, kv := range environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], envPrefix) {
+			continue
+		}
+		name, value := parts[0], parts[1]
+		path := strings.ToLower(strings.Replace(strings.TrimPrefix(name, envPrefix), "_", ".", -1))
+		f := fieldAt(cfg, path)
+		if f == nil {
+			log.Debugf("Ignoring environment variable %v that doesn't match any config field", name)
+			continue
+		}
+		if err := f.setString(value); err != nil {
+			return fmt.Errorf("Error in environment variable %v: %v", name, err)
+		}
+		applied[path] = fmt.Sprintf("%v (%v)", SourceEnv, name)
+	}
+	return nil
+}
+
+// applyFlags updates this Config from any command-line flags that were passed
+// in.
+func (updated *Config) applyFlags(flags map[string]interface{}, applied map[string]string) error {
+	// Visit all flags that have been set and copy to config
+	for key, value := range flags {
+		path, found := flagPaths[key]
+		if !found {
+			continue
+		}
+		s, _ := value.(string)
+		if s == "" && applied[path] != "" {
+			// Some flags are always passed, even when blank. Don't let those clear
+			// values that were explicitly set by a lower layer.
+			continue
+		}
+		if err := fieldAt(updated, path).setString(s); err != nil {
+			return err
+		}
+		if s != "" {
+			applied[path] = fmt.Sprintf("%v (-%v)", SourceFlag, key)
+		}
+	}
+	return nil
+}
+
+// Describe lists every field of cfg, one per line, along with its effective
+// value and the layer that value came from.
+func Describe(cfg *Config) string {
+	sourcesMx.RLock()
+	defer sourcesMx.RUnlock()
+
+	var buf bytes.Buffer
+	for _, f := range fields(cfg) {
+		source := sources[f.path]
+		if source == "" {
+			source = SourceDefault
+		}
+		fmt.Fprintf(&buf, "%v = %v [%v]\n", f.path, describeValue(f.value), source)
+	}
+	return buf.String()
+}
+
+// describeValue renders simple values as JSON and collections of complex
+// values (like the chained servers) as a count, which keeps the output
+// readable and avoids dumping auth tokens.
+func describeValue(v reflect.Value) string {
+	if v.Kind() == reflect.Map || v.Kind() == reflect.Slice {
+		switch v.Type().Elem().Kind() {
+		case reflect.Ptr, reflect.Struct, reflect.Map, reflect.Slice, reflect.Interface:
+			return fmt.Sprintf("<%d entries>", v.Len())
+		}
+	}
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", v.Interface())
+	}
+	return string(b)
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldAt(t *testing.T) {
+	cfg := &Config{}
+	f := fieldAt(cfg, "client.minqos")
+	if assert.NotNil(t, f, "Should find nested field") {
+		assert.NoError(t, f.setString("5"))
+		assert.Equal(t, 5, cfg.Client.MinQOS, "Should have set nested field")
+	}
+	assert.Nil(t, fieldAt(cfg, "client"), "Nested struct is not a field")
+	assert.Nil(t, fieldAt(cfg, "client.nonexistent"), "Unknown field")
+	assert.Nil(t, fieldAt(cfg, "configdir"), "Unexported field")
+}
+
+func TestApplyOverridesPrecedence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "overrides")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Errorf("Could not remove dir? %v", err)
+		}
+	}()
+	overrides := `
+cloudconfig: http://overrides/cloud.yaml.gz
+cpuprofile: overrides.prof
+client:
+  minqos: 3
+  proxiedconnectports: [443]
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, overridesFileName), []byte(overrides), 0644))
+
+	oldEnviron := environ
+	defer func() {
+		environ = oldEnviron
+	}()
+	environ = func() []string {
+		return []string{
+			"HOME=/home/lantern",
+			"FLASHLIGHT_CLOUDCONFIG=http://env/cloud.yaml.gz",
+			"FLASHLIGHT_CLIENT_DUMPHEADERS=true",
+			"FLASHLIGHT_NOTAFIELD=whatever",
+		}
+	}
+
+	cfg := &Config{configDir: dir, CloudConfig: "http://file/cloud.yaml.gz", MemProfile: "file.prof"}
+	flags := map[string]interface{}{
+		"cloudconfig": "http://flag/cloud.yaml.gz",
+		"cpuprofile":  "",
+		"memprofile":  "",
+	}
+	if !assert.NoError(t, cfg.applyOverrides(flags)) {
+		return
+	}
+	assert.Equal(t, "http://flag/cloud.yaml.gz", cfg.CloudConfig, "Flag should win over env and overrides")
+	assert.Equal(t, "overrides.prof", cfg.CpuProfile, "Blank flag should not clear override")
+	assert.Equal(t, "", cfg.MemProfile, "Blank flag should clear file value")
+	assert.Equal(t, 3, cfg.Client.MinQOS, "Override file should apply")
+	assert.Equal(t, []int{443}, cfg.Client.ProxiedCONNECTPorts, "Override file should replace lists")
+	assert.True(t, cfg.Client.DumpHeaders, "Env should apply")
+
+	described := Describe(cfg)
+	assert.Contains(t, described, `cloudconfig = "http://flag/cloud.yaml.gz" [flag (-cloudconfig)]`)
+	assert.Contains(t, described, `client.dumpheaders = true [env (FLASHLIGHT_CLIENT_DUMPHEADERS)]`)
+	assert.Contains(t, described, "client.minqos = 3 [overrides")
+	assert.False(t, strings.Contains(described, "configdir"), "Unexported fields should not be described")
+}
+
+func TestApplyOverridesBadEnv(t *testing.T) {
+	oldEnviron := environ
+	defer func() {
+		environ = oldEnviron
+	}()
+	environ = func() []string {
+		return []string{"FLASHLIGHT_CLIENT_MINQOS=high"}
+	}
+	cfg := &Config{configDir: os.TempDir()}
+	assert.Error(t, cfg.applyOverrides(map[string]interface{}{}), "Unparseable env value should fail")
+}
+
+func TestRecordFileSources(t *testing.T) {
+	bootstrap := []byte("cloudconfig: http://bootstrap\nupdateserverurl: http://update\n")
+	persisted := []byte("cloudconfig: http://bootstrap\nupdateserverurl: http://changed\n")
+	recordFileSources(persisted, bootstrap)
+	assert.Equal(t, SourceBootstrap, sources["cloudconfig"])
+	assert.Equal(t, SourceFile, sources["updateserverurl"])
+	assert.Equal(t, SourceDefault, sources["client.minqos"])
+}
+
+func TestPreviewAppliesOverridesAfterDefaults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "preview")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Errorf("Could not remove dir? %v", err)
+		}
+	}()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "lantern-9.9.9.yaml"), []byte("client:\n  minqos: 1\n"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, overridesFileName), []byte("trustedcas: []\n"), 0644))
+
+	cfg, err := Preview("9.9.9", dir, map[string]interface{}{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, cfg.Client.MinQOS)
+	assert.Empty(t, cfg.TrustedCAs, "Defaults shouldn't refill what an override blanked")
+	assert.NotEmpty(t, cfg.ProxiedSites.Cloud, "Defaults should still apply")
+}
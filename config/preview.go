@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getlantern/yaml"
+
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/secret"
+)
+
+// Preview returns the configuration that Init would start with for the given
+// version, config directory and flags, layered the same way (defaults,
+// bootstrap, file, overrides, env and flags) so that it can be passed to
+// Describe. Unlike Init, it never writes anything: it doesn't create the
+// config directory or the encryption key, doesn't copy or migrate config
+// files on disk and doesn't poll for or watch config changes.
+func Preview(version string, configDir string, flags map[string]interface{}) (*Config, error) {
+	cdir := resolveConfigDir(configDir)
+	configPath := filepath.Join(cdir, "lantern-"+version+".yaml")
+
+	if err := secret.Load(cdir); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("Unable to load encryption key: %v", err)
+	}
+	bootstrap, err := readBootstrapConfig()
+	if err != nil {
+		log.Debugf("Unable to read bootstrap config, previewing without it: %v", err)
+	}
+	persisted := readPreviewConfigFile(cdir, configPath)
+	if persisted == nil {
+		log.Debugf("No config file in %v, previewing bootstrap config", cdir)
+		persisted = bootstrap
+	}
+
+	tree, err := parseYAMLTree(persisted)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse config file: %v", err)
+	}
+	if _, err := tree.migrate(); err != nil {
+		return nil, err
+	}
+	migrated, err := yaml.Marshal(map[interface{}]interface{}(tree))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal migrated config: %v", err)
+	}
+	cfg := &Config{configDir: configDir}
+	if err := yaml.Unmarshal(migrated, cfg); err != nil {
+		return nil, fmt.Errorf("Unable to read config file: %v", err)
+	}
+	if cfg.Client == nil {
+		cfg.Client = &client.ClientConfig{}
+	}
+	// Like Init, apply the defaults before the overrides, so that overrides
+	// can blank out default values.
+	cfg.ApplyDefaults()
+	recordFileSources(migrated, bootstrap)
+	if err := cfg.applyOverrides(flags); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// readPreviewConfigFile returns the contents of the config file that Init
+// would use, which is the one at configPath or else the most recently
+// modified one from a previous version of Lantern. It returns nil if there is
+// none, in which case Init would use the bootstrap config.
+func readPreviewConfigFile(configDir string, configPath string) []byte {
+	candidates := append([]string{configPath}, previousConfigFiles(configDir, filepath.Base(configPath))...)
+	for _, path := range candidates {
+		bytes, err := ioutil.ReadFile(path)
+		if err == nil {
+			log.Debugf("Previewing config from %v", path)
+			return bytes
+		}
+		if !os.IsNotExist(err) {
+			log.Errorf("Unable to read config at %v: %v", path, err)
+		}
+	}
+	return nil
+}
@@ -5,9 +5,15 @@ import (
 	"encoding/json"
 )
 
-var base64ProxiedSites = "WyIwMDAwYS1mYXN0LXByb3h5LmRlIiwiMDAwZHkuY29tIiwiMDAwcHJveHkuaW5mbyIsIjAwMjcxLmNvbSIsIjAwN3NuLmNvbSIsIjAxMGx5LmNvbSIsIjAxMTEuY29tLmF1IiwiMDEyNnd5dC5jb20iLCIwMjB1c2EuY29tIiwiMDMzYi5jb20iLCIwNjNnLmNvbSIsIjA2NjYuaW5mbyIsIjA2NjguY2MiLCIwNzMuY2MiLCIwNzM3d2VhbC5jb20iLCIwODA5OS5jb20iLCIwOWNhby5jb20iLCIwZGF5LmtpZXYudWEiLCIwcnoudHciLCIxLWFwcGxlLmNvbS50dyIsIjEwMDAudHYiLCIxMDAwODYwMDA2LmNvbSIsIjEwMDBnaXJpLm5ldCIsIjEwMDBpZGVhc2RlbmVnb2Npb3MuY29tIiwiMTAwMGthbi5jb20iLCIxMDBwLWRvdWdhLmNvbSIsIjEwMjQwLmNvbS5hciIsIjEwMjRnby5pbmZvIiwiMTAzMG9rLmNvbSIsIjEwbW92cy5jb20iLCIxMHJlbnRpLmNvbSIsIjEwdGltZXMuY29tIiwiMTB4ancuY29tIiwiMTB5b3V0dWJlLmNvbSIsIjExMHNlLmNvbSIsIjExMS5jb20iLCIxMTEuY29tLmNuIiwiMTExMTExLmNvbS50dyIsIjExMTF0cC5jb20iLCIxMTFneC5jb20iLCIxMTYxMzkuY29tIiwiMTE2ODgubmV0IiwiMTFmZmJiLmNvbSIsIjExZm94eS5jb20iLCIxMWhraGsuY29tIiwiMTFway5uZXQiLCIxMjM0LmNvbSIsIjEyMzQ1cHJveHkuY28iLCIxMjM0NXByb3h5LmluZm8iLCIxMjM0NXByb3h5Lm5ldCIsIjEyMzQ1cHJveHkub3JnIiwiMTIzYm9tYi5jb20iLCIxMjNyZi5jb20iLCIxMmJldC5jb20iLCIxMnNlY29uZGNvbW11dGUuY29tIiwiMTJ2cG4uY29tIiwiMTJ2cG4ubmV0IiwiMTMyMzcuY29tIiwiMTM5Z2FuLmNvbSIsIjEzZGVhbHMuY29tIiwiMTQwZGV2LmNvbSIsIjE0MTQuZGUiLCIxNDF0dWJlLmNvbSIsIjE0N3JyLmNvbSIsIjE1NWdhbWUuY29tIiwiMTVjYW8uY29tIiwiMTYxc2V4LmNvbSIsIjE2ODguY29tLmF1IiwiMTZtYXBsZS5jb20iLCIxNzNuZy5jb20iLCIxNzd3eXQuY29tIiwiMTd0MTdwLmNvbSIsIjE3d3RsYmIuY29tIiwiMTgtMjEtdGVlbnMuY29tIiwiMTgtc2Nob29sZ2lybHouY29tIiwiMTgtc2V4LnVzIiwiMTgwMGZsb3dlcnMuY29tIiwiMTgwd2FuLmNvbSIsIjE4YXZvay51cyIsIjE4ZGFvLmNvbSIsIjE4amFjay5jb20iLCIxOG9ubHlnaXJscy5jb20iLCIxOHB1c3N5Y2x1Yi5jb20iLCIxOHZpcmdpbnNleC5jb20iLCIxOHhncm91cC5jb20iLCIxOTg0YmJzLm9yZyIsIjFiYW8ub3JnIiwiMWMucnUiLCIxZHB3LmNvbSIsIjFlZXcuY29tIiwiMWZpY2hpZXIuY29tIiwiMWh1aXN1by5uZXQiLCIxa2FuLmNvbSIsIjFwcm94eS5kZSIsIjFzdC1nYW1lLm5ldCIsIjFzdG9wY24uY29tIiwiMXN0d2ViZ2FtZS5jb20iLCIyLWhhbmQuaW5mbyIsIjItcG9ybi5jb20iLCIyMDAwYm8uY29tIiwiMjAwMGZ1bi5jb20iLCIyMDAwbW92LmNvbSIsIjIwMDgud3MiLCIyMDA4eGlhbnpoYW5nLmluZm8iLCIyMDEyc2UuaW5mbyIsIjIwMTJ0dC5jb20iLCIyMDE0bm5uLmNvbSIsIjIwamFjay5jb20iLCIyMG1pbnV0b3MudHYiLCIyMHlvdHViZS5jb20iLCIyMTEyMTEyLm5ldCIsIjIxMXp5LmNvbSIsIjIxM3l5LmNvbSIsIjIxc2V4dHVyeS5jb20iLCIyMXdpZmUuY29tIiwiMjIybWltaS5uZXQiLCIyMm5mLmluZm8iLCIyMnRyYWNrcy5jb20iLCIyMzRtci5jb20iLCIyMzVqb2IuY29tIiwiMjNkeS5pbmZvIiwiMjN2aWRlby5jb20iLCIyNDd3b3JraW5naG9zdC5jb20iLCIyNDhjYy5jb20iLCIyNDlzcy5jb20iLCIyNGhvdXJwcmludC5jb20iLCIyNG9wZW4ucnUiLCIyNHByb3h5LmNvbSIsIjI0c21pbGUub3JnIiwiMjR0b3Bwcm94eS5jb20iLCIyNHRyYWZmaWMuaW5mbyIsIjI0dHVubmVsLmNvbSIsIjI3MTQ0LmNvbSIsIjI3aGhoLmNvbSIsIjI4dGxiYi5jb20iLCIyYWR1bHRmbGFzaGdhbWVzLmNvbSIsIjJhbm9ueW1vdXNwcm94eS5jb20iLCIyZGllNGZhbS5jb20iLCIyZzM0LmNvbSIsIjJrYW5waWFuLmNvbSIsIjJray5jYyIsIjJsaXBzdHViZS5jb20iLCIybTUyLmNvbSIsIjJwLm5ldCIsIjJwcm94eS5kZSIsIjJzaGFyZWQuY29tIiwiMnRpcHMuY29tIiwiMnVuYmxvY2t5b3V0dWJlLmNvbSIsIjJ5ODg4OC5jb20iLCIzMDBhdmkuY29tIiwiMzBib3hlcy5jb20iLCIzMG1haWwubmV0IiwiMzEwMGJvb2suY29tIiwiMzE0NC5uZXQiLCIzMTVsei5jb20iLCIzMTdiby5jb20iLCIzMTlwYXBhZ28uaWR2LnR3IiwiMzFiYi5jb20iLCIzMjFzb3NvLmNvbSIsIjMyMXlvdXR1YmUuY29tIiwiMzJyZWQuY29tIiwiMzMzM2NuLmNvbSIsIjMzM2d4LmNvbSIsIjMzYXYuY29tIiwiMzNtZC5uZXQiLCIzM3NxZHkuaW5mbyIsIjMzeW91dHViZS5jb20iLCIzNDNkeS5uZXQiLCIzNDUuaWR2LnR3IiwiMzQ1bW0uY29tIiwiMzY1c2IuY29tIiwiMzY1c2luZ2xlcy5jb20uYXIiLCIzODUyMi5jb20iLCIzOGFiLmNvbSIsIjM4bHVubGkuaW5mbyIsIjM4cnYuY29tIiwiMzljYW8uY29tIiwiM2E2YWF5ZXIuY29tIiwiM2FuaW1hbHNleC5jb20iLCIzYW5pbWFsc2V4dHViZS5jb20iLCIzYXJhYnR2LmNvbSIsIjNib3lzMmdpcmxzLmNvbSIsIjNkYXlibGluZHMuY29tIiwiM2RzZXh2aWxsYS5jb20iLCIzZm0ubmwiLCIzaThpLm5ldCIsIjNraXUuaW5mbyIsIjNraXUubmV0IiwiM2w4Ny5jb20iLCIzb3JvZC5jb20iLCIzcC1saW5rLmNvbSIsIjNwcm94eS5kZSIsIjNyZC1wYXJ0eS5vcmcudWsiLCIzcmVuLmNhIiwiM3NhdC5kZSIsIjNzc2VlLmNvbSIsIjNzc25uLmNvbSIsIjNzdWlzc2VzLmZyIiwiM3dpc3AuY29tIiwiNDAwYWkuY29tIiwiNDMycHBwLmNvbSIsIjQ0MW1pLmNvbSIsIjQ0MW1pLm5ldCIsIjQ0NDRray5jb20iLCI0NDR4Zy5jb20iLCI0NDUyNTIuY29tIiwiNDQ2NmsuY29tIiwiNDRxcy5jb20iLCI0NWJ5dGVzLmluZm8iLCI0NXdvb29sLmNvbSIsIjQ2MGR2ZC5jb20iLCI0N2FpLmluZm8iLCI0OS5pZHYudHciLCI0ZXZlcnByb3h5LmJpeiIsIjRldmVycHJveHkuY29tIiwiNGV2ZXJwcm94eS5kZSIsIjRldmVycHJveHkub3JnIiwiNGZyZWVwcm94eS5jb20iLCI0aWsucnUiLCI0amo0amouY29tIiwiNGtrYmIuY29tIiwiNG11c2NsZW1lbi5jb20iLCI0bmV3dHViZS5jb20iLCI0cGRhLnRvIiwiNHByb3h5LmRlIiwiNHNoYXJlZC5jb20iLCI0c3Nubi5jb20iLCI0dHViZS5jb20iLCI1MDBweC5vcmciLCI1MHdlYnMuY29tIiwiNTE0LmNuIiwiNTFlby5jb20iLCI1MWx1b2Jlbi5jb20iLCI1MXNvbGUuY29tIiwiNTF3YWt1LmNvbSIsIjUyMDBkZC5jb20iLCI1MjY4Mi5jb20iLCI1Mjc4LmNjIiwiNTJqYXYuY29tIiwiNTJ0bGJiLmNvbSIsIjUyd3BlLmNvbSIsIjUyeWlueWluLmluZm8iLCI1MnlvdWppLm9yZyIsIjUzeWlueWluLmluZm8iLCI1NDI3MS5jb20iLCI1NDN3eXQuY29tIiwiNTRkeS5uZXQiLCI1NHh1ZS5jb20iLCI1NTM5OS5jb20iLCI1NTVhdHYuY29tIiwiNTczLmpwIiwiNTc5dXUuY29tIiwiNTkyNy5jYyIsIjVkN3kubmV0IiwiNWhlNS5jb20iLCI1aTAxLmNvbSIsIjVpay50diIsIjVpc290b2k1Lm9yZyIsIjVtYW9kYW5nLmNvbSIsIjVwcm94eS5jb20iLCI1cXVsdS5jb20iLCI1dWRhbmhhby5jb20iLCI1eHhuLmNvbSIsIjV5ZTguY29tIiwiNjI5OS5uZXQiLCI2MzU3Ny5jb20iLCI2MzYzd2luLmNvbSIsIjYzampqLmNvbSIsIjY0dGlhbndhbmcuY29tIiwiNjYuY2EiLCI2NjY4MTQuY29tIiwiNjY2a2IuY29tIiwiNjY2bWltaS5jb20iLCI2NjZuZi5jb20iLCI2NmdyZWVuMy5jb20iLCI2NnBlZXJzLmluZm8iLCI2NzE2MC5jb20iLCI2NzhoZS5jb20iLCI2Nzhrai5jb20iLCI2OTY5NjY5OS5vcmciLCI2OWdvb2RzLmNvbSIsIjY5amlhb3lvdS5jb20iLCI2OWtpc3MubmV0IiwiNjl0dWJlc2V4LmNvbSIsIjZhYW9vLmNvbSIsIjZqc3EubmV0IiwiNmsuY29tLnR3IiwiNmxhdy5pZHYudHciLCI2bGlrb3N5LmNvbSIsIjZwYXJrLmNvbSIsIjZwcm94eS5wdyIsIjZ2NmRvdGEuY29tIiwiNzA2MC5jb20iLCI3MGNodW4uY29tIiwiNzEwa251cy5jb20iLCI3MTExaGguY29tIiwiNzEyMTAwLmNvbSIsIjcxYWIuY29tIiwiNzIwZHZkLmNvbSIsIjcyMjJoaC5jb20iLCI3MnNhby5jb20iLCI3Mzg4NzcuY29tIiwiNzR4eS5jb20iLCI3NTNubi5jb20iLCI3NjY2aGguY29tIiwiNzc0NGQuY29tIiwiNzc3ZGFpbGkuY29tIiwiNzc3cGQuY29tIiwiNzc3cm1iLmNvbSIsIjc3N3J2LmNvbSIsIjc3cGhvbmUuY29tIiwiNzd5b3V0dWJlLmNvbSIsIjc4OXNzc3MuY29tIiwiNzk5OWhoLmNvbSIsIjdjb3cuY29tIiwiN2RheWRhaWx5LmNvbSIsIjdkb2cuY29tIiwiN21zcG9ydC5jb20iLCI3bmV0LmNvbS50dyIsIjdzZHkuY29tIiwiN3NwaW5zLmNvbSIsIjd0dmIuY29tIiwiN3h4OC5jb20iLCI3eTd5LmNvbSIsIjgtZC5jb20iLCI4MDAwODYuY29tIiwiODAwcXN3LmNvbSIsIjgwODA4MC5iaXoiLCI4MDkwa2suY29tIiwiODA5MHhpbmduYW4ubmV0IiwiODBzbXA0LmNvbSIsIjg1MWZhY2Vib29rLmNvbSIsIjg1Y2FvLmNvbSIsIjg1Y2MubmV0IiwiODVnYW8uY29tIiwiODVzdC5jb20iLCI4N2Jvb2suY29tIiwiODgxMWQuY29tIiwiODgxOTAzLmNvbSIsIjg4OC5jb20iLCI4ODgwNi5jb20iLCI4OGNoaW5hdG93bi5jb20iLCI4OGx1c2UuY29tIiwiODhzcWR5LmNvbSIsIjg4d2lucy5jb20iLCI4OHhmLmluZm8iLCI4OHhveG8uY29tIiwiODh4cHhwLmNvbSIsIjhhaS5pbmZvIiwiOHNzZWUuY29tIiwiOTAwMTcwMC5jb20iLCI5MDl6eS5uZXQiLCI5MGhlLmNvbSIsIjkwa3h3LmNvbSIsIjkwbWluLmNvbSIsIjkxNTMwLmNvbSIsIjkxcG9ybi5jb20iLCI5MXBvcm4ubWUiLCI5MmNjYXYuY29tIiwiOTN0dmIubmV0IiwiOTQ5NTguY29tIiwiOTQ5d3l0LmNvbSIsIjk1d2VuLmNvbSIsIjk3MDA5Ny5jb20iLCI5NzdhaS5jb20iLCI5Nzh6LmNvbSIsIjk3YWkuY29tIiwiOTdsbS5jb20iLCI5N3NlcXUuY29tIiwiOTkwNTc4LmNvbSIsIjk5MS5jb20iLCI5OTk5Y24ub3JnIiwiOTliYnMub3JnIiwiOTlwcm94eS5jb20iLCI5OXUuY29tIiwiOTl1YmIuY29tIiwiOWI5YjliLmNvbSIsIjlibG93LmNvbSIsIjloYW93LmNuIiwiOWlyZW50aS5jb20iLCI5am5nLmNvbSIsIjlvZmEuY29tIiwiOXN2aXAuY29tIiwiOXR2Yi5jb20iLCI5dzkub3JnIiwiOXh5b3V0dWJlLmNvbSIsImEtZmVpLmlkdi50dyIsImE1LmNvbS5ydSIsImE2ODguaW5mbyIsImE4OC51cyIsImE4ODhiLmNvbSIsImE5OS5pbmZvIiwiYWFhazEuY29tIiwiYWFhazMuY29tIiwiYWFqamouY29tIiwiYWFtYWNhdS5jb20iLCJhYXByb3h5LnB3IiwiYWJjLmNvbSIsImFiYy5jb20ubGIiLCJhYmMuY29tLnBsIiwiYWJjLmNvbS5weSIsImFiYy5wcC5ydSIsImFibHdhbmcuY29tIiwiYWJuYW5kaHJhanlvdGh5LmNvbSIsImFib2x1b3dhbmcuY29tIiwiYWJvbmRhbmNlLmNvbSIsImFib3V0Z2Z3LmNvbSIsImFib3V0c2V4eHguY29tIiwiYWJwbGl2ZS5pbiIsImFicy5lZHUua3ciLCJhYnNva3UwNzIuY29tIiwiYWJ1c2VhdC5vcmciLCJhYy1yZW5uZXMuZnIiLCJhYy12ZXJzYWlsbGVzLmZyIiwiYWNhZGVteWFydC5lZHUiLCJhY2Nlc3NtZXByb3h5LmNvbSIsImFjY2V4YW0uY29tIiwiYWNlcm9zLWRlLWhpc3BhbmlhLmNvbSIsImFjZXNzb3RvdGFsLm5ldCIsImFjZXZwbi5jb20iLCJhY2hhdGRlc2lnbi5jb20iLCJhY2hpLmlkdi50dyIsImFjdGltZXMuY29tLmF1IiwiYWN0aW9ubmV0d29yay5vcmciLCJhY3RpdmVwcm94aWVzLm9yZyIsImFkLXRlY2guY29tIiwiYWRiLm9yZyIsImFkZGl0dWRlbWFnLmNvbSIsImFkZG1lZmFzdC5jb20iLCJhZGVleC5pbiIsImFkai5pZHYudHciLCJhZG9iZXd3Zm90cmFpbmluZy5jb20iLCJhZG9vcy5jb20iLCJhZG9wdGFwZXQuY29tIiwiYWRzY2FsZS5kZSIsImFkdGhyaXZlLmNvbSIsImFkdWx0Y29taWNzY2x1Yi5jb20iLCJhZHVsdGN5YmVyc2l0ZXMuY29tIiwiYWR1bHRmcmllbmRmaW5kZXIuY29tIiwiYWR1bHRnYWdhLmNvbSIsImFkdWx0bWVnYXBvcm4uY29tIiwiYWR1bHRwb3JudG9kYXkuY29tIiwiYWR1bHR0b3A1MC5ubCIsImFkdWx0dHViZS5pbmZvIiwiYWR2YW5jZWRmaWxlb3B0aW1pemVyLmNvbSIsImFkdmFyLW5ld3MuYml6IiwiYWR4aG9zdGluZy5uZXQiLCJhZTUwMDAucnUiLCJhZWlvdS5wdCIsImFlc3N1Y2Nlc3Mub3JnIiwiYWZmYS5heiIsImFmZmFyaXRhbGlhbmkuaXQiLCJhZmxhbWhxLmNvbSIsImFmcmFuZXQuY29tIiwiYWZyaWsuY29tIiwiYWdncmVzc2l2ZWJhYmVzLmNvbSIsImFncmFubnlwb3JuLmNvbSIsImFncmlkcnkuY29tIiwiYWhiaW1pLmNvbSIsImFobGFsaGRlZXRoLmNvbSIsImFobWlsZi5jb20iLCJhaHJjaGsubmV0IiwiYWhycS5nb3YiLCJhaWxhbi5pZHYudHciLCJhaW1pemkuY29tIiwiYWltb3JyaWRlc3VuZ2FicmFuY2EuY29tIiwiYWlvbjgub3JnIiwiYWlvcHJveHkuY29tIiwiYWlwLmlkdi50dyIsImFpcmFzaWFnby5jb20ubXkiLCJhaXJibmIuY28uaW4iLCJhaXJibmIuY29tIiwiYWlyYm5iLml0IiwiYWlydGlja2V0cy5nciIsImFpcnZwbi5vcmciLCJhaXNleC5jb20iLCJhaXQub3JnLnR3IiwiYWl3ZWl3ZWkuY29tIiwiYWl3ZWl3ZWlibG9nLmNvbSIsImFpeWVsbG93LmNvbSIsImFqYW5rYW1pbC5jb20iLCJhamFuc3Nwb3IuY29tIiwiYWpheGxvYWQuaW5mbyIsImFqc2FuZHMuY29tIiwiYWstZmFjZWJvb2suY29tIiwiYWthZGVtaWtwZXJzcGVrdGlmLmNvbSIsImFrYWhvc2hpdGFrdXlhLmNvbSIsImFrYW1haWhkLm5ldCIsImFraW5hdG9yLmNvbSIsImFrcmFkeW8ubmV0IiwiYWt0aWZoYWJlci5jb20iLCJhbC1mYWRqci5jb20iLCJhbC1zaGFycS5jb20iLCJhbC13YXRhbi5jb20iLCJhbDNhYnk4LmNvbSIsImFsYWFuLmNjIiwiYWxhYW4udHYiLCJhbGFib3V0LmNvbSIsImFsYWtoYmFhci5vcmciLCJhbGV4YTEwMC5jb20iLCJhbGV4YW5kcmVidWlzc2Uub3JnIiwiYWxleGRvbmcuY29tIiwiYWxpYmFiYWdyb3VwLmNvbSIsImFsaWNlLml0IiwiYWxpdHRsZWJpdGNoZWVreS5jb20iLCJhbGl2ZXByb3h5LmNvbSIsImFsbGFib3V0LmNvLmpwIiwiYWxsYXNpYW5zLmNvbSIsImFsbGJhbmtpbmdzb2x1dGlvbnMuY29tIiwiYWxsYm9uZXIuY29tIiwiYWxsY2FyLmlkdi50dyIsImFsbGRyYXduc2V4LmNvbSIsImFsbGZhY2Vib29rLmNvbSIsImFsbGhhcmRzZXh0dWJlLmNvbSIsImFsbGlhbmNlLm9yZy5oayIsImFsbGluZmEuY29tIiwiYWxsaW5tYWlsLmNvbS5iciIsImFsbGludmFuY291dmVyLmNvbSIsImFsbGphY2twb3RzY2FzaW5vLmNvbSIsImFsbG1vdmllLmNvbSIsImFsbHBvc3RlcnMuY29tIiwiYWxscHJvZHVjdHMuY29tLnR3IiwiYWxscHJveHlzaXRlcy5jb20iLCJhbGxyZWNpcGVzLmNvbS5teCIsImFsbHJ1c2FtYXRldXJzLmNvbSIsImFscGhhcG9ybm8uY29tIiwiYWxzYmJvcmEuY29tIiwiYWx0ZXJuYXRpdmVpbmNvbWVuZy5jb20iLCJhbHdhZGlmYS1tYXJvYy5jb20iLCJhbHdheXNkYXRhLmNvbSIsImFsd2VoZGEuZ292LnN5IiwiYWx5YW91bTI0LmNvbSIsImFtNzMwLmNvbS5oayIsImFtYWtpbmdzLmNvbSIsImFtYXJ1amFsYS5jb20iLCJhbWFyeWxsaXNzLmlkdi50dyIsImFtYXRldXJjb21tdW5pdHkuZGUiLCJhbWF0ZXVyZ2FsbHMuY29tIiwiYW1hdGV1cmhvbWV2aWRzLmNvbSIsImFtYXRldXJpdHkuY29tIiwiYW1hdGV1cnNleHkubmV0IiwiYW1hemluZ3N1cGVycG93ZXJzLmNvbSIsImFtYXpvbmF3cy5jb20iLCJhbWF6b25zdXBwbHkuY29tIiwiYW1lYmxvLmpwIiwiYW1lbGkuZnIiLCJhbWVudG90YXh1cy5pZHYudHciLCJhbWVyaWNhLXByb3h5LmNvbSIsImFtZXJpY2FuZXhwcmVzc29ubGluZS5jb20uYnIiLCJhbWVyaWNhbm11c2NsZS5jb20iLCJhbWVyaWNvcnBzLmdvdiIsImFtZXJpa2FuaW5zZXNpLmNvbSIsImFtaWFtaS5qcCIsImFtaW5zYWJldGkubmV0IiwiYW1uZXN0eS5jYSIsImFtbmVzdHkuY2giLCJhbW5lc3R5LmllIiwiYW1uZXN0eS5vcmciLCJhbW5lc3R5Lm9yZy5hdSIsImFtbmVzdHkub3JnLmdyIiwiYW1uZXN0eS5vcmcuaGsiLCJhbW5lc3R5Lm9yZy5pbiIsImFtbmVzdHkub3JnLm56IiwiYW1uZXN0eS5vcmcucnUiLCJhbW5lc3R5Lm9yZy50ciIsImFtbmVzdHkub3JnLnVhIiwiYW1uZXN0eS5vcmcudWsiLCJhbW5lc3R5dXNhLm9yZyIsImFtb2lpc3QuY29tIiwiYW1vdXJhbmdlbHMucHciLCJhbXoudHciLCJhbmEtd2hpdGUuY29tIiwiYW5hZG9sdWhhYmVyaW0uY29tIiwiYW5hbGl0aWtiYWtpcy5jb20iLCJhbmFseXNpc3dlYnNpdGVzLmNvbSIsImFuY2hvcmZyZWUubmV0IiwiYW5kY3ljbGUuaWR2LnR3IiwiYW5kaHJhanlvdGh5LmNvbSIsImFuZGhyYW5ld3MubmV0IiwiYW5kcm9pZHBpdC5jb20uYnIiLCJhbmRyb2lkcHViLmNvbSIsImFuZ2VsZS1wcm94eS5pbmZvIiwiYW5ncnliaXJkcy5jb20iLCJhbmlrb3JlLmpwIiwiYW5pbWFsaG9zdC5jb20iLCJhbmltZS1kb2ppbi5jb20iLCJhbmltZS1lcm9kb3VnYS5jb20iLCJhbmltZS1tZWRpYS5jb20iLCJhbmltZWNyYXp5Lm5ldCIsImFuaW1lc2hpcHB1dWRlbi5jb20iLCJhbmltZXNwaXJpdC5ydSIsImFuaXNjYXJ0dWpvLmNvbSIsImFubm9uY2UuY3oiLCJhbm50dy5jb20iLCJhbm51bmNpLm5ldCIsImFub25pZW1zdXJmZW4uZXUiLCJhbm9ucGFzcy5jb20iLCJhbm9ucHJveHkuZXUiLCJhbm9uc2VydmVyLnNlIiwiYW5vbnltaXR5cHJveHkuY29tIiwiYW5vbnltaXplci5jb20iLCJhbm9ueW1vdXMtcHJveHkuY29tLmRlIiwiYW5vbnltb3VzLXN1cmZpbmcuZXUiLCJhbm9ueW1vdXMyNC5wbCIsImFub255bW91c2UubWUiLCJhbm9ueW1vdXNlLm9yZyIsImFub255bW91c3N1cmYudXMiLCJhbm9ueW1vdXN3ZWJwcm94eS51cyIsImFub255bXouY29tIiwiYW5vbnlzdXJmLmNvbSIsImFucG9wby5jb20iLCJhbnF1eWUuY29tIiwiYW5zYXItYWxoYXFxLm5ldCIsImFuc3dlcmluZy1pc2xhbS5vcmciLCJhbnRpLWJsb2NrLmNvbSIsImFudGl3YXZlLm5ldCIsImFudHBva2VyLmNvbSIsImFudW50aW9tYXRpYy5jb20iLCJhbnVudHVsLnJvIiwiYW55cG9ybi5jb20iLCJhbnlwb3JuLmluZm8iLCJhbnlzZXguY29tIiwiYW55dS5vcmciLCJhb2FvbHUuY2MiLCJhb2FvbHUuY29tIiwiYW9hb2x1Lm5ldCIsImFvYW92b2QuY29tIiwiYW9iby5jb20uYXUiLCJhb2wuY2EiLCJhb2wuY28udWsiLCJhb2wuY29tIiwiYW9sbmV3cy5jb20iLCJhb21pd2FuZy5jb20iLCJhcC5vcmciLCJhcGFydG1lbnRndWlkZS5jb20iLCJhcGFzdHlsZS5vcmciLCJhcGV0dWJlLmNvbSIsImFwaWdlZS5jb20iLCJhcG9udGFkb3IuY29tLmJyIiwiYXBvcnQucnUiLCJhcHBlZHUuY29tLnR3IiwiYXBwbGViYWxsYS5jb20iLCJhcHBsZWRhaWx5LmNvbS5oayIsImFwcGxlZGFpbHkuY29tLnR3IiwiYXBwbGVpbnNpZGVyLmNvbSIsImFwcGxldHViZS5ydSIsImFwcHNmdXR1cmUuaW5mbyIsImFwcHNwb3QuY29tIiwiYXBwdnVpZmFjZWJvb2suY29tIiwiYXBweS1nZWVrLmNvbSIsImFwcmVzbGFjaGF0LmNvbSIsImFxLmNvbSIsImFyMTUuY29tIiwiYXJhYm8uY29tIiwiYXJhYnMteW91dHViZS5jb20iLCJhcmFzaHphZC5uZXQiLCJhcmJpdHJhZ2V0b3AuY29tIiwiYXJjaGlwcm9kdWN0cy5jb20iLCJhcmNoaXZlLmlzIiwiYXJjaGl2ZS5vcmciLCJhcmNoaXZlLnRvZGF5IiwiYXJjdG9zaWEuY29tIiwiYXJkLmRlIiwiYXJkcm9uZS1mb3J1bS5jb20iLCJhcmdlbnByb3AuY29tIiwiYXJnZW50YS5iZSIsImFyZ2VudGluYWJheS5pbmZvIiwiYXJpb25tb3ZpZXMuY29tIiwiYXJtYWRhYm9hcmQuY29tIiwiYXJtYW5pZXhjaGFuZ2UuY29tIiwiYXJtZW5wcmVzcy5hbSIsImFycm93LmNvbSIsImFyc2VuYWwuY29tIiwiYXJ0Y29taXguY29tIiwiYXJ0aWNsZXNwaGVyZS5jb20iLCJhcnRsZWJlZGV2LnJ1IiwiYXJ2aXhlY2xvdWQuY29tIiwiYXNhaGljaGluZXNlLmNvbSIsImFzZy50byIsImFzZ2hhcmFnaGEuY29tIiwiYXNoYnVybmljZWFuZ2Vscy5vcmciLCJhc2hsZXlybmFkaXNvbi5jb20iLCJhc2lhZS5jby5rciIsImFzaWFoYXJ2ZXN0Lm9yZyIsImFzaWFuLWJveS1tb2RlbHMuY29tIiwiYXNpYW4tZG9sbHMubmV0IiwiYXNpYW4tc2xhdmUtYm95LmNvbSIsImFzaWFuYmVhdXR5dHViZS5jb20iLCJhc2lhbmV3cy5pdCIsImFzaWFueGhhbXN0ZXIuY29tIiwiYXNpYXNleHZpZGVvcy5jb20iLCJhc2lhdGdwLmNvbSIsImFza2ZyYW5rLm5ldCIsImFza3luei5uZXQiLCJhc3Bkb3RuZXQtc3VyZXNoLmNvbSIsImFzcmVkYXMuY29tIiwiYXNyZWtob2Ryby5jb20iLCJhc3NlbWJsYS5jb20iLCJhc3NlbWJsZWUtbmF0aW9uYWxlLmZyIiwiYXN0cm9tZW5kYWJhcmFuZC5jb20iLCJhc3VyZWthemFuaS5jb20iLCJhdGF2aS5jb20iLCJhdGNoLm1lIiwiYXRjaGluZXNlLmNvbSIsImF0ZWJpdHMuY29tIiwiYXRnZncub3JnIiwiYXRoZW5zYmFycy5nciIsImF0ai5vcmcudHciLCJhdGxhc3Bvc3QuY29tIiwiYXRuZXh0LmNvbSIsImF0cmVzcGxheWVyLmNvbSIsImF0dHVubG9ja2VyLnVzIiwiYXUxMjMuY29tIiwiYXVjZmFuLmNvbSIsImF1ZGlibGUuY28udWsiLCJhdWZmbGljay5jb20iLCJhdWdzYnVyZ2VyLWFsbGdlbWVpbmUuZGUiLCJhdW5ibG9jay5jb20iLCJhdW5ibG9jay5wayIsImF1b2RhLmNvbSIsImF1c256Lm5ldCIsImF1c3NpZWFkdWx0ZnJpZW5kZmluZGVyLmNvbSIsImF1c3NpZXByb3h5LmluZm8iLCJhdXN0cmFsaWEtcHJveHkuY29tIiwiYXV0b2d1aWRlLmNvbSIsImF1dG9oaWRlaXAuY29tIiwiYXV0b3Bvc3RmYWNlYm9vay5jb20iLCJhdXRvcG9zdHRvZmFjZWJvb2suY29tIiwiYXV0b3NvdHRvY29zdG8uY29tIiwiYXV0b3R1bi5uZXQiLCJhdi1hZHVsdC5jb20iLCJhdi1vay5jb20iLCJhdjEwMGZ1bi5jb20iLCJhdjEwMS5uZXQiLCJhdjEwNjkuY29tIiwiYXYxODEubmV0IiwiYXY1OTEuY29tIiwiYXY3NzcuY29tIiwiYXY5LmNjIiwiYXZhYXoub3JnIiwiYXZhdHJhZGUuY29tIiwiYXZheGhtLmNvbSIsImF2YmFieS5pbmZvIiwiYXZiZHNob3AuY29tIiwiYXZjaXR5LnR2IiwiYXZjb21lLnR3IiwiYXZjb3kuY29tIiwiYXZkYi5pbiIsImF2ZGQubmV0IiwiYXZkaXNoLmNvbSIsImF2ZHZkLm5ldCIsImF2ZW51ZS5jb20iLCJhdmVudWVzdXBwbHkuY2EiLCJhdmVyeS5jby51ayIsImF2ZXJ5LmNvbS5teCIsImF2ZmFjZWJvb2suY29tIiwiYXZoaWdoLm5ldCIsImF2aG9tZS50diIsImF2aXNvc2Rlb2Nhc2lvbi5jb20iLCJhdmxhbmcuY29tIiwiYXZsYW5nMjIuY29tIiwiYXZub21hLmNvbSIsImF2b25lLnR2IiwiYXZzZXg4LmNvbSIsImF2c3AycC5jb20iLCJhdnQxMTEuY29tIiwiYXZ0dC5uZXQiLCJhdnR0My5uZXQiLCJhdnR0My5vcmciLCJhdnR1YmUudHYiLCJhdnVsdS5jb20iLCJhd2VicHJveHkuY29tIiwiYXdmbGFzaGVyLmNvbSIsImF4ZS1uZXQuZnIiLCJheWJpbGdpLm5ldCIsImF6ZXJiYXljYW4udHYiLCJhemVyaW1peC5jb20iLCJhemVydHkxMjMuY29tIiwiYXpvaC5pbmZvIiwiYXpwcm94aWVzLmNvbSIsImIxMTdmOGRhMjM0NDZhOTEzODdlZmVhMGU0MjgzOTJhLnBsIiwiYjFzZWN1cmUuY29tIiwiYmEtYmFtYWlsLmNvbSIsImJhYi11bC1pc2xhbS5uZXQiLCJiYWJha2RhZC5ibG9nc3BvdC5mciIsImJhYmVsaW8uY29tIiwiYmFiZXNhbmRzdGFycy5jb20iLCJiYWJ5LWtpbmdkb20uY29tIiwiYmFieW5ldC5jb20uaGsiLCJiYWNrY2hpbmEuY29tIiwiYmFja3BhY2tlcnMuY29tLnR3IiwiYmFja3lhcmRjaGlja2Vucy5jb20iLCJiYWRqb2pvLmNvbSIsImJhZ3VldGUuY29tLmJyIiwiYmFoaWFub3RpY2lhcy5jb20uYnIiLCJiYWlkLnVzIiwiYmFpZ2V2cG4uY29tIiwiYmFpc2U2NjYuY29tIiwiYmFqYXJmYWNlYm9vay5jb20iLCJiYWphcm1wMy5uZXQiLCJiYWphcnlvdXR1YmUuY29tIiwiYmFsYXRhcmluLmNvbSIsImJhbGxwdXJlLmNvbSIsImJhbmNhbWFyY2hlLml0IiwiYmFuY29wb3N0YWNsaWNrLml0IiwiYmFuZGljYW0uY29tIiwiYmFuZ2Jyb3MxLmNvbSIsImJhbmtlcnNhZGRhLmNvbSIsImJhbmtleGFtc3RvZGF5LmNvbSIsImJhbmtoYXBvYWxpbS5jby5pbCIsImJhbmtuZXRwb3dlci5uZXQiLCJiYW5rcGFzYXJnYWQuY29tIiwiYmFubmVkYm9vay5vcmciLCJiYW5uZWRmdWNrZXJzLmNvbSIsImJhby5saSIsImJhb3poaS5ydSIsImJhcmVuYWtlZGlzbGFtLmNvbSIsImJhcm5hYnUuY28udWsiLCJiYXJyYWN1ZGEuY29tIiwiYmFzZTk5LmNvbSIsImJhc2lsLmlkdi50dyIsImJhc3dhcmUuY29tIiwiYmF0aWFjdHUuY29tIiwiYmF5cHJveHkub3JnIiwiYmF5dm9pY2UubmV0IiwiYmF5d29yZHMuY29tIiwiYmI2NmNjLm9yZyIsImJiYXYzNjAuY29tIiwiYmJjLmNvLnVrIiwiYmJjLmNvbSIsImJiYy5vcmcuY24iLCJiYmNjaGluZXNlLmNvbSIsImJiY2kuY28udWsiLCJiYmNpbWcuY28udWsiLCJiYmcuZ292IiwiYmJneXkubmV0IiwiYmJoLmNvbSIsImJicHJveHkucHciLCJiYnMtdHcuY29tIiwiYmJzODg4OC5uZXQiLCJiYnM5Ny5jb20iLCJiYnNpbmRleC5jb20iLCJiYnNsYW5kLmNvbSIsImJidG95c3RvcmUuY29tIiwiYmJ3cG9ybm90dWJlcy5jb20iLCJiYnd0dWJlcG9ybi54eHgiLCJiYnd2aWRlb3N0dWJlLmNvbSIsImJieXkubmFtZSIsImJjLnZjIiwiYmNjLmNvbS50dyIsImJjY2hpbmVzZS5uZXQiLCJiZGdlc3QuY29tIiwiYmRtb3RlLm5ldCIsImJkc20uY29tIiwiYmRzbS5jb20udHciLCJiZHNtYmFuZy5jb20iLCJiZHNtZm9yYWxsLmNvbSIsImJkc212aWRlb3MubmV0IiwiYmVhdGZpbHRlcmluZy5jb20iLCJiZWF1dHk4OC5jb20udHciLCJiZWF1dHlnaXJsLXN0b3J5LmNvbSIsImJlYm8uY29tIiwiYmVjdW9ubGluZWJhbmtpbmcub3JnIiwiYmVmdWNrLmNvbSIsImJlZ2Vlay5mciIsImJlaGluZGtpbmsuY29tIiwiYmVpamluZ3NwcmluZy5jb20iLCJiZWxhamFyaWtsYW5kaWZhY2Vib29rLmNvbSIsImJlbGFzdGluZ2RpZW5zdC5ubCIsImJlbG92ZS5qcCIsImJlbHRhLmJ5IiwiYmVtaWRqaXBpb25lZXIuY29tIiwiYmVybGludHdpdHRlcndhbGwuY29tIiwiYmVybS5jby5ueiIsImJlc3QtaGFuZGpvYi5jb20iLCJiZXN0LXByb3h5LmNvbS5kZSIsImJlc3QtdmlkZW9zLXlvdXR1YmUuY29tIiwiYmVzdGFuZGZyZWUuY29tIiwiYmVzdGZvcmNoaW5hLm9yZyIsImJlc3RmcmVldnBuLmNvbSIsImJlc3RpbnN0YWdyYW0uY29tLmJyIiwiYmVzdGl6Lm5ldCIsImJlc3RvZnlvdXR1YmUuY29tIiwiYmVzdHBvcm4uY29tIiwiYmVzdHBvcm5zdGFyZGIuY29tIiwiYmVzdHByb3guY29tIiwiYmVzdHByb3h5c2l0ZXMubmV0IiwiYmVzdHJlYW1zLm5ldCIsImJlc3RzZWN1cml0eXRpcHMuY29tIiwiYmVzdHNweS5uZXQiLCJiZXN0c3VyZmluZy5pbmZvIiwiYmVzdHVrdnBuLmNvbSIsImJlc3R2aWRlb29ueW91dHViZS5jb20iLCJiZXN0dmlkZW9zb255b3V0dWJlLmNvbSIsImJlc3R2aW50YWdldHViZS5jb20iLCJiZXN0dnBuLmNvbSIsImJlc3R2cG5zZXJ2aWNlLmNvbSIsImJlc3R2cG51c2EuY29tIiwiYmVzdHh4eGxpc3QuY29tIiwiYmVzdHkucGwiLCJiZXN0eW91dHViZXByb3h5LmluZm8iLCJiZXQzNjUuY29tIiwiYmV0MzY1LmNvbS5hdSIsImJldGJhc2UxLmluZm8iLCJiZXRjbG91ZC5jb20iLCJiZXRmYWlyLmNvbSIsImJldGZhaXIuY29tLmF1IiwiYmV0dHdlZW4uY29tIiwiYmV0dXMuY29tLnBhIiwiYmV3d3cubmV0IiwiYmV5b25kZmlyZXdhbGwuY29tIiwiYmZtdHYuY29tIiwiYmc2Ny5jb20iLCJiZ2VuZXJhbC5jb20iLCJiZ2Y1Ny5jb20iLCJiZ3Byb3h5Lm9yZyIsImJndG9ycmVudHMuaW5mbyIsImJoYXJhdHN0dWRlbnQuY29tIiwiYmhsZG4uY29tIiwiYmlhMi5jb20iLCJiaWF1c2Eub3JnIiwiYmliaWthLnJ1IiwiYmlibGVzZm9yYW1lcmljYS5vcmciLCJiaWdsb2JlLm5lLmpwIiwiYmlnbmV3cy5vcmciLCJiaWdvbnlvdXR1YmUuY29tIiwiYmlncGFyYS5jb20iLCJiaWdzb3VuZC5vcmciLCJiaWd0aXRtb21teS5jb20iLCJiaWd0aXRzLmNvbSIsImJpZ3RpdHN0b2t5by5jb20iLCJiaWgubmljLmluIiwiYmlrZWktbmV3aGFsZi5jb20iLCJiaW5hcnltb25zdGVyLm5ldCIsImJpbmQyLmNvbSIsImJpbmdwbGFjZXMuY29tIiwiYmluZ3VzaG9wLmNvbSIsImJpb3dhcmUuY29tIiwiYmlwaWMubmV0IiwiYmlyZGhvdXNlYXBwLmNvbSIsImJpc2VsYWhvcmUuY29tIiwiYml0LmRvIiwiYml0Lmx5IiwiYml0Y29pbnRhbGsub3JnIiwiYml0aHVtZW4uYmUiLCJiaXRseS5jb20iLCJiaXRzaGFyZS5jb20iLCJiaXR0b3JyZW50LmNvbSIsImJpemhhdC5jb20iLCJiaXptYW4uY29tLnR3IiwiYml6cG93YS5jb20iLCJiam5ld2xpZmUub3JnIiwiYmp6Yy5vcmciLCJibGFja2RpYW1vbmQtYWkuY29tIiwiYmxhY2tsb2dpYy5jb20iLCJibGFja3NleHNpdGUubmV0IiwiYmxhY2t0b3doaXRlLm5ldCIsImJsYWNrdmlkdHViZS5jb20iLCJibGFja3Zwbi5jb20iLCJibGFuY2hlcG9ydGUuZnIiLCJibGFuY28uY29tIiwiYmxib3lzdHViZS5jb20iLCJibGV3cGFzcy5jb20iLCJibGlnb28uY29tIiwiYmxpbmdibGluZ3NxdWFkLm5ldCIsImJsaW5reC5jb20iLCJibGlwLnR2IiwiYmxvY2tjbi5jb20iLCJibG9ja2Vkc2l0ZWFjY2Vzcy5jb20iLCJibG9nLmNvbSIsImJsb2cuaWR2LnR3IiwiYmxvZ2NhdGFsb2cuY29tIiwiYmxvZ2dlci5iaiIsImJsb2dnZXIuY29tIiwiYmxvZ2dlci5jb20uYnIiLCJibG9nZ2VyM2Nlcm8uY29tIiwiYmxvZ2ltZy5qcCIsImJsb2dsaW5lcy5jb20iLCJibG9nbG92aW4uY29tIiwiYmxvZ21hcmtzLm5ldCIsImJsb2dtZXRyaWNzLm9yZyIsImJsb2duZXZlc2h0LmNvbSIsImJsb2dwaG9uZ3RodXkuY29tIiwiYmxvZ3MuY29tIiwiYmxvZ3Nwb3QuYWUiLCJibG9nc3BvdC5iZSIsImJsb2dzcG90LmNhIiwiYmxvZ3Nwb3QuY2giLCJibG9nc3BvdC5jby5pbCIsImJsb2dzcG90LmNvLm56IiwiYmxvZ3Nwb3QuY28udWsiLCJibG9nc3BvdC5jb20iLCJibG9nc3BvdC5jb20uYXIiLCJibG9nc3BvdC5jb20uYXUiLCJibG9nc3BvdC5jb20uYnIiLCJibG9nc3BvdC5jb20uZXMiLCJibG9nc3BvdC5jb20udHIiLCJibG9nc3BvdC5jeiIsImJsb2dzcG90LmRlIiwiYmxvZ3Nwb3QuZGsiLCJibG9nc3BvdC5maSIsImJsb2dzcG90LmZyIiwiYmxvZ3Nwb3QuZ3IiLCJibG9nc3BvdC5oayIsImJsb2dzcG90Lmh1IiwiYmxvZ3Nwb3QuaWUiLCJibG9nc3BvdC5pbiIsImJsb2dzcG90Lml0IiwiYmxvZ3Nwb3QuanAiLCJibG9nc3BvdC5teCIsImJsb2dzcG90Lm5sIiwiYmxvZ3Nwb3Qubm8iLCJibG9nc3BvdC5wdCIsImJsb2dzcG90LnJlIiwiYmxvZ3Nwb3Qucm8iLCJibG9nc3BvdC5ydSIsImJsb2dzcG90LnNlIiwiYmxvZ3Nwb3Quc2ciLCJibG9nc3BvdC5zayIsImJsb2dzcG90LnR3IiwiYmxvZ3RkLm9yZyIsImJsb29tYmVyZy5jbiIsImJsb29tYmVyZy5jb20iLCJibG9vbWJlcmcuY29tLmJyIiwiYmxvb21iZXJnLmNvbS5teCIsImJsb3dqb2Jjb2xsZWN0aW9uLmNvbSIsImJsdWVzeXN0ZW0ucnUiLCJibHVleGhhbXN0ZXIuY29tIiwiYmx1cnJ5LWV5ZXMuaW5mbyIsImJsdXJ0aXQuY29tIiwiYm5iODkuY29tIiwiYm9hcmRyZWFkZXIuY29tIiwiYm9kb2cxNjguY29tIiwiYm9kb2c4OC5jb20iLCJib2ZhbmcubGEiLCJib2dnbGV1cC5jb20iLCJib2hlbWlhbmNvZGluZy5jb20iLCJib2xlaHZwbi5uZXQiLCJib2xseW1lYW5pbmcuY29tIiwiYm9sbHl3b29kLW1wMy5jb20iLCJib20uZ292LmF1IiwiYm9uYm9ubWUuY29tIiwiYm9uYm9ueW91LmNvbSIsImJvbmRhZ2Vjby5jb20iLCJib25kYWdlcG9zaXRpb24uY29tIiwiYm9uZGFnZXNjYXBlLmNvbSIsImJvbmRlZG9tYWluLmNvbSIsImJvbm55Lmlkdi50dyIsImJvbnBvcm4uY29tIiwiYm9udXN2aWQuY29tIiwiYm9vazR1LmNvbS50dyIsImJvb2ticm93c2UuY29tIiwiYm9va2ZpbmRlci5jb20iLCJib29raW5nYnVkZHkuY29tIiwiYm9va21hcmtpbmdob3N0LmNvbSIsImJvb2tzLmNvbS50dyIsImJvb2tzZm9yZXZlcnlvbmUub3JnIiwiYm9vbGJlcnJ5LmJsdWUiLCJib29tcGxheWVyLmNvbSIsImJvb21wcm94eS5jb20iLCJib29tdHVubmVsLmNvbSIsImJvb29vYnMub3JnIiwiYm9vc3R3aXR0ZXIuY29tIiwiYm9vdHN0cmFwdmFsaWRhdG9yLmNvbSIsImJvcmRhLnJ1IiwiYm9yZWRvbWJhc2guY29tIiwiYm9yc2FndW5kZW0uY29tIiwiYm90Lm51IiwiYm90YW5pa3JleW9uLm9yZyIsImJvdGFud2FuZy5jb20iLCJib3RpZC5vcmciLCJib3V5Z3Vlc3RlbGVjb20uY29tIiwiYm93ZW5wcmVzcy5jb20iLCJib3dlci5pbyIsImJveC5jb20iLCJib3gubmV0IiwiYm94Y2FyLmlvIiwiYm94Y24ubmV0IiwiYm94b2ZmaWNlbW9qby5jb20iLCJib3hwbi5jb20iLCJib3h1ZXNreS5jb20iLCJib3h1bi5jb20iLCJib3h1bi50diIsImJveWZyaWVuZHR2LmNvbSIsImJveWdsb3J5aG9sZS5jb20iLCJib3lzZm9vZC5jb20iLCJicGVyZ3JvdXAubmV0IiwiYnBzMTAyNS5jb20iLCJici1vbHNob3AuY29tIiwiYnJhaW5nbGUuY29tIiwiYnJhaW5qdWljZXIuY29tIiwiYnJhaW5wb3AuZnIiLCJicmFta2EtcHJveHkucGwiLCJicmFta2Fwcm94eS5uZXQucGwiLCJicmFuZGliZWxsZS5jb20iLCJicmFzc3JpbmcuY29tIiwiYnJhdmVqb3VybmFsLmNvbSIsImJyYXZpY2EudHYiLCJicmF2b2Vyb3RpY2EuY29tIiwiYnJhdm90ZWVucy5jb20iLCJicmF2b3R1YmUuY29tIiwiYnJhdm90dWJlLm5ldCIsImJyYXppbHByb3h5LmNvbSIsImJyYi50byIsImJyZWFrLmNvbSIsImJyZWFraW5ndHdlZXRzLmNvbSIsImJyaWVmZHJlYW0uY29tIiwiYnJpbm8uaW5mbyIsImJyaXN0b2xwb3N0LmNvLnVrIiwiYnJpdGlzaG11c2V1bS5vcmciLCJicm9hZGNhc3R5b3V0dWJlLmNvbSIsImJyb2tlYmFja2FzaWFucy5jb20iLCJicm93bnN1Z2FyLmlkdi50dyIsImJyb3dzZWMuY29tIiwiYnJzYm94LmNvbSIsImJydC5pdCIsImJydXRhbHRncC5jb20iLCJic25sLmNvLmluIiwiYnQuY29tIiwiYnRjY2hpbmEuY29tIiwiYnRkaWdnLm9yZyIsImJ0a2l0dHkuY29tIiwiYnRvbGF0LmNvbSIsImJ0c210aC5jb20iLCJidHVubmVsLmNvbSIsImJ1YnVrdWEuY29tIiwiYnVkYS5pZHYudHciLCJidWRhZWR1Lm9yZyIsImJ1ZGFlZHUub3JnLnR3IiwiYnVkZGhhbmV0Lmlkdi50dyIsImJ1ZGRoaXN0Y2hhbm5lbC50diIsImJ1ZHRlcmVuY2UudGsiLCJidWxsb2cub3JnIiwiYnVsbG9nZ2VyLmNvbSIsImJ1bm55bHVzdC5jb20iLCJidXJheWRhaGNpdHkubmV0IiwiYnVybzI0Ny5ydSIsImJ1c2F5YXJpLmNvbSIsImJ1c2NhcGUuY29tLmJyIiwiYnVzaW5lc3MtZ2F6ZXRhLnJ1IiwiYnVzaW5lc3MuZ292LmF1IiwiYnVzaW5lc3NiYWxsYS5jb20iLCJidXNpbmVzc29mY2luZW1hLmNvbSIsImJ1c2luZXNzc3BlY3RhdG9yLmNvbS5hdSIsImJ1c2luZXNzdGltZXMuY29tLmNuIiwiYnVzaW5lc3N3ZWVrLmNvbSIsImJ1c3R5Y2F0cy5jb20iLCJidXN5Yml0cy5jb20iLCJidXN5dHJhZGUuY29tIiwiYnV0dGVyZnVuay5jb20iLCJidXR0ZnVja2luZ2J1bmNoLmNvbSIsImJ1eGRvdC5jb20iLCJidXktaW5zdGFncmFtLmNvbSIsImJ1eWluZ2lxLmNvbSIsImJ1enpmZWVkLmNvbSIsImJ1enptYWcuanAiLCJidXp6cHJveHkuY29tIiwiYnV6enR0ZXIuY29tIiwiYnhkbHcuY29tIiwiYnh3eC5uZXQiLCJieHd4Lm9yZyIsImJ5Y29udGV4dC5jb20iLCJieWV0aG9zdDguY29tIiwiYnlwYXNzLWJsb2NrLmNvbSIsImJ5cGFzczEyMy5jb20iLCJieXBhc3NhYmxlLmNvbSIsImJ5cGFzc3NjaG9vbGZpbHRlci5jb20iLCJieXBhc3NzaXRlLmNvbSIsImJ5cGFzc3RoYXQuY29tIiwiYnlwYXNzdGhlLm5ldCIsImJ5cGFzc3kuY29tIiwiYnlwcm94eXNlcnZlci5jb20iLCJieXRiaWwuY29tIiwiYy1zcGFudmlkZW8ub3JnIiwiYzAwOS5uZXQiLCJjMmJzYS5jb20iLCJjYWNhb3dlYi5vcmciLCJjYWNudy5jb20iLCJjYWN0dXN2cG4uY29tIiwiY2FkZHkuaWR2LnR3IiwiY2FkZW5hMTAwLmVzIiwiY2FkZW5hMy5jb20iLCJjYWZlYmxvZy5odSIsImNhZmVwcmVzcy5jb20iLCJjYWZlcHJlc3MuY29tLmF1IiwiY2FoYWwtbWFuaWEuY29tIiwiY2FsYW1lby5jb20iLCJjYWxjaWF0b3JpYnJ1dHRpLmNvbSIsImNhbGdhcnljaGluZXNlLmNvbSIsImNhbGdhcnluZXdsaWZlLmNvbSIsImNhbG9vLmpwIiwiY2FtNC5jby51ayIsImNhbTQuY29tIiwiY2FtNC5jb20uYXUiLCJjYW00LmNvbS5iciIsImNhbTQuY29tLmN5IiwiY2FtNC5jb20udHIiLCJjYW00LmpwIiwiY2FtNC5ubCIsImNhbWRvdWdoLmNvbSIsImNhbWVsZW8ucnUiLCJjYW1lcmFjYXB0dXJlcy5jb20iLCJjYW1mcm9nLmNvbSIsImNhbXBhaWdubGl2ZS5jby51ayIsImNhbXBiZWxsc2tpdGNoZW4uY29tIiwiY2Ftcy5jb20iLCJjYW1zLmNvbS5hdSIsImNhbmFkYS5jb20iLCJjYW5hZGFtZWV0Lm1lIiwiY2FuYWxwbHVzLmZyIiwiY2FubGlza29yLmNvbSIsImNhbmxpeWF5aW4ub3JnIiwiY2Fub2UuY2EiLCJjYW5vbmljYWwuY29tIiwiY2FudHYubmV0IiwiY2FueXUub3JnIiwiY2FvLmltIiwiY2FvMzEuY29tIiwiY2FvNjQuY29tIiwiY2FvODkuY29tIiwiY2FvYmlhbi5pbmZvIiwiY2FvY2hhbmdxaW5nLmNvbSIsImNhb2xpdXNoZXF1NTIwLmluZm8iLCJjYW9wb3JuLmNvbSIsImNhcGFkZWZhY2Vib29rLmNvbSIsImNhcHRhaW5zcXVhcnRlcnMuY29tIiwiY2FwdGlvbnNmb3J5b3V0dWJlLmNvbSIsImNhci5jb20iLCJjYXJhYmluYXN5cGlzdG9sYXMuY29tIiwiY2FyYWRpc2lhYy5jb20iLCJjYXJhbmRjbGFzc2ljLmNvLnVrIiwiY2FyZWVybGF1bmNoZXIuY29tIiwiY2FyaS5jb20ubXkiLCJjYXJtb3RvcnNob3cuY29tIiwiY2FydG9vbmFuaW1lZmFucy5jb20iLCJjYXJ0b29ubW92ZW1lbnQuY29tIiwiY2FydG9vbm5ldHdvcmtzaG9wLmNvbSIsImNhcnRvb25zZXh4Lm5ldCIsImNhcnRvb25zcXVlZW4uY29tIiwiY2Fyem9uZS5pZSIsImNhc2F0aWJldC5vcmcubXgiLCJjYXNoYWRwcm94eS5pbmZvIiwiY2FzaGZvcnNleHRhcGUuY29tIiwiY2FzaW5vYmVsbGluaS5jb20iLCJjYXNpbm9ldXJvLmNvbSIsImNhc2lub2xhc3ZlZ2FzLmNvbSIsImNhc2lub3JpdmEuY29tIiwiY2FzdGFuZXQubmV0IiwiY2F0LXdvcmxkLmNvbS5hdSIsImNhdGhuZXdzLmNvbSIsImNhdGhvbGljLm9yZy5oayIsImNhdGhvbGljLm9yZy50dyIsImNhdGh2b2ljZS5vcmcudHciLCJjYmMuY2EiLCJjYnNuZXdzLmNvbSIsImNienM4ODcuY24iLCJjYy1hbmltZS5jb20iLCJjY2RhaWxpLmNvbSIsImNjZHRyLm9yZyIsImNjaW0ub3JnIiwiY2Nwcm94eS5wdyIsImNjdGhlcmUuY29tIiwiY2N0b25nYmFvLmNvbSIsImNjdHY1emIuY29tIiwiY2N1ZS5jYSIsImNjdWUuY29tIiwiY2N5b3V0dWJlLmNvbSIsImNkaXNjb3VudC5jb20uY28iLCJjZG5ldC50diIsImNkbmV3cy5jb20udHciLCJjZG5zLmNvbS50dyIsImNkbzIzLmlkdi50dyIsImNkc212b2QuY29tIiwiY2UuZ292LmJyIiwiY2U0YXJhYi5jb20iLCJjZWNjLmdvdiIsImNlZ2FsYXBpdGFzaXJvcnN6YWdiYW4uaW5mbyIsImNlbC5ybyIsImNlbGVicml0eW1vdmllYmxvZy5jb20iLCJjZWxscGhvbmVzaG9wLm5ldCIsImNlbnRhbmV0LmNvbSIsImNlbnRlcmJicy5jb20iLCJjZW50ZXJibG9nLm5ldCIsImNlbnRyb21ldGVvaXRhbGlhbm8uaXQiLCJjZW50dXJ5Y2hpbmEuY29tIiwiY2VudHVyeXMubmV0IiwiY2VyZGFzLmNvbSIsImNlc3VtYXIuYnIiLCJjZmlzaC5pZHYudHciLCJjaGFiYWQub3JnIiwiY2hhbmRhbi5vcmciLCJjaGFuZ2Uub3JnIiwiY2hhbmdlNTIxLmNvbSIsImNoYW5nZXRoZWlwLmNvbSIsImNoYW5ncC5jb20iLCJjaGFubnllaW4ub3JnIiwiY2hhcG0yNS5jb20iLCJjaGFyb25ib2F0LmNvbSIsImNoYXR1cmJhdGUuY29tIiwiY2hhdHp5LmNvbSIsImNoYXlpY2kuaW5mbyIsImNoZWFwZXJzZWVrZXIuY29tIiwiY2hlYXB5b3V0dWJlLmNvbSIsImNoZWNrZWRwcm94eWxpc3RzLmNvbSIsImNoZWNrbXltaWxmLmNvbSIsImNoZWNrdGhpcy5jb20iLCJjaGVla3kuY29tLmFyIiwiY2hlbmdtaW5nbWFnLmNvbSIsImNoZW5ncmVuYmFyLmNvbSIsImNoZW5ndWFuZ2NoZW5nLmNvbSIsImNoZXNzYm9tYi5jb20iLCJjaGV6YXNpdGUuY29tIiwiY2hpY2Fnb25vdy5jb20iLCJjaGljYXNmYWNlYm9vay5jb20iLCJjaGllZnN1bi5vcmcudHciLCJjaGluYS1sYWJvdXIub3JnLmhrIiwiY2hpbmEtcHJveHkub3JnIiwiY2hpbmEtd2Vlay5jb20iLCJjaGluYTEwMS5jb20iLCJjaGluYTUwMDAudXMiLCJjaGluYWNpdHkuYmUiLCJjaGluYWNpdHlpbmZvLmJlIiwiY2hpbmFkaWFsb2d1ZS5uZXQiLCJjaGluYWRpZ2l0YWx0aW1lcy5uZXQiLCJjaGluYWVsZWN0aW9ucy5vcmciLCJjaGluYWV3ZWVrbHkuY29tIiwiY2hpbmFnZncub3JnIiwiY2hpbmFncmVlbnBhcnR5Lm9yZyIsImNoaW5hZ3Jvd3MuY29tIiwiY2hpbmFodXNoLmNvbSIsImNoaW5haW5wZXJzcGVjdGl2ZS5jb20iLCJjaGluYWlucGVyc3BlY3RpdmUub3JnIiwiY2hpbmFsYWJvcndhdGNoLm9yZyIsImNoaW5hbXVsZS5jb20iLCJjaGluYXByZXNzLmNvbS5teSIsImNoaW5hcHJveHkubWUiLCJjaGluYXJlYWN0aW9uLmNvbSIsImNoaW5hcmlnaHRzaWEub3JnIiwiY2hpbmFzbWlsZS5uZXQiLCJjaGluYXRvcGl4LmNvbSIsImNoaW5hdG93bi5jb20uYXUiLCJjaGluYXR1bmdzdGVuLmNvbSIsImNoaW5hd29ya2VyLmluZm8iLCJjaGluYXlvdXRoLm9yZy5oayIsImNoaW5lc2UtaGVybWl0Lm5ldCIsImNoaW5lc2UubmV0LmF1IiwiY2hpbmVzZWRhaWx5LmNvbSIsImNoaW5lc2VpbmxhLmNvbSIsImNoaW5lc2Vsb3ZlbGlua3MuY29tIiwiY2hpbmVzZW4uZGUiLCJjaGluZXNlcGVuLm9yZyIsImNoaW5lc2Vwb3Jud2ViLmNvbSIsImNoaW5lc2V0YWxrcy5uZXQiLCJjaGluZ2NoZW9uZy5jb20iLCJjaGluaHBodS52biIsImNob2RpZW50dS52biIsImNob2lzdGVyLnJ1IiwiY2hvc3VuLmNvbSIsImNocmlzdGFiZWxsZS5pZHYudHciLCJjaHJpc3RpYW5tYXRjaG1ha2VyLmNvbSIsImNocmlzdGlhbnN0dWR5LmNvbSIsImNocmlzdGlhbnRpbWVzLm9yZy5oayIsImNocmxhd3llcnMuaGsiLCJjaHJvbWUuY29tIiwiY2h1YmJ5cGFyYWRlLmNvbSIsImNodWJieXBvcm4ueHh4IiwiY2h1YnVuLmNvbSIsImNodW5zaHVpdGFuZy5jb20udHciLCJjaWVueS5jb20iLCJjaW5jb2RpYXMuY29tIiwiY2luZW1hdGlja2V0Lm9yZyIsImNpbmVzcG9ydC5jb20iLCJjaXByb3h5LmRlIiwiY2lyY2xlb2Ztb21zLmNvbSIsImNpcmNvdmlyYWwuY29tIiwiY2l0aS5jb20iLCJjaXRpYmFuay5jby5qcCIsImNpdGl6ZW5sYWIub3JnIiwiY2l0eTM2NS5jYSIsImNpdHk5eC5jb20iLCJjaXR5Y2x1YmNhc2luby5jb20iLCJjaXR5dmliZS5jb20iLCJjaXZpY3BhcnR5LmhrIiwiY2l2aWxtZWRpYS50dyIsImNpdmlzZWMub3JnIiwiY2l4cHJveHkuY29tIiwiY2sxMDEuY29tIiwiY2tkdmQuY29tIiwiY2tmNTgwLmNvbSIsImNsYXNzaWNhbGl0ZS5jb20iLCJjbGFzc2lmaWVkczRtZS5jb20iLCJjbGFzc2lmaWVkc2ZvcmZyZWUuY29tIiwiY2xheW1vbnQuY29tIiwiY2xiLm9yZy5oayIsImNsZWFuYWR1bHRob3N0LmNvbSIsImNsZWFuZnJlZXBvcm4uY29tIiwiY2xlYXJjbGlwcy5jb20iLCJjbGVhcmhhcm1vbnkubmV0IiwiY2xlYXJoaWRlLmNvbSIsImNsZWFycHguaW5mbyIsImNsZWFyd2lzZG9tLm5ldCIsImNsZW9ib29icy5jb20iLCJjbGljaWMuY29tIiwiY2xpY2twcm90ZWN0cy5jb20iLCJjbGlja3h0aS5jb20iLCJjbGluaWNhLXRpYmV0LnJ1IiwiY2xpcC5kaiIsImNsaXBhcnRwYW5kYS5jb20iLCJjbGlwZmlzaC5kZSIsImNsaXBodW50ZXIuY29tIiwiY2xpcG9ueXUuY29tIiwiY2xpcHNvbnlvdXR1YmUuY29tIiwiY2xpcHRvZGF5LnZuIiwiY2xpcHhvb20uY29tIiwiY2xpcHlvdXR1YmUuY29tIiwiY2xpdGdhbWVzLmNvbSIsImNsb2FrZWQuZXUiLCJjbG9vYi5jb20iLCJjbG91ZGZvcmNlLmNvbSIsImNsb3VkdnBuLmJpeiIsImNsdDIwLmNvbSIsImNsdWItZS5uZXQiLCJjbHViZWRpbmhlaXJvbm9mYWNlYm9vay5jb20iLCJjbHVidGhhaWNoaXguY29tIiwiY21zdHJhZGVyLmNvbSIsImNtdWxlLmNvbSIsImNtdWxlLm5ldCIsImNuNi5ldSIsImNuYS5jb20iLCJjbmEuY29tLmJyIiwiY25hLmNvbS50dyIsImNuYWJjLmNvbSIsImNuYXZpc3RhLmNvbS50dyIsImNuZC5vcmciLCJjbml0dGVyLmNvbSIsImNubi5jb20iLCJjbnByb3h5LmNvbSIsImNudHJhdmVsbGVyLmNvbSIsImNueWVzLmNvbSIsImNuenouY2MiLCJjby50diIsImNvY2hsZWFyLmNvbSIsImNvZGUtY2x1Yi5pZHYudHciLCJjb2RlMTk4NC5jb20iLCJjb2RlYXNpdGUuY29tIiwiY29kaWdvZXNwYWd1ZXRpLmNvbSIsImNvZW5yYWV0cy5vcmciLCJjb2Zvby5jb20iLCJjb2lud2Fyei5jb20iLCJjb2xhY2xhc3NpYy5jby51ayIsImNvbGV2YWxsZXljaHJpc3RpYW4ub3JnIiwiY29sZmluYW5jaWFsLmNvbSIsImNvbGxlZ2Vib2FyZC5jb20iLCJjb2xvcm15ZmFjZWJvb2suY29tIiwiY29tLnVrIiwiY29tY2FzdC5jb20iLCJjb21kb3RnYW1lLmNvbSIsImNvbWVmcm9tY2hpbmEuY29tIiwiY29taWNib29rLmNvbSIsImNvbWx1LmNvbSIsImNvbW1hbmRhcm1zLmNvbSIsImNvbXBhbnljaGVjay5jby51ayIsImNvbXBhcmVyYWphLmluIiwiY29tcGFzcy1zdHlsZS5vcmciLCJjb21wbGV0ZWxvdW5nZS5jb20iLCJjb21wcmFyc2VndWlkb3Jlc2luc3RhZ3JhbS5jb20iLCJjb21wcmVzc25vdy5jb20iLCJjb21wdXRlcnZhbGxleS5pdCIsImNvbXB5dGhvbi5uZXQiLCJjb25leGFva2luZ2hvc3QuY29tLmJyIiwiY29uZmlndXJhcmVxdWlwb3MuY29tIiwiY29ubmVjdC5mYWNlYm9vay5uZXQiLCJjb25zdW1lci5lcyIsImNvbnRhY3RtdXNpYy5jb20iLCJjb250b2Vyb3RpY28uY29tIiwiY29udmVyc2lvbmhlcm8uY29tLmJyIiwiY29udmVydGlzc2V1ci15b3V0dWJlLmNvbSIsImNvbnZlcnRvbmxpbmVmcmVlLmNvbSIsImNvbnZlcnR5b3V0dWJlLmNvbSIsImNvb2JhaS5jb20iLCJjb29iYXkuY29tIiwiY29va2llcGFydHMuY29tIiwiY29va2luZ2xpZ2h0LmNvbSIsImNvb2xhbGVyLmNvbSIsImNvb2xiaXRzLm9yZyIsImNvb2xsb3VkLm9yZy50dyIsImNvb2xuY3V0ZS5jb20iLCJjb29sc3BvdHRlcnMuY29tIiwiY29vbHN1bi5pZHYudHciLCJjb3BlcnRpbmFmYWNlYm9vay5jb20iLCJjb3JjaG9kZWxwYWlzLmNvbSIsImNvcnBiYW5rLmNvbSIsImNvcnJlY3QtaW5zdGFsbC5jb20iLCJjb3JyZWlvMjRob3Jhcy5jb20uYnIiLCJjb3JyZWlvYnJhemlsaWVuc2UuY29tLmJyIiwiY29ydGVyYS5jb20iLCJjb3Ntb2hpc3Bhbm8uY29tIiwiY290d2VldC5jb20iLCJjb3VnYXJwb3JuLmNvbSIsImNvdW50cnl2cG4uY29tIiwiY291dmVydHVyZWZhY2Vib29rLmNvbSIsImNvdmVyZWRjYS5jb20iLCJjb3ZlcnRicm93c2luZy5jb20iLCJjb3djb3RsYW5kLmNvbSIsImNwai5vcmciLCJjcHJveHkuY29tIiwiY3Byb3h5ZXIuY29tIiwiY3B1aWQuY29tIiwiY3FlbnQubmV0IiwiY3JhY2tlZC5jb20iLCJjcmFja2xlLmNvbSIsImNyYWNrbGUuY29tLmFyIiwiY3JhY2tsZS5jb20uYnIiLCJjcmFja2xlLmNvbS5kbyIsImNyYWNrbGUuY29tLm14IiwiY3JhY2tsZS5jb20ucGEiLCJjcmFja2xlLmNvbS52ZSIsImNyYXp5cy5jYyIsImNyYXp5c2hpcnRzLmNvbSIsImNyZWFkZXJzLm5ldCIsImNyZWFybGlzdGFjb255b3V0dWJlLmNvbSIsImNyZWFydGllbmRhZW5mYWNlYm9vay5jb20iLCJjcmVhdGVpdC5wbCIsImNyZWF0aXZlYmxvcS5jb20iLCJjcmlhcmluc3RhZ3JhbS5jb20iLCJjcmlhcmluc3RhZ3JhbS5jb20uYnIiLCJjcm1scy5vcmciLCJjcm9jb2d1aWRlLmNvbSIsImNyb2NvdHViZS5jb20iLCJjcm9uaWNhLmNvbS5hciIsImNyb3NzdGhld2FsbC5uZXQiLCJjcm9zc3Zwbi5vcmciLCJjcm93bnByb3h5LmNvbSIsImNyb3ducm95YWwuY29tIiwiY3J1aXNlY3JpdGljLmNvbSIsImNzaGFiYy5jb20iLCJjc3MtdmFsaWRhdG9yLm9yZyIsImNzdWNoaWNvLmVkdSIsImN0ZnJpZW5kLm5ldCIsImN0aXR2LmNvbS50dyIsImN0cy5jb20udHciLCJjdHRzcnYuY29tIiwiY3R1bm5lbC5jb20iLCJjdS5lZHUuZWciLCJjdWJhY29udGVtcG9yYW5lYS5jb20iLCJjdWhrYWNzLm9yZyIsImN1aWh1YS5vcmciLCJjdWl3ZWlwaW5nLm5ldCIsImN1bHRkZWFkY293LmNvbSIsImN1bHR1cmUudHciLCJjdW0taW4tYWlyLmNvbSIsImN1bXBvb2wuY29tIiwiY3VtcG9ybnR1YmUuY29tIiwiY3Vwb25vbWlhLmNvbS5iciIsImN1cmVqb3kuY29tIiwiY3VyZXpvbmUub3JnIiwiY3VycC5nb2IubXgiLCJjdXJyeXMuY28udWsiLCJjdXJ0aW5kb2ltYWdlbnNub2ZhY2Vib29rLmNvbSIsImN1dGU4Mi5jb20iLCJjdXRlZGVhZGd1eXMubmV0IiwiY3ZzLmNvbSIsImN3LmNvbS50dyIsImN3YWhpLm5ldCIsImN3Yi5nb3YudHciLCJjeWJlci1uaW5qYS5qcCIsImN5YmVyY3RtLmNvbSIsImN5YmVyZ2hvc3R2cG4uY29tIiwiY3liZXJ0cmFuc2xhdG9yLmlkdi50dyIsImN5Y2xld29ybGQuY29tIiwiY3lsZXguY29tLmF1IiwiY3l0dS5iZSIsImN6LmNjIiwiZC0wNjQuY29tIiwiZC1hZ2VuY3kubmV0IiwiZDB6Lm5ldCIsImQxMDAubmV0IiwiZDFnLmNvbSIsImQyanNwLm9yZyIsImQzanMub3JnIiwiZDgudHYiLCJkOWNuLmNvbSIsImQ5dm9kLmNvbSIsImRhYnIuY28udWsiLCJkYWRhemltLmNvbSIsImRhZGVzY2hvb2xzLm5ldCIsImRhZGkzNjAuY29tIiwiZGFlcGlzby5jb20iLCJkYWZhaGFvLmNvbSIsImRhaWRvc3R1cC5ydSIsImRhaWxpYW4uY28ua3IiLCJkYWlsaWRhaWxpLmNvbSIsImRhaWxpbGEubmV0IiwiZGFpbHkubWsiLCJkYWlseWRvdC5jb20iLCJkYWlseWZ4LmNvbS5oayIsImRhaWx5bWUuY29tIiwiZGFpbHltb3Rpb24uY29tIiwiZGFpbHluZXdzLmNvbSIsImRhaWx5bm9yc2VtYW4uY29tIiwiZGFpbHlzdHJlbmd0aC5vcmciLCJkYWlseXRlY2guY29tIiwiZGFpcnltYXJ5LmNvbSIsImRhaXdhMjEuY29tIiwiZGFqaXl1YW4uY29tIiwiZGFqaXl1YW4uZXUiLCJkYWtkb3duLm5ldCIsImRhbGFpbGFtYS1oYW1idXJnLmRlIiwiZGFsYWlsYW1hLmNvbSIsImRhbGFpbGFtYWNlbnRlci5vcmciLCJkYWxhaWxhbWF3b3JsZC5jb20iLCJkYWxpdWxpYW4uY29tIiwiZGFtaW1pLnVzIiwiZGFuY2luZ2JlYXIuY29tIiwiZGFuZm9zcy5jb20iLCJkYW5nZXJwcm94eS5jb20iLCJkYW5qdXIuY29tIiwiZGFua2U0Y2hpbmEubmV0IiwiZGFudGVudy5jb20iLCJkYW53ZWkub3JnIiwiZGFvbGFuLm5ldCIsImRhcGV0ZHVpdGRhcml0d2l0dGVyLmNvbSIsImRhcm1vd2UtcHJveHkucGwiLCJkYXRlZm9ybW9yZS5kZSIsImRhdmlkZ3VvLmlkdi50dyIsImRhdmlkbmV3cy5jb20iLCJkYXZpZHppZWdsZXIubmV0IiwiZGF3aG9pcy5jb20iLCJkYXlhYm9vay5jb20iLCJkYXlsaWZlLmNvbSIsImRheW9uZWFwcC5jb20iLCJkYnMuY29tIiwiZGNtaWxpdGFyeS5jb20iLCJkZC1wZWxpY3VsYXMuY29tIiwiZGQ4NTguY29tIiwiZGRjLmNvbS50dyIsImRkZm5ldHdvcmsuY29tIiwiZGRody5jb20iLCJkZGx2YWxsZXkucm9ja3MiLCJkZG5zLm1lIiwiZGRucy5tZS51ayIsImRkb2tiYXJvLmNvbSIsImRkb28uY2MiLCJkZHNvbmd5eS5jb20iLCJkZS1zY2kub3JnIiwiZGVhbGFtLmNvbSIsImRlYXJob25leS5pZHYudHciLCJkZWFybW9uZXkuaWR2LnR3IiwiZGViaWFuLm9yZyIsImRlY2F0aGxvbi5jb20uYnIiLCJkZWNhdGhsb24uaW4iLCJkZWR1ZHUuY29tIiwiZGVmYXVsdC1zZWFyY2gubmV0IiwiZGVmaWx0ZXIudXMiLCJkZWZpbmViYWJlLmNvbSIsImRla2hvLmluIiwiZGVsZXRlZmFjZWJvb2suY29tIiwiZGVsZmllbGQuY29tIiwiZGVsaGkuZ292LmluIiwiZGVsc2hla2FzdGUuY29tIiwiZGVtYW5kd2FyZS5uZXQiLCJkZW1vY3JhdHMub3JnIiwiZGVtb3RpdmF0aW9uLm1lIiwiZGVubWFya2JheS5pbmZvIiwiZGVub2ZnZWVrLnVzIiwiZGVueXNvZnlhbi53ZWIuaWQiLCJkZXBvLnVhIiwiZGVwcWMuY29tIiwiZGVyZWtoc3UuaG9tZWlwLm5ldCIsImRlc2Nhcmdhcmluc3RhZ3JhbS5jb20iLCJkZXNjYXJnYXJ2aWRlb3NmYWNlYm9vay5jb20iLCJkZXNjYXJnYXJ5b3V0dWJlLmNvbSIsImRlc2thcHBsaWMuY29tIiwiZGVzdGlueWNoYXQuY29tIiwiZGVzdHJveW1pbGYuY29tIiwiZGV3YXdlYi5jb20iLCJkZXppeW91LmluIiwiZGYuZ29iLm14IiwiZGdsb2JlLmNvbSIsImRnc25qcy5jb20iLCJkaGFkcy5jb20iLCJkaGwuY29tIiwiZGhsLml0IiwiZGlhZ2VvLWNhcmVlcnMuY29tIiwiZGlhZ2VvLmNvbSIsImRpYW95dWlzbGFuZHMub3JnIiwiZGlhcmlvZGVjdWJhLmNvbSIsImRpYXJpb2RlY3V5by5jb20uYXIiLCJkaWFyaW9kZW5hdmFycmEuZXMiLCJkaWFyaW9sYXNhbWVyaWNhcy5jb20iLCJkaWFyaW92YXNjby5jb20iLCJkaWFyeS5ydSIsImRpY2FzaW5zdGFncmFtLmNvbSIsImRpZ2lzb2NpYWwuY29tIiwiZGlnaXRhbGNhbWVyYXdvcmxkLmNvbSIsImRpZ2l0YWxqb3VybmFsLmNvbSIsImRpZ2l0YWxrYW1lcmEuZGUiLCJkaWdpdGFsbGluay5pbmZvIiwiZGlnaXRhbHJvb20uY29tIiwiZGlnaXRhbHNweS5jby51ayIsImRpZ2l0YWx0cmVuZHMuY29tIiwiZGlpZ28uY29tIiwiZGlsYmVydC5jb20iLCJkaW1lbnNpb25kYXRhLmNvbSIsImRpbmFtaW5hLmxrIiwiZGluZG8uY29tLmNvIiwiZGlub2RpcmVjdC5jb20iLCJkaW8uaWR2LnR3IiwiZGlwaXR5LmNvbSIsImRpcGx5LmNvbSIsImRpcHV0YWRvcy5nb2IubXgiLCJkaXNjdXNzLmNvbS5oayIsImRpc2N1c3M0dS5jb20iLCJkaXNpc2UuY29tIiwiZGlzbmV5LmVzIiwiZGlzbmV5LnBsIiwiZGlzbmV5anVuaW9yLmNvbSIsImRpc3AuY2MiLCJkaXNwdXQuYXoiLCJkaXQtaW5jLnVzIiwiZGl0ZW5vay5jb20iLCJkaXZlaW50b2h0bWw1LmluZm8iLCJkaXZ4ZGwuaW5mbyIsImRpd3VqaS5jYyIsImRpeWlkbS5uZXQiLCJkaXpoaWRpemhpLmNvbSIsImRpemlnb2xkLmNvbSIsImRpeml3dS5jb20iLCJkamF6YWlyZXNzLmNvbSIsImRqanNxLmNvbSIsImRqb3J6LmNvbSIsImRqdGVjaHRvb2xzLmNvbSIsImRrcHJveHkuY29tIiwiZGwtb25saW5lLmNvbSIsImRsaW5rLmNvbSIsImRtNS5jb20iLCJkbW0xOC5uZXQiLCJkbW5ld3MuY29tIiwiZG5iLmNvbSIsImRuczJnby5jb20iLCJkbnNjcnlwdC5vcmciLCJkbnN0dWJlLnRrIiwiZG8zbi5jb20iLCJkb2NiYW8udm4iLCJkb2NzdG9jLmNvbSIsImRvY3RvcnByb3h5LmNvbSIsImRvY3RvcnZvaWNlLm9yZyIsImRvZ2JyZWVkaW5mby5jb20iLCJkb2dlY29pbi5jb20iLCJkb2dpZGVhc2l0ZS5jb20iLCJkb2d4eHh0dWJlLmNvbSIsImRvamluLmNvbSIsImRvay1mb3J1bS5uZXQiLCJkb2xjLmRlIiwiZG9tYWkubnIiLCJkb21haW4uY2x1Yi50dyIsImRvbWFpbjRpay5ydSIsImRvbWlrLnVhIiwiZG9uZ2ZhbmdzaG91bGllLmNvbSIsImRvbmdsZS5jYyIsImRvbmd0YWl3YW5nLmNvbSIsImRvbmd0YWl3YW5nLm5ldCIsImRvbmd5YW5namluZy5jb20iLCJkb25rcGFydHkuY29tIiwiZG9udGZpbHRlci51cyIsImRvby5pZHYudHciLCJkb29iaXQuaW5mbyIsImRvcHIubmV0IiwiZG9yamVzaHVnZGVuLmNvbSIsImRvc3NpZXJmYW1pbGlhbC5jb20iLCJkb3RwbGFuZS5jb20iLCJkb3RzdWIuY29tIiwiZG90dXAub3JnIiwiZG90dnBuLmNvbSIsImRvdWJsZWNsaWNrLmNvbSIsImRvdXBoaW5lLmNvbSIsImRvdXN5b2tvLm5ldCIsImRvdXl1dHYuY29tIiwiZG93bmF2LmNvbSIsImRvd25mYWNlYm9vay5jb20iLCJkb3dubG9hZC1ub3ctZm9yLXBjLm5ldCIsImRvd25sb2FkLmNvbSIsImRvd25sb2FkLmNvbS52biIsImRveW91dGhpbmtpbXByb3h5LmluZm8iLCJkcHAub3JnLnR3IiwiZHJhZ29uYnl0ZS10ZWNoLmNvbSIsImRyYWd0aW1lcy5jb20iLCJkcmF1Z2FzLmx0IiwiZHJlYW1tb3ZpZXMuY29tIiwiZHJlYW1uZXQuY29tIiwiZHJlYW15b3V0dWJlLmNvbSIsImRyaWJiYmxlLmNvbSIsImRyb2lkdnBuLmNvbSIsImRyb3Bib3guY29tIiwiZHJvcGJveC5jb20uYnIiLCJkcm9wYm94cHJveHkuY29tIiwiZHJvcHRhc2suY29tIiwiZHJ0dWJlci5jb20iLCJkcnVua2VudGVlbm9yZ2llcy5jb20iLCJkc3BhcmtpbmcuY29tIiwiZHR1bm5lbC5jb20iLCJkdHotdWdsLmNvbSIsImR1LmFjLmluIiwiZHVhbnRpYW4uY29tIiwiZHViaW50ZXJ2aWV3ZXIuY29tIiwiZHVjay5oayIsImR1Y2tkdWNrZ28uY29tIiwiZHVja2xvYWQuY29tIiwiZHVnYS5qcCIsImR1aWh1YS5vcmciLCJkdW55YWJ1bHRlbmkubmV0IiwiZHVueWFuZXdzLnR2IiwiZHVvd2VpdGltZXMuY29tIiwiZHVwaW5nLm5ldCIsImR1c2hpLmNhIiwiZHVzdC01MTQub3JnIiwiZHV0Y2hwcm94eS5ubCIsImR2ZC01MC5jb20iLCJkdmR2aWRlb3NvZnQuY29tIiwiZHctd29ybGQuY29tIiwiZHctd29ybGQuZGUiLCJkdy5kZSIsImR3aGVlbGVyLmNvbSIsImR3bmV3cy5jb20iLCJkeGlvbmcuY29tIiwiZHkxLmNjIiwiZHkyMDE4LmNvbSIsImR5Nzc4OC5jb20iLCJkeTkxLmNvbSIsImR5aGx3LmNvbSIsImR5bGlhbm1lbmcuY29tIiwiZHluYW1vLmtpZXYudWEiLCJkeW5kbnMub3JnIiwiZHplbXBsb2kub3JnIiwiZHp6ZS5jb20iLCJlLWRhc2hlci5jb20iLCJlLWZhbWlseW5ldC5jb20iLCJlLWdvbGQuY29tIiwiZS1pbmZvLm9yZy50dyIsImUta29nYWwuY29tIiwiZS1zaG9wLmdyIiwiZS1zcGFjeS5jb20iLCJlLXRyYWRlcmxhbmQubmV0IiwiZS10cmF2ZWwuY29tIiwiZTEucnUiLCJlMTIzLmhrIiwiZTIwMjAuY28ubnoiLCJlOTYucnUiLCJlYWdsZXByb3h5LmNvbSIsImVhcnRobGlua3RlbGUuY29tIiwiZWFzdGNvYXN0bWFtYS5jb20iLCJlYXN0Z2FtZS5vcmciLCJlYXN5LWhpZGVpcC5jb20iLCJlYXN5NjA0LmNvbSIsImVhc3lhZ2Uub3JnIiwiZWFzeWJyYW5jaGVzLmNvbSIsImVhc3ljYS5jYSIsImVhc3lmaW5hbmNlLnJ1IiwiZWFzeXBpYy5jb20iLCJlYXN5c3BhY2UuY29tIiwiZWFzeXZwbnNlcnZpY2UuY29tIiwiZWFzeXdlYi5oayIsImVhem9uLmNvbSIsImViYXljb21tZXJjZW5ldHdvcmsuY29tIiwiZWJvbnktYmVhdXR5LmNvbSIsImVib255dHViZXR2LmNvbSIsImVib29rYnJvd3NlLmNvbSIsImVidWRrYS5jb20iLCJlYnV5Y2x1Yi5jb20iLCJlY2VudGVyLmlkdi50dyIsImVjaG8ubXNrLnJ1IiwiZWNob2VjaG8uY29tIiwiZWNob2Zvbi5jb20iLCJlY29tbWF0ZS5pbmZvIiwiZWNvbW1lcmNlYnJhc2lsLmNvbS5iciIsImVjb25vbXkuZ292LmF6IiwiZWNzdGFydC5jb20iLCJlY3VtZW5pY2FsbmV3cy5jb20iLCJlY3VyZWQuY3UiLCJlY3hzLmFzaWEiLCJlZGViaXlhdGRlZnRlcmkuY29tIiwiZWRnZWNhc3RjZG4ubmV0IiwiZWRpY3lwYWdlcy5jb20iLCJlZGlsbHkuY29tIiwiZWRtb250b25jaGluYS5jb20iLCJlZG9tZXguZ29iLm14IiwiZWRvb3JzLmNvbSIsImVkcDI0LmNvLnVrIiwiZWR0Z3VpZGUuY29tIiwiZWR1YnJpZGdlLmNvbSIsImVmY2Mub3JnLmhrIiwiZWZlLmNvbSIsImVmZi5vcmciLCJlZnBmYW5maWMubmV0IiwiZWZ1a3QuY29tIiwiZWZ5dGltZXMuY29tIiwiZWdpdGlteXV2YXNpLmNvbSIsImVneWlnLmNvbSIsImVoYW5kZWwuc2UiLCJlai5ydSIsImVrLnVhIiwiZWwtYW5udWFpcmUuY29tIiwiZWwtbWV4aWNhbm8uY29tLm14IiwiZWxhcnRlZGVzYWJlcnZpdmlyLmNvbSIsImVsY29sb21iaWFuby5jb20iLCJlbGRpYXJpb21vbnRhbmVzLmVzIiwiZWxkaWFyaW9ueS5jb20iLCJlbGVjdHJvbmljc2NsdWIuaW5mbyIsImVsZWZhbnQucm8iLCJlbGVwaGFudGpvdXJuYWwuY29tIiwiZWxldmlvci5jb20iLCJlbGhlcmFsZG8uY28iLCJlbGlhc2NsZWFuZXJzLmNvLnVrIiwiZWxpc2FiZXR0YWJlcnRvbGluaS5jb20iLCJlbGl0ZXByb3NwZWN0cy5jb20iLCJlbGl6YWJldGhhdmVudWV3ZXN0LmNvbSIsImVsbGVudHYuY29tIiwiZWxsZXVrLmNvbSIsImVsbWVtZS5tZSIsImVsbWVyY3VyaW8uY29tIiwiZWxtdW5kby5jb20udmUiLCJlbG5vcnRlLmNvbSIsImVsb24uZWR1IiwiZWxwYWlzLmNvbSIsImVscGFpcy5jb20uY28iLCJlbHBhaXMuY29tLmRvIiwiZWxwYWlzLmNvbS51eSIsImVsc2FoZnkuY29tIiwiZWxzYWx2YWRvcnR2Lm9yZyIsImVsc2VwdGltb2FydGUubmV0IiwiZWx0b25kaXNuZXkuY29tIiwiZWx3YXRhbi5jb20iLCJlbWJlZGluc3RhZ3JhbS5jb20iLCJlbWVkZW11amVyLmNvbSIsImVtZWRpYXRlLmV1IiwiZW1mb3JjZS5jby5rciIsImVtZ29nLmNvbSIsImVtaWx5cy1jbG9zZXQuY29tIiwiZW1vamlzdHdpdHRlci5jb20iLCJlbW9yeS5lZHUiLCJlbW90aWNvbi1mYWNlYm9vay5jb20iLCJlbXBpcmVvbmxpbmUuY29tIiwiZW1wbG95bWVudGxhd2FsbGlhbmNlLmNvbSIsImVtcG9ybml1bS5tZSIsImVtcHR5bWlycm9yYm9va3MuY29tIiwiZW11bGUtZWQyay5jb20iLCJlbXZpZGVpcmEuY29tLmJyIiwiZW5iYW5rLm5ldCIsImVuY2FiZXphZG9zdHdpdHRlci5jb20iLCJlbmNhci5jb20iLCJlbmN5Y2xvLm5sIiwiZW5kZWF2b3Iub3JnLmJyIiwiZW5lbC5jb20iLCJlbmVyZ3kuZ292IiwiZW5mYWwuZGUiLCJlbmdhZGdldC5jb20iLCJlbmdoZWxhYmUtZXNsYW1pLmNvbSIsImVuZ2xpc2hmb3JldmVyeW9uZS5vcmciLCJlbmdsaXNoZnJvbWVuZ2xhbmQuY28udWsiLCJlbmdsaXNocGVuLm9yZyIsImVuZ2xpc2h0ZWFzdG9yZS5jb20iLCJlbmpveWZyZWV3YXJlLm9yZyIsImVuam95cHJveHkuY29tIiwiZW5qdWljZS5jb20iLCJlbmxhZGlzY28uY29tIiwiZW5zdGFyei5jb20iLCJlbnRlbC5jbCIsImVudGVydGFpbm1lbnR3aXNlLmNvbSIsImVudGlyZWx5cGV0cy5jb20iLCJlbnRudC5jb20iLCJlb2dsaS5vcmciLCJlb2lkYy5uZXQiLCJlb25saW5lLmNvbSIsImVwZmluZGlhLmNvbSIsImVwaG90b3ppbmUuY29tIiwiZXBpY3Bvcm50dWJlLmNvbSIsImVwaXNjb3BhbGNodXJjaC5vcmciLCJlcG9jaGhrLmNvbSIsImVwb2NodGltZXMtYmcuY29tIiwiZXBvY2h0aW1lcy1yb21hbmlhLmNvbSIsImVwb2NodGltZXMuY28uaWwiLCJlcG9jaHRpbWVzLmNvLmtyIiwiZXBvY2h0aW1lcy5jb20iLCJlcG9jaHRpbWVzLmNvbS5iciIsImVwb2NodGltZXMuY29tLmhrIiwiZXBvY2h0aW1lcy5jb20udHciLCJlcG9jaHRpbWVzLmNvbS51YSIsImVwb2NodGltZXMuZGUiLCJlcG9jaHRpbWVzLmZyIiwiZXBvY2h0aW1lcy5pZSIsImVwb2NodGltZXMuaXQiLCJlcG9jaHRpbWVzLmpwIiwiZXBvY2h0aW1lcy5ydSIsImVwb2NodGltZXNjaGljYWdvLmNvbSIsImVwb2Nod2Vla2x5LmNvbSIsImVwc3BvcnQuaWR2LnR3IiwiZXF1aW5lbm93LmNvbSIsImVyYWJhcnUubmV0IiwiZXJlbW5ld3MuY29tIiwiZXJlcHVibGlrLmNvbSIsImVyZ2Vibmlzc2VsaXZlLmNvbSIsImVybnN0aW5ncy1mYW1pbHkuZGUiLCJlcm9oYXJ1aGkubmV0IiwiZXJvaWxvZy5jb20iLCJlcm9qdW1wLm5ldCIsImVyb3NoaW5iby5jb20iLCJlcm90aWNzYWxvb24ubmV0IiwiZXJzbGlzdC5jb20iLCJlc2VjdXJlLmNvbS50dyIsImVzaGFrdGkuY29tIiwiZXNwMjUwNS5pbmZvIiwiZXNwcmVzby50diIsImVzcXVpcmUuZXMiLCJlc3Rla2h0YW0uY29tIiwiZXN0d2l0dGVyLmNvbSIsImV0YWR1bHQuY29tIiwiZXRhaXdhbm5ld3MuY29tIiwiZXR5bW9ubGluZS5jb20iLCJldWNhc2luby5jb20iLCJldWdlbmRvcmYubmV0IiwiZXVsYW0uY29tIiwiZXVybzJkYXkuZ3IiLCJldXJvbWlsaG9lcy5jb20iLCJldXJvcGFnZXMuY29tLnJ1IiwiZXVyb3BhZ2VzLnBsIiwiZXVyb3BhbGFjZS5jb20iLCJldXJvcGVhbmNoZXNzY2x1YmN1cDIwMTQuY29tIiwiZXVyb3Byb3h5LmV1IiwiZXVyb3RyYXZlbC5pZHYudHciLCJldXJvd29uLmNvbSIsImV2YS52biIsImV2ZW50dXJlLmNvbSIsImV2ZXN0aGVyZW55b3V0dWJlLmNvbSIsImV2aXRlLmNvbSIsImV2b3VzLmZyIiwiZXhibG9nLmNvLmpwIiwiZXhjZWxzaW9yLmNvbS5teCIsImV4Y2l0ZS5lcyIsImV4Y25uLmNvbSIsImV4Z2ZzaGVhdmVuLmNvbSIsImV4cGF0LWRha2FyLmNvbSIsImV4cGF0cHJveHkuY29tIiwiZXhwZWRpYS5jYSIsImV4cGVkaWEuY28ua3IiLCJleHBlZGlhLmNvbSIsImV4cGVkaWEuY29tLnNnIiwiZXhwZWRpYS5kZSIsImV4cGVkaWEuZnIiLCJleHBla3QuY29tIiwiZXhwZXJ0cy11bml2ZXJzLmNvbSIsImV4cGxvYWRlci5uZXQiLCJleHBvZnV0dXJlcy5jb20iLCJleHByZXNzLXZwbi5jb20iLCJleHByZXNzLmJlIiwiZXhwcmVzc3Zwbi5jb20iLCJleHByZXNzdnBuLm9yZyIsImV4dG1hdHJpeC5jb20iLCJleHRvbGUuY29tIiwiZXh0cmFwcm94eS5jb20iLCJleHRyYXZpZC5jb20iLCJleHRyZW1lZXh0cmVtZWV4dHJlbWUuY29tIiwiZXh0cmVtZWZ0dmdpcmxzLmNvbSIsImV4dHJlbWVmdXNlLmNvbSIsImV4dHJlbWV0dWJlLmNvbSIsImV4dmFnb3MuY29tIiwiZXh3b2xmLmNvbSIsImV5bnkuY29tIiwiZXpib3guaWR2LnR3IiwiZXpjb21tZXJjZS5jb20uYnIiLCJlenBjLnRrIiwiZXpwZWVyLmNvbSIsImY0aC5jb20iLCJmYWJvcm94eS5jb20iLCJmYWJ1bG91c2Zvb2RzLmNvbSIsImZhY2Vib29rLmNvbSIsImZhY2Vib29rLmNvbS5iciIsImZhY2Vib29rLmNvbS5wayIsImZhY2Vib29rLmNvbS52biIsImZhY2Vib29rLm5ldCIsImZhY2VoaWRkZW4uY29tIiwiZmFjZWxlc3MubWUiLCJmYWN0bW9uc3Rlci5jb20iLCJmYWN0c2xpZGVzLmNvbSIsImZhaWwuaGsiLCJmYWlsem9vbS5jb20iLCJmYWtrdS5uZXQiLCJmYWt0eS51YSIsImZhbHNlZmlyZS5jb20iLCJmYWx1bmRhZmEub3JnIiwiZmFtaWxqZWxpdi5zZSIsImZhbWlseWZlZC5vcmciLCJmYW1pbHlmcmllbmRwb2Vtcy5jb20iLCJmYW4tcWlhbmcuY29tIiwiZmFuZGVqdWVnb3MuY29tIiwiZmFuZmljdGlvbi5uZXQiLCJmYW5nYmlueGluZy5jb20iLCJmYW5wYWdla2FybWEuY29tIiwiZmFucWlhbmdob3UuY29tIiwiZmFuczRwcm94eS5jb20iLCJmYW50YXN5LWhhbmRqb2IuY29tIiwiZmFueWF5bGMuY29tIiwiZmFueXVlLmluZm8iLCJmYXBkdS5jb20iLCJmYXB2aWQuY29tIiwiZmFyc3R3aXR0ZXIuY29tIiwiZmFyd2VzdGNoaW5hLmNvbSIsImZhc2hpb25ob3Rib3guY29tIiwiZmFzaGlvbm5zdHlsZS5jb20iLCJmYXNoaW9ucHVsaXMuY29tIiwiZmFzaGlvbnNuYXAuY29tIiwiZmFzdC1wcm94eS5jb20uZGUiLCJmYXN0ZXN0LXByb3h5LmNvbS5kZSIsImZhc3RmcmVlcHJveHkuaW5mbyIsImZhc3RmcmVlcHJveHkub3JnIiwiZmFzdGZyZXNoLmluZm8iLCJmYXN0cHJpdi5jb20iLCJmYXN0cHJveHlmcmVlLmluZm8iLCJmYXN0cHJveHluZXR3b3JrLmNvbSIsImZhc3R1c2Fwcm94eS5jb20iLCJmYXQtYXNzLXR1YmUuY29tIiwiZmF0YnJvd25ndXkuY29tIiwiZmF0ZXN0LmdhIiwiZmF0Z2lybHNleC5uZXQiLCJmYXRwb3JuLnh4eCIsImZhdHByb3h5LmNvbSIsImZhdm90dGVyLm5ldCIsImZhdnN0YXIuZm0iLCJmYXdhbmdodWlodWkub3JnIiwiZmJjZG4uY29tIiwiZmJjZG4ubmV0IiwiZmJ1bmJsb2NrZXIubmV0IiwiZmMyLmNvbSIsImZjMmJsb2cubmV0IiwiZmMyY2hpbmEuY29tIiwiZmNjYXNoLmNvbSIsImZjZGFsbGFzLmNvbSIsImZkYm94LmNvbSIsImZkYzg5LmpwIiwiZmVhdGhlcnNpdGUuY29tIiwiZmViZXIuc2UiLCJmZWRuZXRiYW5rLmNvbSIsImZlZWRidXJuZXIuY29tIiwiZmVuZ2ZpcmUuaW5mbyIsImZlbmd6aGVuZ2h1LmNvbSIsImZlcnJhcml3b3JsZC5jb20iLCJmZXNjb21haWwubmV0IiwiZmV0aXNoYm94LmNvbSIsImZldGlzaHBvcm5maWxtcy5jb20iLCJmZXV2ZXJ0LmZyIiwiZmZmZmYuYXQiLCJmZnByb3h5LnB3IiwiZmZzdXJmLm5ldCIsImZnbXR2Lm9yZyIsImZobi5nb3YuYXoiLCJmaTUudXMiLCJmaWF0Lml0IiwiZmliaGFiZXIuY29tIiwiZmlnYXJldC5jb20iLCJmaWdodG5ld3MuY29tIiwiZmlsZS5zaCIsImZpbGVmYWN0b3J5LmNvbSIsImZpbGVmYXAuY29tIiwiZmlsZWZseWVyLmNvbSIsImZpbGVnaXIuY29tIiwiZmlsZXMybWUuY29tIiwiZmlsZXNkb3dubG9hZGVyLmNvbSIsImZpbGVzZXJ2ZS5jb20iLCJmaWxtNGlrLnJ1IiwiZmlsbWFmZmluaXR5LmNvbSIsImZpbG1lc2RveW91dHViZS5jb20iLCJmaWxtZmFyZS5jb20iLCJmaWxtdWxldHVsLXppbGVpLnJvIiwiZmlsbXV4Lm5ldCIsImZpbHRlcmJ5cGFzcy5tZSIsImZpbHRoZHVtcC5jb20iLCJmaW5hbGZhbnRhc3l4aXYuY29tIiwiZmluYW5jZXR3aXR0ZXIuY29tIiwiZmluYW56YXNwZXJzb25hbGVzLmNvbS5jbyIsImZpbmFuemZyYWdlLm5ldCIsImZpbmFuem5hY2hyaWNodGVuLmRlIiwiZmluY2h2cG4uY29tIiwiZmluZGFtby5jb20iLCJmaW5kaXQuZmkiLCJmaW5kbWVzcG90LmNvbSIsImZpbmVwcm94eS5vcmciLCJmaW5ldmlkcy5jb20iLCJmaW5sYW5kYmF5LmluZm8iLCJmaW5tYXJrZXQucnUiLCJmaXJlb2ZsaWJlcnR5Lm9yZyIsImZpcmVwbGFjZWNvdW50cnkuY29tIiwiZmlyZXR3ZWV0LmlvIiwiZmlyc3RhbmFscXVlc3QuY29tIiwiZmlyc3RtZXJjaGFudHMuY29tIiwiZmlyc3Ryb3dwcm94eS5vcmciLCJmaXJzdHJvd3B0LmV1IiwiZmlyc3R0b2tub3cuY29tIiwiZml0NGxpZmUucnUiLCJmaXRuZXNzZmlyc3QuY28udGgiLCJmaXRuZXNzZmlyc3QuY28udWsiLCJmaXRuZXNzZmlyc3QuY29tLmF1IiwiZml0cHJlZ25hbmN5LmNvbSIsImZpeHByb3h5LmNvbSIsImZpenppay5jb20iLCJmbGFnbWEucnUiLCJmbGFnc29ubGluZS5pdCIsImZsYW1lZmFucy5jb20iLCJmbGFzaHBvaW50LWludGVsLmNvbSIsImZsYXNocG9ybm1vdnMuY29tIiwiZmxhc2hzY29yZS5ybyIsImZsYXR1aWNvbG9ycy5jb20iLCJmbGlja3IuY29tIiwiZmxpcGl0LmNvbSIsImZsaXBvcmEuY29tIiwiZmxpdHRvLmNvbSIsImZsbmV0Lm9yZyIsImZsdWVnZS5kZSIsImZsdWVudHUuY29tIiwiZmx5NGV2ZXIubWUiLCJmbHltZW93Lmlkdi50dyIsImZseXBncy5jb20iLCJmbHlwcm94eS5jb20iLCJmbHl2cG4uY29tIiwiZmx5dnBuLm5ldCIsImZtOTQ5c2QuY29tIiwiZm9jdXN2cG4uY29tIiwiZm9sa2ZhY2Vib29rLmNvbSIsImZvbGxvdy1pbnN0YWdyYW0uY29tIiwiZm9sbG93ZXJzaW5zdGFncmFtLmNvbSIsImZvbnRyaXZlci5jb20iLCJmb29kYW5kd2luZS5jb20iLCJmb29kYmxvZ2dlcnByby5jb20iLCJmb29maW5kLmNvbSIsImZvb2xzbW91bnRhaW4uY29tIiwiZm9vb29vLmNvbSIsImZvb3Rlby5jb20iLCJmb290d2VhcmV0Yy5jb20iLCJmb3JjZS5jb20iLCJmb3JjZWRmbGl4LmNvbSIsImZvcmZyZWVzdXJmaW5nLm5ldCIsImZvcm9pcGhvbmUuY29tIiwiZm9ycmVudC5qcCIsImZvcnVtNGhrLmNvbSIsImZvcnVtcy1mcmVlLmNvbSIsImZvcnVteC5jb20uYnIiLCJmb3RvLWdpcmwuY29tIiwiZm90b3NwYXJhdHdpdHRlci5jb20iLCJmb3ZhbmVzYS5jb20iLCJmb3hidXNpbmVzcy5jb20iLCJmcG10Lm9yZyIsImZwc2MuZ292LnBrIiwiZnBzZXhnYWxzLmNvbSIsImZxcm91dGVyLmNvbSIsImZyLmNyIiwiZnJhbmNlMjQuY29tIiwiZnJhbmNlOTkuY29tIiwiZnJhbmNlYmxldS5mciIsImZyYW5jZWluZm8uZnIiLCJmcmFuY2VpbnRlci5mciIsImZyYW5jZXByb3h5Lm5ldCIsImZyYW5jZXByb3h5Lm9yZyIsImZyYXNlZmFjZWJvb2suY29tIiwiZnJhc2VzY2VsZWJyZXMubmV0IiwiZnJhdC1wYXJ0eS1zbHV0cy5jb20iLCJmcmF6cGMucGwiLCJmcmNuYi5jb20iLCJmcmVha29ub21pY3MuY29tIiwiZnJlYWtzaGFyZS5jb20iLCJmcmVkYXN2b2ljZS5jb20iLCJmcmVlLS1wcm94eS5uZXQiLCJmcmVlLWVib29rcy5uZXQiLCJmcmVlLWhpZGVpcC5jb20iLCJmcmVlLW1wMy1kb3dubG9hZC5vcmciLCJmcmVlLW9ubGluZXByb3h5LmNvbSIsImZyZWUtcHJveHktb25saW5lLmNvbSIsImZyZWUtcHJveHkuY29tLmRlIiwiZnJlZS1wcm94eXNlcnZlci5jb20iLCJmcmVlLXByb3h5c2l0ZS5jb20iLCJmcmVlLXNleHZpZGVvc2ZjMi5jb20iLCJmcmVlLXNzaC5jb20iLCJmcmVlLXRlZW4tcHVzc3kuY29tIiwiZnJlZS11bmJsb2NrLmNvbSIsImZyZWUtd2ViLXByb3h5LmRlIiwiZnJlZS13ZWJwcm94eS5jb20iLCJmcmVlLXh4eC1wb3JuLm9yZyIsImZyZWUuZnIiLCJmcmVlMTgubmV0IiwiZnJlZTRwcm94eS50diIsImZyZWU0dS5jb20uYXIiLCJmcmVlOC5jb20iLCJmcmVlYW5pbWFsc2V4dHViZS5uZXQiLCJmcmVlYW5pbWVzb25saW5lLmNvbSIsImZyZWViYXNlLmNvbSIsImZyZWViZWFyYmxvZy5vcmciLCJmcmVlYmllLWFjLmNvbSIsImZyZWVieXBhc3MuY29tIiwiZnJlZWJ5cGFzc3Byb3h5LmNvbSIsImZyZWVjYW5hZGF2cG4uY29tIiwiZnJlZWNoYWwuY29tIiwiZnJlZWRvbS1pcC5jb20iLCJmcmVlZG9taG91c2Uub3JnIiwiZnJlZWZjMi5jb20iLCJmcmVlZ2FvLmNvbSIsImZyZWVoZW50YWltYW5nYS5uZXQiLCJmcmVlaG9zdGlhLmNvbSIsImZyZWVrYXRsaXR0ZXIuY29tIiwiZnJlZWxpYnMub3JnIiwiZnJlZWxvdHRvLmNvbSIsImZyZWVtcDNpbi5jb20iLCJmcmVlbXAzdmlkZW8ubmV0IiwiZnJlZW1wM3gub3JnIiwiZnJlZW5ldC1jaGluYS5vcmciLCJmcmVlbmV0cHJvamVjdC5vcmciLCJmcmVlbmluamFwcm94eS5jb20iLCJmcmVlbmluamFwcm94eS5pbmZvIiwiZnJlZW9kYS5jb20iLCJmcmVlb3BlbnByb3h5LmNvbSIsImZyZWVvcGVudnBuLmNvbSIsImZyZWVvei5vcmciLCJmcmVlcGVuLmdyIiwiZnJlZXBlb3BsZS5jb20iLCJmcmVlcGhvdG9zZXJpZXMuY29tIiwiZnJlZXBvcm4udG8iLCJmcmVlcG9ybm9mcmVlcG9ybi5jb20iLCJmcmVlcHB0cHZwbi5uZXQiLCJmcmVlcHJveHktc2VydmVyLm5ldCIsImZyZWVwcm94eS5pbyIsImZyZWVwcm94eS5uZXQiLCJmcmVlcHJveHkucm8iLCJmcmVlcHJveHk0eW91LmNvbSIsImZyZWVwcm94eWxpc3RzLmNvbSIsImZyZWVwcm94eXNlcnZlci5jYSIsImZyZWVwcm94eXNlcnZlci5uZXQiLCJmcmVlcHJveHlzZXJ2ZXIudWsiLCJmcmVlcHJveHl0b2NoYW5nZWlwLmNvbSIsImZyZWVwcm94eXdlYmxpc3QuY29tIiwiZnJlZXNhZmVpcC5jb20iLCJmcmVlc2VydmUuY28udWsiLCJmcmVlc2V4OC5jb20iLCJmcmVlc2V4dHViZS5jb20iLCJmcmVlc29mdC5ydSIsImZyZWVzcGVlY2hkZWJhdGUuY29tIiwiZnJlZXNzdHB2cG4uY29tIiwiZnJlZXRpYmV0Lm9yZyIsImZyZWV2aWRlb3Byb3h5LmNvbSIsImZyZWV2aWV3bW92aWVzLmNvbSIsImZyZWV2cG4uY2MiLCJmcmVldnBuc2FrdXJhLmNvbSIsImZyZWV2cG5zcG90LmNvbSIsImZyZWV2cG5zc2guY29tIiwiZnJlZXZwbnNzaC5vcmciLCJmcmVldnBud29ybGQuY29tIiwiZnJlZXdlYnByb3h5LmFzaWEiLCJmcmVld2VicHJveHkuY29tIiwiZnJlZXdlYnByb3h5LmluIiwiZnJlZXdlYnByb3h5LmluZm8iLCJmcmVld2VicHJveHkudXMiLCJmcmVld2Vicy5jb20iLCJmcmVld2VidGVtcGxhdGVzLmNvbSIsImZyZWV3ZWliby5jb20iLCJmcmVld3NvZG93bmxvYWRzLm5ldCIsImZyZWV4aGFtc3Rlci5jb20iLCJmcmVleGlud2VuLmNvbSIsImZyZWV5ZWxsb3cuY29tIiwiZnJlZXlvdXR1YmVwcm94eS5vcmciLCJmcmVuY2h3ZWIuZnIiLCJmcmVzaC1wcm94aWVzLm5ldCIsImZyZXNoYXNpYW50aHVtYnMuY29tIiwiZnJlc2hkZXNrLmNvbSIsImZyZXNoZXJzdm9pY2UuY29tIiwiZnJlc2htYWlsLnBsIiwiZnJlc2hwcm94eS5udSIsImZyZXNocHJveHlsaXN0LmNvbSIsImZyZXNodGVlbnoubmV0IiwiZnJlc2h4eHh0dWJlLmNvbSIsImZyaWVuZGZlZWQuY29tIiwiZnJpbmcuY29tIiwiZnJvbWJhci5jb20iLCJmcm9tcGxheS5jb20iLCJmcm9udGxpbmVkZWZlbmRlcnMub3JnIiwiZnJvb3R2cG4uY29tIiwiZnN1cmYuY29tIiwiZnRhaC5pZHYudHciLCJmdWNkLmNvbSIsImZ1Y2tjbm5pYy5uZXQiLCJmdWNrZWQtdHViZS5jb20iLCJmdWNrZW5ib3JlZC5jb20iLCJmdWNrZW5ib3JlZC5uZXQiLCJmdWNrbXlyZWFsd2lmZS5jb20iLCJmdWNrbnZpZGVvcy5jb20iLCJmdWltcG9zdGluZ2l0LmNvbSIsImZ1bGlrb25nLmNvbSIsImZ1bGxjZWx1bGFyZXMuY29tIiwiZnVsbGRscy5jb20iLCJmdWxsbW92aWV5b3V0dWJlLmNvbSIsImZ1bGx0aWx0cG9rZXIuY29tIiwiZnVsbHR1YmVtb3ZpZXMuY29tIiwiZnVsbHhoYW1zdGVyLmNvbSIsImZ1bGx5cG9ybi5jb20iLCJmdW5jaW9ucHVibGljYS5nb2IubXgiLCJmdW5mLnR3IiwiZnVucC5jb20iLCJmdW5wLmNvbS50dyIsImZ1bnByb3h5Lm5ldCIsImZ1cmZ1ci5tZSIsImZ1dGVib2xhb3Zpdm8ubmV0IiwiZnV0ZmFuYXRpY3MuY29tLmJyIiwiZnV0dWJlLm5ldCIsImZ1dHVyZWNoaW5hZm9ydW0ub3JnIiwiZnV0dXJlcHJveHkuY29tIiwiZnV1Z2xlLm5ldCIsImZ1dXpva3UuaW5mbyIsImZ1eC5jb20iLCJmdnBuLmNvbSIsImZ4Z20uY29tIiwiZnh4Z3cuY29tIiwiZnlkb3dubG9hZC5jb20iLCJmemxtLmNvbSIsImctY2FzaC5iaXoiLCJnYWVwcm94eS5jb20iLCJnYWZvcnVtLm9yZyIsImdhZ2FtYXRjaC5jb20iLCJnYWdnZWR0b3AuY29tIiwiZ2FncmVwb3J0LmNvbSIsImdhbHlhLnJ1IiwiZ2FtY29yZS5jb20iLCJnYW1lYmFzZS5jb20udHciLCJnYW1lY29weXdvcmxkLmNvbSIsImdhbWVqb2x0LmNvbSIsImdhbWVyLmNvbS50dyIsImdhbWVzLmdyIiwiZ2FtZXNvZmRlc2lyZS5jb20iLCJnYW1lc3RsYmIuY29tIiwiZ2FtZXouY29tLnR3IiwiZ2Ftb3VzYS5jb20iLCJnYW5nYmFuZy1hcmVuYS5jb20iLCJnYW5nZXMuY29tIiwiZ2FuaGFyZXVyb21pbGhvZXMuY29tIiwiZ2FvMDEuY29tIiwiZ2FvNDEuY29tIiwiZ2FvY2h1bnYuY29tIiwiZ2FvbWluZy5uZXQiLCJnYW9waS5uZXQiLCJnYW95YW5nc2wuY29tIiwiZ2FwLmNvLnVrIiwiZ2FwLmV1IiwiZ2FyYW5jZWRvcmUuZnIiLCJnYXN0cm9ub20ucnUiLCJnYXRndW5zLmNvbSIsImdhdGhlci5jb20iLCJnYXRoZXJwcm94eS5jb20iLCJnYXRpLm9yZy50dyIsImdhd2tlcmFzc2V0cy5jb20iLCJnYXkteW91dHViZS5jb20iLCJnYXliZWVmLmNvbSIsImdheWNvY2twb3JuLmNvbSIsImdheW0uanAiLCJnYXlwb3JucGljcG9zdC5jb20iLCJnYXpldGEucGwiLCJnYXpldGEucnUiLCJnYXpldGFkaXRhLmFsIiwiZ2F6ZXRhZXNwb3J0aXZhLm5ldCIsImdhemV0ZTIwMjMuY29tIiwiZ2F6ZXRlbGVyLmNvbSIsImdhem8tY2gubmV0IiwiZ2F6b3R1YmUuY29tIiwiZ2F6emV0dGFkaXBhcm1hLml0IiwiZ2Jsb2NrZXIuaW5mbyIsImdjbGwuaW5mbyIsImdjcG5ld3MuY29tIiwiZ2RidC5uZXQiLCJnZGtleGVyY2lzZXRoZXJhcHkuY29tIiwiZ2VibmVnb3ppb25saW5lLmNvbSIsImdlZWttYWRlLmNvLnVrIiwiZ2Vla3NhcmVzZXh5Lm5ldCIsImdlZWtzbnVkZS5jb20iLCJnZWdhc3VyZi5nYSIsImdlbXNjb29sLmNvbSIsImdlbi54eXoiLCJnZW5lcmFsLWVib29rcy5jb20iLCJnZW5lcmFsLXBvcm4uY29tIiwiZ2VuZ2Z1Lm5ldCIsImdlbnltb3Rpb24uY29tIiwiZ2VvY2l0aWVzLmNvLmpwIiwiZ2VvY2l0aWVzLmNvbSIsImdlb21lZGlhbi5jb20iLCJnZW9yZ2lhLmdvdiIsImdlcm1hbi1wcm94eS5jb20uZGUiLCJnZXJtYW4tcHJveHkuZGUiLCJnZXJtYW4td2VicHJveHkuZGUiLCJnZXJtYW55LXByb3h5LmNvbS5kZSIsImdlcm1hbnliYXkuaW5mbyIsImdlc3VuZGhlaXRzZnJhZ2UubmV0IiwiZ2V0Y2h1LmNvbSIsImdldGNsb2FrLmNvbSIsImdldGRvZ3NleC5jb20iLCJnZXRmb3h5cHJveHkub3JnIiwiZ2V0ZnJlZWR1ci5jb20iLCJnZXRpdC5pbiIsImdldGl0b24uY29tIiwiZ2V0amV0c28uY29tIiwiZ2V0bGFudGVybi5vcmciLCJnZXRtZW1hLmNvbSIsImdldHNvY2lhbHNjb3BlLmNvbSIsImdldHVzdnBuLmNvbSIsImdldHlvdXJhbS5jb20iLCJnZmFjZWJvb2suY29tIiwiZ2Zidi5kZSIsImdmc2FsZS5jb20iLCJnZnNvc28uY29tIiwiZ2Z3Lm9yZy51YSIsImdmd2hpa2luZy5vcmciLCJnZ2Rhb2hhbmcuY29tIiwiZ2dwaHQuY29tIiwiZ2dwcm94eS5wdyIsImdnc3NsLmNvbSIsImdob3N0ZXJ5LmNvbSIsImdpNTUuY29tIiwiZ2lhbi5pZHYudHciLCJnaWFudHRpZ2VyLmNhIiwiZ2lkZGVucy5pZHYudHciLCJnaWZ0MDAxLmNvbSIsImdpZnlvdXR1YmUuY29tIiwiZ2lnYWJ5dGUuY29tIiwiZ2lnYW50aXRzLmNvbSIsImdpZ2FudHRpLmZpIiwiZ2lncG9ybm8ucnUiLCJnaWdzYW5kZmVzdGl2YWxzLmNvLnVrIiwiZ2lwc3l0ZWFtLnJ1IiwiZ2lybHNnb2dhbWVzLmNvLnVrIiwiZ2lybHNwbGF5LmNvbSIsImdpdGEuaWR2LnR3IiwiZ2l0Ym9va3MuaW8iLCJnaXRodWIuY29tIiwiZ2l6Ym90LmNvbSIsImdpemxlbi5uZXQiLCJnaXptb2RvLmNvbSIsImdsYW1vdXIucnUiLCJnbGFzc29mcG9ybi5jb20iLCJnbGF2Y29tLnVhIiwiZ2xnb28uY29tIiwiZ2xvYmFsLXByb3h5LmNvbSIsImdsb2JhbC11bml0eS5uZXQiLCJnbG9iYWxld2FsbGV0LmNvbSIsImdsb2JhbGludmVudGlvbnMuY28udWsiLCJnbG9iYWxzb3VyY2VzLmNvbS5jbiIsImdsb2JhbHZvaWNlc29ubGluZS5vcmciLCJnbG9ic2F5YXN5dGVzLm5ldCIsImdsb2NrLmNvbSIsImdsb3J5aG9sZS5jb20iLCJnbHlwZS1wcm94eS5pbmZvIiwiZ21haWxwcm94eS5jb20iLCJnbWFuZS5vcmciLCJnbWVpaHVhLmNvbSIsImdud2F5Lm5ldCIsImdvLWZ1em9rdS50diIsImdvLXBraS5jb20iLCJnbzJhdi5jb20iLCJnb2FnZW50LmJpeiIsImdvYWxhZC5jb20iLCJnb2IudmUiLCJnb2QudHYiLCJnb2RzZGlyZWN0Y29udGFjdC5jb20iLCJnb2RzZGlyZWN0Y29udGFjdC5pbmZvIiwiZ29kc2RpcmVjdGNvbnRhY3Qub3JnIiwiZ29kc2RpcmVjdGNvbnRhY3Qub3JnLnR3IiwiZ29maXJlZmx5Lm9yZyIsImdvZm9sbG93LmZyIiwiZ29mb2xsb3cuaW5mbyIsImdvZ2FtZXMubWUiLCJnb2dvMnNleC5jb20iLCJnb2hhcHB5dGltZS5jb20iLCJnb2hhd2FpaS5jb20iLCJnb2liaWJvLmNvbSIsImdvaW5ndGhlcmUub3JnIiwiZ29sYW5nLm9yZyIsImdvbGRhc2lhbnMuY29tIiwiZ29sZGJldC5jb20iLCJnb2xkaml6ei5jb20iLCJnb2xkcHJveHlsaXN0LmNvbSIsImdvbGZkaWdlc3QuY29tIiwiZ29ta28ubmV0IiwiZ29uZ3d0LmNvbSIsImdvbnpvby5jb20iLCJnb28uZ2wiLCJnb29kYnllbXlkYXJsaW5nLmNvbSIsImdvb2RuZXdzLm9yLmtyIiwiZ29vZHJlYWRlcnMuY29tIiwiZ29vZHJlYWRzLmNvbSIsImdvb2R0aGFpZ2lybC5jb20iLCJnb29kdHYudHYiLCJnb29nbGUtYW5hbHl0aWNzLmNvbSIsImdvb2dsZS5hZCIsImdvb2dsZS5hZSIsImdvb2dsZS5hbCIsImdvb2dsZS5hbSIsImdvb2dsZS5hcyIsImdvb2dsZS5hdCIsImdvb2dsZS5heiIsImdvb2dsZS5iYSIsImdvb2dsZS5iZSIsImdvb2dsZS5iZiIsImdvb2dsZS5iZyIsImdvb2dsZS5iaSIsImdvb2dsZS5iaiIsImdvb2dsZS5icyIsImdvb2dsZS5idCIsImdvb2dsZS5ieSIsImdvb2dsZS5jYSIsImdvb2dsZS5jYXQiLCJnb29nbGUuY2QiLCJnb29nbGUuY2YiLCJnb29nbGUuY2ciLCJnb29nbGUuY2giLCJnb29nbGUuY2kiLCJnb29nbGUuY2wiLCJnb29nbGUuY20iLCJnb29nbGUuY24iLCJnb29nbGUuY28uYW8iLCJnb29nbGUuY28uYnciLCJnb29nbGUuY28uY2siLCJnb29nbGUuY28uY3IiLCJnb29nbGUuY28uaWQiLCJnb29nbGUuY28uaWwiLCJnb29nbGUuY28uaW4iLCJnb29nbGUuY28uanAiLCJnb29nbGUuY28ua2UiLCJnb29nbGUuY28ua3IiLCJnb29nbGUuY28ubHMiLCJnb29nbGUuY28ubWEiLCJnb29nbGUuY28ubXoiLCJnb29nbGUuY28ubnoiLCJnb29nbGUuY28udGgiLCJnb29nbGUuY28udHoiLCJnb29nbGUuY28udWciLCJnb29nbGUuY28udWsiLCJnb29nbGUuY28udXoiLCJnb29nbGUuY28udmUiLCJnb29nbGUuY28udmkiLCJnb29nbGUuY28uemEiLCJnb29nbGUuY28uem0iLCJnb29nbGUuY28uenciLCJnb29nbGUuY29tIiwiZ29vZ2xlLmNvbS5hZiIsImdvb2dsZS5jb20uYWciLCJnb29nbGUuY29tLmFpIiwiZ29vZ2xlLmNvbS5hciIsImdvb2dsZS5jb20uYXUiLCJnb29nbGUuY29tLmJkIiwiZ29vZ2xlLmNvbS5iaCIsImdvb2dsZS5jb20uYm4iLCJnb29nbGUuY29tLmJvIiwiZ29vZ2xlLmNvbS5iciIsImdvb2dsZS5jb20uYnoiLCJnb29nbGUuY29tLmNvIiwiZ29vZ2xlLmNvbS5jdSIsImdvb2dsZS5jb20uY3kiLCJnb29nbGUuY29tLmRvIiwiZ29vZ2xlLmNvbS5lYyIsImdvb2dsZS5jb20uZWciLCJnb29nbGUuY29tLmV0IiwiZ29vZ2xlLmNvbS5maiIsImdvb2dsZS5jb20uZ2giLCJnb29nbGUuY29tLmdpIiwiZ29vZ2xlLmNvbS5ndCIsImdvb2dsZS5jb20uaGsiLCJnb29nbGUuY29tLmptIiwiZ29vZ2xlLmNvbS5raCIsImdvb2dsZS5jb20ua3ciLCJnb29nbGUuY29tLmxiIiwiZ29vZ2xlLmNvbS5seSIsImdvb2dsZS5jb20ubW0iLCJnb29nbGUuY29tLm10IiwiZ29vZ2xlLmNvbS5teCIsImdvb2dsZS5jb20ubXkiLCJnb29nbGUuY29tLm5hIiwiZ29vZ2xlLmNvbS5uZiIsImdvb2dsZS5jb20ubmciLCJnb29nbGUuY29tLm5pIiwiZ29vZ2xlLmNvbS5ucCIsImdvb2dsZS5jb20ub20iLCJnb29nbGUuY29tLnBhIiwiZ29vZ2xlLmNvbS5wZSIsImdvb2dsZS5jb20ucGciLCJnb29nbGUuY29tLnBoIiwiZ29vZ2xlLmNvbS5wayIsImdvb2dsZS5jb20ucHIiLCJnb29nbGUuY29tLnB5IiwiZ29vZ2xlLmNvbS5xYSIsImdvb2dsZS5jb20uc2EiLCJnb29nbGUuY29tLnNiIiwiZ29vZ2xlLmNvbS5zZyIsImdvb2dsZS5jb20uc2wiLCJnb29nbGUuY29tLnN2IiwiZ29vZ2xlLmNvbS50aiIsImdvb2dsZS5jb20udHIiLCJnb29nbGUuY29tLnR3IiwiZ29vZ2xlLmNvbS51YSIsImdvb2dsZS5jb20udXkiLCJnb29nbGUuY29tLnZjIiwiZ29vZ2xlLmNvbS52biIsImdvb2dsZS5jdiIsImdvb2dsZS5jeiIsImdvb2dsZS5kZSIsImdvb2dsZS5kaiIsImdvb2dsZS5kayIsImdvb2dsZS5kbSIsImdvb2dsZS5keiIsImdvb2dsZS5lZSIsImdvb2dsZS5lcyIsImdvb2dsZS5maSIsImdvb2dsZS5mbSIsImdvb2dsZS5mciIsImdvb2dsZS5nYSIsImdvb2dsZS5nZSIsImdvb2dsZS5nZyIsImdvb2dsZS5nbCIsImdvb2dsZS5nbSIsImdvb2dsZS5ncCIsImdvb2dsZS5nciIsImdvb2dsZS5neSIsImdvb2dsZS5obiIsImdvb2dsZS5ociIsImdvb2dsZS5odCIsImdvb2dsZS5odSIsImdvb2dsZS5pZSIsImdvb2dsZS5pbSIsImdvb2dsZS5pcSIsImdvb2dsZS5pcyIsImdvb2dsZS5pdCIsImdvb2dsZS5qZSIsImdvb2dsZS5qbyIsImdvb2dsZS5rZyIsImdvb2dsZS5raSIsImdvb2dsZS5reiIsImdvb2dsZS5sYSIsImdvb2dsZS5saSIsImdvb2dsZS5sayIsImdvb2dsZS5sdCIsImdvb2dsZS5sdSIsImdvb2dsZS5sdiIsImdvb2dsZS5tZCIsImdvb2dsZS5tZSIsImdvb2dsZS5tZyIsImdvb2dsZS5tayIsImdvb2dsZS5tbCIsImdvb2dsZS5tbiIsImdvb2dsZS5tcyIsImdvb2dsZS5tdSIsImdvb2dsZS5tdiIsImdvb2dsZS5tdyIsImdvb2dsZS5uZSIsImdvb2dsZS5ubCIsImdvb2dsZS5ubyIsImdvb2dsZS5uciIsImdvb2dsZS5udSIsImdvb2dsZS5wbCIsImdvb2dsZS5wbiIsImdvb2dsZS5wcyIsImdvb2dsZS5wdCIsImdvb2dsZS5ybyIsImdvb2dsZS5ycyIsImdvb2dsZS5ydSIsImdvb2dsZS5ydyIsImdvb2dsZS5zYyIsImdvb2dsZS5zZSIsImdvb2dsZS5zaCIsImdvb2dsZS5zaSIsImdvb2dsZS5zayIsImdvb2dsZS5zbSIsImdvb2dsZS5zbiIsImdvb2dsZS5zbyIsImdvb2dsZS5zciIsImdvb2dsZS5zdCIsImdvb2dsZS50ZCIsImdvb2dsZS50ZyIsImdvb2dsZS50ayIsImdvb2dsZS50bCIsImdvb2dsZS50bSIsImdvb2dsZS50biIsImdvb2dsZS50byIsImdvb2dsZS50dCIsImdvb2dsZS52ZyIsImdvb2dsZS52dSIsImdvb2dsZS53cyIsImdvb2dsZWFkc2VydmljZXMuY29tIiwiZ29vZ2xlYXBpcy5jb20iLCJnb29nbGVjb2RlLmNvbSIsImdvb2dsZXBhZ2VzLmNvbSIsImdvb2dsZXNpbGUuY29tIiwiZ29vZ2xldXNlcmNvbnRlbnQuY29tIiwiZ29vZ2xldmlkZW8uY29tIiwiZ29veWEuY29tIiwiZ29wZXRpdGlvbi5jb20iLCJnb3Byb3hpbmcuY29tIiwiZ29wcm94eXNlcnZlci5jb20iLCJnb3JzdWNoLmNvbSIsImdvc3BlbGhlcmFsZC5jb20iLCJnb3NwZWxoZXJhbGQuY29tLmhrIiwiZ29zc2lwLXR2LmdyIiwiZ29zdG9zYW5vdmluaGEuY29tIiwiZ29zdXJmLmFzaWEiLCJnb3RvcC5pZHYudHciLCJnb3RydXN0ZWQuY29tIiwiZ291ZGVuZ2lkcy5iZSIsImdvdi5hciIsImdvdm9yaW1wcm8udXMiLCJnb3dhbGxhLmNvbSIsImdvd25pZGVhc2l0ZS5jb20iLCJnb3hmYy5jb20iLCJncG8uZ292IiwiZ3BvZGRlci5uZXQiLCJncHguaWR2LnR3IiwiZ3IyNC51cyIsImdyYWRlci5jb20iLCJncmFmaWthcnQuZnIiLCJncmFuZGFzY2VudC5jb20iLCJncmFuZGVwcmVtaW8uY29tLmJyIiwiZ3Jhbmdvcnoub3JnIiwiZ3JhcGhpcy5uZS5qcCIsImdyZWF0ZmlyZS5vcmciLCJncmVhdGZpcmV3YWxsb2ZjaGluYS5vcmciLCJncmVlY2ViYXkuaW5mbyIsImdyZWVuaG91c2VjaHVyY2gub3JnIiwiZ3JlZW5wYXJ0eS5vcmcudHciLCJncmVlbnByb3h5Lm5ldCIsImdyZWVudnBuLm5ldCIsImdyZWVudnBuLm9yZyIsImdyZW1saW5qdWljZS5jb20iLCJncmpzcS5tZSIsImdyanNxLnR2IiwiZ3JvdXAtZmFjaWFscy5jb20iLCJnc3Aucm8iLCJnc3RhdGljLmNvbSIsImdzdzc3Ny5jb20iLCJndDN0aGVtZXMuY29tIiwiZ3VhbnlpbmNpdHRhLmNvbSIsImd1YXJkc3Rlci5jb20iLCJndWZmaW5zLmNvbSIsImd1aWhhbmcub3JnIiwiZ3VpbGlub2suY29tIiwiZ3VpdGFyd29ybGQuY29tIiwiZ3VqYXJhdC5nb3YuaW4iLCJndW4td29ybGQubmV0IiwiZ3VuLmluLnRoIiwiZ3Vuc2FtZXJpY2EuY29tIiwiZ3Vuc2FuZGFtbW8uY29tIiwiZ3VzdHR1YmUuY29tIiwiZ3V1YmlpLmluZm8iLCJndmh1bnRlci5jb20iLCJndm0uY29tLnR3IiwiZ3lhbHdhcmlucG9jaGUuY29tIiwiZ3lwc3l4eHguY29tIiwiZ3l3eXMuY29tIiwiZ3pib2xpbmt0di5jb20iLCJnemRzc2YuY29tIiwiZ3ptLnR2IiwiaDFkZS5uZXQiLCJoMzFidC5uZXQiLCJoMzN0LnRvIiwiaDUyOC5jb20iLCJoYWFyZXR6LmNvLmlsIiwiaGFhcmV0ei5jb20iLCJoYWJlcjUuY29tIiwiaGFiZXJjaW0xOS5jb20iLCJoYWJlcm5hbWUuY29tIiwiaGFjazg1LmNvbSIsImhhY2tlbi5jYyIsImhhY2tlci5vcmciLCJoYWNraW5nLWZhY2Vib29rLmNvbSIsImhhY2tpbmd1bml2ZXJzaXR5LmluIiwiaGFnYWguY29tLmJyIiwiaGFpcnktYmVhdXR5LmNvbSIsImhhaXJ5LW51ZGlzdC5jb20iLCJoYWtjaG91Zi5jb20iLCJoYWtrYXR2Lm9yZy50dyIsImhhbGxlbHMuY29tIiwiaGFtYS1rLmNvbSIsImhhbmFtb2t1LmNvbSIsImhhbmRicmFrZS5mciIsImhhbmkuY28ua3IiLCJoYW51bnlpLmNvbSIsImhhbzAwMS5uZXQiLCJoYW8xMjMuY29tLmJyIiwiaGFvMTIzMTE0LmNvbSIsImhhb2thbjk0Ni5jbiIsImhhb3NmLmNvbSIsImhhb3NmLmNvbS5jbiIsImhhb3l1bjAxLmNmIiwiaGFwcHljYW1wdXMuY29tIiwiaGFwcHl0cmlwcy5jb20iLCJoYXByb3h5Lm9yZyIsImhhcmRzZXh0dWJlLmNvbSIsImhhcmR3YXJlLmNvbS5iciIsImhhcmR4aGFtc3Rlci5jb20iLCJoYXJyeXBvdHRlcnNob3AuY29tIiwiaGFydW55YWh5YS5jb20iLCJoYXR0cmljay5vcmciLCJoYXZlOC5jb20iLCJoZC1ibG93LmNvbSIsImhkLWZlZXQuY29tIiwiaGQtcG9ybi1tb3ZpZXMuY29tIiwiaGQteGhhbXN0ZXIuY29tIiwiaGRiLmdvdi5zZyIsImhkYmlyZC5jb20iLCJoZHRlZW5wb3JubW92aWVzLmNvbSIsImhkd2FsbHBhcGVyc2lubi5jb20iLCJoZHdpbmcuY29tIiwiaGR6aW9uLmNvbSIsImhlYWx0aGdlbmllLmluIiwiaGVhcnN0bWFncy5jb20iLCJoZWFydC15b3V0dWJlLmNvbSIsImhlYmFvLm5ldCIsImhlY2FpdG91Lm5ldCIsImhlY2hhamkuY29tIiwiaGVncmUtYXJ0LmNvbSIsImhlaXNob3UuY24iLCJoZWl4LnBwLnJ1IiwiaGVqaTcwMC5jb20iLCJoZWxsb2NvdG9uLmZyIiwiaGVsbG90eHQuY29tIiwiaGVsbG91ay5vcmciLCJoZWxscG9ybm8uY29tIiwiaGVsbHMucGwiLCJoZWxwZWFjaHBlb3BsZS5jb20iLCJoZWxwdGFvYmFvLmNuIiwiaGVscHpodWxpbmcub3JnIiwiaGVuaGVucGVuZzguY29tIiwiaGVubmFibG9nc3BvdC5jb20iLCJoZW50YWktaGlnaC1zY2hvb2wuY29tIiwiaGVudGFpbWFuZ2FvbmxpbmUuY29tIiwiaGVudGFpdHViZS50diIsImhlbnRhaXZpZGVvd29ybGQuY29tIiwiaGVudGFpemEubmV0IiwiaGVyaXRhZ2Uub3JnIiwiaGVybWFuemVnZXJtYW4uY29tIiwiaGVyb2Vzd20ucnUiLCJoZXJva3VhcHAuY29tIiwiaGVyb3Byb3h5LmNvbSIsImhlcm96ZXJvZ2FtZS5jb20iLCJoZXJyZW5hdXNzdGF0dGVyLmRlIiwiaGV4aS1oYS5jb20iLCJoZXhpZXNoZS5jb20iLCJoZXlwcm94eS5jb20iLCJoZXl3aXJlLmNvbSIsImhmYWNlYm9vay5jb20iLCJoZ3NlYXYuY29tIiwiaGhwcm94eS5wdyIsImhpLWtpc3MuY29tIiwiaGktb24ub3JnLnR3IiwiaGliaXlhLWxzcC5jb20iLCJoaWRkZW5nb29kLmluZm8iLCJoaWRlLW1lLm9yZyIsImhpZGUubWUiLCJoaWRlLnBsIiwiaGlkZWFuZGdvLmNvbSIsImhpZGVidXguY29tIiwiaGlkZWJ1enouY29tIiwiaGlkZWJ1enoudXMiLCJoaWRlZG9vci5jb20iLCJoaWRlaXAuY28iLCJoaWRlaXBmcmVlLmNvbSIsImhpZGVpcHByb3h5LmNvbSIsImhpZGVpcHZwbi5jb20iLCJoaWRlbWFuLm5ldCIsImhpZGVtZS5iZSIsImhpZGVtZS5pbyIsImhpZGVtZTEwMS5pbmZvIiwiaGlkZW1lMTAyLmluZm8iLCJoaWRlbWUxMDguaW5mbyIsImhpZGVtZTExMC5pbmZvIiwiaGlkZW1lbm93Lm5ldCIsImhpZGVteWFzcy5jb20iLCJoaWRlbXlib3guY29tIiwiaGlkZW15aXBhZGRyZXNzLm9yZyIsImhpZGVteXRyYXhwcm94eS5jYSIsImhpZGVuaW5qYS5jb20iLCJoaWRlb3h5LmNvbSIsImhpZGV0aGVpbnRlcm5ldC5jb20iLCJoaWRldGhpc2lwLm5ldCIsImhpZGV2cG4uYXNpYSIsImhpZGV3ZWJzaXRlLmNvbSIsImhpZGV4eS5jb20iLCJoaWRpbmdub3cub3JnIiwiaGlmaS1mb3J1bS5kZSIsImhpZ2Z3LmNvbSIsImhpZ2gtc3RvbmUtZm9ydW0uY29tIiwiaGloaWZvcnVtLmNvbSIsImhpbGl2ZS50diIsImhpbG9hZC5vcmciLCJoaWxvYWQucGsiLCJoaW1hbGF5YW5nbGFjaWVyLmNvbSIsImhpbWUubWUiLCJoaW1la3VyaWNhbGVuZGFyLmNvbSIsImhpbWVtaXguY29tIiwiaGluZXQubmV0IiwiaGlyb3NoaW1hLXUuYWMuanAiLCJoaXNwZWVkcHJveHkuY29tIiwiaGlzdXBwbGllci5jb20iLCJoaXRmaXguY29tIiwiaGl0Z2Vsc2luLmNvbSIsImhpdHByb3h5LmNvbSIsImhpd2loaGkuY29tIiwiaGl6Yi11dC10YWhyaXIuaW5mbyIsImhpemItdXQtdGFocmlyLm9yZyIsImhqY2x1Yi5pbmZvIiwiaGstcHViLmNvbSIsImhrLm5leHRtZWRpYS5jb20iLCJoazMyMTY4LmNvbSIsImhrNS5jYyIsImhrYXR2bmV3cy5jb20iLCJoa2JmLm9yZyIsImhrYmlnbWFuLm5ldCIsImhrYm9va2NpdHkuY29tIiwiaGtkYWlseW5ld3MuY29tLmhrIiwiaGtlai5jb20iLCJoa2VwYy5jb20iLCJoa2ZvcnVtLmluZm8iLCJoa2ZyZWV6b25lLmNvbSIsImhrZ29sZGVuLmNvbSIsImhrZ3JlZW5yYWRpby5vcmciLCJoa2hlYWRsaW5lLmNvbSIsImhraHJtLm9yZy5oayIsImhranAub3JnIiwiaGtwdHUub3JnIiwiaGtyZXBvcnRlci5jb20iLCJoa3UuaGsiLCJoa3p6OC5jb20iLCJobG9saS5uZXQiLCJobWhhY2suY29tIiwiaG1vbmdhcHAuY29tIiwiaG1vbmdob3QuY29tIiwiaG1vbmdqb2IuY29tIiwiaG1vbmdwbGF5LmNvbSIsImhtb25ncGx1cy5jb20iLCJobXR3ZWIuY29tIiwiaG9iYnlsb2JieS5jb20iLCJob2NrZXlhcHAubmV0IiwiaG9ob3NleC5jb20iLCJob2xhLm9yZyIsImhvbGlkYXlhdXRvcy5kZSIsImhvbGxhbmQuaWR2LnR3IiwiaG9tY29tLXNob3AuZGUiLCJob21lY2luZW1hLWZyLmNvbSIsImhvbWVmdHAubmV0IiwiaG9tZWdyb3duZnJlYWtzLm5ldCIsImhvbWVtYWRlbW92aWV6LmNvbSIsImhvbWVtYWRldHViZXouY29tIiwiaG9tZW5ldC5vcmciLCJob21lcGVydmVyc2lvbi5jb20iLCJob21lcG9ybmFkdmVudHVyZXMuY29tIiwiaG9tZXN0YXlpbi5jb20iLCJob21ldGVlbm1vdnMuY29tIiwiaG9tZXRpZWQuY29tIiwiaG9uZ3poaS5saSIsImhvb3BwYXkuY29tIiwiaG9vdGxldC5jb20iLCJob290c3VpdGUuY29tIiwiaG9wdG8ub3JnIiwiaG9ybnliYnd0dWJlLmNvbSIsImhvcm8uaWR2LnR3IiwiaG9ydWthbi5jb20iLCJob3N0MWZyZWUuY29tIiwiaG9zdDRwb3N0LnB3IiwiaG9zdGVscy5jb20iLCJob3N0aW5nYnVsay5jb20iLCJob3N0aW5nZXIuYWUiLCJob3N0aW5nZXIuY28udWsiLCJob3N0aW5nZXIuY29tLmJyIiwiaG9zdGluZ2VyLmVzIiwiaG9zdGxvdmUuY29tIiwiaG90LXNleC10dWJlLmNvbSIsImhvdC5lZSIsImhvdDUwcGx1cy5jb20iLCJob3Rhdi50diIsImhvdGJveC5jb20iLCJob3Rjb3Vwb253b3JsZC5jb20iLCJob3RmcmVldnBuLmNvbSIsImhvdGZyb2cuY29tLnR3IiwiaG90Z2FtZXNmb3JnaXJscy5jb20iLCJob3Rnb28uY29tIiwiaG90aG1vbmcuY29tIiwiaG90aG91c2UuY29tIiwiaG90bGluZS51YSIsImhvdG5ha2VkbWVuLmNvbSIsImhvdHBlcHBlci5qcCIsImhvdHBvcm5zaG93LmNvbSIsImhvdHBvdGF0by5jb20iLCJob3RzYWxlLmNvbS5teCIsImhvdHNoYW1lLmNvbSIsImhvdHNwb3RzaGllbGQuY29tIiwiaG90dGVlbm1vdmllLmNvbSIsImhvdHR5c3RvcC5jb20iLCJob3R4aGFtc3Rlci5jb20iLCJob3VzZXRvaG9tZS5jby51ayIsImhvdXNld2ViLmNvbS50dyIsImhvdy10by1kaXkub3JnIiwiaG93cHJveHkuY29tIiwiaG93c3R1ZmZ3b3Jrcy5jb20iLCJob3l0cy5jb20uYXIiLCJocC1lei5jb20iLCJocS1zZXgtdHViZS5jb20iLCJocS14aGFtc3Rlci5jb20iLCJocS14bnh4LmNvbSIsImhxY2RwLm9yZyIsImhxZmVtZG9tLmNvbSIsImhxbW92aWVzLmNvbSIsImhxc2V4eWdpcmxzLmNvbSIsImhyYmxvY2suY29tIiwiaHJjaXIuY29tIiwiaHJlYS5vcmciLCJocmljaGluYS5vcmciLCJocm5hYmkuY29tIiwiaHJ3Lm9yZyIsImhzbi5jb20iLCJoc3NlbGl0ZS5jb20iLCJoc3QubmV0LmJyIiwiaHN0YnIubmV0IiwiaHN0ZC5uZXQiLCJoc3Rlcm4ubmV0IiwiaHN0dC5uZXQiLCJodC1hZmdoYW5pc3Rhbi5jb20iLCJodGZjbi5jb20iLCJodGtvdS5uZXQiLCJodG1scHVibGlzaC5jb20iLCJodHRwcHJveHkuc2UiLCJodWFnbGFkLmNvbSIsImh1YW5naHVhZ2FuZy5vcmciLCJodWFyZW4udXMiLCJodWFyZW5saWZlLmNvbSIsImh1ZGF0b3JpcS53ZWIuaWQiLCJodWdlaW5jLmNvbSIsImh1aGFpdGFpLmNvbSIsImh1bGtzaGFyZS5jb20iLCJodW1hbml0ZS5wcmVzc2UuZnIiLCJodW1hbm1ldHJpY3MuY29tIiwiaHVtYW5zZXJ2aWNlcy5nb3YuYXUiLCJodW1taW5nYmlyZDR0d2l0dGVyLmNvbSIsImh1bmcteWEuY29tIiwiaHVudHVyay5uZXQiLCJodW9taWUuY29tIiwiaHVzYWltZW5nLmNvbSIsImh1c3N5dHViZS5jb20iLCJodXN0bGVyaHVucy5jb20iLCJodXQyLnJ1IiwiaHV0b25nOS5uZXQiLCJodXlhbi53ZWIuaWQiLCJodXlhbmRleC5jb20iLCJody5hYy51ayIsImh4aWFvc2h1by5uZXQiLCJoeXBlcmdhbWVzLm5ldCIsImh5cGVyaW56ZXJjZS5jeiIsImktY2FibGUuY29tIiwiaS1zdXguY29tIiwiaS13cml0ZS5pZHYudHciLCJpYW0ubWEiLCJpYW1hYmlnYm9zcy5pbmZvIiwiaWFua2VyLmNvbSIsImlhc2suY2EiLCJpYmMxMjguY29tIiwiaWJlcmlhLmNvbSIsImliaWJvLmNvbSIsImlibGlzdC5jb20iLCJpYm9uZXIuY29tIiwiaWJvb2suaWR2LnR3IiwiaWJvcGUuY29tLmJyIiwiaWJvdHViZS5jb20iLCJpYnRpbWVzLmNvbSIsImlidnBuLmNvbSIsImljYW1zLmNvbSIsImljZXBvcm4uY29tIiwiaWNlcm9ja2V0LmNvbSIsImljaWNpYmFuay5jby5pbiIsImljaWoub3JnIiwiaWNydC5jdSIsImljeWxlYWYuY29tIiwiaWQ0Lmlkdi50dyIsImlkYWl3YW4uY29tIiwiaWRhbWVyaWNhbnkuY29tIiwiaWRkYWEuY29tIiwiaWRlYWxpc3RhLml0IiwiaWRlbnRpLmNhIiwiaWRlc2t0b3AudHYiLCJpZGZhY2Vib29rLmNvbSIsImlkaG9zdGluZ2VyLmNvbSIsImlkb2tlcC5odSIsImlkb25ldW0uY29tIiwiaWRvdWdhLmNvbSIsImlkb3dubG9hZHBsYXkuY29tIiwiaWRvd25sb2Fkc25vdy5jb20iLCJpZHJlYW14LmNvbSIsImlkc2FtLmNvbSIsImlkdi50dyIsImlkeC5jby5pZCIsImllNjY2Lm5ldCIsImllZDJrLm5ldCIsImlmYS1iZXJsaW4uZGUiLCJpZmFucWlhbmcuY29tIiwiaWZhbnIuY29tIiwiaWZjLmNvbSIsImlmY29uZmlnLm1lIiwiaWZjc3Mub3JnIiwiaWZpbG0uY29tIiwiaWZpbG0uY29tLnR3IiwiaWZ0dHQuY29tIiwiaWcuY29tLmJyIiwiaWdhZmVuY3UuY29tIiwiaWdmdy5uZXQiLCJpZ29nby5lcyIsImlnb3RtYWlsLmNvbS50dyIsImloYXZlYW5pZGVhLm9yZyIsImloYXZlc21hbGx0aXRzLmNvbSIsImloaC5vcmcudHIiLCJpaWJlcnJ5LmNvbSIsImlpajR1Lm9yLmpwIiwiaWlwcm94eS5wdyIsImlqYXBhbmVzZXBvcm4uY29tIiwiaWtlYS5ydSIsImlrZWVwYm9va21hcmtzLmNvbSIsImlrbGFubGFoLmNvbSIsImlsZ2lvcm5hbGVkaWZhY2Vib29rLmNvbSIsImlsa2VoYWJlcmFqYW5zaS5jb20udHIiLCJpbG1hdHRpbm8uaXQiLCJpbG92ZWNvdWdhcnMuY29tIiwiaWxvdmVtYXR1cmUubmV0IiwiaWxvdmVtb2JpLmNvbSIsImlsb3ZldXUuaW5mbyIsImlsb3ZleGhhbXN0ZXIuY29tIiwiaWxzb2Z0d2FyZS5pdCIsImlsdnBuLmNvbSIsImltODgudHciLCJpbWFnZWZhcC5jb20iLCJpbWFnZWZsZWEuY29tIiwiaW1hZ2VtLXBhcmEtZmFjZWJvb2suY29tIiwiaW1hZ2VuZXNlbmZhY2Vib29rLmNvbSIsImltYWdlbmVzeWZyYXNlc3BhcmFmYWNlYm9vay5jb20iLCJpbWFnZW5wYXJhZWxmYWNlYm9vay5jb20iLCJpbWFnZW5wYXJhZmFjZWJvb2suY29tIiwiaW1hZ2Vuc2VtZW5zYWdlbnNwYXJhZmFjZWJvb2suY29tIiwiaW1hZ2VzZmFjZWJvb2suY29tIiwiaW1hZ2VzbG92ZS5uZXQiLCJpbWFzdGVyeW91dHViZS5jb20iLCJpbWF6aW5nLmlkdi50dyIsImltYi5vcmciLCJpbWVuYS51YSIsImltZy5seSIsImltZ2V0dGluZ3RoZXJlLmNvbSIsImltZ2Zhcm0uY29tIiwiaW1nbG9yeS5jb20iLCJpbWtldi5jb20iLCJpbWxpdmUuY29tIiwiaW1taWdyYXRpb24uZ292LnR3IiwiaW1tb3JhbC5qcCIsImltcG90cy5nb3V2LmZyIiwiaW1wcm94eS5pbmZvIiwiaW1zcy5nb2IubXgiLCJpbXVqZXIuY29tIiwiaW4tZGlzZ3Vpc2UuY29tIiwiaW45OS5vcmciLCJpbmJhbmJhbi5jb20iLCJpbmJsb2dzcG90LmNvbSIsImluY2V6dC5uZXQiLCJpbmNsb2FrLmNvbSIsImluY3JlYXNlLXlvdXR1YmUuY29tIiwiaW5kYW1haWwuaHUiLCJpbmRlcGVuZGVudC5pZSIsImluZGlhLmNvbSIsImluZGlhYmF5LmluZm8iLCJpbmRpYWNvbS5jb20iLCJpbmRpYWZyZWVzdHVmZi5pbiIsImluZGlhbnByb3h5LmJpeiIsImluZGlhcG9zdC5nb3YuaW4iLCJpbmRpYXByb3h5Lm9yZyIsImluZGllbWVyY2guY29tIiwiaW5kaWdvLmNhIiwiaW5kb25lc2lhYmF5LmluZm8iLCJpbmRvbmVzaWFubW90b3JzaG93LmNvbSIsImluZG9wb3MuY28uaWQiLCJpbmRvc2F0LmNvbSIsImluZHltZWRpYS5vcmciLCJpbmVlZGhpdHMuY29tIiwiaW5maW5pdHVtbW92aWwubmV0IiwiaW5mby1ncmFmLmZyIiwiaW5mby1uZXQuY29tLnBsIiwiaW5mb2xpYnJlLmVzIiwiaW5mb24ucnUiLCJpbmZvcm1hY2lvbi1lbXByZXNhcy5jb20iLCJpbmZvcm1hdGlvbmlzYmVhdXRpZnVsLm5ldCIsImluZm93b3JsZC5jb20iLCJpbmcuZGsiLCJpbmdyZXNvc2V4dHJhc2NvbnlvdXR1YmUuY29tIiwiaW5rY2x1Yi5jb20iLCJpbmt1aS5jb20iLCJpbm1lZGlhaGsubmV0IiwiaW5ub2dhbWVzLmNvbSIsImlub3NtaS5ydSIsImlub3RlLnR3IiwiaW5zYW5ldHJhZmZpY25vdy5pbmZvIiwiaW5zZWUuZnIiLCJpbnNpZGVmYWNlYm9vay5jb20iLCJpbnNpZ2h0LmNvLmtyIiwiaW5zb21uaWEyNDcubmwiLCJpbnN0YWdyYW0uY29tIiwiaW5zdGFncmFtLmNvbS5iciIsImluc3RhZ3JhbXByb3h5LmNvbSIsImluc3RhbGxtYWMuY29tIiwiaW5zdGFwYXBlci5jb20iLCJpbnRlcmVzbml0ZWUuaW5mbyIsImludGVybWFyZ2lucy5uZXQiLCJpbnRlcm5ldGJyYW5kcy5jb20iLCJpbnRlcm5ldGNsb2FrLmNvbSIsImludGVyb3BlcmFiaWxpdHlicmlkZ2VzLmNvbSIsImludGVyc3RhdHMub3JnIiwiaW50ZXJ0ZWxlY29tLnVhIiwiaW50ZXJ0d2l0dGVyLmNvbSIsImludGVyd2VhdmVzdG9yZS5jb20iLCJpbnlvdXJwb2NrZXQuY29tIiwiaW8udWEiLCJpb2JpdC5jb20iLCJpb3Nob3cuY29tIiwiaW94eS5kZSIsImlwYWRpemF0ZS5lcyIsImlwYW5lbG9ubGluZS5jb20iLCJpcGFzc2V4YW0uY29tIiwiaXBjaGFuZ2Vwcm94eS5jb20iLCJpcGNoYW5naW5nLmNvbSIsImlwY2xvYWsudXMiLCJpcGNvbmNlYWwuY29tIiwiaXBnaXpsZS5uZXQiLCJpcGhpZGVyLm9yZyIsImlwaGlkZXJwcm8uY29tIiwiaXBob24uZnIiLCJpcGhvbmUtZGV2Lm9yZyIsImlwaG9uZWRldi5jby5rciIsImlwaG9zdGVyLm5ldCIsImlwamV0YWJsZS5uZXQiLCJpcGxhbWEuY29tIiwiaXBtYXNrLnVzIiwiaXByZWRhdG9yLnNlIiwiaXByb2R1Y3RzLmNvbS50dyIsImlwcm94eTA3LmNvbSIsImlwdHYuY29tLnR3IiwiaXB2NnByb3h5Lm5ldCIsImlwdmFuaXNoLmNvbSIsImlyYW5iYXkuaW5mbyIsImlyYW5ncmVlbnZvaWNlLmNvbSIsImlyYW5pYW4uY29tIiwiaXJhbmlwcm94eS5jb20iLCJpcmFucHJvdWQuY29tIiwiaXJhbnZvbGxleWJhbGwuY29tIiwiaXJhc3V0b3lhLmNvbSIsImlyZWRtYWlsLm9yZyIsImlyZWxhbmRiYXkuaW5mbyIsImlyb25meC5jb20iLCJpc2FhY21hby5jb20iLCJpc2IuZWR1IiwiaXNldC5jb20udHciLCJpc2lraW5zYWF0bHRkLmNvbS50ciIsImlzbGFtLm9yZy5oayIsImlzbGFtaG91c2UuY29tIiwiaXNsYW1pY2F3YWtlbmluZy5jb20iLCJpc2xhbWljaXR5LmNvbSIsImlzbGFtdG9kYXkubmV0IiwiaXNsYW5kLmxrIiwiaXNtYWxsdGl0cy5jb20iLCJpc25vdG9uZmFjZWJvb2suY29tIiwiaXNwLXVuYmxvY2tlci5jb20iLCJpc3BiYW4uY29tIiwiaXNwb3QudHYiLCJpc3JhZWxuYXRpb25hbG5ld3MuY29tIiwiaXN0YXJzLmNvLm56IiwiaXN0ZWYuaW5mbyIsImlzdGlxbGFsaGFiZXIuY29tIiwiaXN0b2NrcGhvdG8uY29tIiwiaXN1bnR2LmNvbSIsIml0YWx5YmF5LmluZm8iLCJpdGFyLXRhc3MuY29tIiwiaXRhdmlzZW4ubm8iLCJpdGJhemFyLmNvbSIsIml0ZXNwcmVzc28uZnIiLCJpdGhhY2F2b2ljZS5jb20iLCJpdGhvbWUuY29tLnR3IiwiaXR3ZWV0Lm5ldCIsIml1NDUuY29tIiwiaXVtc29ubGluZS5vcmciLCJpdW5sb2NrZXIubmV0IiwiaXZhY3kuY29tIiwiaXZjLW9ubGluZS5jb20iLCJpd2VicHJveHkuY29tIiwiaXhpZ28uY29tIiwiaXhxdWljay1wcm94eS5jb20iLCJpeHF1aWNrLmNvbSIsIml5aW4ubmV0IiwiaXphb2Jhby51cyIsIml6aWhvc3Qub3JnIiwiaXpsZXMubmV0IiwiaXpsZXNlbS5vcmciLCJpemxlc2VuZS5jb20iLCJqLWEtcC1hLW4uY29tIiwiai5tcCIsImo3d3l0LmNvbSIsImphY2tqaWEuY29tIiwiamFja3BvdC50diIsImphY3NvLmhrIiwiamFnb2ludmVzdG9yLmNvbSIsImphaHByb3h5LmNvbSIsImphaW1lbG92ZXNzdHVmZi5jb20iLCJqYW1hYXQub3JnIiwiamFtZXNlZGl0aW9uLmNvbSIsImphbmVzLmNvbSIsImphcGFuLXdob3Jlcy5jb20iLCJqYXBhbmVzZXNwb3J0Y2Fycy5jb20iLCJqYXBhbnBvc3QuanAiLCJqYXBhbndlYi5pbmZvIiwiamFwYW53ZWJwcm94eS5jb20iLCJqYXNhb3B0aW1hc2l0d2l0dGVyLmNvbSIsImphc29uYWxkZWFuLmNvbSIsImphdjE4OC5jb20iLCJqYXZkb3dubG9hZGVyLmluZm8iLCJqYXZob3Qub3JnIiwiamF2bWUuY29tIiwiamF2cGVlLmNvbSIsImphdnpvby5jb20iLCJqYnRhbGtzLmNjIiwiamRiYnMuY29tIiwiamVlcG9mZmVycy5jYSIsImplZXZhbnNhdGhpLmNvbSIsImplcnVzYWxlbS5jb20iLCJqZXR6dHNwaWVsZW4uZGUiLCJqZXV4LmZyIiwiamhhbGRlcm0uY29tIiwiamllaHVhLmN6Iiwiamllamllc2hlLmNvbSIsImppZXBhaW9rLmNvbSIsImppZXBhbmcuY29tIiwiamloYWRvbG9neS5uZXQiLCJqaWppLmNvbSIsImppbW9wYXJ0eS5jb20iLCJqaW4xMTUuY29tIiwiamluZ3Bpbi5vcmciLCJqaW5oYWkuZGUiLCJqaXJ1YW4ubmV0IiwiamlzdWRhbi5vcmciLCJqaXZvc2l0ZS5jb20iLCJqaXp6Ym8uY29tIiwiaml6emh1dC5jb20iLCJqamdpcmxzLmNvbSIsImprYi5jYyIsImprZm9ydW0ubmV0IiwiamxnY3l5LmNvbSIsImptYS1uZXQuZ28uanAiLCJqbWJ1bGxpb24uY29tIiwiam9iaWpvYmEuY29tIiwiam9icmFwaWRvLmNvbSIsImpvYnMubmV0Iiwiam9ic3RyZWV0LmNvbS5waCIsImpvZXByb3h5LmNvLnVrIiwiam9leXJvYmVydC5vcmciLCJqb2ZvZ2FzLmh1Iiwiam9ubnkuaWR2LnR3Iiwiam9vYmxlLnJ1Iiwiam9vbWxhLW1vbnN0ZXIuY29tIiwiam9zZXB2aW5haXhhLmNvbSIsImpvdXJuYWxhdXRvLmNvbSIsImpvdXJuYWxjaHJldGllbi5uZXQiLCJqb3VybmFsZHVnYW1lci5jb20iLCJqb3VybmFsZHVnZWVrLmNvbSIsImpveWVudC5jb20iLCJqcGxvcHNvZnQuaWR2LnR3IiwianBtd2FycmFudHMuY29tLmhrIiwianF1ZXJ5dWkuY29tIiwianItc2hpa29rdS5jby5qcCIsImpyYS5qcCIsImpzZHBuLmNvbSIsImp1YWxha3VudHdpdHRlci5jb20iLCJqdWRpcy5uaWMuaW4iLCJqdWVnb3MuY29tIiwianVlZ29zZGVjaGljYXMuY29tIiwianVlZ29zZGlhcmlvcy5jb20iLCJqdWVnb3NqdWVnb3MuY29tIiwianVnZ3dvcmxkLmNvbSIsImp1anVmZWVkLmNvbSIsImp1bGFpYmFvLmNvbSIsImp1bGVzam9yZGFuLmNvbSIsImp1bmVmb3VydGgtMjAubmV0IiwianVuZ2xlZS5jb20iLCJqdW5ob25nYmxvZy5pbmZvIiwianVyaXN3YXkub3JnLmJyIiwianVzdC1jb29sLm5ldCIsImp1c3Qucm8iLCJqdXN0Zm9ydGlueXBlb3BsZS5jb20iLCJqdXN0ZnJlZXZwbi5jb20iLCJqdXN0aW4udHYiLCJqdXN0b25qdWljZS5jb20iLCJqdXN0cGFzdGUuaXQiLCJqdXN0cHJveHkuY28udWsiLCJqeXhmLm5ldCIsImsxMnJlYWRlci5jb20iLCJrMTc4eC5jb20iLCJrOXNhZmVzZWFyY2guY29tIiwia2FneXUub3JnIiwia2FneXUub3JnLnphIiwia2FneXVvZmZpY2Uub3JnIiwia2FneXVvZmZpY2Uub3JnLnR3Iiwia2FpeXVhbi5kZSIsImtha2FvLmNvLmtyIiwia2FrYW8uY29tIiwia2FsZW1lLmNvbSIsImthbGVuZGVyd29jaGUubmV0Iiwia2FuLWJlLmNvbSIsImthbmFsNS5jb20ubWsiLCJrYW5jYi5jb20iLCJrYW5neWUub3JnIiwia2Fuc2hpZmFuZy5jb20iLCJrYW56aG9uZ2d1by5jb20uYXUiLCJrYW8xNjUuaW5mbyIsImthb3RpYy5jb20iLCJrYXBvb2suY29tIiwia2FxaS5jYyIsImthcmFrYXJ0YWwuY29tIiwia2FybWFsb29wLmNvbSIsImthcm1hcGEub3JnIiwia2FzaWtvcm5iYW5rZ3JvdXAuY29tIiwia2F0LXByb3h5Lm9yZyIsImthdGVkcmFsYS5jeiIsImthdGhpbWVyaW5pLmdyIiwia2F1Zm1pY2guY29tIiwia2F1bnNhLmNvbSIsImthd2FubGFoLmNvbSIsImtheWhhbmxvbmRvbi5jb20iLCJrYXplLXRyYXZlbC5jby5qcCIsImtibGxkeS5pbmZvIiwia2NldC5vcmciLCJrY29tZS5vcmciLCJrZWJhYnN0YWxsLmNvbSIsImtlYnJ1bS5jb20iLCJrZWNoYXJhLmNvbSIsImtlZXBhbmRzaGFyZS5jb20iLCJrZWVwYXdheWZyb21teS5pbmZvIiwia2VlcG1lcy5jb20iLCJrZWlvLmNvLmpwIiwia2VpdGh1cmJhbi5uZXQiLCJrZW1waW5za2kuY29tIiwia2VuLXN0dWRpby5pZHYudHciLCJrZW5kaW5jb3MubmV0Iiwia2VuZW5nYmEuY29tIiwia2VubWluZy5pZHYudHciLCJrZW50b25saW5lLmNvLnVrIiwia2VwYXJkLmNvbSIsImtlcmFsYS5nb3YuaW4iLCJrZXJqYW55YS5uZXQiLCJrZXNvLmNuIiwia2V0bm9vaS5jb20iLCJraGFiZGhhLm9yZyIsImtobWVyMzMzLmNvbSIsImtobXVzaWMuY29tLnR3Iiwia2hvdS5jb20iLCJraWNrYXNzcHJveHkuY29tIiwia2lja3N0YXJ0ZXIuY29tIiwia2lja3lvdXR1YmUuY29tIiwia2lkbG9nZ2VyLm5ldCIsImtpa2lub3RlLmNvbSIsImtpbGx3YWxsLmNvbSIsImtpbXkuY29tLnR3Iiwia2luZzUuY29tIiwia2luZ2JpZy5pZHYudHciLCJraW5nY291bnR5LmdvdiIsImtpbmdkb21zYWx2YXRpb24ub3JnIiwia2luZ2hvc3QuY29tIiwia2luZ2hvc3QuY29tLmJyIiwia2luZ3N0b25lLmNvbS50dyIsImtpbm9zdGFyLnV6Iiwia2lwcm94eS5jb20iLCJraXIuanAiLCJraXNzYmJhby5jbiIsImtpc3N5b3V0dWJlLmNvbSIsImtpdGFnYXdhLXByby5jb20iLCJraXRjaGVuZGVzaWduc2lkZWFzaXRlLmNvbSIsImtpd2kua3oiLCJrazUuYml6Iiwia2twcm94eS5jb20iLCJra3Byb3h5LnB3Iiwia2wuYW0iLCJrbGFydC5zZSIsImtsZWluZXplaXR1bmcuYXQiLCJrbGljay10aXBwLmNvbSIsImtsaWNrbWVtYmVyc3Byb2plY3QuY29tLmJyIiwia2xpcC5tZSIsImttLnJ1Iiwia203Ny5jb20iLCJrbXRsYmIuY29tIiwia25pZmVob21lLmNvbS50dyIsImtubWkubmwiLCJrbm93bGFyaXR5LmNvbSIsImtub3dsZWRnZXJ1c2guY29tIiwia25vd3lvdXJtb2JpbGUuY29tIiwia29hY2kuY29tIiwia29iZS1ucC5jby5qcCIsImtvYmUuaWR2LnR3Iiwia29jaGJhci5kZSIsImtvbWl4eHguY29tIiwia29tcGFzLmNvbSIsImtvbXBhc2lhbmEuY29tIiwia29tcGFzcy5jb20iLCJrb211bml0YXNmYWNlYm9vay5jb20iLCJrb29ybmsuY29tIiwia29vemFpLmNvbSIsImtvcmVhLXR3aXR0ZXIuY29tIiwia29yemlrLm5ldCIsImtveHkuZGUiLCJrcG9wLWluc3RhZ3JhbS5jb20iLCJrcG9wc3RhcnouY29tIiwia3Byb3h5LmNvbSIsImtwcm94eS5pbiIsImtwcnMuY29tIiwia3F2b2QubmV0Iiwia3JhZnRmdXR0ZXJtaXNjaHdlcmsuZGUiLCJrcmVhdGlvbmp1aWNlLmNvbSIsImtyaXN0aW5hbmRjb3J5LmNvbSIsImtzbmV3cy5jb20udHciLCJrdHJtci5jb20iLCJrdHNjYy5pZHYudHciLCJrdHVubmVsLmNvbSIsImt0dW5uZWwubmV0Iiwia3R2LmpwIiwia3R2NTIuY29tIiwia3U4bS5jb20iLCJrdWFpYm80NDQuY29tIiwia3VhaWhlaS5jb20iLCJrdWFpdHYubmV0Iiwia3VhaXp1aS5vcmciLCJrdWkubmFtZSIsImt1bWFvLmNjIiwia3VuLmltIiwia3VuZ2Z1Ym9hcmQuY29tIiwia3VyendlaWxhaS5uZXQiLCJrdXNjLm9yZyIsImt1dmV5dHR1cmsuY29tLnRyIiwia3V5b3V3by5jbiIsImt3ZXN0aWFzbWFrdS5jb20iLCJrd29uZ3dhaC5jb20ubXkiLCJreGxmLmNvbSIsImt4bGguY29tIiwia3kzLmNvbSIsImt5aXZwb3N0LmNvbSIsImt5b2JvYm9vay5jby5rciIsImt5b2hrLm5ldCIsImt5b3RvLXUuYWMuanAiLCJremVuZy5pbmZvIiwibC1hbm9uLmNvbSIsImxhLWZvcnVtLm9yZyIsImxhYmlvZ3VpYS5jb20iLCJsYWJ1dGFjYS5uZXQiLCJsYWRicm9rZXMuYmUiLCJsYWRicm9rZXMuY29tIiwibGFkYnJva2VzLmNvbS5hdSIsImxhZHktc29uaWEuY29tIiwibGFkeWJveWdsb3J5aG9sZS5jb20iLCJsYWR5Y2hlZWt5LmNvbSIsImxhZHlsaWtlLmdyIiwibGFnb21ldGVyLmRlIiwibGFncmFuZXBvY2EuY29tIiwibGFpYmEuY29tLmF1IiwibGFrbWVpbmRpYS5jb20iLCJsYWx1bGFsdS5jb20iLCJsYW1hLmNvbS50dyIsImxhbWF5ZXNoZS5jb20iLCJsYW1pc3N0d2l0dGVyLmNvbSIsImxhbmFjaW9uLmNvbS5hciIsImxhbmNlbmV0LmNvbS5iciIsImxhbmRvZm5vZC5jb20iLCJsYW5nMzMuY29tIiwibGFuZ3ByaXNtLmNvbSIsImxhbmthaG90bmV3cy5pbmZvIiwibGFvbGExLmF0IiwibGFvbGExLnR2IiwibGFveWFuZy5pbmZvIiwibGFwYWdpbmEuY29tLnN2IiwibGFwZXlyZS5mciIsImxhcHMzLmNvbSIsImxhcHRvcHNkaXJlY3QuY28udWsiLCJsYXFpbmdkYW4ubmV0IiwibGFyYXpvbi5lcyIsImxhcmVwdWJsaXF1ZWRlc3B5cmVuZWVzLmZyIiwibGFyZ2Vwb3JudHViZS5jb20iLCJsYXJnZXhoYW1zdGVyLmNvbSIsImxhc3RmbS5lcyIsImxhdGFheW91dHViZS5jb20iLCJsYXRpbWVzLmNvbSIsImxhdGluYmFiZXNsaW5rcy5jb20iLCJsYXRyaWJ1bmUuZnIiLCJsYXVyYWcudHYiLCJsYXZlcmRhZC5jb20iLCJsYXZvaXhkdW5vcmQuZnIiLCJsYXZvcmF0b3Jpby5pdCIsImxhdm9yaWNyZWF0aXZpLmNvbSIsImxhdm96dHguY29tIiwibGF3LmNvbSIsImxheW5lZ2xhc3MuY29tIiwibGF6YWRhLmNvbS5waCIsImxhenltaWtlLmNvbSIsImxjYWRzLnJ1IiwibGRtc3R1ZGlvLmNvbSIsImxlLWRpY3Rpb25uYWlyZS5jb20iLCJsZWFkLmlkdi50dyIsImxlYWRmZXJyZXQuY29tIiwibGVhZmx5LmNvbSIsImxlYXJudG9oYWNrZmFjZWJvb2suY29tIiwibGVlZHMuYWMudWsiLCJsZWVsYS50diIsImxlZmpzcS5jb20iLCJsZWZvcmEuY29tIiwibGVnZ3ljYXNoLmNvbSIsImxlbWF0aW4uY2giLCJsZW1tb25qdWljZS5jb20iLCJsZW1vbmRlLmZyIiwibGVtb25pdGV1ci5mciIsImxlbmRpbmdjbHViLmNvbSIsImxlbnMuaGsiLCJsZW56b3IuY29tIiwibGVwcm9ncmVzLmZyIiwibGVzb2lyLmJlIiwibGVzdGVyODUwLmluZm8iLCJsZXRyYXNmYWNlYm9vay5jb20iLCJsZXRzYWxsaGlkZS5pbmZvIiwibGV0c2NvcnAuY29tIiwibGV0c2NvcnAubmV0IiwibGV4aWxvZ29zLmNvbSIsImxlemN1dGllcy5jb20iLCJsZ2UuY29tIiwibGlhbjMzLmNvbSIsImxpYW55dWUubmV0IiwibGlhb3RpLm5ldCIsImxpYW93YW5neGl6YW5nLm5ldCIsImxpYmVyYWwub3JnLmhrIiwibGliZXJ0eXRpbWVzLmNvbS50dyIsImxpYnJlbWVyY2Fkby5jb20iLCJsaWRlY2hlbmcuY29tIiwibGlkbC1oZWxsYXMuZ3IiLCJsaWZlLmh1IiwibGlmZWhhY2tlci5jby5pbiIsImxpZmVoYWNrZXIuY29tIiwibGlmZXNjcmlwdC5jb20iLCJsaWdodGJveC5jb20iLCJsaWdodGhvdXNldGVlbnNlcmllcy5jb20iLCJsaWdodGluZ2RpcmVjdC5jb20iLCJsaWlnYS5maSIsImxpaXN0ZmFjZWJvb2suY29tIiwibGlrZWRkb3QuY29tIiwibGluY29sbmZwLmNvbSIsImxpbmRhaWtlamlibG9nc3BvdC5jb20iLCJsaW5lLm1lIiwibGluZTI1LmNvbSIsImxpbmdsaW5nZmEuY29tIiwibGluZ3lpLmNjIiwibGluazY2Ni5pbmZvIiwibGlua2FkZWguY29tIiwibGlua2VkaW4uY29tIiwibGlua2VkaW5qdWljZS5jb20iLCJsaW5raWRlby5jb20iLCJsaW5rbWVmcmVlLm5ldCIsImxpbmttZXR1YmUuY29tIiwibGlua3Mub3JnLmF1IiwibGlua3NhbHBoYS5jb20iLCJsaW5rdXN3ZWxsLmNvbSIsImxpbmt3b3J0aC5jb20iLCJsaW5reGNoYW5nZXIubW9iaSIsImxpbmt5b3V0dWJlLmNvbSIsImxpbnBpZS5jb20iLCJsaXBzeS5jby51ayIsImxpcHVtYW4uY29tIiwibGlxdWlkYS5pdCIsImxpc3Q0cHJveHkuZGUiLCJsaXN0ZW50b3lvdXR1YmUuY29tIiwibGlzdG90aWMuY29tIiwibGlzdHByb3h5LmluZm8iLCJsaXR0bGVzaG9vdC5vcmciLCJsaXR0bGV3ZWJkaXJlY3RvcnkuY29tIiwibGl1aGFueXUuY29tIiwibGl1amlhbnNodS5jb20iLCJsaXZlLXByb3h5LmNvbSIsImxpdmVjaGVubmFpLmNvbSIsImxpdmVqb3VybmFsLmNvbSIsImxpdmVsZWFrLmNvbSIsImxpdmVzY2llbmNlLmNvbSIsImxpdmVzY29yZS5jby5rciIsImxpdmVzY29yZS5pbiIsImxpdmVzZXh2b2QuY29tIiwibGl2ZXNtaS5jb20iLCJsaXZlc3BvcnRzLnBsIiwibGl2ZXN0YXRpb24uY29tIiwibGl2ZXZpZGVvLmNvbSIsImxpeWNobi5jbiIsImxpemhpZHkuY29tIiwibGxwcm94eS5wdyIsImxtemoubmV0IiwibG9hZC50byIsImxvY2FsZG9tYWluLndzIiwibG9jYWxob3N0LmNvbSIsImxvY2FscHJlc3Noay5jb20iLCJsb2NhbHN0cmlrZS5uZXQiLCJsb2dnbHkuY29tIiwibG9naWMtaW1tby5iZSIsImxvZ2lucHJveHkuY29tIiwibG9nb2Rlc2lnbmp1aWNlLmNvbSIsImxvZ29nZW5pZS5uZXQiLCJsb2dzb2t1LmNvbSIsImxvaWNsZW1ldXIuY29tIiwibG9qYTIuY29tLmJyIiwibG9sY2xhc3NpYy5jb20iLCJsb2xsaXBvcC1uZXR3b3JrLmNvbSIsImxvbWFkZWUuY29tIiwibG9uZG9uY2hpbmVzZS5jYSIsImxvbmVzdGFybmF1Z2h0eWdpcmxzLmNvbSIsImxvbmdoYWlyLmhrIiwibG9uZ3dhcmpvdXJuYWwub3JnIiwibG9ubnkuY29tIiwibG9va2F0Z2FtZS5jb20iLCJsb29wbmV0LmNvbSIsImxvb3h5LmNvbSIsImxvcGFuYS5jb20uYnIiLCJsb3Jkb2Z0dWJlLmNvbSIsImxvc2FuZGVzLmNvbS5hciIsImxvc3Jpb3MuZWR1IiwibG91cGFrLmN6IiwibG91dnJlLmZyIiwibG92ZWJha2VzZ29vZGNha2VzLmNvbSIsImxvdmVkLmhrIiwibHJ0eXMuY29tIiwibHNkLm9yZy5oayIsImxzZm9ydW0ubmV0IiwibHNtLm9yZyIsImxzbWNoaW5lc2Uub3JnIiwibHRuLmNvbS50dyIsImx0c2h1LmNvbSIsImx1YmV0dWJlLmNvbSIsImx1anVuaG9uZzJvci5jb20iLCJsdWp1bmhvbmcyb3Iub3JnIiwibHVrZTU0Lm9yZyIsImx1a2VzYmxvZ3Nwb3QuY29tIiwibHVsdWx1LmNjIiwibHVsdWx1d2FuZy5jb20iLCJsdW5saXlzLmNvbSIsImx1cG0ub3JnIiwibHVzdGZ1bC1naXJscy5jb20iLCJsdXN0ZnVsM2RnaXJscy5jb20iLCJsdXRhdGFhLmNvbSIsImx1eGViYy5jb20iLCJsdXh1cnllc3RhdGUuY29tIiwibHV4dXJ5Z2lybHMuY29tIiwibHZpbnByZXNzLmNvbSIsImx2djIuY29tIiwibHlkZGthcnRjaXJjdWl0LmNvbSIsImx5ZmhrLm5ldCIsImx5b25lc3MudHYiLCJseXJzZW5zZS5jb20iLCJtM3JmLmNvbSIsIm04MDA4LmNvbSIsIm04OGEuY29tIiwibTg4YXNpYS5jb20iLCJtYWFyaXAub3JnIiwibWFibGV0LmNvbSIsIm1hY2F1LXNsb3QuY29tIiwibWFjYXVzbG90LmNvbSIsIm1hY29ic2VydmVyLmNvbSIsIm1hY3JvdnBuLmNvbSIsIm1hZC5jb20iLCJtYWRhZDIuY29tIiwibWFkZWJvbnkuY29tIiwibWFkc2V4dHViZS5jb20iLCJtYWdhemluZW1hbmFnZXIuY29tIiwibWFnaWNicmlja3MuY29tIiwibWFnaXJhbi5jb20iLCJtYWhub3IuY29tIiwibWFpY2h1bnRhbmcuaW5mbyIsIm1haWlvLm5ldCIsIm1haWwtYXJjaGl2ZS5jb20iLCJtYWlsaG9zdGJveC5jb20iLCJtYWlscC5pbiIsIm1haWx4bWFpbC5jb20iLCJtYWlubGlua2Fkcy5jb20iLCJtYWlucHJveC5jb20iLCJtYWtlcnN0dWRpb3MuY29tIiwibWFrdXJlLmNvbSIsIm1hbGF2aWRhLmNvbSIsIm1hbGF5c2lhYmF5LmluZm8iLCJtYWx0YXdlYXRoZXJzaXRlLmNvbSIsIm1hbHRlc2UuY29tIiwibWFtaXNpdGUuY29tIiwibWFuYWdlcnlvdXR1YmUuY29tIiwibWFuYWdlcnpvbmUuY29tIiwibWFuYXRlbHVndS5pbiIsIm1hbmdvdnBuLmNvbSIsIm1hbmlhc2guY29tIiwibWFuaWN1cjRpay5ydSIsIm1hbm90bzEuY29tIiwibWFucy5lZHUuZWciLCJtYW5zaW9uLmNvbSIsIm1hbnNpb25wb2tlci5jb20iLCJtYW50YW4td2ViLmpwIiwibWFueXBpY3R1cmUuY29tIiwibWFvZGFpbGkudXMiLCJtYW9tYW90bGJiLmNvbSIsIm1hb21laS5pbmZvIiwibWFwc29maW5kaWEuY29tIiwibWFyY2FtYXJjYS5jb20udHIiLCJtYXJjaGUuZnIiLCJtYXJjb3Zhc2NvLmZyIiwibWFyZG9tYWsub3JnIiwibWFyZ3Vlcml0ZS5zdSIsIm1hcmlhbm5lLm5ldCIsIm1hcmtldGZvcmNlLmNvbSIsIm1hcmtldHJvbi5jb20iLCJtYXJrZXRzYW5kbWFya2V0cy5jb20iLCJtYXJ4aXN0LmNvbSIsIm1hcnhpc3RzLm9yZyIsIm1hc2hhYmxlLmNvbSIsIm1hc2loYWxpbmVqYWQuY29tIiwibWFzdGVyYXBsYXllci5jb20iLCJtYXN0ZXJjaXR5LnJ1IiwibWF0Y2hlbmRpcmVjdC5mciIsIm1hdGhjb3Vyc2UubmV0IiwibWF0aGxhbmQuaWR2LnR3IiwibWF0b21lYW50ZW5hLmNvbSIsIm1hdHJpeHRlZW5zLmNvbSIsIm1hdHN1Lmlkdi50dyIsIm1hdHQxLm5ldCIsIm1hdHVyZS1nbG9yeWhvbGUuY29tIiwibWF0dXJlZ25vbWUuY29tIiwibWF0dXJldmlkc3R1YmUuY29tIiwibWF0dXJleGZ1Y2suY29tIiwibWF0dXJleGhhbXN0ZXIuY29tIiwibWF2ZW4ub3JnIiwibWF4aWNlcC5jb20iLCJtYXhpZGl2eC5jb20iLCJtYXhpb2Npby5uZXQiLCJtYXhwcmVwcy5jb20iLCJtYXlham8uY29tIiwibWJjLm5ldCIsIm1idXNhLmNvbSIsIm1jZG9uYWxkcy5jb20iLCJtY3JlYXNpdGUuY29tIiwibWN0Lmdvdi5heiIsIm1kLXQub3JnIiwibWRqdW5jdGlvbi5jb20iLCJtZWN6ZTI0LnBsIiwibWVkaWFmaXJlLmNvbSIsIm1lZGlhZnJlYWtjaXR5LmNvbSIsIm1lZGlheW91Lm5ldCIsIm1lZGljYWxkYWlseS5jb20iLCJtZWRpY2FtZW50b3MuY29tLm14IiwibWVkaWNhcmUuZ292IiwibWVkeWF0d2l0dGVyLmNvbSIsIm1lZW1pLmNvbSIsIm1lZXRpYy5jb20iLCJtZWV0aWMuZXMiLCJtZWV0aWMuaXQiLCJtZWZlZWRpYS5jb20iLCJtZWdhLXhoYW1zdGVyLmNvbSIsIm1lZ2FieWV0Lm5ldCIsIm1lZ2FpbmRleC50diIsIm1lZ2FtaWRpYS5jb20uYnIiLCJtZWdhcG9ybi5jb20iLCJtZWdhcHJveHkuY29tIiwibWVnYXByb3h5LmNvbS5hciIsIm1laW1laWR5LmNvbSIsIm1laW5laWhhbi5jb20iLCJtZWludnNoaXBpbi5uZXQiLCJtZWpvcnByb3h5LmNvbSIsIm1lbGltYXpoYWJpLmNvbSIsIm1lbGlzc2FkYXRhLmNvbSIsIm1lbWJ1YXRmYWNlYm9vay5jb20iLCJtZW1laGsuY29tIiwibWVtcmlqdHRtLm9yZyIsIm1lbmtpLmlkdi50dyIsIm1lbm92ZXIzMC5jb20iLCJtZW5zYWdlbnNjb21hbW9yLmNvbSIsIm1lbnRhbGZsb3NzLmNvbSIsIm1lcmN5cHJvcGhldC5vcmciLCJtZXJpZGlhbm8uY29tLnZlIiwibWVyaXQtdGltZXMuY29tLnR3IiwibWVyaXZpZXcuaW4iLCJtZXJsaW9uLmNvbSIsIm1lc290dy5jb20iLCJtZXQtYXJ0LmNvbSIsIm1ldGEudWEiLCJtZXRhY2FmZS5jb20iLCJtZXRhY3JpdGljLmNvbSIsIm1ldGFmZmlsaWF0aW9uLmNvbSIsIm1ldGFydDEuY29tIiwibWV0YXJ0ei5jb20iLCJtZXRlby5jYXQiLCJtZXRlb2NvbnN1bHQuZnIiLCJtZXRlb21lZGlhLmNvbSIsIm1ldGlmYXIuY29tIiwibWV0cmljLWNvbnZlcnNpb25zLm9yZyIsIm1ldHJvcG9saS5jb20iLCJtZXRyb3JhZGlvLmNvbS5oayIsIm1ldHJvdWkub3JnLnVhIiwibWV0c2VydmljZS5jb20iLCJtZXlvdS5qcCIsIm1mYWNlYm9vay5jb20iLCJtZmJpei5jb20iLCJtZm9yb3MuY29tIiwibWdvb24uY29tIiwibWdzdGFnZS5jb20iLCJtaDcwMC5jZiIsIm1oYS5uaWMuaW4iLCJtaHJhZGlvLm9yZyIsIm1pYnJ1anVsYS5jb20iLCJtaWRpYW1heC5jb20iLCJtaWRpbGlicmUuZnIiLCJtaWRuaWdodGZldmVyLmNvbSIsIm1pZ2h0eWRlYWxzLmNvbSIsIm1paGFuYmxvZy5jb20iLCJtaWhhbm1hcmtldC5jb20iLCJtaWhrLmhrIiwibWloci5jb20iLCJtaWxhbm90b2RheS5pdCIsIm1pbGl0YXJ5ZmFjdG9yeS5jb20iLCJtaWxsaXBpeWFuZ28uZ292LnRyIiwibWlsc3VycHMuY29tIiwibWlsdHQuY29tIiwibWltaXZpcC5jb20iLCJtaW5kYW5kbGlmZS5vcmciLCJtaW5kc3BhcmsuY29tIiwibWluZ2h1aS1zY2hvb2wub3JnIiwibWluZ2h1aS5kZSIsIm1pbmdodWkub3JnIiwibWluZ2ppbmdsaXNoaS5jb20iLCJtaW5namluZ25ld3MuY29tIiwibWluZ3Bhby5jb20iLCJtaW5ncGFvY2FuYWRhLmNvbSIsIm1pbmdwYW9mdW4uY29tIiwibWluZ3Bhb21vbnRobHkuY29tIiwibWluZ3Bhb25ld3MuY29tIiwibWluZ3Bhb255LmNvbSIsIm1pbmdwYW9zZi5jb20iLCJtaW5ncGFvdG9yLmNvbSIsIm1pbmdwYW92YW4uY29tIiwibWluZ3NoZW5nYmFvLmNvbSIsIm1pbmkxODkuY29tIiwibWluaW5vdmEub3JnIiwibWluaXNpemViaWtpbmkuY29tIiwibWluaXN0cnlib29rcy5vcmciLCJtaW5rY2hhbi5jb20iLCJtaW51dGVidXp6LmNvbSIsIm1pbnV0b2RpZ2l0YWwuY29tIiwibWluemh1aHVhLm5ldCIsIm1pbnpodXpob25nZ3VvLm9yZyIsIm1pcWlxdm9kLmNvbSIsIm1pcnJvcmJvb2tzLmNvbSIsIm1pc2lvbmVzY3VhdHJvLmNvbSIsIm1pc3Mtbm8xLmNvbSIsIm1pc3R5LXdlYi5jb20iLCJtaXRiYnMuY2EiLCJtaXRiYnMuY28ubnoiLCJtaXRiYnMuY28udWsiLCJtaXRiYnMuY29tIiwibWl0YmJzLm9yZyIsIm1pdGJic2F1LmNvbSIsIm1pdGJic2hrLmNvbSIsIm1pdGJic2pwLmNvbSIsIm1pdGJic3NnLmNvbSIsIm1pdGJic3R3LmNvbSIsIm1pdG1wcm94eS5vcmciLCJtaXhlcm8uY29tIiwibWl4bHIuY29tIiwibWl4cG9kLmNvbSIsIm1peHR1cmVjbG91ZC5jb20iLCJtaXh4LmNvbSIsIm1peHh4eC5jb20iLCJtay5ydSIsIm1rNTAwMC5jb20iLCJta3Rtb2JpLmNvbSIsIm1sY29vbC5jb20iLCJtbS0xMS5jb20iLCJtbS1jZy5jb20iLCJtbTZ5dC5jb20iLCJtbWNvby5jbiIsIm1tZGF5cy5jb20iLCJtbW1jYS5jb20iLCJtb2JpbGUwMS5jb20iLCJtb2JpbGVsYWJ5LmNvbSIsIm1vYmlsZXNtb3ZpZS5pbiIsIm1vYnlwaWN0dXJlLmNvbSIsIm1vYnpvLm5ldCIsIm1vY2tibG9jay5jb20iLCJtb2NvdmlkZW8uanAiLCJtb2RlbC10b2t5by5jb20iLCJtb2RlbGxzLmNvbSIsIm1vZS5nb3YubXkiLCJtb2VnaXJsLm9yZyIsIm1vZm9zZXguY29tIiwibW9pbnMtZGVwZW5zZXIuY29tIiwibW9qYW5nLmNvbSIsIm1vamltLmNvbSIsIm1vanR2LmhyIiwibW9rYTkuY29tIiwibW9saWh1YS5vcmciLCJtb21teXNsaXR0bGVjb3JuZXIuY29tIiwibW9tby1kLmpwIiwibW9tb24tZ2EuY29tIiwibW9tc2V4Y2xpcHouY29tIiwibW9tdHViZWNsaXB6LmNvbSIsIm1vbmRlYmFycmFzLmZyIiwibW9uZGVtcDMuY29tIiwibW9uZXktbGluay5jb20udHciLCJtb25leW1ha2VyZ3JvdXAuY29tIiwibW9uZ29kYi5vcmciLCJtb25pdG9yaW52ZXN0LnJ1IiwibW9ub3ByaXguZnIiLCJtb25zdGVycHJveHkuaW5mbyIsIm1vbnRyZWFsZ2F6ZXR0ZS5jb20iLCJtb29vLmNvbSIsIm1vb3NlamF3LmNvbSIsIm1vcmJlbGwuY29tIiwibW9yaWEuY28ubnoiLCJtb3JuaW5nc3Rhci5jb20iLCJtb3JwaGl1bS5pbmZvIiwibW9zdGZhc3Rwcm94eS5vcmciLCJtb3RocWZhbi5jb20iLCJtb3RvLml0IiwibW90b3I0aWsucnUiLCJtb3RvcmlvbmxpbmUuY29tIiwibW90b3JsaWZlLml0IiwibW91c2VicmVha2VyLmNvbSIsIm1vdmllLWphbXJvY2suY29tIiwibW92aWUya3Byb3h5LmNvbSIsIm1vdmllNGsudG8iLCJtb3ZpZTRrcHJveHkuY29tIiwibW92aWU4ay50byIsIm1vdmllZ2FsbGVyaS5uZXQiLCJtb3ZpZXRpdGFuLmNvbSIsIm1vdmlzdGFyLmNvbS52ZSIsIm1venR3Lm9yZyIsIm1wM2J1c2NhZG9yLmNvbSIsIm1wM2RheXMuY29tIiwibXAzanVpY2VzLmNvbSIsIm1wM29rYXkuY29tIiwibXAzcXUuY29tIiwibXAzcmhpbm8uY29tIiwibXAzc2t1bGwuY29tIiwibXAzc2t1bGwudHYiLCJtcDNzdHJhbmEuY29tIiwibXAzdHJ1Y2submV0IiwibXAzeWUuZXUiLCJtcDRtb3ZpZXMuaW5mbyIsIm1wZ2dhbGF4eS5jb20iLCJtcGluZXdzLmNvbSIsIm1wcm5ld3Mub3JnIiwibXI3LnJ1IiwibXJicm93c2VyLmNvbSIsIm1yZ3JlZW4uY29tIiwibXJzdGlmZi5jb20iLCJtcnVuYmxvY2suY29tIiwibXM4ODEuY29tIiwibXNndWFuY2hhLmNvbSIsIm1zaGNkbi5jb20iLCJtc2kuY29tIiwibXNrLnN1IiwibXNuLmNvbSIsIm1zbi5jb20udHciLCJtdC5nb3YuYnIiLCJtdG0ub3IuanAiLCJtdG5sZGVsaGkuaW4iLCJtdHZhdi5jb20iLCJtdWFiYW4ubmV0IiwibXVhbWJhdG9yLmNvbS5iciIsIm11Y2hvc3Vja28uY29tIiwibXVsaGFrLmNvbSIsIm11bGx2YWQubmV0IiwibXVsdGlwcm94eS5vcmciLCJtdWx0aXVwbG9hZC5jb20iLCJtdW1teXNnb2xkLmNvbSIsIm11bmRvZGVzY29ub2NpZG8uZXMiLCJtdW5kb2RvbWFya2V0aW5nLmNvbS5iciIsIm11bmRvc2V4YW51bmNpby5jb20iLCJtdW5kb3Rvcm8uY29tIiwibXVybXVyLnR3IiwibXVyeW91YXYubmV0IiwibXVzZWxpbmtzLmNvLmpwIiwibXVzaWNhZGUubmV0IiwibXVzaWN0aW1lcy5jb20iLCJtdXNpY3ZpZGVvbXAzLmNvbSIsIm11c2lrLXZpZGVvcy5kayIsIm11c2ltdW5kby5jb20iLCJtdXNsaW12aWRlby5jb20iLCJtdXNsbS5vcmciLCJtdXN0YXFpbS5uZXQiLCJtdXpvZm9uLmNvbSIsIm13b2xrLmNvbSIsIm15LWZvcm1vc2EuY29tIiwibXktcGVyc29uYWx0cmFpbmVyLml0IiwibXktcHJpdmF0ZS1uZXR3b3JrLmNvLnVrIiwibXktcHJveHkuY29tIiwibXkxdHViZS5jb20iLCJteTN4eHguY29tIiwibXk5MDMuY29tIiwibXlhcHAuY29tLnR3IiwibXlhdWRpb2Nhc3QuY29tIiwibXlhdi5jb20udHciLCJteWJhYnlwcm94LmluZm8iLCJteWJkc212aWRlb3MubmV0IiwibXliZXN0dnBuLmNvbSIsIm15YmV0LmNvbSIsIm15YmxvZy5pdCIsIm15Ym5iLnR3IiwibXljYTE2OC5jb20iLCJteWNoYXQudG8iLCJteWNoaW5hbXlob21lLmNvbSIsIm15Y2hpbmFuZXdzLmNvbSIsIm15Y2xvdWQuaWR2LnR3IiwibXljb3VsZC5jb20iLCJteWRhdGkuY29tIiwibXlkbGluay5jb20iLCJteWVhc3l0di5jb20iLCJteWV4LmNvbSIsIm15ZmFzaGlvbmp1aWNlLmNvbSIsIm15Zm9ydW0uY29tLmhrIiwibXlmb3J1bS5jb20udWsiLCJteWZyZWVwYXlzaXRlLmNvbSIsIm15ZnJlc2huZXQuY29tIiwibXloYXJkcGhvdG9zLnR2IiwibXloaGcub3JnIiwibXlob3RzaXRlLm5ldCIsIm15aXAubXMiLCJteWlwLm5ldCIsIm15aXBoaWRlLmNvbSIsIm15bGl0dGxlYmxvZ3Nwb3QuY29tIiwibXltYWlsc3J2ci5jb20iLCJteW1hamkuY29tIiwibXltb3ZpZXMuaXQiLCJteXBhZ2VyYW5rLm5ldCIsIm15cGFzcy5kZSIsIm15cHJveHlzaXRlLm9yZyIsIm15cmVjaXBlcy5jb20iLCJteXJldHJvdHViZS5jb20iLCJteXNpbmFibG9nLmNvbSIsIm15c29mdC5pZHYudHciLCJteXNwYWNlLmNvbSIsIm15c3BhY2V1bmJsb2NraXQuY29tIiwibXlzcGFjZXVubG9jay5jb20iLCJteXRlZGF0YS5uZXQiLCJteXZpZG8xLmNvbSIsIm15dm5jLmNvbSIsIm15dm91Y2hlcmNvZGVzLmNvLnVrIiwibXl3ZWJwcm94eS5hc2lhIiwibXl3ZWJzZWFyY2guY29tIiwibXl3ZW5keXNtdXNpYy5jb20iLCJtei13ZWIuZGUiLCJuYWJibGUuY29tIiwibmFpbGlkZWFzaXRlLmNvbSIsIm5hamxlcHN6ZS5uZXQiLCJuYWtlZC1udWRlLmNvbSIsIm5ha2lkby5jb20iLCJuYWx1b25lLmJpeiIsIm5hbHVvbmUubmV0IiwibmFtZXN0YXRpb24uY29tIiwibmFub3Byb3h5LmNvbSIsIm5hbm9wcm94eS5kZSIsIm5hbnlhbmcuY29tIiwibmFueWFuZy5jb20ubXkiLCJuYW55YW5ncG9zdC5jb20iLCJuYW56YW8uY29tIiwibmFvbC5jYSIsIm5hcmltYW4ubWUiLCJuYXQuZ292LnR3IiwibmF0YWRvLmNvbSIsIm5hdGlvbndpZGUuY29tIiwibmF0bGNvbnNlcnZhdGl2ZS5jb20iLCJuYXR1cmFsbHljdXJseS5jb20iLCJuYXR1cmUuY29tIiwibmF1Z2h0eWFtZXJpY2EuY29tIiwibmF1Z2h0eXR1YmUubmV0IiwibmF5YXJpdGVubGluZWEubXgiLCJuYmN3YXNoaW5ndG9uLmNvbSIsIm5jY3dhdGNoLm9yZy50dyIsIm5jaC5jb20udHciLCJuY24ub3JnIiwibmNvbC5jb20iLCJuZHIuZGUiLCJuZWNjbGFzc2ljbW90b3JzaG93LmNvbSIsIm5lZC5vcmciLCJuZWRpeW9yLmNvbSIsIm5laWdoYm9yaG9vZHIuY29tIiwibmVpeGlvbmcuY29tIiwibmVsbmV0Lm5ldCIsIm5lb2xlZS5jbiIsIm5lcmZub3cuY29tIiwibmV0LWEtcG9ydGVyLmNvbSIsIm5ldC5ociIsIm5ldGJpcmRzLmNvbSIsIm5ldGZpcm1zLmNvbSIsIm5ldGhlcmxhbmRzYmF5LmluZm8iLCJuZXRpLmVlIiwibmV0bG9nLmNvbSIsIm5ldHNwZW5kLmNvbSIsIm5ldHdvcms1NC5jb20iLCJuZXR3b3JrZWRibG9ncy5jb20iLCJuZXR3b3Jrdmlldy5ydSIsIm5ldHhlZS5jb20iLCJuZXV0cm9nZW5hLmNvbSIsIm5ldmVybnVtYi5jb20iLCJuZXctYWtpYmEuY29tIiwibmV3LXhoYW1zdGVyLmNvbSIsIm5ld2NlbnR1cnltYy5jb20iLCJuZXdjaGVuLmNvbSIsIm5ld2ZyZWVwcm94eS5jb20iLCJuZXdncm91bmRzLmNvbSIsIm5ld2lwbm93LmNvbSIsIm5ld2thbGluaW5ncmFkLnJ1IiwibmV3bGFuZG1hZ2F6aW5lLmNvbS5hdSIsIm5ld25ld3MuY2EiLCJuZXdwcm94eS5wdyIsIm5ld3Byb3h5bGlzdC5uZXQiLCJuZXdyaWNobW9uZC1uZXdzLmNvbSIsIm5ld3MtbWVkaWNhbC5uZXQiLCJuZXdzLXhoYW1zdGVyLmNvbSIsIm5ld3MuYXQiLCJuZXdzMS5rciIsIm5ld3MxMDAuY29tLnR3IiwibmV3czI0Ny5nciIsIm5ld3M0YW5kaHJhLmNvbSIsIm5ld3M0amF4LmNvbSIsIm5ld3M1MjAuaWR2LnR3IiwibmV3c2FuY2FpLmNvbSIsIm5ld3NhcmFtYS5jb20iLCJuZXdzY24ub3JnIiwibmV3c2RoLmNvbSIsIm5ld3NleHR1YmUub3JnIiwibmV3c2l0LmdyIiwibmV3c2l0ZXByb3h5LmluZm8iLCJuZXdzcGVhay5jYyIsIm5ld3NwaWNrdXAuY29tIiwibmV3c3IuaW4iLCJuZXdzdGFwYS5vcmciLCJuZXdzdGFybmV0LmNvbSIsIm5ld3N0dWJlLnJ1IiwibmV3c3R3aXR0ZXIuY29tIiwibmV3dGFpd2FuLmNvbS50dyIsIm5ld3RhbGsudHciLCJuZXd1bmJsb2NrZXIuY29tIiwibmV4dDExLmNvLmpwIiwibmV4dGNvbnRlbnQucGwiLCJuZXh0bWVkaWEuY29tIiwibmV4dHR2LmNvbS50dyIsIm5mLmlkLmF1IiwibmZ4eG9vLmNvbSIsIm5oYWNzby5uZXQiLCJuaHJhLmNvbSIsIm5pY2V5b3VuZ3RlZW5zLmNvbSIsIm5pY2hlZ2Fsei5jb20iLCJuaWNvdHdpdHRlci5jb20iLCJuaWNvdmlkZW8uanAiLCJuaWNvdmlld2VyLm5ldCIsIm5pZWR6aWVsYS5ubCIsIm5pZ2h0d2Fsa2VyLmNvLmpwIiwibmlrb24uY29tIiwibmlsb25nZGFvLmNvbS5jbiIsIm5pbWVuaHV1dG8uY29tIiwibmluZy5jb20iLCJuaW5pc2l0ZS5jb20iLCJuaW5qYWJyb3dzZS5jb20iLCJuaW5qYWNsb2FrLmNhIiwibmluamFjbG9ha3Byb3h5LmNvbSIsIm5pbmphcHJveHkuZXUiLCJuaW5qYXByb3h5LmluZm8iLCJuaW5raXBhbC5jb20iLCJuam1hcS5jb20iLCJua29uZ2ppYW4uY29tIiwibmxiLnNpIiwibmxmcmVldnBuLmNvbSIsIm5sb2cuY2MiLCJubHByb3h5LnJ1Iiwibm4tbnltcGhldHMuY29tIiwibm4yMDE0LmNvbSIsIm5ubGlhbi5pbmZvIiwibm5wcm94eS5wdyIsIm5vLWlwLm9yZyIsIm5vYmxlY2FzaW5vLmNvbSIsIm5vZGVzbm9vcC5jb20iLCJub2wuaHUiLCJub2xhZ3MucGwiLCJub21pbmV0Lm9yZy51ayIsIm5vbW9yZXJhY2suY29tIiwibm9udG9uODguY29tIiwibm9vei5nciIsIm5vcmF1dG8uZnIiLCJub3Jkc3Ryb20uY29tIiwibm9ydGhhbWVyaWNhbnByb3h5LmNvbSIsIm5vcnRoamVyc2V5LmNvbSIsIm5vcndheWJheS5pbmZvIiwibm9zdHJpbmdzYXR0YWNoZWQuY29tIiwibm90YWVscGFpcy5jb20iLCJub3RpY2VzLXBkZi5jb20iLCJub3RpY2lhcy5pbmZvIiwibm90aWNpYXNyY24uY29tIiwibm90aWNpZXJvZGlnaXRhbC5jb20iLCJub3RqdXN0b2suY29tIiwibm90dGluZ2hhbXBvc3QuY29tIiwibm92ZWRhZGVzZmFjZWJvb2suY29tIiwibm92ZWxhc2ZhY2Vib29rLmNvbSIsIm5vdm9zdGltaXJhLmJpeiIsIm5vd25ld3MuY29tIiwibm93dG9ycmVudHMuY29tIiwibm95cGYuY29tIiwibnBhLmdvLmpwIiwibnBnLmlkdi50dyIsIm5wcy5nb3YiLCJucW1hLm5ldCIsIm5xdXJhbi5jb20iLCJucmsubm8iLCJuc2MuZ292LnR3IiwibnNmd3lvdXR1YmUuY29tIiwibnN0YXJpa292LnJ1IiwibnN0ZWVucy5vcmciLCJudGQudHYiLCJudGR0di5jb20iLCJudGR0di5qcCIsIm50ZHR2LnJ1IiwibnRkdHZsYS5jb20iLCJudHUuZWR1LnR3IiwibnUubmwiLCJudWJlbG8uY29tIiwibnViaWxlcy5uZXQiLCJudWJpbGV3b3JsZC5jb20iLCJudWRlLmh1IiwibnVkZWdpcmxzLnBybyIsIm51ZGV0dWJlLmNvbSIsIm51ZG9ncmFwaHkuY29tIiwibnVvdm9tZWdhdmlkZW8uY29tIiwibnV0ZWNoLm5sIiwibnV2aWQuY29tIiwibnZpZXdlci5tb2JpIiwibnd6b25saW5lLmRlIiwibnljZ28uY29tIiwibnlkdXMuY2EiLCJueWthYS5jb20iLCJueWxvbi1hbmdlbC5jb20iLCJueWxvbnN0b2NraW5nc29ubGluZS5jb20iLCJueXRpbWVzLmNvbSIsIm55eGNvc21ldGljcy5mciIsIm56Y2hpbmVzZS5uZXQubnoiLCJvMnlvdXR1YmUuY29tIiwibzRlLnB3Iiwib2F1dGgubmV0Iiwib2Jlcm9uLW1lZGlhLmNvbSIsIm9idXR1LmNvbSIsIm9jYXNwcm8uY29tIiwib2NjdXBpZXIuaGsiLCJvY2tzLm9yZyIsIm9jbHAuaGsiLCJvY3JlYW1waWVzLmNvbSIsIm9jdGFuZS50diIsIm9jdGFuZXZwbi5jb20iLCJvY3dlZWtseS5jb20iLCJvY3dlbmN1c3RvbWVycy5jb20iLCJvZWtlci5uZXQiLCJvZW0uY29tLm14Iiwib2ZmaWNlaG9sZGluZ3MuY28udWsiLCJvZmZpY2VyLmNvbSIsIm9mZnNob3JlaXAuY29tIiwib2dhb2dhLm9yZyIsIm9nZ2xpc3QuY29tIiwib2dsYWYuY29tIiwib2dsaS5vcmciLCJvZ3AubWUiLCJvaGl4LmNvbSIsIm9oamFwYW5wb3JuLmNvbSIsIm9odHVsZWh0LmVlIiwib2lrb3MuY29tLnR3Iiwib2lrdHYuY29tIiwib2s4NjY2LmNvbSIsIm9rYXlmcmVlZG9tLmNvbSIsIm9rZXpvbmUuY29tIiwib2tpcC5pbmZvIiwib2trLnR3Iiwib2ttYWxsLnR3Iiwib2twcm94eS5jb20iLCJvbGRlci1iZWF1dHkuY29tIiwib2xkaS5ydSIsIm9sZHh5LmluZm8iLCJvbGl2ZW9pbHNlZ2EuaWR2LnR3Iiwib2xvYmxvZ2dlci5jb20iLCJvbHguY29tLm5nIiwib2x4LmNvbS5zdiIsIm9seW1waWN3YXRjaC5vcmciLCJvbS5uZXQiLCJvbWFwYXNzLmNvbSIsIm9tZWdhd2F0Y2hlcy5jb20udHciLCJvbWd1YnVudHUuY28udWsiLCJvbWxvZ2lzdGljcy5jby5pbiIsIm9tbml0YWxrLmNvbSIsIm9tcm9lcGJyYWJhbnQubmwiLCJvbXNrLnN1Iiwib215LnNnIiwib24uY2MiLCJvbmVnYXlkYWRkeS5jb20iLCJvbmVnby5ydSIsIm9uZW1lZGljYWwuY29tIiwib25lbmV3c3BhZ2UuY29tIiwib25lcGllY2VvZmJsZWFjaC5jb20iLCJvbmV5b3V0dWJlLmNvbSIsIm9uaW5zdGFncmFtLmNvbSIsIm9uaXNlcC5mciIsIm9uanVpY2UuY29tIiwib25saW5lLWFub255bWl6ZXIuY29tIiwib25saW5lLWNhc2luby5kZSIsIm9ubGluZWFjY2VzczEuY29tIiwib25saW5lYW5kaHJhYmFuay5uZXQuaW4iLCJvbmxpbmVhbm9ueW1pemVyLmNvbSIsIm9ubGluZWJhbmsuY29tIiwib25saW5lYml6Z3VpZGUubmV0Iiwib25saW5lY2hhLmNvbSIsIm9ubGluZWZpbG14LnJ1Iiwib25saW5laW5zdGFncmFtLmNvbSIsIm9ubGluZWlwY2hhbmdlci5jb20iLCJvbmxpbmVtYXR1cmV0dWJlLmNvbSIsIm9ubGluZW1lZGlhZ3JvdXBsbGMuY29tIiwib25saW5lcHJveHkuY28udWsiLCJvbmxpbmVwcm94eS51cyIsIm9ubGluZXByb3h5ZnJlZS5jb20iLCJvbmxpbmV2aWRlb2NvbnZlcnRlci5jb20iLCJvbmxpbmV5b3V0dWJlLmNvbSIsIm9ubHktbS15b3V0dWJlLmNvbSIsIm9ubHliZXN0c2V4LmNvbSIsIm9ubHlqaXp6LmNvbSIsIm9ubHlsYWR5LmNuIiwib25tb29uLmNvbSIsIm9uczIyLmNvbSIsIm9uczk2LmNvbSIsIm9udGhlaHVudC5jb20iLCJvbnRyYWMuY29tIiwib28uY29tLmF1Iiwib29vLXNleC5jb20iLCJvb3BzZm9ydW0uY29tIiwib3AuZmkiLCJvcGVsLmRlIiwib3Blbi13ZWJzaXRlcy51cyIsIm9wZW4uY29tLmhrIiwib3BlbmRlbW9jcmFjeS5uZXQiLCJvcGVuZG9vcnMubmwiLCJvcGVuZG9vcnMub3JnLmF1Iiwib3Blbmlua3BvdC5vcmciLCJvcGVubGVha3Mub3JnIiwib3Blbm5ldC5ydSIsIm9wZW5wcm94eS5jby51ayIsIm9wZW5yaWNlLmNvbSIsIm9wZW5zdXJmLmluZm8iLCJvcGVudGhpcy5wbCIsIm9wZW52cG4ubmV0Iiwib3BlcmEtbWluaS5uZXQiLCJvcGVyYS5jb20iLCJvcG0uZ292Iiwib3B0bWQuY29tIiwib3B2aWV3LmNvbS50dyIsIm9yYWt1bC51YSIsIm9yYW5nZS5jb20iLCJvcmFuZ2VzLmlkdi50dyIsIm9yY2hpZGJicy5jb20iLCJvcmdhc20uY29tIiwib3JnZnJlZS5jb20iLCJvcmllbnRhbGJ1dHRzLmNvbSIsIm9yaWVudGFsZGFpbHkuY29tLm15Iiwib3JrdXQuY29tIiwib3JveHkuY29tIiwib3J6ZHJlYW0uY29tIiwib3Npa2tvLmpwIiwib3N5YW4ubmV0Iiwib3RubmV0d29yay5uZXQiLCJvdWVzdC1mcmFuY2UuZnIiLCJvdWxvdmUub3JnIiwib3VwLmNvbSIsIm91ci10d2l0dGVyLmNvbSIsIm91cmdhbWVzLnJ1Iiwib3VycHJveHkub3JnIiwib3Vyc29nby5jb20iLCJvdXJzdGVwcy5jb20uYXUiLCJvdXRkb29ybGlmZS5jb20iLCJvdXRlcmxhbmRzc2YuY29tIiwib3V2YWxhbGdlcmllLmNvbSIsIm92YWNpb25kaWdpdGFsLmNvbS51eSIsIm92ZXItYmxvZy5jb20iLCJvdmVyLXRpbWUuaWR2LnR3Iiwib3ZlcmNsb2NrZXJzLmNvbS5hdSIsIm92ZXJwbGF5Lm5ldCIsIm92aC5pZSIsIm92aC5pdCIsIm92aS5jb20iLCJvdy5seSIsIm93aW5kLmNvbSIsIm94aWNhbXMuY29tIiwib3lheC5jb20iLCJveW90YS5uZXQiLCJvemNoaW5lc2UuY29tIiwib3p5b3lvLmNvbSIsInAxMnAuY29tIiwicGFjaWZpY3Bva2VyLmNvbSIsInBhY2tldGl4Lm5ldCIsInBhZ2UycnNzLmNvbSIsInBhZ2V1cHBlb3BsZS5jb20iLCJwYWdpbmVnaWFsbGUuaXQiLCJwYWlkMnR3aXR0ZXIuY29tIiwicGFpZDJ5b3V0dWJlLmNvbSIsInBhaW5sZXNzLmlkdi50dyIsInBhaXBhbi1nYXpvLmNvbSIsInBhaXNkZWxvc2p1ZWdvcy5lcyIsInBha2ZhY2Vib29rLmNvbSIsInBha2lzdGFuLnR2IiwicGFraXN0YW5qb2JzYmFuay5jb20iLCJwYWtpc3RhbnByb3h5LmNvbSIsInBha3Byb3h5LmNvbSIsInBhbGFjZW1vb24uY29tIiwicGFsYWt1YW4ub3JnIiwicGFsZXJtby5lZHUiLCJwYWxtLmNvbSIsInBhbG1pc2xpZmUuY29tIiwicGFuZG9yYS50diIsInBhbmRvcmF2b3RlLm5ldCIsInBhbmx1YW4ubmV0IiwicGFub3JhbWlvLmNvbSIsInBhbnR5Zml4YXRpb24uY29tIiwicGFvLXBhby5uZXQiLCJwYW83Ny5jb20iLCJwYXAuZnIiLCJwYXBhcHJveHkuY29tIiwicGFwYXByb3h5Lm5ldCIsInBhcGVybGVzc3Bvc3QuY29tIiwicGFwZXJzaXplcy5vcmciLCJwYXB5LmNvLmpwIiwicGFyYWRlLmNvbSIsInBhcmNlbGZvcmNlLmNvbSIsInBhcnNpdGViLmNvbSIsInBhcnRuZXJjYXNoLmNvbSIsInBhcnR5Y2FzaW5vLmNvbSIsInBhcnR5cG9rZXIuY29tIiwicGFzc2Fkb3Byb3h5LmNvbSIsInBhc3Npb24uY29tIiwicGFzc2lvbmZydWl0YWRzLmNvbSIsInBhc3Npb250aW1lcy5oayIsInBhc3NrZXkuY29tIiwicGFzdGViaW4uY29tIiwicGFzdGllLm9yZyIsInBhdGguY29tIiwicGF0aGVvcy5jb20iLCJwYXRodG9zaGFyZXBvaW50LmNvbSIsInBhdHJpa2EuY29tIiwicGF2aWV0bmFtLnZuIiwicGF5LWNsaWNrLnJ1IiwicGF5cGFsLmNvbSIsInBheXBhbG9iamVjdHMuY29tIiwicGF5cm9sbGFwcDIuY29tIiwicGJhc2UuY29tIiwicGJ4ZXMuY29tIiwicGNhbmFseXNpcy5uZXQiLCJwY2FwcHNwb3QuY29tIiwicGNkaXNjdXNzLmNvbSIsInBjZHZkLmNvbS50dyIsInBjaG9tZS5jb20udHciLCJwY2lqLm9yZyIsInBjbmV0Lmlkdi50dyIsInBjcmV2aWV3LmNvLnVrIiwicGNzYy5jb20udHciLCJwY3NvLmdvdi5waCIsInBjdC5vcmcudHciLCJwY3ZlY3Rvci5uZXQiLCJwZGYyanBnLm5ldCIsInBkcHJveHkuY29tIiwicGVhY2VmaXJlLm9yZyIsInBlYWNlaGFsbC5jb20iLCJwZWFrZGlzY291bnRtYXR0cmVzcy5jb20iLCJwZWFyc29uY21nLmNvbSIsInBlZGlkb3N5YS5jb20uYXIiLCJwZWVhc2lhbi5jb20iLCJwZWVwaW5nLWhvbGVzLmNvbSIsInBlZXJwcm94eS5jb20iLCJwZWp3YW55b3V0dWJlLmNvbSIsInBla2luZ2R1Y2sub3JnIiwicGVsaWN1bGFzMjEuY29tIiwicGVsaWN1bGFzZGV5b3V0dWJlLmNvbSIsInBlbGljdWxhc2VueW91dHViZS5jb20iLCJwZWxpY3VsYXNsYW5kLmNvbSIsInBlbGlzbWFzZXJpZXMuY29tIiwicGVuY2hpbmVzZS5uZXQiLCJwZW5jaGluZXNlLm9yZyIsInBlbmlzYm90LmNvbSIsInBlbnRhbG9naWMubmV0IiwicGVudGhvdXNlLmNvbSIsInBlbnRob3VzZWJhYmVzd29ybGQuY29tIiwicGVvcGxlLmJnIiwicGVvcGxlbmV3cy50dyIsInBlb3BsZXBldHMuY29tIiwicGVvcG8ub3JnIiwicGVyY3kuaW4iLCJwZXJmZWN0Z2lybHMubmV0IiwicGVyZmVjdHZwbi5uZXQiLCJwZXJmZWt0ZWdpcmxzLmNvbSIsInBlcmZzcG90LmNvbSIsInBlcm5pYXNwb3B1cHNob3AuY29tIiwicGVyc2VjdXRpb24ubmV0IiwicGVyc2lhbmZhY2Vib29rLmNvbSIsInBlcnNpYW5raXR0eS5jb20iLCJwZXQwMS50dyIsInBldGFyZGFzLXlvdXR1YmUuY29tIiwicGV0YXJkYXNoZC5jb20iLCJwZXRmaWxtLmJpeiIsInBld2hpc3BhbmljLm9yZyIsInBld2ludGVybmV0Lm9yZyIsInBld3Jlc2VhcmNoLm9yZyIsInBld3NvY2lhbHRyZW5kcy5vcmciLCJwaDE1OC5jb20iLCJwaDg0Lmlkdi50dyIsInBoYW5kYW5na2hvYS5jb20iLCJwaGFwbHVhbi5vcmciLCJwaGF0b2dyYXBoLmNvbSIsInBoYXl1bC5jb20iLCJwaGljYS5uZXQiLCJwaGlsbHluZXdzLmNvbSIsInBoaW0xOS5jb20iLCJwaGltc2V4dmlwLmNvbSIsInBoaW12aWRlby5vcmciLCJwaG9uZWdhcC5jb20iLCJwaG90aHV0YXcuY29tIiwicGhvdG8tYWtzLmNvbSIsInBob3RvYm94LmNvbSIsInBob3RvZ2Fscy5jb20iLCJwaHAtcHJveHkubmV0IiwicGhwNS5pZHYudHciLCJwaHBiaWRvLmNvbSIsInBocG15cHJveHkuY29tIiwicGhyZWUtcG9ybi5jb20iLCJwaHl3b3JsZC5pZHYudHciLCJwaWIyNC5jb20iLCJwaWNkbi5uZXQiLCJwaWNpZGFlLm5ldCIsInBpY3NtYXN0ZXIubmV0IiwicGlkb3duLmNvbSIsInBpZWNlc2V0cG5ldXMuY29tIiwicGlnbi5uZXQiLCJwaWtjaHVyLmNvbSIsInBpbGlvLmlkdi50dyIsInBpbG90bW9vbi5jb20iLCJwaW1wdHViZWQuY29tIiwicGluNi5jb20iLCJwaW5nLmZtIiwicGluZ3Rlc3QubmV0IiwicGlucHJveHkuY29tIiwicGludGFuZy5pbmZvIiwicGludXBmaWxlcy5jb20iLCJwaXBlcG9ybnR1YmUuY29tIiwicGlwZXByb3h5LmNvbSIsInBpcG9zYXkuY29tIiwicGlwcGl0cy5jb20iLCJwaXJhYXR0aWxhaHRpLm9yZyIsInBpcmF0Yml0Lm5ldCIsInBpcmF0ZWJheXByb3h5LmNvIiwicGlyYXRlYmF5cHJveHkuY28udWsiLCJwaXJhdGVicm93c2VyLmNvbSIsInBpcmF0ZXJmYWNlYm9vay5jb20iLCJwaXJlbGxpLmNvbSIsInBpcmluZy5jb20iLCJwaXNzLmpwIiwicGl4YXJ0cHJpbnRpbmcuZXMiLCJwaXhlbGJ1ZGRoYS5uZXQiLCJwaXhuZXQuaW4iLCJwaXhuZXQubmV0IiwicGsuY29tIiwicGtvYnAucGwiLCJwa3NwaXl1bmdhbi5vcmciLCJwa3QucGwiLCJwbGFuZXRzdXp5Lm9yZyIsInBsYW5wcm94eS5jb20iLCJwbGFudC1zZWVkcy5pZHYudHciLCJwbGFudGlsbGFzLWJsb2dnZXItYmxvZ3Nwb3QuY29tIiwicGxhdGludW1oaWRlaXAuY29tIiwicGxhdHVtLmtyIiwicGxheWJveS5ybyIsInBsYXllci5wbCIsInBsYXlmb3JjZW9uZS5jb20iLCJwbGF5ZnVuLm1vYmkiLCJwbGF5bG92ZXIubmV0IiwicGxheXMuY29tLnR3IiwicGxpYWdlLXNlcnZpZXR0ZS1wYXBpZXIuaW5mbyIsInBsaXhpLmNvbSIsInBsbS5vcmcuaGsiLCJwbG5kci5jb20iLCJwbHVuZGVyLmNvbSIsInBsdXJrLmNvbSIsInBsdXMuZXMiLCJwbHVzMjguY29tIiwicGx1c2JiLmNvbSIsInBtYXRlcy5jb20iLCJwbWkuaXQiLCJwb2NrZXRjYWxjdWxhdG9yc2hvdy5jb20iLCJwb2RjYXN0Ymxhc3Rlci5jb20iLCJwb2dsZWRhai5uYW1lIiwicG9pbnQubWQiLCJwb2ludGJsYW5rLnJ1IiwicG9pbnRzdHJlYWsuY29tIiwicG9rZXJzdGFycy5jb20iLCJwb2tlcnN0YXJzLmV1IiwicG9rZXJzdGFycy5uZXQiLCJwb2tlcnN0cmF0ZWd5LmNvbSIsInBvbGljamEuZ292LnBsIiwicG9saXRpY28uY29tIiwicG9saXRvYnpvci5uZXQiLCJwb2xsc3Rhci5jb20iLCJwb2x5Z2FtaWEucGwiLCJwb2x5c29sdmUuY29tIiwicG9vbmZhcm0uY29tIiwicG9wbmV4c3VzLmNvbSIsInBvcHBob3RvLmNvbSIsInBvcHVsYXIteW91dHViZS5jb20iLCJwb3B5YXJkLmNvbSIsInBvcHlhcmQub3JnIiwicG9ybi14aGFtc3Rlci5jb20iLCJwb3JuLmNvbSIsInBvcm4yLmNvbSIsInBvcm45OS5uZXQiLCJwb3JuYmFzZS5vcmciLCJwb3JuZXJicm9zLmNvbSIsInBvcm5ob21lLmNvbSIsInBvcm5ob3N0LmNvbSIsInBvcm5odWIuY29tIiwicG9ybmh1Yi5jb20uYnoiLCJwb3JuaHVia2luZy5jb20iLCJwb3JuaHVibGl2ZS5jb20iLCJwb3JuaWNvbS5jb20iLCJwb3Jub3NoYXJhLnR2IiwicG9ybm94by5jb20iLCJwb3JucGluLmNvbSIsInBvcm5wbGF5cy5jb20iLCJwb3JucmFwaWRzaGFyZS5jb20iLCJwb3Juc3RhcmNsdWIuY29tIiwicG9ybnRpdGFuLmNvbSIsInBvcm50dWJlLmNvbSIsInBvcm50dWJlbmV3cy5jb20iLCJwb3JudHViZXhoYW1zdGVyLmNvbSIsInBvcm52aXNpdC5jb20iLCJwb3JzaC5pZHYudHciLCJwb3J0YWRhc3BhcmFmYWNlYm9vay5jb20iLCJwb3J0b3NlZ3Vyby5jb20uYnIiLCJwb3NiLmNvbS5zZyIsInBvc2VzdGFjaW8uY29tLmJyIiwicG9zdDg1Mi5jb20iLCJwb3N0YWRzMjQuY29tIiwicG9zdGVyb3VzLmNvbSIsInBvc3Rtb25leS5jb20uYnIiLCJwb3N0b25mYWNlYm9vay5jb20iLCJwb3VycXVvaWRvY3RldXIuZnIiLCJwb3Zwbi5vcmciLCJwb3ZwbjMuY29tIiwicG93ZXIuY29tIiwicG93ZXJtYXBwZXIuY29tIiwicG93ZXJwb2ludG5pbmphLmNvbSIsInBveHkucGwiLCJwb3puYXZhdGVsbm9lLnR2IiwicHBwcm94eS5wdyIsInBwc3Mua3IiLCJwci5nb3YiLCJwcmFiaGF0a2hhYmFyLmNvbSIsInByYXNpbmFuZWEuZ3IiLCJwcmF2ZGEucnUiLCJwcmVkcHJpZW1hY2guY29tIiwicHJlc2lkZW50LmF6IiwicHJlc2lkZW50Lmdvdi50dyIsInByZXN0aWdlY2FzaW5vLmNvbSIsInByZXR0eXZpcmdpbi5jb20iLCJwcmV0dHl3aWZlcy5jb20iLCJwcmV2aWRlbmNpYS5nb3YuYnIiLCJwcmljZS51YSIsInByaWNlbWluaXN0ZXIuY29tIiwicHJpY2V0cmF2ZWwuY29tLm14IiwicHJpZGVzaXRlcy5jb20iLCJwcmltZXBvcm50dWJlLmNvbSIsInByaW1pY2lhLmNvbS52ZSIsInByaXNvbmVyYWxlcnQuY29tIiwicHJpdHVubC5jb20iLCJwcml2YXRlLWludGVybmV0LmluZm8iLCJwcml2YXRlYnJvd3NpbmcuaW5mbyIsInByaXZhdGVpbnRlcm5ldGFjY2Vzcy5jb20iLCJwcml2YXRlcHJveHlyZXZpZXdzLmNvbSIsInByaXZhdGVzZXJ2ZXIubnUiLCJwcml2YXRldHVubmVsLmNvbSIsInByaXZhdGV2b3lldXIuY29tIiwicHJpdmF0ZXZwbi5jb20iLCJwcm8tNHUuY29tIiwicHJvLXVuYmxvY2suY29tIiwicHJvY2Vzby5jb20ubXgiLCJwcm9maXR0YXNrLmNvbSIsInByb2dyYW1hc3R3aXR0ZXIuY29tIiwicHJvZ3JhbW1hYmxld2ViLmNvbSIsInByb2plY3RoLnVzIiwicHJva3NpYWsucGwiLCJwcm9rc3lmcmVlLmNvbSIsInByb2xpbmsucGwiLCJwcm9tLnVhIiwicHJvbW9wcm8uY29tIiwicHJvbi5jb20iLCJwcm9ub3N0aWNvcy5nb2IubXgiLCJwcm9wcm94eS5tZSIsInByb3NwZXIuY29tIiwicHJvdGVjdG15aWQuY29tIiwicHJvdGVjdHByb3h5LmNvbSIsInByb3R2LnJvIiwicHJvdW5sb2NrLm9yZyIsInByb3ZlcmJpYS5uZXQiLCJwcm92aWRlb2NvYWxpdGlvbi5jb20iLCJwcm92aW56LmJ6Lml0IiwicHJvd2FuZy5pZHYudHciLCJwcm94LnB3IiwicHJveGF5LmNvLnVrIiwicHJveGVyeS5jb20iLCJwcm94Zmx5LmNvbSIsInByb3hmcmVlLmNvbSIsInByb3hmcmVlLnBrIiwicHJveGlmaWVyLmNvbSIsInByb3hpZnkuY28udWsiLCJwcm94aWZ5LmNvbSIsInByb3hpdGUubWUiLCJwcm94aXRlLm5ldCIsInByb3hpdGUub3JnIiwicHJveGl0ZS51cyIsInByb3hsZXQuY29tIiwicHJveG15YXNzLmNvbSIsInByb3hwbi5jb20iLCJwcm94dGlrLmNvbSIsInByb3h1cmYuY29tIiwicHJveHh5LmNvIiwicHJveHktMjAxNC5jb20iLCJwcm94eS1iZy5jb20iLCJwcm94eS1ieXBhc3MuY29tIiwicHJveHktaXAtbGlzdC5jb20iLCJwcm94eS1vbmxpbmUubmV0IiwicHJveHktcm9tYW5pYS5pbmZvIiwicHJveHktc2VydmVyLmF0IiwicHJveHktc2VydmljZS5jb20uZGUiLCJwcm94eS1zZXJ2aWNlLmRlIiwicHJveHktc2l0ZS5jb20uZGUiLCJwcm94eS10b3AuY29tIiwicHJveHktdW5sb2NrLmNvbSIsInByb3h5LmFsIiwicHJveHkuY29tLmRlIiwicHJveHkub3JnIiwicHJveHkueXQiLCJwcm94eTAxLmNvbSIsInByb3h5MTQuY29tIiwicHJveHkyMDE0Lm5ldCIsInByb3h5NGZyZWUuY2YiLCJwcm94eTRmcmVlLmNvbSIsInByb3h5NGZyZWUucGwiLCJwcm94eTR1c2EuY29tIiwicHJveHk0eW91dHViZS5jb20iLCJwcm94eTR5b3V0dWJlLmluZm8iLCJwcm94eTguYXNpYSIsInByb3h5YW5vbmltby5lcyIsInByb3h5YW5vbnltaXplci5uZXQiLCJwcm94eWFwcC5vcmciLCJwcm94eWJheS5pbmZvIiwicHJveHliYXkubmwiLCJwcm94eWJpZy5uZXQiLCJwcm94eWJpdGNvaW4uY29tIiwicHJveHlib29zdC5uZXQiLCJwcm94eWJyb3dzZS5uZXQiLCJwcm94eWJyb3dzZXIub3JnIiwicHJveHlicm93c2Vyb25saW5lLmNvbSIsInByb3h5YnJvd3NpbmcuY29tIiwicHJveHlidXRsZXIuY28udWsiLCJwcm94eWJ1dHRvbi5jb20iLCJwcm94eWNhYi5jb20iLCJwcm94eWNoaW5hLm5ldCIsInByb3h5Y2x1YmUub3JnIiwicHJveHljbi5jbiIsInByb3h5Y3JpbWUuY29tIiwicHJveHlkYWRhLmNvbSIsInByb3h5ZG9nZy5jb20iLCJwcm94eWZvci5ldSIsInByb3h5Zm9ycGxheS5jb20iLCJwcm94eWZvcnlvdXR1YmUubmV0IiwicHJveHlmb3VuZGVyLmNvbSIsInByb3h5Zm94eS5jb20iLCJwcm94eWZyZWUub3JnIiwicHJveHlnaXpsZW4uY29tIiwicHJveHlnb2dvLmluZm8iLCJwcm94eWd1cnUuaW5mbyIsInByb3h5aGFzaC5pbmZvIiwicHJveHlodWIuZXUiLCJwcm94eWtpY2thc3MuY29tIiwicHJveHlrb3JlYS5pbmZvIiwicHJveHlsaXN0Lm9yZy51ayIsInByb3h5bGlzdC5zZSIsInByb3h5bGlzdGluZy5vcmciLCJwcm94eWxpc3Rwcm8uY28udWsiLCJwcm94eWxpc3Rwcm8uY29tIiwicHJveHlsaXN0cy5tZSIsInByb3h5bGlzdHkuY29tIiwicHJveHltZXNoLmNvbSIsInByb3h5bWV4aWNvLmNvbSIsInByb3h5bXVzLmRlIiwicHJveHlub2lkLmNvbSIsInByb3h5b2suY29tIiwicHJveHlvbmxpbmUucm8iLCJwcm94eW9vLmNvbSIsInByb3h5cGsuY29tIiwicHJveHlwcm9udG8uY29tIiwicHJveHlweS5uZXQiLCJwcm94eXB5Lm9yZyIsInByb3h5cmVnaXN0ZXIuY29tIiwicHJveHlyb2FkLmNvbSIsInByb3h5cy5wdyIsInByb3h5c2FuLmluZm8iLCJwcm94eXNhbmR5LmNvbSIsInByb3h5c2Vuc2F0aW9uLmNvbSIsInByb3h5c2VydmVyLmFzaWEiLCJwcm94eXNlcnZlci5jb20iLCJwcm94eXNlcnZlci5wayIsInByb3h5c2VydmVyLnB3IiwicHJveHlzaGllbGQub3JnIiwicHJveHlzaXRlLmNvbSIsInByb3h5c2l0ZS5wdyIsInByb3h5c2l0ZS53cyIsInByb3h5c2l0ZWxpc3Qub3JnIiwicHJveHlzaXRlcy5jb20iLCJwcm94eXNpdGVzLmluIiwicHJveHlzaXRlcy5uZXQiLCJwcm94eXNpeC5jb20iLCJwcm94eXNtdXJmLm5sIiwicHJveHlzbmVsLm5sIiwicHJveHlzcGFpbi5jb20iLCJwcm94eXNzbHVuYmxvY2tlci5jb20iLCJwcm94eXN0cmVhbWluZy5jb20iLCJwcm94eXN1Ym1pdC5jb20iLCJwcm94eXN1cmZpbmcub3JnIiwicHJveHlzdXJmcy5jb20iLCJwcm94eXR1LmNvbSIsInByb3h5dHVubmVsLmluIiwicHJveHl0dW5uZWwubmV0IiwicHJveHl0dXJiby5jb20iLCJwcm94eXVuYmxvY2tlci5vcmciLCJwcm94eXVzYS5vcmciLCJwcm94eXZpZGVvcy5jb20iLCJwcm94eXZwbi5ldSIsInByb3h5d2ViLmNvbS5lcyIsInByb3h5d2ViLm5ldCIsInByb3h5d2VicHJveHkuaW5mbyIsInByb3h5d2Vic2l0ZS5jby51ayIsInByb3h5d2Vic2l0ZS5vcmciLCJwcm94eXdlYnNpdGUudXMiLCJwcm94eXphbi5pbmZvIiwicHJ1ZGVudG1hbi5pZHYudHciLCJwcndlZWsuY29tIiwicHJ4LmltIiwicHJ4bWUuY29tIiwicHMzeW91dHViZS5jb20iLCJwc2UxMDBpLmlkdi50dyIsInBzaS5nb3Yuc2ciLCJwc3UuYWMudGgiLCJwc3ljaGNlbnRyYWwuY29tIiwicHN5Y2hvbG9naWVzLnJ1IiwicHRpdGNoZWYuY29tIiwicHRzLm9yZy50dyIsInB0dC5jYyIsInB0dC5nb3YudHIiLCJwdS5lZHUucGsiLCJwdWJsaWMubHUiLCJwdWJsaXN1aXRlcy5jb20iLCJwdWJsaXguY29tIiwicHVidS5jb20udHciLCJwdWZmaW5icm93c2VyLmNvbSIsInB1ZmZzdG9yZS5jb20iLCJwdWppYWhoLmNvbSIsInB1bHNlcG9pbnQuY29tIiwicHVuaXNodHViZS5jb20iLCJwdXJlYW5kc2V4eS5vcmciLCJwdXJlY2ZubS5jb20iLCJwdXJlaW5zaWdodC5vcmciLCJwdXJlbG92ZXJzLmNvbSIsInB1cmVwZW9wbGUuY29tLmJyIiwicHVyZXZwbi5jb20iLCJwdXJpZnltaW5kLmNvbSIsInB1c2hwc2F2ZXJhLmNvbSIsInB1c2h0cmFmZmljLm5ldCIsInB1c3N5Lm9yZyIsInB1dGF6LmNvbSIsInB1dGlob21lLm9yZyIsInB1dGxvY2tlci5jb20iLCJwdXRwcm94eS5jb20iLCJwdXVrby5jb20iLCJwd255b3V0dWJlLmNvbSIsInB4LmNvLm5sIiwicHhhYS5jb20iLCJweXBuYS5jb20iLCJweXJhbXlkYWlyLmNvbSIsInB5dGhvbi5jb20iLCJweXRob24uY29tLnR3IiwicTIydy5jb20iLCJxYW5vdGUuY29tIiwicWdhaXJzb2Z0LmNvbS5iciIsInFpNDEuY29tIiwicWlhbmdiLmNvbSIsInFpZGlhbi5jYSIsInFpZW5rdWVuLm9yZyIsInFpbHVtb3ZpZS5jb20iLCJxaW5taW44LmNvbSIsInFpcWlzZWEuY29tIiwicWlyZTEyMy5jb20iLCJxaXNleGluZy5jb20iLCJxaXN1dS5jb20iLCJxaXUubGEiLCJxaXdlbi5sdSIsInFrc2hhcmUuY29tIiwicW16ZGQuY29tIiwicW9vcy5jb20iLCJxb296YS5oayIsInFxLWF2LmNvbSIsInFxLmNvLnphIiwicXFwcm94eS5wdyIsInFxcTMyMS5jb20iLCJxcnVxLmNvbSIsInF1YWNraXQuY29tIiwicXVlYW50dWJlLmNvbSIsInF1ZWNob2lzaXIub3JnIiwicXVlZXJtZW5vdy5uZXQiLCJxdWlja3Byb3guY29tIiwicXVpY2twcm94eS5jby51ayIsInF1aWV0eW91dHViZS5jb20iLCJxdWthbmR5LmNvbSIsInF2Yy5kZSIsInF2Y3VrLmNvbSIsInF2b2QxMjMubmV0IiwicXZvZDYubmV0IiwicXZvZGNkLmNvbSIsInF2b2RoZS5jb20iLCJxdm9kenkub3JnIiwicXdleXkuY29tIiwicXdxc2hvdy5jb20iLCJxeC5uZXQiLCJyMnNhLm5ldCIsInJhY2NvbnRpbWlsdS5jb20iLCJyYWNpbmd2cG4uY29tIiwicmFkYS5nb3YudWEiLCJyYWRhcmlzLmNvbSIsInJhZGlvYXVzdHJhbGlhLm5ldC5hdSIsInJhZGlvZmFyZGEuY29tIiwicmFkaW90aW1lLmNvbSIsInJhZGlvemFtYW5laC5jb20iLCJyYWR5b3R1cmtpc3Rhbi5jb20iLCJyYWVsLm9yZyIsInJhaWRjYWxsLmNvbSIsInJhaWRjYWxsLmNvbS5iciIsInJhaWRjYWxsLmNvbS5ydSIsInJhaWRjYWxsLmNvbS50dyIsInJhaWRlcnMuY29tIiwicmFpZHRhbGsuY29tLnR3IiwicmFpZmZlaXNlbnBvbGJhbmsuY29tIiwicmFqYW5ld3MuY29tIiwicmFqYXlvdXR1YmUuY29tIiwicmFrdXRlbi1iYW5rLmNvLmpwIiwicmFtYmxpbmdzb2ZtYW1hLmNvbSIsInJhbmR5Ymx1ZS5jb20iLCJyYW50ZW5uYS5uZXQiLCJyYW9vby5jb20iLCJyYXBidWxsLm5ldCIsInJhcGVmb3JjZWQuY29tIiwicmFwaWRwcm94eS5vcmciLCJyYXBpZHByb3h5LnVzIiwicmFwaWRzaGFyZWRhdGEuY29tIiwicmFwcGxlci5jb20iLCJyYXFxLmNvbSIsInJhdGVnZi5jb20iLCJyYXpyYWJvdDRpay5ydSIsInJjLWZhbnM4OC5jb20iLCJyY2luZXQuY2EiLCJyZWFjaGxvY2FsLm5ldCIsInJlYWQxMDAuY29tIiwicmVhZGVyc2RpZ2VzdC5kZSIsInJlYWRlcnNkaWdlc3RkaXJlY3QuY29tLmF1IiwicmVhZGluZ3RpbWVzLmNvbS50dyIsInJlYWRtb28uY29tIiwicmVhZG5ld3MuZ3IiLCJyZWFkdGlnZXIuY29tIiwicmVhZHlkb3duLmNvbSIsInJlYWxjbGVhcnNwb3J0cy5jb20iLCJyZWFsZXN0YXRlLmNvbSIsInJlYWxob21lc2V4Lm5ldCIsInJlYWxpdHkuaGsiLCJyZWFsbWFkcmlkLmNvbSIsInJlYWxtYWRyeXQucGwiLCJyZWFsbWF0dXJlc3dpbmdlcnMuY29tIiwicmVhbG1hdHVyZXR1YmUuY29tIiwicmVhbHJhcHRhbGsuY29tIiwicmVhbHNleHBhc3MuY29tIiwicmVhbHNpbXBsZS5jb20iLCJyZWFsc3BlZWQuaW5mbyIsInJlY2V0YXNncmF0aXMubmV0IiwicmVjZXR0ZXMuZGUiLCJyZWNpcGRvbm9yLmNvbSIsInJlY29ubmVjdGlvbnRhaXdhbi50dyIsInJlY29yZGhpc3Rvcnkub3JnIiwicmVjb3Zlcnkub3JnLnR3IiwicmVkY3JhY2tsZS5jb20iLCJyZWRkaXQuY29tIiwicmVkaWFuLnRvZGF5IiwicmVkbGlnaHRjZW50ZXIuY29tIiwicmVkc29jaWFsZmFjZWJvb2suY29tIiwicmVkdHViZS5jb20iLCJyZWR0dWJlLmNvbS5iciIsInJlZHR1YmUuY29tLmVzIiwicmVkdHViZS5uZXQucGwiLCJyZWR0dWJlLm9yZy5wbCIsInJlZWQuY28udWsiLCJyZWVsemhvdC5jb20iLCJyZWZlcmVuY2V1ci5iZSIsInJlZmVyZXIudXMiLCJyZWcucnUiLCJyZWdpb25hbC1maW5kZXIuY29tIiwicmVpYmVydC5pbmZvIiwicmVpZmVmcmF1ZW4uY29tIiwicmVsYXhiYnMuY29tIiwicmVsZXZhbmNlLmNvbSIsInJlbGlhbmNlZGlnaXRhbC5pbiIsInJlbGlhbmNlbmV0Y29ubmVjdC5jby5pbiIsInJlbWF4LmNvbSIsInJlbW9udGthLnBybyIsInJlbW92ZWZpbHRlcnMubmV0IiwicmVuZmUuZXMiLCJyZW5taW5iYW8uY29tIiwicmVucmVucGVuZy5jb20iLCJyZW50YWxjYXJzLmNvbSIsInJlbnl1cmVucXVhbi5vcmciLCJyZXBsYXl5b3V0dWJlLmNvbSIsInJlcm91dGVkLm9yZyIsInJlc2N1ZW1lLm9yZyIsInJlc3BvbnNlLmpwIiwicmV0cmFuc3R3aXR0ZXIuY29tIiwicmV0d2VldHJhbmsuY29tIiwicmV1dGVycy5jb20iLCJyZXZpZXdjZW50cmUuY29tIiwicmV2aXZlb3VyaGVhcnRzLmNvbSIsInJldmxlZnQuY29tIiwicmV2dmVyLmNvbSIsInJleG9zcy5jb20iLCJyZmEub3JnIiwicmZhY2hpbmEuY29tIiwicmZhbW9iaWxlLm9yZyIsInJmYXdlYi5vcmciLCJyZmVybC5vcmciLCJyZmkuZnIiLCJyZ2ZhY2Vib29rLmNvbSIsInJnaG9zdC5ydSIsInJoY2xvdWQuY29tIiwicmk4Ni5jb20iLCJyaWIxMDAuY29tIiwicmliaWFvemkuaW5mbyIsInJpY2FyZG9lbGV0cm8uY29tLmJyIiwicmljaG1lZGlhZ2FsbGVyeS5jb20iLCJyaWdwYS5vcmciLCJyaWpubW9uZC5ubCIsInJpa3UubWUiLCJyaWxleWd1aWRlLmNvbSIsInJpcGxleS5jb20ucGUiLCJyaXZlZ2F1Y2hlLnJ1Iiwicmx3bHcuY29tIiwicm5jeW91dHViZS5jb20iLCJybncubmwiLCJyb2FkYmlrZXJldmlldy5jb20iLCJyb2FkcnVubmVyLmNvbSIsInJvYWRzaG93LmhrIiwicm9ja21lbHQuY29tIiwicm9kLmlkdi50dyIsInJvbWFuYW5kcmVnLmNvbSIsInJvb2RvLmNvbSIsInJvb2dlbi5jb20iLCJyb296b25saW5lLmNvbSIsInJvc2kubW4iLCJyb3NpbW4uY29tIiwicm9zdGVsZWNvbS5ydSIsInJvdHRlbi5jb20iLCJyb3VuZGN1YmUubmV0Iiwicm91dGVzZXJ2ZXIuc2UiLCJyb3lhbGxlcGFnZS5jYSIsInJvemV0a2EuY29tLnVhIiwicm96aGxhcy5jeiIsInJvenJ5ZmthLnBsIiwicm96emxvYmVuaW11emkuY29tIiwicnBwLmNvbS5wZSIsInJyYmFsZC5nb3YuaW4iLCJycnNvc28uY29tIiwicnJ5b3V0dWJlLmNvbSIsInJzYi5ydSIsInJzZi1jaGluZXNlLm9yZyIsInJzZi5vcmciLCJyc3NpbmcuY29tIiwicnNzbWVtZS5jb20iLCJydGhrLmhrIiwicnRoay5vcmcuaGsiLCJydGkub3JnLnR3IiwicnR5c20ubmV0IiwicnVhbnlpZmVuZy5jb20iLCJydWJpYXMxOS5jb20iLCJydWJ5Zm9ydHVuZS5jb20iLCJydWxlcnR1YmUuY29tIiwicnVubmluZ3dhcmVob3VzZS5jb20iLCJydXBvcm4udHYiLCJydXNjYW1zLmNvbSIsInJ1c2hiZWUuY29tIiwicnVzc2lhbnByb3h5Lm9yZyIsInJ1c3hoYW1zdGVyLmNvbSIsInJ1dG9yLm9yZyIsInJ1dHdpdHRlci5jb20iLCJydXVoLmNvbSIsInJ1di5pcyIsInJ1dnIucnUiLCJydXlpc2Vlay5jb20iLCJyeGhqLm5ldCIsInJ4cHJveHkuY29tIiwicnllcnNvbi5jYSIsInMtZHJhZ29uLm9yZyIsInMxMzUuY29tIiwiczhzb3NvLmNvbSIsInNhY2l0YXNsYW4uY29tIiwic2FmYXJpcHJveHkuY29tIiwic2FmZW5mcmVlLmNvbSIsInNhZmVwcm94eS5vcmciLCJzYWZlcnZwbi5jb20iLCJzYWZldW5ibG9jay5jb20iLCJzYWdlb25lLmNvbSIsInNhZ2VwYXkuY29tIiwic2FpcS5tZSIsInNhaXNodXUtaGVudGFpLm5ldCIsInNha3VyYWxpdmUuY29tIiwic2FsYW1uZXdzLm9yZyIsInNhbGVzZm9yY2VsaXZlYWdlbnQuY29tIiwic2FsaXIuY29tIiwic2Fsb25taWNyb2VudHJlcHJpc2VzLmNvbSIsInNhbHZhdGlvbi5vcmcuaGsiLCJzYW1haXIucnUiLCJzYW1hbnlvbHVoYWJlci5jb20iLCJzYW1iYW1lZGlhc2wuY29tIiwic2FuY2FkaWxsYS5uZXQiLCJzYW5qaXR1LmNvbSIsInNhbmtlaWJpei5qcCIsInNhbm1pbi5jb20udHciLCJzYW50YWJhbnRhLmNvbSIsInNhbndhcHViLmNvbSIsInNhbzQ3LmNvbSIsInNhbzkyLmNvbSIsInNhcG9uZXdvcmxkLmNvbSIsInNhc2ExMjMuY29tIiwic2F0MjQuY29tIiwic2F0NHRoZS5jby51ayIsInNhdWRlLmdvdi5iciIsInNhdWRpLXR3aXRlci50ayIsInNhdWRpYXJhYmlhYmF5LmluZm8iLCJzYXZlaW50ZXIubmV0Iiwic2F2ZW15cnVwZWUuY29tIiwic2F2ZXRpYmV0Lm9yZyIsInNhdmV0aWJldC5ydSIsInNhdmV2aWQuY29tIiwic2F2ZXlvdXR1YmUuY29tIiwic2F3bWlsbGNyZWVrLm9yZyIsInNheS1tb3ZlLm9yZyIsInNheXByb3h5LmluZm8iLCJzYm8xMjguY29tIiwic2JvMjIyLmNvbSIsInNjLmdvdi5iciIsInNjYW1hdWRpdC5jb20iLCJzY2FtYm9vay5jb20iLCJzY2FzaW5vLmNvbSIsInNjY2MxLmNvbSIsInNjaGVtYS5vcmciLCJzY2hvb2wtcHJveHkub3JnIiwic2Nob29sLXVuYmxvY2suY29tIiwic2Nob29sLXVuYmxvY2submV0Iiwic2Nob29scHJveHkuY28iLCJzY2hvb2xwcm94eS5pbmZvIiwic2Nob29scHJveHkucGsiLCJzY2hvb2x0dW5uZWwubmV0Iiwic2NodWguY28udWsiLCJzY2llbmNlc2V0YXZlbmlyLmZyIiwic2NpcnAub3JnIiwic2NsdWIudHciLCJzY21wLmNvbSIsInNjbXBjaGluZXNlLmNvbSIsInNjb3Blc2FuZGFtbW8uY29tIiwic2NyYXB5Lm9yZyIsInNjcmVlbjR1Lm5ldCIsInNjcmliZC5jb20iLCJzY3JpcHRpbmd2aWRlb3MuaW5mbyIsInNjcnV6YWxsLmNvbSIsInNjdGltZXNtZWRpYS5jb20iLCJzY3R2MzEuY29tIiwic2RibHVlcG9pbnQuY29tIiwic2UtZHVjLXRpdmUuY29tIiwic2U5NHNlLm9yZyIsInNlOTY3OC5jb20iLCJzZTk5OXNlLmNvbSIsInNlYWhhd2tzLmNvbSIsInNlYXJjaC1tcDMuY29tIiwic2VhcmNoLmNvbSIsInNlYXJjaDRwYXNzaW9uLmNvbSIsInNlYXJjaGJsb2dzcG90LmNvbSIsInNlYXJjaGdlZWsuY29tIiwic2VhcmNoaW5zdGFncmFtLmNvbSIsInNlYXJjaHRydXRoLmNvbSIsInNlY3JldC5seSIsInNlY3JldGNoaW5hLmNvbSIsInNlY3JldG9zZGVmYWNlYm9vay5jb20iLCJzZWNyZXRzbGluZS5iaXoiLCJzZWNyZXRzb2Z0aGVmZWQuY29tIiwic2Vjc3VyZmluZy5jb20iLCJzZWN0dXIuZ29iLm14Iiwic2VjdXJlYm94LmFzaWEiLCJzZWN1cmVmb3IuY29tIiwic2VjdXJlcHJvLmFzaWEiLCJzZWN1cmVzZXJ2ZXIubmV0Iiwic2VjdXJlc3VyZi5wdyIsInNlY3VyaXR5LmNsIiwic2VjdXJpdHlpbmFib3gub3JnIiwic2VjdXJpdHlraXNzLmNvbSIsInNlZWtiYWJlcy5jb20iLCJzZWVrcGFydC5jb20iLCJzZWVrdG9leHBsb3JlLmNvbSIsInNlZ291NDU2LmluZm8iLCJzZWd1aWRvcmVzaW5zdGFncmFtLmNvbSIsInNlZ3llLmNvbSIsInNlaDEuY29tIiwic2VoNS5jb20iLCJzZWg3LmNvbSIsInNlaWthdHN1emFjY2EuY29tIiwic2VsYW5nLmNhIiwic2VsYW5nMzMuY29tIiwic2VsYW9lci5jb20iLCJzZWxlY3Rvcm5ld3MuY29tIiwic2VsZWt3ZWIuY29tIiwic2VtYW9taS5jb20iLCJzZW1vbmsuaW5mbyIsInNlbmRncmlkLm5ldCIsInNlbmRpbmdjbi5jb20iLCJzZW5kc3BhY2UuY29tIiwic2VuaW9yc291bG1hdGVzLmNvbSIsInNlbnNvcnRvd2VyLmNvbSIsInNlbnN1YWxnaXJscy5vcmciLCJzZW56dXJpdHYuY29tIiwic2VvY2hla2kubmV0Iiwic2VvcGFuZGEubmV0Iiwic2Vvc3BoZXJlLmNvbSIsInNlb3ZhbGxleS5jb20iLCJzZXF1b2lhY2FwLmNvbSIsInNlcmFwaC5tZSIsInNlcnZlYmxvZy5uZXQiLCJzZXJ2ZWlyYy5jb20iLCJzZXJ2ZXBpY3MuY29tIiwic2VydmVybWFuaWEuY29tIiwic2VydmVybWF0cml4Lm9yZyIsInNlcnZpY2UuZ292LnVrIiwic2VzOTk5LmNvbSIsInNlc2F3ZS5vcmciLCJzZXNlOTc5Ny5jb20iLCJzZXNlYWEuY29tIiwic2VzZXJlbnRpLmNvbSIsInNldHR5LmNvbS50dyIsInNldmVubG9hZC5jb20iLCJzZXZlbnRlZW5iYWJlcy5jb20iLCJzZXgtMTEuY29tIiwic2V4LXNtcy1jb250YWN0cy5jb20iLCJzZXguY29tIiwic2V4MTY5Lm9yZyIsInNleDMuY29tIiwic2V4NTIwLm5ldCIsInNleDguY2MiLCJzZXhhbmRzdWJtaXNzaW9uLmNvbSIsInNleGJvdC5jb20iLCJzZXhkZWxpdmVyeS5jb20iLCJzZXhkb3VnYWJsb2c3N2ZjMi5jb20iLCJzZXhmb3J0di5jb20iLCJzZXhnYW1lcGFyay5jb20iLCJzZXhnbzJhdi5jb20iLCJzZXhnb2VzbW9iaWxlLmNvbSIsInNleGh1LmNvbSIsInNleGh1YW5nLmNvbSIsInNleGluc2V4Lm5ldCIsInNleGphcGFucG9ybi5jb20iLCJzZXhtbTUyMC5jb20iLCJzZXhtb25leS5jb20iLCJzZXhuaGFuaC5jb20iLCJzZXhvdGVyaWMuY29tIiwic2V4cHJldmlld3MuZXUiLCJzZXhzdGFyc29ubHkuY29tIiwic2V4dHJhY2tlci5jb20iLCJzZXh0dWJlc2V0LmNvbSIsInNleHR2eC5jb20iLCJzZXh1YWxoZW50YWkubmV0Iiwic2V4eHNlLmNvbSIsInNleHktYmFiZS1waWNzLmNvbSIsInNleHktbGluZ2VyaWUud3MiLCJzZXh5LmNvbSIsInNleHlhbmRmdW5ueS5jb20iLCJzZXh5ZnVja2dhbWVzLmNvbSIsInNleWVkbW9qdGFiYS12YWhlZGkuYmxvZ3Nwb3QuY28udWsiLCJzZjU1OC5jb20iLCJzZmFjZWJvb2suY29tIiwic2ZpbGV5ZHkuY29tIiwic2ZvcmEucGwiLCJzZ2Jsb2dzLmNvbSIsInNnY2hpbmVzZS5uZXQiLCJzZ2NwYW5lbC5jb20iLCJzZ3R0dC5jb20iLCJzaGFibG9sLmNvbSIsInNoYWJuYW1uZXdzLmNvbSIsInNoYWNrbmV3cy5jb20iLCJzaGFkb3dzb2Nrcy5vcmciLCJzaGFoYW1hdC1lbmdsaXNoLmNvbSIsInNoYWhhbWF0LW1vdmllLmNvbSIsInNoYW1iaGFsYXN1bi5jb20iLCJzaGFuZ2Zhbmcub3JnIiwic2hhb2thLmNvbSIsInNoYXBlLmluLnRoIiwic2hhcGVzZXJ2aWNlcy5uZXQiLCJzaGFyZWJlZS5jb20iLCJzaGFycGRhaWx5LmNvbS5oayIsInNoYXJwZGFpbHkuaGsiLCJzaGF1bnRoZXNoZWVwLmNvbSIsInNoYXZlZHB1c3N5LnBybyIsInNoYXl1anNxLm9yZyIsInNoZWZhY2Vib29rLmNvbSIsInNoZWlreWVybWFtaS5jb20iLCJzaGVsZG9uc2ZhbnMuY29tIiwic2hlbGYzZC5jb20iLCJzaGVsbGZpcmUuZGUiLCJzaGVtYWxlLWFzaWEuY29tIiwic2hlbWFsZXRpbXBvLmNvbSIsInNoZW5naHVvbmV0LmNvbSIsInNoZW55dW4uY29tIiwic2hlbnl1bnBlcmZvcm1pbmdhcnRzLm9yZyIsInNoZXJkb2cuY29tIiwic2hpYXR2Lm5ldCIsInNoaWZ0ZGVsZXRlLm5ldCIsInNoaW55aS1haWtpZG8uaWR2LnR3Iiwic2hpcmF4eHguY29tIiwic2hpcmV5aXNodW5qaWFuLmNvbSIsInNoaXJleWlzaHVuamlhbi5vcmciLCJzaGlzdGxiYi5jb20iLCJzaGl0YW90di5vcmciLCJzaGl6aGFvLm9yZyIsInNob2NrLW5ld3MubmV0Iiwic2hvb3NodGltZS5jb20iLCJzaG9vdHEuY29tIiwic2hvcC5ieSIsInNob3AyMDAwLmNvbS50dyIsInNob3BjYWRlLmNvbSIsInNob3BwaW5nLmNvbSIsInNob3BwaW5nLmNvbS5ibiIsInNob3BwaW5nLmNvbS51YSIsInNob3J0aGFpcnN0eWxlaWRlYXNpdGUuY29tIiwic2hvdXR1c3NhbGFtLmNvbSIsInNob3d0aGlzLnBsIiwic2hvd3RpbWUuanAiLCJzaHFpcGxpdmUuaW5mbyIsInNodWFuZ3R2Lm5ldCIsInNodWxvdS5jb20iLCJzaHV0dGVyc3RvY2suY29tIiwic2h2b29uZy5jb20iLCJzaHdjaHVyY2gzLmNvbSIsInNpYWthcGtlbGkuY29tIiwic2lhbXNwb3J0LmNvLnRoIiwic2lrcy5jb20iLCJzaWxrYm9vay5jb20iLCJzaWx2ZXJmYWNlYm9vay5jb20iLCJzaWx2ZXJzdHJpcGUub3JnIiwic2ltYS1sYW5kLnJ1Iiwic2ltYm9saS1mYWNlYm9vay5jb20iLCJzaW1ib2xvc3BhcmFmYWNlYm9vay5jb20iLCJzaW1ib2xvc3R3aXR0ZXIuY29tIiwic2ltaWxzaXRlcy5jb20iLCJzaW5hLmNvbSIsInNpbmEuY29tLmhrIiwic2luYS5jb20udHciLCJzaW5nYXBvcmViYXkuaW5mbyIsInNpbmdhcG9yZXBvb2xzLmNvbS5zZyIsInNpbmd0YW8uY2EiLCJzaW5ndGFvLmNvbSIsInNpbmd0YW8uY29tLmF1Iiwic2luZ3Rhb3VzYS5jb20iLCJzaW5neW91dHViZS5jb20iLCJzaW5pY2EuZWR1LnR3Iiwic2lub2FudHMuY29tIiwic2lub2Npc20uY29tIiwic2lub25ldC5jYSIsInNpbm9waXR0LmluZm8iLCJzaW5vcXVlYmVjLmNvbSIsInNpczAwMS5jb20iLCJzaXMwMDEudXMiLCJzaXM4LmNvbSIsInNpdGEuYWVybyIsInNpdGUydW5ibG9jay5jb20iLCJzaXRlYmxvY2tlZC5vcmciLCJzaXRlYnJvLnR3Iiwic2l0ZWV4cGxvcmVyLmluZm8iLCJzaXRlZmlsZS5vcmciLCJzaXRlZ2V0Lm5ldCIsInNpdGVtYW5wcm8uY29tIiwic2l0ZW5hYmxlLmNvbSIsInNraW10dWJlLmNvbSIsInNraW5ueXRhc3RlLmNvbSIsInNreS5jb20uYnIiLCJza3liZXQuY29tIiwic2t5a2l3aS5jb20iLCJza3lwZS5jb20iLCJza3lzY2FubmVyLmNvbS5iciIsInNreXNjYW5uZXIuZnIiLCJza3lzY2FubmVyLm5sIiwic2t5d29yZC5jb20iLCJza3l6b25lLmNvbSIsInNsYW5kci5uZXQiLCJzbGRhby51cyIsInNsaWNrYW1lcmljYW5zLmNvbSIsInNsaWNrdnBuLmNvbSIsInNsaWRlc2hhcmUubmV0Iiwic2xpbWUuY29tLnR3Iiwic2xpbmtzZXQuY29tIiwic2xpcHN0aWNrLmNvbSIsInNsb2dnaS5jb20iLCJzbG9wcHl0dWJlLmNvbSIsInNsdXRsb2FkLmNvbSIsInNsdXRyb3VsZXR0ZS5jb20iLCJzbHV0c29maW5zdGFncmFtLmNvbSIsInNseXNvZnQuY29tIiwic2x5dXNlci5jb20iLCJzbWFhdG8uY29tIiwic21hcnR1c2EuY29tIiwic21hc2hpbmdyZWFkZXIuY29tIiwic21pbGUuY28udWsiLCJzbWl0aG1pY3JvLmNvbSIsInNtbG0uaW5mbyIsInNtb2tlcHJveHkuY29tIiwic211ZGUuZWR1LmluIiwic214aW5saW5nLmNvbSIsInNuYWtlY2FzdGxlLmNvbSIsInNuYXBkZWFsbWFpbC5pbiIsInNuYXB0dS5jb20iLCJzbmVha3pvcnouY29tIiwic25pcHVybC5jb20iLCJzbm9iLnJ1Iiwic25vb3Blci5jby51ayIsInNub3dwcm94eS5jb20iLCJzby1nYS5uZXQiLCJzby1uZXQubmV0LnR3Iiwic28tbmV3cy5jb20iLCJzb2JlZXMuY29tIiwic29jY2VyYmFzZS5jb20iLCJzb2NjZXJzdGFuZC5jb20iLCJzb2NpYWwtYm9va21hcmtpbmctc2l0ZS5jb20iLCJzb2NpYWxhcHBzcG90LmNvbSIsInNvY2lhbHNlY3VyaXR5LmdvdiIsInNvY2lhbHRoZWF0ZXIuY29tIiwic29jaXN5bmQuY29tIiwic29ja2V0LmlvIiwic29ja3NsaXN0Lm5ldCIsInNvY3JlYy5vcmciLCJzb2QuY28uanAiLCJzb2Z0Lmlkdi50dyIsInNvZnQ0ZnVuLm5ldCIsInNvZnRhbm9ueS5pbmZvIiwic29mdGV0aGVyLWRvd25sb2FkLmNvbSIsInNvZnRldGhlci5jby5qcCIsInNvZnRldGhlci5vcmciLCJzb2Z0b25pYy5pdCIsInNvZnRvbmljLnBsIiwic29mdHN1cnJvdW5kaW5nc291dGxldC5jb20iLCJzb2Z0dGltZS5ydSIsInNvZ2NsdWIuY29tIiwic29nbzM2MC5jb20iLCJzb2dvby5vcmciLCJzb2hjcmFkaW8uY29tIiwic29oZnJhbmNlLm9yZyIsInNvaWZpbmQuY29tIiwic29sb2dpcmxwdXNzeS5jb20iLCJzb2xvcGVsaWN1bGFzZ3JhdGlzLmNvbSIsInNvbG9wb3MuY29tIiwic29sb3N0b2Nrcy5jb20iLCJzb2xveHkuY29tIiwic29tZWUuY29tIiwic29uZGV2aXIuY29tIiwic29uZ2ppYW5qdW4uY29tIiwic29uZ3NmYWNlYm9vay5jb20iLCJzb25ndGV4dGUuY29tIiwic29ueS5jby5qcCIsInNvbnkubmV0Iiwic29ueXlvdXR1YmUuY29tIiwic29wY2FzdC5jb20iLCJzb3BjYXN0Lm9yZyIsInNvcGl0YXMuY29tIiwic29ycnkuaWR2LnR3Iiwic29ydGlyYXBhcmlzLmNvbSIsInNvcnRvbC5jb20iLCJzb3N0YXYucnUiLCJzb3N5YWxtZWR5YS5jbyIsInNvdWJvcnkuY29tIiwic291bW8uaW5mbyIsInNvdW5kY2xvdWQuY29tIiwic291bmRvZmhvcGUub3JnIiwic291cmNlZm9yZ2UubmV0Iiwic291cmNld2FkaW8uY29tIiwic291dGhuZXdzLmNvbS50dyIsInNvd2Vycy5vcmcuaGsiLCJzb3dpa2kubmV0Iiwic3BhY2ViYXR0bGVzLmNvbSIsInNwYWdnaWFyaS5ldSIsInNwYW5raW5ndHViZS5jb20iLCJzcGFua2luZ3R1YmUuY29tLmFyIiwic3Bhbmt3aXJlLmNvbSIsInNwYXJrYXNzZS1rcmVmZWxkLmRlIiwic3BhcnJvd21haWxhcHAuY29tIiwic3BiLmNvbSIsInNwYm8uY29tIiwic3BlYWtlYXN5Lm5ldCIsInNwZWFrZXJkZWNrLmNvbSIsInNwZWN0cmFsdHViZS5jb20iLCJzcGVlZC1wcm94eS5jb20iLCJzcGVlZGFuYWx5c2lzLm5ldCIsInNwZWVkcGx1c3Mub3JnIiwic3BlZWR0ZXN0Lm5ldCIsInNwZWxhLnNlIiwic3BpY2VqZXQuY29tIiwic3BpY3l4eHh0dWJlLmNvbSIsInNwaWVsZW4uY29tIiwic3Bpa2UuY29tIiwic3Bpcml0MTA1My5jb20iLCJzcG9pbGVkdmlyZ2lucy5jb20iLCJzcG9uc29yaXp6YWNvbmZhY2Vib29rLmNvbSIsInNwb3J0LmJlIiwic3BvcnQuY3oiLCJzcG9ydC51YSIsInNwb3J0Ym94LnJ1Iiwic3BvcnRjaGFsZXQuY29tIiwic3BvcnRpbmdsaWZlLmNvbSIsInNwb3J0b3dlZmFrdHkucGwiLCJzcG9ydHNtYW5zb3V0ZG9vcnN1cGVyc3RvcmUuY29tIiwic3BvcnRzbmV0LmNhIiwic3BvcnRzd29ybGRpLmNvbSIsInNwb3J0dHUuY29tIiwic3BvdGZsdXguY29tIiwic3ByaW5nLm9yZy51ayIsInNwcm94eS5uZXQiLCJzcHktbW9iaWxlLXBob25lLmNvbSIsInNweS5jby5ubCIsInNweTJtb2JpbGUuY29tIiwic3B5c3VyZmluZy5jb20iLCJzcmVhbGl0eS5jeiIsInNyaXNsYW5kLmNvbSIsInNyeW91dHViZS5jb20iLCJzc2ZhY2Vib29rLmNvbSIsInNza3luLmNvbSIsInNzbHByb3h5LmluIiwic3Nsc2VjdXJlcHJveHkuY29tIiwic3Nwcm94eS5wdyIsInNzcy5jb20iLCJzc3NoaGg4LmNvbSIsInNzc3lvdXR1YmUuY29tIiwic3N0bWx0Lm5ldCIsInNzeW91dHViZS5jb20iLCJzdGFja2ZpbGUuY29tIiwic3RhcmRvbGxwcm94eS5jby51ayIsInN0YXJwMnAuY29tIiwic3RhcnByaXZhY3kuY29tIiwic3RhcnRwYWdlLmNvbSIsInN0YXRlLmdvdiIsInN0YXRlMTY4LmNvbSIsInN0YXRlb2Z0aGVtZWRpYS5vcmciLCJzdGF0cy5jb20iLCJzdGVhbHRoaW5lc3Mub3JnIiwic3RlYWx0aHdlYi5uZXQiLCJzdGVlcHRvLmNvbSIsInN0ZWdhbm9zLmNvbSIsInN0ZXZlcy1kaWdpY2Ftcy5jb20iLCJzdGhlYWRsaW5lLmNvbSIsInN0aWNrYW0uY29tIiwic3RpY2thbS5qcCIsInN0aWxlcHJvamVjdC5jb20iLCJzdGlyaWxlcHJvdHYucm8iLCJzdGpvYnMuc2ciLCJzdG9ja2luZ3Nmb3JzZXguY29tIiwic3Rvb3EucGwiLCJzdG9wLWJsb2NrLmNvbSIsInN0b3B0aWJldGNyaXNpcy5uZXQiLCJzdG9ybW1lZGlhZ3JvdXAuY29tIiwic3RyYWlnaHR0YWxrLmNvbSIsInN0cmFuYWJnLmNvbSIsInN0cmFuYW1hc3Rlcm92LnJ1Iiwic3RyZWFtYXRlLmNvbSIsInN0cmVhbWljYS5jb20iLCJzdHJlYW1pbmdtZWRpYS5jb20iLCJzdHJlYW1wb2NrZXQuY29tIiwic3RyZWV0dm9pY2UuY29tIiwic3RyaXBwcm94eS5pbmZvIiwic3Ryb25ndnBuLmNvbSIsInN0dGxiYi5jb20iLCJzdHVkZW50LnR3Iiwic3R1ZGVudHJhdGUuY29tIiwic3R1ZHlzdXJmLmNvbSIsInN0dW1ibGV1cG9uLmNvbSIsInN0dXBpZGNhbXMuY29tIiwic3R1cGlkdmlkZW9zLmNvbSIsInN0eWxlYmlzdHJvLmNvbSIsInN0eWxlYm9vay5kZSIsInN1Yml0by5pdCIsInN1Ym1hcmluby5jb20uYnIiLCJzdWJzY3JpYmUucnUiLCJzdWRhbmZhY2Vib29rLmNvbSIsInN1ZGFuaS5zZCIsInN1ZG91ZXN0LmZyIiwic3VnYXJzeW5jLmNvbSIsInN1bS5jb20udHciLCJzdW1taWZ5LmNvbSIsInN1bXJhbmRvLmNvbSIsInN1bi5tdiIsInN1bjExMS5jb20iLCJzdW5iaXJkYXJpem9uYS5jb20iLCJzdW5jaXR5LmNvbS5teCIsInN1bnBvcm5vLmNvbSIsInN1b2x1by5vcmciLCJzdXBlcmRvd25sb2Fkcy5jb20uYnIiLCJzdXBlcmZyZWV2cG4uY29tIiwic3VwZXJnbGFtLmNvbSIsInN1cGVyZ3JlZW5zdHVmZi5jb20iLCJzdXBlcmhpZGVpcC5jb20iLCJzdXBlcmhxcG9ybi5jb20iLCJzdXBlcnBhZ2VzLmNvbSIsInN1cGVydHYuY29tLnR3Iiwic3VwZXJ0d2VldC5uZXQiLCJzdXBlcnZwbi5uZXQiLCJzdXByZW1lbWFzdGVydHYuY29tIiwic3VyZXNvbWUuY29tIiwic3VyZi1hbm9ueW1vdXMuaW5mbyIsInN1cmYtZm9yLWZyZWUuY29tIiwic3VyZjEwMC5jb20iLCJzdXJmNTUuY29tIiwic3VyZmFsbC5uZXQiLCJzdXJmY292ZXJ0bHkuY29tIiwic3VyZmVhc3kuY29tIiwic3VyZmVhc3kuY29tLmF1Iiwic3VyZmVydC5ubCIsInN1cmZoaWRkZW4uY29tIiwic3VyZmludGVybmV0Lm9yZyIsInN1cmZpdC5pbmZvIiwic3VyZm1hLm51Iiwic3VyZnR1bm5lbC5jb20iLCJzdXJmdHVubmVsLm9yZyIsInN1cmZ3ZWJzaXRlLm9yZyIsInN1cnZleWJ5cGFzcy5jb20iLCJzdXNqZWQuY29tIiwic3V0dW5oYWJlci5jb20iLCJzdXp1a2kuY28uanAiLCJzd2FwaXAuY29tIiwic3dlZGVuYmF5LmluZm8iLCJzd2VldGFuZHB1c3N5LmNvbSIsInN3aWF0bG9wYW5hLmNvbSIsInN3aXNzdnBuLm5ldCIsInN3aXNzd2VicHJveHkuY2giLCJzd2l0Y2hhZGh1Yi5jb20iLCJzd2l0Y2h2cG4ubmV0Iiwic3dvcmRzb2Z0Lmlkdi50dyIsInN5ZG5leXRvZGF5LmNvbSIsInN5bXBhdGljby5jYSIsInN5b3V0dWJlLmNvbSIsInN5cmlhbnR1YmUubmV0Iiwic3l0ZXMubmV0Iiwic3l4ODYuY24iLCJzeXg4Ni5jb20iLCJzemJicy5uZXQiLCJzemV0b3dhaC5vcmcuaGsiLCJ0LWJhc2ljLmNvbSIsInQtbW9iaWxlYmFua293ZS5wbCIsInQuY28iLCJ0MzNuaWVzLmNvbSIsInQzNS5jb20iLCJ0NjZ5LmJpeiIsInQ2NnkuY29tIiwidGFhLXVzYS5vcmciLCJ0YWF6ZS50dyIsInRhYmxldHBjcmV2aWV3LmNvbSIsInRhYmxvaWRub3ZhLmNvbSIsInRhYm9vZnVja3R1YmUuY29tIiwidGFidHRlci5qcCIsInRhY2VtLm9yZyIsInRhY29uZXQuY29tLnR3IiwidGFlZHAub3JnLnR3IiwidGFnZXNzcGllZ2VsLmRlIiwidGFnZ3kuanAiLCJ0YWlwZWkuZ292LnR3IiwidGFpcGVpc29jaWV0eS5vcmciLCJ0YWlzZWxlLmNvbSIsInRhaXNlbGUubmV0IiwidGFpd2FuLXNleC5jb20iLCJ0YWl3YW5iaWJsZS5jb20iLCJ0YWl3YW5jb24uY29tIiwidGFpd2FuZGFpbHkubmV0IiwidGFpd2FuanVzdGljZS5jb20iLCJ0YWl3YW5raXNzLmNvbSIsInRhaXdhbm5hdGlvbi5jb20udHciLCJ0YWl3YW5uZXdzLmNvbS50dyIsInRhaXdhbnR0Lm9yZy50dyIsInRhaXdhbnVzLm5ldCIsInRhaXlhbmdiYW8uY2EiLCJ0YWthcmF0b215LmNvLmpwIiwidGFrYm9vay5jb20iLCJ0YWtlMmhvc3RpbmcuY29tIiwidGFrZXNwb3J0Lmlkdi50dyIsInRha3ZhaGFiZXIubmV0IiwidGFsa2VuZ2xpc2guY29tIiwidGFtaXpoeW91dHViZS5jb20iLCJ0YW1wYWJheS5jb20iLCJ0YW10YXkudm4iLCJ0YW5lYS5nciIsInRhbmdhLmNvbSIsInRhb2h1YXp1LnVzIiwidGFvaXNtLm5ldCIsInRhb2x1bi5pbmZvIiwidGFwLmF6IiwidGFwY2hpZGFub25nLm9yZyIsInRhcHV6LmNvLmlsIiwidGFyYWdhbmEuY29tIiwidGFyaW5nYS5uZXQiLCJ0YXN0ZS5jb20uYXUiLCJ0YXRhbW90b3JzLmNvbSIsInRhdGFyLnJ1IiwidGF3ZWV0LmNvbSIsInRhd2hlZC53cyIsInRheGVzLmdvdi5heiIsInRheWVsdS5jb20iLCJ0YnNlYy5vcmciLCJ0YnNuLm9yZyIsInRic3NlYXR0bGUub3JnIiwidGNhLm9yZy50dyIsInRjbHl5LmNvbSIsInRlYXNoYXJrLmNvbSIsInRlY2hub3BvaW50LnJ1IiwidGVjaG9udGhlbmV0LmNvbSIsInRlY2hzb25pYW4uY29tIiwidGVjaHRhcmdldC5jb20iLCJ0ZWNodGltZXMuY29tIiwidGVjaHVsYXRvci5jb20iLCJ0ZWNtaWxlbmlvLmVkdS5teCIsInRlY21pbnQuY29tIiwidGVlbi1oYW5kam9iLmNvbSIsInRlZW5icmF0cy5jb20iLCJ0ZWVuY29yZWNsdWIuY29tIiwidGVlbmhvdHR1YmUuY29tIiwidGVlbnBpbmt2aWRlb3MuY29tIiwidGVlbnBvcnQuY29tIiwidGVlbnMtcGljLmNvbSIsInRlZW5zaW5hc2lhLmNvbSIsInRlZW5zb25jb3VjaC5jb20iLCJ0ZWVuc3lvdXR1YmUuY29tIiwidGVlbnp2aWR6LmNvbSIsInRlZmFsLmNvLnVrIiwidGVmYWwuY29tIiwidGVoYWdvLmNvbSIsInRlamppLmNvbSIsInRla25vc2EuY29tIiwidGVrc3Rvd28ucGwiLCJ0ZWxlY2hhcmdlci12aWRlb3MteW91dHViZS5jb20iLCJ0ZWxlY29taXRhbGlhLml0IiwidGVsZWNvbXNwYWNlLmNvbSIsInRlbGVmb25pY2EuY29tIiwidGVsZWZvbmluby5uZXQiLCJ0ZWxlZ3JhcGguY28udWsiLCJ0ZWxlbXVuZG8uY29tIiwidGVsZW5ldC5iZSIsInRlbGV0cmFjLmNvbSIsInRlbGV4ZnJlZS5jb20iLCJ0ZWxrb20uY28uaWQiLCJ0ZW1wbGF0ZS1ibG9nc3BvdC5jb20iLCJ0ZW1wbGF0ZS5teS5pZCIsInRlbXBsYXRlcGFyYWJsb2dzcG90LmNvbSIsInRlbmFjeS5jby51ayIsInRlbmFjeS5jb20iLCJ0ZW5hbnRzYWN0Lm9yZy5hdSIsInRlbm5pcy13YXJlaG91c2UuY29tIiwidGgzOC5jb20iLCJ0aGFpY24uY29tIiwidGhhaWRvamluLmNvbSIsInRoZS1jbG9hay5jb20iLCJ0aGVhZnJpY2F2b2ljZS5jb20iLCJ0aGViZXN0ZGFpbHlwcm94eS5jb20iLCJ0aGViZXN0b2Zwcm94eS5pbmZvIiwidGhlYmVzdHByb3h5LmluZm8iLCJ0aGViZXN0cHJveHlzZXJ2ZXIuY29tIiwidGhlYmxhemUuY29tIiwidGhlYm9icy5jb20iLCJ0aGVjaGluYWJlYXQub3JnIiwidGhlY292ZXRldXIuY29tIiwidGhlY3Jldy5jb20iLCJ0aGVkZXNpZ25pbnNwaXJhdGlvbi5jb20iLCJ0aGVlcG9jaHRpbWVzLmNvbSIsInRoZWZhY2Vib29rLmNvbSIsInRoZWZhY2Vzb2ZmYWNlYm9vay5jb20iLCJ0aGVmYXN0YmF5LmNvbSIsInRoZWZ1dG9uY3JpdGljLmNvbSIsInRoZWdsb2JhbG1haWwub3JnIiwidGhlZ3VhcmRpYW4uY28iLCJ0aGVndWFyZGlhbi5jb20iLCJ0aGVob3VzZW5ld3MuY29tIiwidGhlaHVuLmNvbSIsInRoZWlucXVpcmVyLm5ldCIsInRoZWxhd2RpY3Rpb25hcnkub3JnIiwidGhlbG9vcC5jYSIsInRoZW1pZnkubWUiLCJ0aGVtb3ZzLmNvbSIsInRoZW5ld2NpdmlscmlnaHRzbW92ZW1lbnQuY29tIiwidGhlbmluamFwcm94eS5jb20iLCJ0aGVub3RlLmNvbS50dyIsInRoZW9wZW5wcm94eS5uZXQiLCJ0aGVwZWFjaHBhbGFjZS5jb20iLCJ0aGVwaXJhdGViYXkub3JnIiwidGhlcGlyYXRlYmF5Lm9yZy5lcyIsInRoZXBpcmF0ZWJheS5vcmcuaW4iLCJ0aGVwaXJhdGVtaXJyb3IuY29tIiwidGhlcHJveHkuZXUiLCJ0aGVwcm94eWZyZWUuY29tIiwidGhlcHJveHlwaXJhdGUuY29tIiwidGhlcmVnaXN0ZXIuY28udWsiLCJ0aGVyb2NrLm5ldC5ueiIsInRoZXNhcnRvcmlhbGlzdC5jb20iLCJ0aGVzaW1wbGVkb2xsYXIuY29tIiwidGhlc3VuLm5ldCIsInRoZXRlbmQuY29tIiwidGhldGliZXRwb3N0LmNvbSIsInRoZXRyYWNrci5jb20iLCJ0aGV1bmlxdWVwcm94eS5jb20iLCJ0aGV2aXNpb250aW1lcy5jYSIsInRoZXdlYXRoZXJuZXR3b3JrLmNvbSIsInRoZXdpc2VndWlzZS5jb20iLCJ0aGV3cmFwLmNvbSIsInRoZXhpdGUuY29tIiwidGhpbmthZHZpc29yLmNvbSIsInRoaW5rZmxhLmNvbSIsInRoaW5raW5ndGFpd2FuLmNvbSIsInRoaXNhdi5jb20iLCJ0aGlzaXNnb29kc2hpdC5pbmZvIiwidGhvbmdkcmVhbXMuY29tIiwidGhvb2YuY29tIiwidGhxYWZhd2UzbG9tLmNvbSIsInRoc2NvcmUuY2MiLCJ0aHVtYnppbGxhLmNvbSIsInRpYW5kaXhpbmcub3JnIiwidGlhbmh1YXl1YW4uY29tIiwidGlhbnRpYm9va3Mub3JnIiwidGlhbnpodS5vcmciLCJ0aWF2YS5jb20iLCJ0aWJldC5jb20iLCJ0aWJldC5jb20uYXUiLCJ0aWJldC5kZSIsInRpYmV0Lm5ldCIsInRpYmV0Lm9yZy50dyIsInRpYmV0YW55b3V0aGNvbmdyZXNzLm9yZyIsInRpYmV0aG91c2UuanAiLCJ0aWJldG9ubGluZS50diIsInRpYmV0c3VuLmNvbSIsInRpYmV0dGltZXMubmV0IiwidGljYmVhdC5jb20iLCJ0aWNrZXQuY29tLnR3IiwidGllbXBvLmNvbSIsInRpZW1wby5obiIsInRpZmZhbnlhcm1lbnQuY29tIiwidGlnZXJkcm9wcGluZ3MuY29tIiwidGlnby5jb20uY28iLCJ0aWtpLW9ubGluZS5jb20iLCJ0aW0uY29tLmJyIiwidGltLml0IiwidGltZGlyLmNvbSIsInRpbWUuY29tIiwidGltZXNpbnRlcm5ldC5pbiIsInRpbWV0dXJrLmNvbSIsInRpbXNhaC5jb20iLCJ0aW1zaGFuLmlkdi50dyIsInRpbXRhZGRlci5jb20iLCJ0aW5leS5jb20iLCJ0aW5ndGluZ2ppZGkuY29tIiwidGludGhldGhhby5jb20udm4iLCJ0aW50dWMxMDEuY29tIiwidGludHVjb25saW5lLmNvbS52biIsInRpbnkuY2MiLCJ0aW55MTgubmV0IiwidGlueWNoYXQuY29tIiwidGlueWp1Z3MuY29tIiwidGlueXN1YnZlcnNpb25zLmNvbSIsInRpcHAzLmF0IiwidGlzY2FsaS5pdCIsInRpc3RvcnkuY29tIiwidGpzcC5qdXMuYnIiLCJ0bWFnYXppbmUuY29tIiwidG1kMzguaW5mbyIsInRtaS5tZSIsInRtbi5pZHYudHciLCJ0bi5nb3YuaW4iLCJ0bmFmbGl4LmNvbSIsInRuZXdzLmNjIiwidG5wc2MuZ292LmluIiwidG5zY2cuY29tIiwidG8ubHkiLCJ0b2J1LmNvLmpwIiwidG9kYXkuaXQiLCJ0b2RvaW5zdGFncmFtLmNvbSIsInRvZ2V0dGVyLmNvbSIsInRva2ZtLnBsIiwidG9reW8tMjQ3LmNvbSIsInRva3lvLWhvdC5jb20iLCJ0b2t5by1tb3RvcnNob3cuY29tIiwidG9reW8yaG90LmNvbSIsInRva3lvY24uY29tIiwidG9tMzY1LmNjIiwidG9taWNhLnJ1IiwidG9tb3lheC5jb20iLCJ0b29kb2MuY29tIiwidG9vbHNsaWIubmV0IiwidG9wLXBhZ2UucnUiLCJ0b3AtcHJveGllcy5jby51ayIsInRvcC14aGFtc3Rlci5jb20iLCJ0b3AxaGVhbHRoLmNvbSIsInRvcDU1Lm5ldCIsInRvcDgxLndzIiwidG9wY2hpbmVzZW5ld3MuY29tIiwidG9wY2hyZXRpZW4uY29tIiwidG9waWZ5LmNvbSIsInRvcGluY2VzdHZpZGVvLmNvbSIsInRvcG5ld3MuaW4iLCJ0b3BuZXdzLmpwIiwidG9wb3I0aWsucnUiLCJ0b3BzaGFyZXdhcmUuY29tIiwidG9wc3BlZWQuY29tIiwidG9wc3kuY29tIiwidG9wdHdlZXQub3JnIiwidG9wdW5ibG9jay5jb20iLCJ0b3B6b25lLm5ldCIsInRvcmNlZG9yZXMuY29tIiwidG9yY24uY29tIiwidG9yZ3VhcmQubmV0IiwidG9yb250by5jYSIsInRvcnByb2plY3Qub3JnIiwidG9ycHJvamVjdC5vcmcuaW4iLCJ0b3JyZW50LXR2LnJ1IiwidG9ycmVudGNyYXp5LmNvbSIsInRvcnJlbnRkYXkuY29tIiwidG9ycmVudGtpdHR5LmNvbSIsInRvcnJlbnRwcml2YWN5LmNvbSIsInRvcnJlbnRwcm94aWVzLmNvbSIsInRvcnJlbnRyb29tLmNvbSIsInRvcnJpbm9tZWRpY2EuaXQiLCJ0b3J0b2lzZXN2bi5uZXQiLCJ0b3J2cG4uY29tIiwidG9zYXJhbmcubmV0IiwidG9zaGliYS5jb20iLCJ0b3RhbGZpbG0uY29tIiwidG90YWx3YXIuY29tIiwidG91a291Z2F6b3UubmV0IiwidG91c2xlc2RyaXZlcnMuY29tIiwidG92ZXIubmV0IiwidG92aW1hLmdyIiwidG93bmdhaW4uY29tIiwidG95cGFyay5pbiIsInRwLWxpbmtydS5jb20iLCJ0cDEuanAiLCJ0cGFyZW50cy5vcmciLCJ0cGUuZ292LnRyIiwidHBpLm9yZy50dyIsInRwaW1hZ2UuY29tIiwidHB1c2VyLmlkdi50dyIsInRyLXlvdXR1YmUuY29tIiwidHIuaW0iLCJ0cmFiYWphbmRvLmNsIiwidHJhYmFqYW5kby5jb20iLCJ0cmFiYWpvcy5jb20iLCJ0cmFja2lkLmluZm8iLCJ0cmFja3lvdXR1YmUuY29tIiwidHJhZmZpYy1kZWxpdmVyeS5jb20iLCJ0cmFmZmljaGF1cy5jb20iLCJ0cmFmdG9uLm9yZyIsInRyYWZ2aXouY29tIiwidHJhbnNkb2MuY29tLmd0IiwidHJhbnNmZXJtYXJrdC5jby51ayIsInRyYW5zZmVybWFya3QuY29tIiwidHJhbnNmZXJtYXJrdC5lcyIsInRyYW5zd29ybGQubmV0IiwidHJhdmVsbGluZ3RoZXJlLmNvbSIsInRyZW5kLmF6IiwidHJlbmRpbmd3ZWIubmV0IiwidHJlbmRzbWFwLmNvbSIsInRyaWFsb2ZjY3Aub3JnIiwidHJpYWxwcm94eS5jb20iLCJ0cmlidW5lLmdyIiwidHJpY2tzZmFjZWJvb2suY29tIiwidHJpb253b3JsZHMuY29tIiwidHJpcHBpbndpdGh0YXJhLmNvbSIsInRyaXVtcGguY29tIiwidHJpdmFnby5jby51ayIsInRyaXZhZ28uZGUiLCJ0cm91dy5ubCIsInRyb3ZhcHJlenppLml0IiwidHJ0Yy5jb20udHciLCJ0cnVjY2hpZmFjZWJvb2suY29tIiwidHJ1c3QucnUiLCJ0cnVzdGVlci5jb20iLCJ0cnVzdHBpbG90Lm5sIiwidHJ1dmVvLmNvbSIsInRyeXRlZW5zdHViZS5jb20iLCJ0czg4ODgubmV0IiwidHNiLmNvLnVrIiwidHNjdHYubmV0IiwidHNlbXR1bGt1LmNvbSIsInRzZXAuaW5mbyIsInRzbi5jYSIsInRzdW5hZ2FydW1vbi5jb20iLCJ0dDIyLmluZm8iLCJ0dGoxMjMuY29tIiwidHRzODg4OC5uZXQiLCJ0dHRhbi5jb20iLCJ0dHYuY29tLnR3IiwidHVhbnp0LmNvbSIsInR1YmFob2xpYy5jb20iLCJ0dWJlLmNvbSIsInR1YmU4LmNvbSIsInR1YmU4LmNvbS5jbyIsInR1YmVjYW8uY29tIiwidHViZWR1cGUuY29tIiwidHViZWdhbHMuY29tIiwidHViZWlzbGFtLmNvbSIsInR1YmVudC5jb20iLCJ0dWJlbnViZS5jb20iLCJ0dWJlb2ZtdXNpYy5jb20iLCJ0dWJlb3h5LmNvbSIsInR1YmVwbGVhc3VyZS5jb20iLCJ0dWJlcHJveHkubnUiLCJ0dWJlcHJveHkuc2UiLCJ0dWJldG9wNjkuY29tIiwidHViZXVtLmNvbSIsInR1YmV3aGFsZS5jb20iLCJ0dWJld29sZi5jb20iLCJ0dWJleHBsb3Jlci5jb20iLCJ0dWVtcHJlc2FlbmZhY2Vib29rLmNvbSIsInR1aWRhbmcub3JnIiwidHVpdHVpLmluZm8iLCJ0dW1ibHIuY29tIiwidHVtdXRhbnppLmNvbSIsInR1bmUucGsiLCJ0dW5laW4uY29tIiwidHVuZXVwbXltYWMuY29tIiwidHVubmVsYmVhci5jb20iLCJ0dW9jaGF0LmNvbSIsInR1cmFuc2FtLm9yZyIsInR1cmFuc2VzaS5jb20iLCJ0dXJib2JpdC5uZXQiLCJ0dXJib2hpZGUuY29tIiwidHVya2V5YmF5LmluZm8iLCJ0dXJrZXlmb3J1bS5jb20iLCJ0dXJraXNobmV3cy5jb20iLCJ0dXJraXN0Lm9yZyIsInR1cmtpc3Rhbm1hYXJpcC5vcmciLCJ0dXJrdGljYXJldC5uZXQiLCJ0dXJuZXIuY29tIiwidHVzZnJhc2VzcGFyYWZhY2Vib29rLmNvbSIsInR1c2h5Y2FzaC5jb20iLCJ0dXNwZWxpcy5jbyIsInR1dG9yaWFsYmxvZ3Nwb3QuY29tIiwidHV0dG9hbm51bmNpLm9yZyIsInR1dHRvbmFwb2xpLm5ldCIsInR1dnBuLmNvbSIsInR2LWdyZWVrLmNvbSIsInR2LmNvbSIsInR2LmNvbS5wayIsInR2MDA3LnR2IiwidHYyLnB3IiwidHY1MDAwLmNvbSIsInR2ODg4OC5uZXQiLCJ0djlrLm5ldCIsInR2Yi5jb20iLCJ0dmJveG5vdy5jb20iLCJ0dmdheS5ydSIsInR2bi5jbCIsInR2cGxheXZpZGVvcy5jb20iLCJ0dnZjZC5jb20iLCJ0dnp2ZXpkYS5ydSIsInR3MDEub3JnIiwidHcxLmNvbSIsInR3MTAwcy5jb20iLCJ0dzExNi5jb20iLCJ0dzc4OS5uZXQiLCJ0d2FpdHRlci5jb20iLCJ0d2F0dGVyLmNvbSIsInR3YXVkLmlvIiwidHdhdmkuY29tIiwidHdhdnR2LmNvbSIsInR3YmJzLm5ldC50dyIsInR3YmJzLm9yZyIsInR3YmJzLnR3IiwidHdlYWtteXR3aXR0ZXIuY29tIiwidHdlZW1hdGljLmNvbSIsInR3ZWVwaS5jb20iLCJ0d2VlcG1sLm9yZyIsInR3ZWV0YmFja3VwLmNvbSIsInR3ZWV0YmluZGVyLmNvbSIsInR3ZWV0Ym9uZXIuYml6IiwidHdlZXRkZWNrLmNvbSIsInR3ZWV0ZS5uZXQiLCJ0d2VldG1lbWUuY29tIiwidHdlZXRwaG90by5jb20iLCJ0d2VldHN3aW5kLmNvbSIsInR3ZWV0dHVubmVsLmNvbSIsInR3ZWV0dmFsdWUuY29tIiwidHdlZXR5bWFpbC5jb20iLCJ0d2VtLmlkdi50dyIsInR3aGlybC5vcmciLCJ0d2liLmpwIiwidHdpYmJsZS5kZSIsInR3aWNzeS5jb20iLCJ0d2llbmRzLmNvbSIsInR3aWZhbi5jb20iLCJ0d2lsaWdodHNleC5jb20iLCJ0d2lsaW8uY29tIiwidHdpbWcuY29tIiwidHdpbWkubmV0IiwidHdpbnN2LmNvbSIsInR3aXBwbGUuanAiLCJ0d2lzaG9ydC5jb20iLCJ0d2lzdGFyLmNjIiwidHdpc3Rlci5uZXQuY28iLCJ0d2lzdGVyaW8uY29tIiwidHdpdDJkLmNvbSIsInR3aXRpcC5jb20iLCJ0d2l0bG9uZ2VyLmNvbSIsInR3aXRtYW5pYS5jb20iLCJ0d2l0cGljLmNvbSIsInR3aXRzdGF0LmNvbSIsInR3aXR0YW5pYy5jb20iLCJ0d2l0dGJvdC5uZXQiLCJ0d2l0dGVyLWljb24uY29tIiwidHdpdHRlci5jb20iLCJ0d2l0dGVyLmNvbS5iciIsInR3aXR0ZXI0ai5vcmciLCJ0d2l0dGVyY2VudHJhbC5jb20uYnIiLCJ0d2l0dGVyY291bnRlci5jb20iLCJ0d2l0dGVyZmFsbC5jb20iLCJ0d2l0dGVyZmVlZC5jb20iLCJ0d2l0dGVyZ2FkZ2V0LmNvbSIsInR3aXR0ZXJrci5jb20iLCJ0d2l0dGhhdC5jb20iLCJ0d2l0dHVyay5jb20iLCJ0d2l0dHVybHkuY29tIiwidHdpdHZpZC5jb20iLCJ0d2l0emFwLmNvbSIsInR3b3BjaGFydHMuY29tIiwidHdyZWcuaW5mbyIsInR3c2t5cGUuY29tIiwidHd0a3IuY29tIiwidHd0bWFzdGVyLmNvbSIsInR3dHJsYW5kLmNvbSIsInR3dW5iYnMuY29tIiwidHd1cmwubmwiLCJ0d3lhYy5vcmciLCJ0d3lsYWguY29tIiwidHljb29sLmNvbSIsInR5cGVtYWcuanAiLCJ0eXBpbmd0ZXN0LmNvbSIsInUxNS50diIsInVhczIuY29tIiwidWJlcmNvbmZlcmVuY2UuY29tIiwidWJlcnZ1LmNvbSIsInViaW50Lm5ldCIsInVibGRpcmVjdC5jb20iLCJ1YnRvei5jb20iLCJ1YnVudHUucnUiLCJ1Y2FtLm9yZyIsInVjb3oucnUiLCJ1Y3IuYWMuY3IiLCJ1Y3NjLmVkdSIsInVkbi5jb20iLCJ1ZG4uY29tLnR3IiwidWZyZWV2cG4uY29tIiwidWZyZXQuanAiLCJ1ZnV4LnBsIiwidWdnYXVzdHJhbGlhLmNvbSIsInVnby5jb20iLCJ1aWdodXJiaXoubmV0IiwidWstcHJveHkuY28udWsiLCJ1ay50byIsInVrYmF5LmluZm8iLCJ1a2xpZmVyYWRpby5jby51ayIsInVrcHJveHlzZXJ2ZXIuY29tIiwidWtyeWouaW5mbyIsInVrdXN2cG4uY29tIiwidWt3ZWJwcm94eS5ldSIsInVsaWtlLm5ldCIsInVsa3VvY2FrbGFyaS5vcmcudHIiLCJ1bHRpbWF0ZXByby5ldSIsInVsdHJhYmVzdHByb3h5LmNvbSIsInVsdHJhZmFzdHByb3h5LmNvbSIsInVsdHJhcHVzc3kuY29tIiwidWx0cmFzdXJmLnBsIiwidWx0cmFzdXJmLnVzIiwidWx0cmFzdXJmaW5nLmNvbSIsInVsdXNhbGthbmFsLmNvbS50ciIsInVtbWV0aXNsYW0ubmV0IiwidW5ibGsubmV0IiwidW5ibG9jay1ldmVyeXRoaW5nLmNvbSIsInVuYmxvY2stZmFjZWJvb2twcm94eS5jb20iLCJ1bmJsb2NrLW1lLm9yZyIsInVuYmxvY2stcHJveHkuY29tIiwidW5ibG9jay1wcm94eWJ1bmtlci5jby51ayIsInVuYmxvY2stdXMuY29tIiwidW5ibG9jay5jbi5jb20iLCJ1bmJsb2NrLm51IiwidW5ibG9jay5wayIsInVuYmxvY2sucGwiLCJ1bmJsb2NrMTIzLmNvbSIsInVuYmxvY2syNC5kZSIsInVuYmxvY2s0ZXZlci5jb20iLCJ1bmJsb2NrNGV2ZXIuaW5mbyIsInVuYmxvY2thY2Nlc3MuY29tIiwidW5ibG9ja2FueXNpdGUubmV0IiwidW5ibG9ja2FueXRoaW5nLmNvbSIsInVuYmxvY2thcHJveHkuY29tIiwidW5ibG9ja2NsaWNrLmluZm8iLCJ1bmJsb2NrZG1tLmNvbSIsInVuYmxvY2tlZC5pbiIsInVuYmxvY2tlZGZhY2Vib29rLm5ldCIsInVuYmxvY2tlZHByb3h5Lm5ldCIsInVuYmxvY2tlZHByb3h5LnVzIiwidW5ibG9ja2VyLmJpeiIsInVuYmxvY2tlci5tZSIsInVuYmxvY2tlcnN1cmYuY29tIiwidW5ibG9ja2Vyei5uZXQiLCJ1bmJsb2NraW5nd2Vic2l0ZS5jb20iLCJ1bmJsb2NraW5zdGFncmFtLmNvbSIsInVuYmxvY2ttZWdhLmNvbSIsInVuYmxvY2tteXdlYi5jb20iLCJ1bmJsb2NrcGlyYXRlLmNvbSIsInVuYmxvY2twb3Juc2l0ZXMuY29tIiwidW5ibG9ja3Byb3h5LnBrIiwidW5ibG9ja3Byb3h5LnVzIiwidW5ibG9ja3JlYWwuaW5mbyIsInVuYmxvY2tzaXQuZXMiLCJ1bmJsb2Nrc2l0ZS5pbmZvIiwidW5ibG9ja3NvY2lhbG1lZGlhLmNvbSIsInVuYmxvY2t0aGF0c2l0ZS5uZXQiLCJ1bmJsb2NrdmlkZW9zLmNvbSIsInVuYmxvY2t2cG4uY29tIiwidW5ibG9ja3dlYnNpdGUubmV0IiwidW5ibG9ja3dlYnNpdGVzLnNlIiwidW5ibG9ja3lvdXR1YmUuY28iLCJ1bmJsb2NreW91dHViZS5jby51ayIsInVuYmxvY2t5b3V0dWJlLmNvbSIsInVuYmxvY2t5b3V0dWJlLmNvbS5wayIsInVuYmxvY2t5b3V0dWJlLmV1IiwidW5ibG9ja3lvdXR1YmUubWUiLCJ1bmJsb2NreW91dHViZS5vcmciLCJ1bmJsb2NreW91dHViZS51cyIsInVuYmxvY2t5b3V0dWJlci5jb20iLCJ1bmJsb2cuZnIiLCJ1bmJsb29jay5jb20iLCJ1bmNlbnNvcmVkamFwYW5wb3JuLmNvbSIsInVuY3cuZWR1IiwidW5jeWNsb3BlZGlhLmluZm8iLCJ1bmN5Y2xvcGVkaWEudHciLCJ1bmRlcnNjb3JlanMub3JnIiwidW5kZXJ3b29kYW1tby5jb20iLCJ1bmktZ29ldHRpbmdlbi5kZSIsInVuaS5jYyIsInVuaWNhLnJvIiwidW5pY29ybi14LmNvbSIsInVuaWZpLml0IiwidW5pZmljYXRpb24ubmV0IiwidW5pb25lc2FyZGEuaXQiLCJ1bmlwYS5pdCIsInVuaXByb3h5LmNvbSIsInVuaXRlZGRhaWx5LmNvbS5teSIsInVuaXRlZHN0YXRlc3Byb3h5LmluZm8iLCJ1bml0bi5pdCIsInVuaXZlcnNhbC1tdXNpYy5kZSIsInVuaXZlcnNpdHlwcm94eS5uZXQiLCJ1bml4MTAwLmNvbSIsInVuaXhtZW4uY29tIiwidW5rbm93bnByb3h5LmNvbSIsInVubG9ja21ldGhpcy5jb20iLCJ1bm9kZWRvcy5jb20iLCJ1bnBvLm9yZyIsInVucmVzdHJpY3RlZC5iaXoiLCJ1bnNlZW4uaXMiLCJ1bnNvbG9jbGljLmluZm8iLCJ1bnRyYWNlYWJsZS51cyIsInVvbC5jb20uYnIiLCJ1cC5hYy56YSIsInVwY29taW5nLm5sIiwidXBkYXRlc21hcnVndWphcmF0LmluIiwidXBkYXRlc3Rhci5jb20iLCJ1cGxvYWQ0dS5pbmZvIiwidXBsb2FkZWQudG8iLCJ1cGxvYWRoZXJvLmNvIiwidXBsb2Fkcy5ydSIsInVwbG9hZHN0YXRpb24uY29tIiwidXI3cy5jb20iLCJ1cmEuZ292LnNnIiwidXJhc3VuZGF5LmNvbSIsInVyYmFub3V0Zml0dGVycy5jb20iLCJ1cmNoZWVreS5jb20iLCJ1cmdlbnRmdXJ5LmNvbSIsInVybC5jb20udHciLCJ1cmwxMC5vcmciLCJ1cmxtLmRlIiwidXJsdm9pZC5jb20iLCJ1cy1wcm94eS5vcmciLCJ1cy13ZWJwcm94eS5jb20iLCJ1cy50byIsInVzYS1wcm94eS5vcmciLCJ1c2EuZ292IiwidXNhZmFzdHByb3h5LmluZm8iLCJ1c2FpcC5ldSIsInVzYXByb3h5Lm9yZyIsInVzYXVwbG9hZC5uZXQiLCJ1c2F3ZWJwcm94eS5jb20iLCJ1c2F6bS5uZXQiLCJ1c2Npcy5nb3YiLCJ1c2VqdW1wLmNvbSIsInVzZXJsb2NhbC5qcCIsInVzZXJ0dWJlLmNvbSIsInVzZi5lZHUiLCJ1c2dzLmdvdiIsInVzaGlwLmNvbSIsInVzaGlzdG9yeS5vcmciLCJ1c3Byb3h5Lm51IiwidXNwcm94eTRmcmVlLmluZm8iLCJ1c3ByeHkuY29tIiwidXN0cmVhbS50diIsInVzd2VicHJveHkuY29tIiwidXN3ZWJwcm94eS51cyIsInV0YWguZ292IiwidXRvbS51cyIsInV0dWJlLXlvdXR1YmUuY29tIiwidXR1YmVjbGFzc2ljLmNvbSIsInV1Lm5sIiwidXUxNTguY29tIiwidXU4OTguY29tIiwidXVleXkuY29tIiwidXVrdC5pZHYudHciLCJ1dXJ0LmNjIiwidXVzaGFyZS5jb20iLCJ1dmEubmwiLCJ1dmlkaS5jb20iLCJ1dy5odSIsInV3YW50cy5jb20iLCJ1d2FudHMubmV0IiwidXdpLmVkdSIsInV5YmJiLmNvbSIsInV5Z2h1ci5jby51ayIsInV5Z2h1cmFtZXJpY2FuLm9yZyIsInV5Z2h1cmNvbmdyZXNzLm9yZyIsInV5Z2h1cm5ldC5vcmciLCJ1eWdodXJwcmVzcy5jb20iLCJ1eWhld2VyLmJpeiIsInV5bWFhcmlwLmNvbSIsInV5c2FtLm9yZyIsInV5dHR0LmNvbSIsInV6YmVrd2ViLm5ldCIsInV6b3I0aWsucnUiLCJ1enVtLnR2IiwidjA3OTEuY29tIiwidjV5eS5jb20iLCJ2Ni5mYWNlYm9vay5jb20iLCJ2YW42OTguY29tIiwidmFuY2l0eWJ1enouY29tIiwidmFuZGVyYmlsdC5lZHUiLCJ2YW5lbXUuY24iLCJ2YW5ndWFyZGlhLmNvbS5teCIsInZhbmlsbGEtanAuY29tIiwidmFuaXR5ZmFpci5jb20iLCJ2YW5wZW9wbGUuY29tIiwidmFuc2t5LmNvbSIsInZjZDAxLmNvbSIsInZjcmlja2V0LmNvbSIsInZjdXBib3NzLmNvbSIsInZjdXBib3gubmV0IiwidmR5b3V0dWJlLmNvbSIsInZlYjQuaW5mbyIsInZlY2xpcC5jb20iLCJ2ZWN0cm9wcm94eS5jb20iLCJ2ZWdhZGlzay5jb20iLCJ2ZWdhc21wZWdzLmNvbSIsInZlZ2FzcmVkLmNvbSIsInZlZ29ycGVkZXJzZW4uY29tIiwidmVpbmZvcG9ydGFsLmNvbSIsInZlbGthZXBvY2hhLnNrIiwidmVvaC5jb20iLCJ2ZXJpem9uLm5ldCIsInZlcnBlbGljdWxheW91dHViZS5jb20iLCJ2ZXJzYXZwbi5jb20iLCJ2ZXJzdXMuY29tIiwidmVydGJhdWRldC5mciIsInZlcnl4ZS5jb20iLCJ2ZnQuY29tLnR3IiwidmcyNDcuY29tIiwidmkubmwiLCJ2aWFqYXIuY29tIiwidmlhbWljaGVsaW4uZGUiLCJ2aWFtaWNoZWxpbi5mciIsInZpYmVyLmNvbSIsInZpY2t5ZnVjay5jb20iLCJ2aWN0aW1zb2Zjb21tdW5pc20ub3JnIiwidmlkYWwuZnIiLCJ2aWRlby1obmVkLmNvbSIsInZpZGVvLXByb3h5LmNvbSIsInZpZGVvYmFtLmNvbSIsInZpZGVvYm94LmNvbSIsInZpZGVvY2hhdGZvcmZhY2Vib29rLmNvbSIsInZpZGVvZGV0ZWN0aXZlLmNvbSIsInZpZGVvZWdnLmNvbSIsInZpZGVvaXhpci5uZXQiLCJ2aWRlb21heGltdW0uY29tIiwidmlkZW9tby5jb20iLCJ2aWRlb3BlZGlhd29ybGQuY29tIiwidmlkZW9waGltLm5ldCIsInZpZGVvc3EuY29tIiwidmlkZW90cmFrZXIuY29tIiwidmlkZW91cmkuY29tIiwidmlkb2V2by5jb20iLCJ2aWRvc2VyLmNvbS51YSIsInZpZG9zZXIub3JnIiwidmlkcHJveHkuY29tIiwidmlkc2Z1Y2tlci5jb20iLCJ2aWR1YmEuY29tIiwidmlkdWxlLmNvbSIsInZpZW1vLmNvbSIsInZpZXRiZXQuY29tIiwidmlldGRhaWt5bmd1eWVuLmNvbSIsInZpZXRmdW4uY29tIiwidmlldG5hbXBsdXMudm4iLCJ2aWV0dmlkZW9zLnR2IiwidmlldHZpZGVvcy52biIsInZpbWVvLmNvbSIsInZpbnRhZ2VjdXRpZXMuY29tIiwidmludGFnZWZldGlzaC5uZXQiLCJ2aW50YWdleGhhbXN0ZXIuY29tIiwidmlwLXpvbmEuYml6IiwidmlwdHViZS5jb20iLCJ2aXJhbHBvcm4uY29tIiwidmlyYWx0YWcuY29tIiwidmlyZ2ludHJhaW5zLmNvLnVrIiwidmlzaWJsZXR3ZWV0cy5jb20iLCJ2aXNpdG9yc3dlYXRlci5ldSIsInZpc2l0c2NvdGxhbmQuY29tIiwidmlzdWFsbGlnaHRib3guY29tIiwidml0LmFjLmluIiwidml0dXRvci5jb20iLCJ2aXZhdHViZS5jb20iLCJ2aXZ0aG9tYXMuY29tIiwidml5b3V0dWJlLmNvbSIsInZpemFyZHNndW5zYW5kYW1tby5jb20iLCJ2aXpsZS50diIsInZqbWVkaWEuY29tLmhrIiwidmtpbm90aWsubmV0Iiwidmt1c3NvdmV0LnJ1Iiwidm9hLm1vYmkiLCJ2b2FjYW50b25lc2UuY29tIiwidm9hY2hpbmVzZS5jb20iLCJ2b2FjaGluZXNlYmxvZy5jb20iLCJ2b2FmYW50aS5jb20iLCJ2b2FuZXdzLmNvbSIsInZvYXRpYmV0YW4uY29tIiwidm9kb3VtZWRpYS5jb20iLCJ2b2d1ZS5jby51ayIsInZvZ3VlLmNvbSIsInZvaWNpLW5ld3MuZnIiLCJ2b2xhbnRpbm9mYWNpbGUuaXQiLCJ2b2x2b2NhcnMuY29tIiwidm9ydGVyaXguY29tIiwidm90Lm9yZyIsInZvdm8yMDAwLmNvbSIsInZvdm9rYW4uY29tIiwidm94ZXIuY29tIiwidm95LmNvbSIsInZveWV1cmRhaWx5LmNvbSIsInZwYWxzeXN0ZW0uY29tIiwidnBuMzMzLmNvbSIsInZwbjRhbGwuY29tIiwidnBuYWNjb3VudC5vcmciLCJ2cG5hY2NvdW50cy5jb20iLCJ2cG5ib29rLmNvbSIsInZwbmJyb3dzZS5jb20iLCJ2cG5jbG91ZC5tZSIsInZwbmN1cC5jb20iLCJ2cG5kYXF1YW4uY29tIiwidnBuZGVsdXhlLmNvbSIsInZwbmdhdGUuanAiLCJ2cG5nYXRlLm5ldCIsInZwbmludG91Y2guY29tIiwidnBuaXAubmV0IiwidnBubWFzdGVyLmNvbSIsInZwbm1hc3Rlci5vcmciLCJ2cG5vbmVjbGljay5jb20iLCJ2cG5waWNrLmNvbSIsInZwbnJlYWN0b3IuY29tIiwidnBuc2VjdXJlLm1lIiwidnBuc3AuY29tIiwidnBuc3Rlci5jb20iLCJ2cG50aW1lLmNvbSIsInZwbnRyYWZmaWMuY29tIiwidnBudHVubmVsLmNvbSIsInZwbnR1bm5lbC5uZXQiLCJ2cG51ay5pbmZvIiwidnBudW5sb2NrLmFzaWEiLCJ2cG52aXAuY29tIiwidnBvcm4uY29tIiwidnNsb3ZldHYuY29tIiwidnR1bm5lbC5jb20iLCJ2dHVubmVsLnBrIiwidnUuZWR1LmF1IiwidnVjbGlwLmNvbSIsInZ1ZmFjZWJvb2suY29tIiwidnVrYWpsaWphLmNvbSIsInZ1a3UucnUiLCJ2dW5ibG9jay5jb20iLCJ2dXJibW90by5jb20iLCJ2dXNheS5jb20iLCJ2dXplLmNvbSIsInZ3di5jbiIsInczLm9yZyIsIndhLWNvbS5jb20iLCJ3YWR0dWJlLmNvbSIsIndhaGFzLmNvbSIsIndhaWdhb2J1LmNvbSIsIndhaWtldW5nLmluZm8iLCJ3YWl3YWllci5jb20iLCJ3YWtlZ292LmNvbSIsIndha3dhay1mYWNlYm9vay5jb20iLCJ3YWxsb25pZS5iZSIsIndhbGxwYXBlci5jb20iLCJ3YW1kYS5jb20iLCJ3YW4tcHJlc3Mub3JnIiwid2FuZ2ppbmJvLm9yZyIsIndhbmdvLm9yZyIsIndhbmdydW93YW5nLm9yZyIsIndhbmsudG8iLCJ3YW5rdHViZS5jb20iLCJ3YW5tbS5pbmZvIiwid2FudC1kYWlseS5jb20iLCJ3YW50Y2hpbmF0aW1lcy5jb20iLCJ3YW50eW91dHViZS5jb20iLCJ3YXByb3h5LmNvbSIsIndhcW4uY29tIiwid2FyYXRhaHMuY29tLmF1Iiwid2FyZWhvdXNlMzMzLmNvbSIsIndhcmdhbWV5YXUubmV0Iiwid2FzZWxwcm8uY29tIiwid2F0Y2hpbmVzZS5jb20iLCJ3YXRjaG15Z2YubmV0Iiwid2F0Y2hwcm94eS5jb20iLCJ3YXRjaHh0dWJlLmNvbSIsIndhdHRwYWQuY29tIiwid2F0dHBhZC5jb20uYnIiLCJ3YXV3YWEuY29tIiwid2F3d3Byb3h5Lm9yZyIsIndheWJpZy5jb20iLCJ3YnhpYS5jb20iLCJ3ZGF6LmNvbSIsIndkY2RuLm5ldCIsIndlYXJlLmhrIiwid2VhcmVoYWlyeS5jb20iLCJ3ZWFybi5jb20iLCJ3ZWI0cHJveHkuY29tIiwid2ViYW5vbnltZS5jb20iLCJ3ZWJhbm9ueW1pemVyLm9yZyIsIndlYmFwcGVycy5jb20iLCJ3ZWJhdWxhLmNvbS5iciIsIndlYmJlZS5pbmZvIiwid2ViY2FtcHJveHkuY29tIiwid2ViY2Ftcy5jb20iLCJ3ZWJkZXNpZ25yZWNpcGVzLmNvbSIsIndlYmVtcHJlc2EuY29tIiwid2ViZXZhZGUuY29tIiwid2ViZmxvdy5jb20iLCJ3ZWJmcmVlci5jb20iLCJ3ZWJsYWd1LmNvbSIsIndlYmxvZ3Nwb3QuY29tIiwid2VibWFpbC5jby56YSIsIndlYm1hc3RhLm9yZyIsIndlYm5ld2NyZWRpdC5uYW1lIiwid2VicGFnZS5pZHYudHciLCJ3ZWJwcm94aWVzLm9yZyIsIndlYnByb3h5LXNlcnZlci5jb20iLCJ3ZWJwcm94eS1zZXJ2aWNlLmRlIiwid2VicHJveHkuYXQiLCJ3ZWJwcm94eS5jYSIsIndlYnByb3h5LmNvbS5kZSIsIndlYnByb3h5LmV1Iiwid2VicHJveHkuaHUiLCJ3ZWJwcm94eS5sYSIsIndlYnByb3h5Lm5ldCIsIndlYnByb3h5LnBrIiwid2VicHJveHkucnUiLCJ3ZWJwcm94eS50byIsIndlYnByb3h5Lnl0Iiwid2VicHJveHlmcmVlLm5ldCIsIndlYnByb3h5ZnJlZS5vcmciLCJ3ZWJwcm94eWxpc3QuYml6Iiwid2VicHJveHlzZXJ2ZXIubmV0Iiwid2VicHJveHl1c2EuY29tIiwid2VicnVzaC5uZXQiLCJ3ZWJzaXRlcHJveHlzaXRlLmNvbSIsIndlYnN0ZXJvbmxpbmUuY29tIiwid2Vic3VyZi5pbiIsIndlYnRhbGtmb3J1bXMuY29tIiwid2VidGViLmNvbSIsIndlYnR1bm5lbC5vcmciLCJ3ZWJ3YXJwZXIubmV0Iiwid2ViemVzdHkubmV0Iiwid2VlYmx5LmNvbSIsIndlZWttYWcuaW5mbyIsIndlZmlnaHRjZW5zb3JzaGlwLm9yZyIsIndlZm9uZy5jb20iLCJ3ZWdvLmNvLmluIiwid2VpYm9sZWFrLmNvbSIsIndlaWR1aS5jbiIsIndlaWdlZ2VieWMuZHJlYW1ob3N0ZXJzLmNvbSIsIndlaW1pbmcuaW5mbyIsIndlaXJkaGVudGFpLmNvbSIsIndlbG92ZWNvY2suY29tIiwid2Vsb3ZldHViZS5jb20iLCJ3ZW4ucnUiLCJ3ZW5nZXdhbmcub3JnIiwid2VuaHVpLmNoIiwid2VueHVlY2l0eS5jb20iLCJ3ZW54dWV3dS5jb20iLCJ3ZW55dW5jaGFvLmNvbSIsIndlcmljaC5pZHYudHciLCJ3ZXJrLm5sIiwid2VybWFjaHR3YXMuaW5mbyIsIndlc3RjYS5jb20iLCJ3ZXN0amV0LmNvbSIsIndlc3RraXQubmV0Iiwid2V0MTIzLmNvbSIsIndldG1pa2UuY29tIiwid2V0cGxhY2UuY29tIiwid2V3ZWhpLmluZm8iLCJ3Zm16LmNvbSIsIndmb3J1bS5jb20iLCJ3Z2FsLmNvbSIsIndoYWFreS5jby5pbiIsIndoYXRibG9ja2VkLmNvbSIsIndoYXRoaWZpLmNvbSIsIndoYXRpc215aXBhZGRyZXNzLmNvbSIsIndoYXRzdGhlc2NvcmUuY29tIiwid2hlbmRpZHlvdWpvaW50d2l0dGVyLmNvbSIsIndoaXBwZWRhc3MuY29tIiwid2hpdGVibGFja3NleC5uZXQiLCJ3aG9pcy5jb20iLCJ3aG9sZTMwLmNvbSIsIndob293bnNmYWNlYm9vay5jb20iLCJ3aG9yZXNvZmluc3RhZ3JhbS5jb20iLCJ3aG9yZXN0dWJlLmNvbSIsIndob3RhbGtpbmcuY29tIiwid2h5ZG9udHlvdXRyeXRoaXMuY29tIiwid2h5ZXdhbmcuY29tIiwid2h5cHJveHkuY29tIiwid2llZnkuY29tIiwid2lmZS14eHguY29tIiwid2lmZW1vdmllcy5uZXQiLCJ3aWZpLmdvdi5oayIsIndpa2lhLmNvbSIsIndpa2lhcnQub3JnIiwid2lraWJvb2tzLm9yZyIsIndpa2lsZWFrcy5vcmciLCJ3aWtpbWVkaWEub3JnIiwid2lraW1lZGlhLm9yZy5tbyIsIndpa2luZXdzLm9yZyIsIndpa2lwZWRpYS5vcmciLCJ3aWtpc291cmNlLm9yZyIsIndpa3NhLmNvbSIsIndpbGRhbW1vLmNvbSIsIndpbGRmYWNlYm9vay5jb20iLCJ3aWxkamFwYW5wb3JuLmNvbSIsIndpbGRwcm94eS5uZXQiLCJ3aWxkdG9ydHVyZS5jb20iLCJ3aWxkem9vc2V4Lm5ldCIsIndpbGxpYW1oaWxsLmNvbSIsIndpbmQuZ3IiLCJ3aW5kb3dzLWNvZGVjLmNvbSIsIndpbmRvd3NtZWRpYS5jb20iLCJ3aW5wb3JuLmNvbSIsIndpcG8uaW50Iiwid2lzZG9tcHVicy5vcmciLCJ3aXNldmlkLmNvbSIsIndpdG9waWEubmV0Iiwid2l6YXJkb2ZvZGRzLmNvbSIsIndtem9uYS5jb20iLCJ3by50YyIsIndvYWljYW9jYW8uY29tIiwid29haXdvYWlzZTEuaW5mbyIsIndvZmFjYWkuY29tIiwid29sZm9mbGFkYnJva2VzLmNvbSIsIndvbGZyYW1hbHBoYS5jb20iLCJ3b21lbm9ubHkuZ3IiLCJ3b21lbnNyaWdodHNvZmNoaW5hLm9yZyIsIndvbWVud2ViLmRlIiwid29vb2wxMjMuY29tIiwid29vcGllLmpwIiwid29vdnBuLmNvbSIsIndvcmRwcmVzcy5jb20iLCJ3b3JkcHJlc3Mub3JnIiwid29yZHJlZmVyZW5jZS5jb20iLCJ3b3JraW5yZXRhaWwuY29tIiwid29ybGRjYXQub3JnIiwid29ybGRqb3VybmFsLmNvbSIsIndvcmxkbWFya2V0LmNvbSIsIndvcmxkbmV3czAxLmNvbSIsIndvcmxkc2V4OC5jb20iLCJ3b3JsZHZwbi5uZXQiLCJ3b3J0aDEwMDAuY29tIiwid29zZWJhci5jb20iLCJ3b3ctY2xlYXIucnUiLCJ3b3lhby5jbCIsIndwaXRhbHkuaXQiLCJ3cGxyLmNvbSIsIndwbmV3LnJ1Iiwid3BvZm9ydW0uY29tIiwid3FsaHcuY29tIiwid3F5ZC5vcmciLCJ3cmFkaW8uY29tLm14Iiwid3JjLmNvbSIsIndyZXN0bGluZ2luYy5jb20iLCJ3cmV0Y2guY2MiLCJ3cml0ZWxvbmdlci5jb20iLCJ3c2otYXNpYS5jb20iLCJ3c2ouY29tIiwid3N3cy5vcmciLCJ3dGZwZW9wbGUuY29tIiwid3VhaXR4dC5jb20iLCJ3dWFsYS5jb20iLCJ3dWZpLm9yZy50dyIsInd1amllbGl1bGFuLmNvbSIsInd1eXVldGFuLmNvbSIsInd1emhvdWNsaWNrLmNvbSIsInd3ZS5jb20iLCJ3d2l0di5jb20iLCJ3d3cuYW03MzAuY29tLmhrIiwid3d3LmZyZWVwcm94eXNlcnZlci51ayIsInd3dy5ob21lZnRwLm5ldCIsInd3dy5pbmZvLnZuIiwid3d3Lm5ld2NlbnR1cnluZXdzLmNvbSIsInd3dy5yaGNsb3VkLmNvbSIsInd5Ym9yY3phLmJpeiIsInd5ZmY0LmNvbSIsInd5bWZ3Lm9yZyIsInd5dDc1MC5jb20iLCJ4LWZpbGUuY29tLmFyIiwieC1udWRpc20ubmV0IiwieC10b3JyZW50cy5vcmciLCJ4MmRzLmNvbSIsIngzNjV4LmNvbSIsIngzeHR1YmUuY29tIiwieDR4Ni5jb20iLCJ4NzcyMDE1Lm5ldCIsIng3NzgwLm5ldCIsIng3Nzg2LmNvbSIsIng4MzEuY29tIiwieDhjY3R2Lm5ldCIsIng4c2VvLmNvbSIsInhhcnRnaXJscy5jb20iLCJ4YXJ0aHVudGVyLmNvbSIsInhhcnRudWRlcy5jb20iLCJ4YXYxLmluZm8iLCJ4YXYzLmluZm8iLCJ4YXh0dWJlLmNvbSIsInhiYWJlLmNvbSIsInhib29rY24uY29tIiwieGJvb2tjbi5uZXQiLCJ4Y2hhbmdlLmNjIiwieGNpdHkuanAiLCJ4Y295b3RlLmNvbSIsInhjcml0aWMuY29tIiwieGN0c2cub3JnIiwieGZjdW4uY29tIiwieGZpbGVzLnRvIiwieGZtLnBwLnJ1IiwieGZyZWVob3N0aW5nLmNvbSIsInhmeXMuaW5mbyIsInhmeXNsdS5jb20iLCJ4aGFtc3Rlci5jb20iLCJ4aGFtc3Rlci52YyIsInhoYW1zdGVyY2Ftcy5jb20iLCJ4aGFtc3RlcmhxLmNvbSIsInhpYWF2LmNjIiwieGlhbzc3LmNjIiwieGlhbzc3Ni5jb20iLCJ4aWFvY2h1bmNuanAuY29tIiwieGlhb2QuaW4iLCJ4aWFvaGV4aWUuY29tIiwieGlhb2xpLmNjIiwieGlhb21pLmluLnVhIiwieGlhb3NlZ2UuY29tIiwieGlkb25nLm5ldCIsInhpZXNodWxvdS5jb20iLCJ4aWV6aS51cyIsInhpaHVhLmVzIiwieGluZy5jb20iLCJ4aW5nYmFubzEuY29tIiwieGluZ2JheW91bmkubmV0IiwieGlubWlhby5jb20uaGsiLCJ4aW5zaGVuZy5uZXQiLCJ4aW53ZW5zaG93LmNvbSIsInhpbnhpMzM2Ni5jb20iLCJ4aW55dWJicy5uZXQiLCJ4aXRlbm93LmNvbSIsInhqYXYudHYiLCJ4aml6ei5jb20iLCJ4bC5wdCIsInhtb2RlbHBpY3MuY29tIiwieG52aWV3LmNvbSIsInhueHguYmxvZy5iciIsInhueHhmYWNlYm9vay5jb20iLCJ4b2NhdC5jb20iLCJ4cG9ybmtpbmcuY29tIiwieHFpZGlhbi5jb20iLCJ4cWl1bWkuY29tIiwieHJiYS5uZXQiLCJ4cmVhLmNvbSIsInhyZW50ZHZkLmNvbSIsInhyZXN0Lm5ldCIsInhzZWppZS5pbmZvIiwieHNreXdhbGtlci5jb20iLCJ4dGVjLmNhdCIsInh0aG9zdC5pbmZvIiwieHRsYmIuY29tIiwieHRyYXNpemUucGwiLCJ4dHViZS5jb20iLCJ4dTQubmV0IiwieHVpdGUubmV0IiwieHVuYmxvY2suY29tIiwieHV4dWxlLm5ldCIsInh2aWRlby5jYyIsInh2aWRlb3MtZmMyLmNvbSIsInh2aWRlb3MuY29tIiwieHZpZGVvcy5jb20uYnIiLCJ4dmlkZW9zLmNvbS5ieiIsInh2aWRlb3MuY29tLmVzIiwieHZpZGVvcy5jb20ubXgiLCJ4dmlkZW9zLmVzIiwieHZpZGVvcy5qcCIsInh2aWRlb3NmYzIuY29tIiwieHZpZGVvc3EuY29tIiwieHgzMy51cyIsInh4YmJ4LmNvbSIsInh4ZXJvbmV0eHguaW5mbyIsInh4a3h3LmNvbSIsInh4bG1vdmllcy5jb20iLCJ4eG9veXkub3JnIiwieHh4LXhoYW1zdGVyLmNvbSIsInh4eC15b3V0dWJlLmNvbSIsInh4eC5jb20iLCJ4eHguY29tLmVzIiwieHh4LmNvbS5teCIsInh4eC5jb20ucHkiLCJ4eHgueHh4IiwieHh4ZGVzc2VydC5jb20iLCJ4eHhob3N0Lm1lIiwieHh4cGFuZGEuY29tIiwieHh4c3Rhc2guY29tIiwieHh4dHYubWUiLCJ4eHh4LmNvbS5hdSIsInh4eHhzZXh0dWJlLmNvbSIsInh4eHltb3ZpZXMuY29tIiwieHh5cy5jYyIsInh4eXkxMjMuY29tIiwieHlzLm9yZyIsInh5ejU2Ni5uZXQiLCJ4emdvZC5uZXQiLCJ5YWJlYi5jb20iLCJ5YWNraXR5LXlhay5jb20iLCJ5YWQyLmNvLmlsIiwieWFob28uY28uanAiLCJ5YWhvby5jb20iLCJ5YWhvby5jb20uaGsiLCJ5YWhvby5jb20udHciLCJ5YWhvby5qcCIsInlha21vdmllcy5jb20iLCJ5YWxhZmFjZWJvb2suY29tIiwieWFuZW4ub3JnIiwieWFwcm94eS5jb20iLCJ5YXNha2xpLm5ldCIsInlhc25pLmNvLnVrIiwieWFzbmkuY29tIiwieWFzdWt1bmkub3IuanAiLCJ5YXlhYmF5Lm5ldCIsInlheXBldGl0ZXRlZW5zLmNvbSIsInlkeGsuY24iLCJ5ZHkuY29tIiwieWVodWEub3JnIiwieWVsbG93cHJveHkubmV0IiwieWVuaWRlbmVyZ2VuZWtvbi5jb20iLCJ5ZXB0dWJlLmNvbSIsInllc2JhbmsuY28uaW4iLCJ5ZXN3YXJlLmNvbSIsInlleWVsdS5jb20iLCJ5aS5vcmciLCJ5aWJhZGEuY29tIiwieWliaWFuLmlkdi50dyIsInlpZGlvLmNvbSIsInlpZnktdG9ycmVudC5vcmciLCJ5aWdlc2UudXMiLCJ5aWt5YWthcHAuY29tIiwieWlsZGl6LmVkdS50ciIsInlpbWcuY29tIiwieWluZ2NoYW84LmNvbSIsInlpbmxhb2hhbi51cyIsInlpbnJlbnNlLmNvbSIsInlpcHViLmNvbSIsInlpd3Vnb3UuY29tIiwieWl4aW5namlhLmluZm8iLCJ5aXlpLmNjIiwieWxlLmZpIiwieW1haWwuY29tIiwieW1rYS50diIsInltb2JpbGUuanAiLCJ5bzE2OC5uZXQiLCJ5b2J0LmNvbSIsInlvYnQudHYiLCJ5b2dlc2hibG9nc3BvdC5jb20iLCJ5b2dpY2hlbi5vcmciLCJ5b2ppenouY29tIiwieW9sdGFyaWZpLmNvbSIsInlvbmtpcy5jb20iLCJ5b3JrYmJzLmNhIiwieW91LXlvdXR1YmUuY29tIiwieW91Y2FuaGlkZS5uZXQiLCJ5b3VjZWY4NS5jb20iLCJ5b3VkZXNpci5jb20iLCJ5b3VkaWFuLmluIiwieW91ZmNrLmNvbSIsInlvdWppa2FuMS5jb20iLCJ5b3VqaXp6LmNvbSIsInlvdWppenoubmV0IiwieW91am9vbWxhLmluZm8iLCJ5b3Vqb3R1YmUuY29tIiwieW91bGlrZXRlZW5zLmNvbSIsInlvdW1ha2VyLmNvbSIsInlvdW1wMy5tb2JpIiwieW91bmdhdGhlYXJ0bW9tbXkuY29tIiwieW91bmdmYXR0aWVzLmNvbSIsInlvdW5nZ2lybHMtc2V4LmNvbSIsInlvdW5nbGVhZnMuY29tIiwieW91bmdwb3JudHViZS5jb20iLCJ5b3VuZ3RlZW5zZXhoZC5jb20iLCJ5b3VuaXZlcnNhbG1lZGlhLmNvbSIsInlvdXBhaS5vcmciLCJ5b3Vwb3JuLmNvbSIsInlvdXBvcm4uY29tLmJ6IiwieW91cHJveHkub3JnIiwieW91cHJveHl0dWJlLmNvbSIsInlvdXItZnJlZWRvbS5uZXQiLCJ5b3VybHVzdC5jb20iLCJ5b3VybWVkaWFocS5jb20iLCJ5b3VydHYuY29tLmF1IiwieW91c2VuZGl0LmNvbSIsInlvdXRobmV0cmFkaW8ub3JnIiwieW91dGh3YW50LmNvbS50dyIsInlvdXRyYW5ueXR1YmUuY29tIiwieW91dHUuYmUiLCJ5b3V0dWJlLW1wMy5jb20iLCJ5b3V0dWJlLW5vY29va2llLmNvbSIsInlvdXR1YmUtdW5sb2NrLmNvbSIsInlvdXR1YmUuYmUiLCJ5b3V0dWJlLmNvbSIsInlvdXR1YmUuY29tLmJyIiwieW91dHViZS5jb20uY28iLCJ5b3V0dWJlY24uY29tIiwieW91dHViZWZyZWVwcm94eS5jb20iLCJ5b3V0dWJlcHJveHkuY28iLCJ5b3V0dWJlcHJveHkub3JnIiwieW91dHViZXByb3h5LnBrIiwieW91dHViZXVuYmxvY2tlZC5vcmciLCJ5b3V0dWJldW5ibG9ja2VyLm9yZyIsInlvdXR1YmV4eW91dHViZS5jb20iLCJ5b3V2ZXJzaW9uLmNvbSIsInlvdXdhdGNoLm9yZyIsInlvdXh1LmluZm8iLCJ5cG1hdGUuY29tIiwieXNsYW5nLmNvbSIsInl0aW1nLmNvbSIsInl0ai5maSIsInl0cy5yZSIsInl1a2EuaWR2LnR3IiwieXVraW55YW4uaW5mbyIsInl1bmJsb2NrLmNvbSIsInl1bmJsb2NrZXIuaW5mbyIsInl1dGFva2VqaS5jb20iLCJ5dXZ1dHUuY29tIiwieXZlc3JvY2hlcnVzYS5jb20iLCJ5dnYuY24iLCJ5d3R4LmNjIiwieXg1MS5uZXQiLCJ5eWhhY2tlci5jb20iLCJ5eWlpLm9yZyIsInl5cGVuZy5jb20iLCJ5eXNlZHkuY29tIiwieXp6ay5jb20iLCJ6OTUzLmNhIiwiemFjZWJvb2suY29tIiwiemFjZWJvb2tway5jb20iLCJ6YWxtb3MuY29tIiwiemFsbW9zLnBrIiwiemFvYmFvLmNvbSIsInphb2Jhby5jb20uc2ciLCJ6YW96b24uY29tIiwiemFwLmNvLmlsIiwiemFwanVlZ29zLmNvbSIsInphdXJ1cy5vcmcudWsiLCJ6Ymlvcm5pay5jb20iLCJ6ZG5ldC5jb20udHciLCJ6ZG5ldC5kZSIsInplbGRhd2lraS5vcmciLCJ6ZWxrYS5vcmciLCJ6ZWxsby5jb20iLCJ6ZW4tY2FydC5jb20iLCJ6ZW5kMi5jb20iLCJ6ZW5kcHJveHkuY29tIiwiemVuZ2ppbnlhbi5vcmciLCJ6ZW5pdGhvcHRpb25zLmNvbSIsInplcm90dW5uZWwuY29tIiwiemVydHViZS5jb20iLCJ6ZXJ4LnR2IiwiemV0YWdhbGxlcmllcy5jb20iLCJ6Zi5ybyIsInpmcmVldC5jb20iLCJ6ZnJlZXouY29tIiwiemhhbmJpbi5uZXQiLCJ6aGFva2FpZmFuZy5jb20iLCJ6aGFveW4uY29tIiwiemhlLmxhIiwiemhlbmdqaWFuLm9yZyIsInpoZW5nd3VuZXQub3JnIiwiemhlbmprLmNvbSIsInpoaWJvOC5jYyIsInpoaW5lbmdsdXlvdS5jb20iLCJ6aG9uZy5wcC5ydSIsInpob25nZ3VvYmFvLm5ldCIsInpodWljaGFndW9qaS5vcmciLCJ6aHVsaXU1MjAuY29tIiwiemlkZHUuY29tIiwiemlkZW8ubmwiLCJ6aW5pby5jb20iLCJ6aXBhaTk5Lm5ldCIsInppcGFuZ2Nhc2luby5jb20iLCJ6aXBvcm4uY29tIiwiemlwdHQuY29tIiwieml5dWFuNS5jb20iLCJ6amJkdC5jb20iLCJ6anlwdy5jb20iLCJ6a2FpcC5jb20iLCJ6bGwuaW4iLCJ6bHZjLm5ldCIsInpuLnVhIiwiem9oby5jb20iLCJ6b2xsLWF1a3Rpb24uZGUiLCJ6b21iaWVnYS5nYSIsInpvbmFldXJvcGEuY29tIiwiem9uYmxlLm5ldCIsInpvby1mdWNrLm5ldCIsInpvb21ieS5ydSIsInpvb3Nob2NrLmNvbSIsInpvb3RvZGF5LmNvbSIsInpvb3Rvb2wuY29tIiwiem9vemxlLm5ldCIsInpvcnBpYS5jb20iLCJ6cXpqLm5ldCIsInpzODA4MC5jb20iLCJ6c2hhcmUubmV0IiwienNyaGFvLmNvbSIsInp0dW5uZWwuY29tIiwienVhcnkuY29tIiwienVvLmxhIiwienVvbGEuY29tIiwienV5b3V0dWJlLmNvbSIsInp1emF6dS5jb20iLCJ6dmVudHMuY29tIiwiendpbmt5LmNvbSIsInp4YzIyLmlkdi50dyIsInp6ODA4MC5jb20iLCJ6emIuYnoiXQ=="
+var base64ProxiedSites = "WyIwMDAwYS1mYXN0LXByb3h5LmRlIiwiMDAwZHkuY29tIiwiMDAwcHJveHkuaW5mbyIsIjAwMjcxLmNvbSIsIjAwN3NuLmNvbSIsIjAxMGx5LmNvbSIsIjAxMTEuY29tLmF1IiwiMDEyNnd5dC5jb20iLCIwMjB1c2EuY29tIiwiMDMzYi5jb20iLCIwNjNnLmNvbSIsIjA2NjYuaW5mbyIsIjA2NjguY2MiLCIwNzMuY2MiLCIwNzM3d2VhbC5jb20iLCIwODA5OS5jb20iLCIwOWNhby5jb20iLCIwZGF5LmtpZXYudWEiLCIwcnoudHciLCIxLWFwcGxlLmNvbS50dyIsIjEwMDAudHYiLCIxMDAwODYwMDA2LmNvbSIsIjEwMDBnaXJpLm5ldCIsIjEwMDBpZGVhc2RlbmVnb2Npb3MuY29tIiwiMTAwMGthbi5jb20iLCIxMDBwLWRvdWdhLmNvbSIsIjEwMjQwLmNvbS5hciIsIjEwMjRnby5pbmZvIiwiMTAzMG9rLmNvbSIsIjEwbW92cy5jb20iLCIxMHJlbnRpLmNvbSIsIjEwdGltZXMuY29tIiwiMTB4ancuY29tIiwiMTB5b3V0dWJlLmNvbSIsIjExMHNlLmNvbSIsIjExMS5jb20iLCIxMTEuY29tLmNuIiwiMTExMTExLmNvbS50dyIsIjExMTF0cC5jb20iLCIxMTFneC5jb20iLCIxMTYxMzkuY29tIiwiMTE2ODgubmV0IiwiMTFmZmJiLmNvbSIsIjExZm94eS5jb20iLCIxMWhraGsuY29tIiwiMTFway5uZXQiLCIxMjM0LmNvbSIsIjEyMzQ1cHJveHkuY28iLCIxMjM0NXByb3h5LmluZm8iLCIxMjM0NXByb3h5Lm5ldCIsIjEyMzQ1cHJveHkub3JnIiwiMTIzYm9tYi5jb20iLCIxMjNyZi5jb20iLCIxMmJldC5jb20iLCIxMnNlY29uZGNvbW11dGUuY29tIiwiMTJ2cG4uY29tIiwiMTJ2cG4ubmV0IiwiMTMyMzcuY29tIiwiMTM5Z2FuLmNvbSIsIjEzZGVhbHMuY29tIiwiMTQwZGV2LmNvbSIsIjE0MTQuZGUiLCIxNDF0dWJlLmNvbSIsIjE0N3JyLmNvbSIsIjE1NWdhbWUuY29tIiwiMTVjYW8uY29tIiwiMTYxc2V4LmNvbSIsIjE2ODguY29tLmF1IiwiMTZtYXBsZS5jb20iLCIxNzNuZy5jb20iLCIxNzd3eXQuY29tIiwiMTd0MTdwLmNvbSIsIjE3d3RsYmIuY29tIiwiMTgtMjEtdGVlbnMuY29tIiwiMTgtc2Nob29sZ2lybHouY29tIiwiMTgtc2V4LnVzIiwiMTgwMGZsb3dlcnMuY29tIiwiMTgwd2FuLmNvbSIsIjE4YXZvay51cyIsIjE4ZGFvLmNvbSIsIjE4amFjay5jb20iLCIxOG9ubHlnaXJscy5jb20iLCIxOHB1c3N5Y2x1Yi5jb20iLCIxOHZpcmdpbnNleC5jb20iLCIxOHhncm91cC5jb20iLCIxOTg0YmJzLm9yZyIsIjFiYW8ub3JnIiwiMWMucnUiLCIxZHB3LmNvbSIsIjFlZXcuY29tIiwiMWZpY2hpZXIuY29tIiwiMWh1aXN1by5uZXQiLCIxa2FuLmNvbSIsIjFwcm94eS5kZSIsIjFzdC1nYW1lLm5ldCIsIjFzdG9wY24uY29tIiwiMXN0d2ViZ2FtZS5jb20iLCIyLWhhbmQuaW5mbyIsIjItcG9ybi5jb20iLCIyMDAwYm8uY29tIiwiMjAwMGZ1bi5jb20iLCIyMDAwbW92LmNvbSIsIjIwMDgud3MiLCIyMDA4eGlhbnpoYW5nLmluZm8iLCIyMDEyc2UuaW5mbyIsIjIwMTJ0dC5jb20iLCIyMDE0bm5uLmNvbSIsIjIwamFjay5jb20iLCIyMG1pbnV0b3MudHYiLCIyMHlvdHViZS5jb20iLCIyMTEyMTEyLm5ldCIsIjIxMXp5LmNvbSIsIjIxM3l5LmNvbSIsIjIxc2V4dHVyeS5jb20iLCIyMXdpZmUuY29tIiwiMjIybWltaS5uZXQiLCIyMm5mLmluZm8iLCIyMnRyYWNrcy5jb20iLCIyMzRtci5jb20iLCIyMzVqb2IuY29tIiwiMjNkeS5pbmZvIiwiMjN2aWRlby5jb20iLCIyNDd3b3JraW5naG9zdC5jb20iLCIyNDhjYy5jb20iLCIyNDlzcy5jb20iLCIyNGhvdXJwcmludC5jb20iLCIyNG9wZW4ucnUiLCIyNHByb3h5LmNvbSIsIjI0c21pbGUub3JnIiwiMjR0b3Bwcm94eS5jb20iLCIyNHRyYWZmaWMuaW5mbyIsIjI0dHVubmVsLmNvbSIsIjI3MTQ0LmNvbSIsIjI3aGhoLmNvbSIsIjI4dGxiYi5jb20iLCIyYWR1bHRmbGFzaGdhbWVzLmNvbSIsIjJhbm9ueW1vdXNwcm94eS5jb20iLCIyZGllNGZhbS5jb20iLCIyZzM0LmNvbSIsIjJnaGVyb29uLmlyIiwiMmthbnBpYW4uY29tIiwiMmtrLmNjIiwiMmxpcHN0dWJlLmNvbSIsIjJtNTIuY29tIiwiMnAubmV0IiwiMnByb3h5LmRlIiwiMnNoYXJlZC5jb20iLCIydGlwcy5jb20iLCIydW5ibG9ja3lvdXR1YmUuY29tIiwiMnk4ODg4LmNvbSIsIjMwMGF2aS5jb20iLCIzMGJveGVzLmNvbSIsIjMwbWFpbC5uZXQiLCIzMTAwYm9vay5jb20iLCIzMTQ0Lm5ldCIsIjMxNWx6LmNvbSIsIjMxN2JvLmNvbSIsIjMxOXBhcGFnby5pZHYudHciLCIzMWJiLmNvbSIsIjMyMXNvc28uY29tIiwiMzIxeW91dHViZS5jb20iLCIzMnJlZC5jb20iLCIzMzMzY24uY29tIiwiMzMzZ3guY29tIiwiMzNhdi5jb20iLCIzM21kLm5ldCIsIjMzc3FkeS5pbmZvIiwiMzN5b3V0dWJlLmNvbSIsIjM0M2R5Lm5ldCIsIjM0NS5pZHYudHciLCIzNDVtbS5jb20iLCIzNjVzYi5jb20iLCIzNjVzaW5nbGVzLmNvbS5hciIsIjM4NTIyLmNvbSIsIjM4YWIuY29tIiwiMzhsdW5saS5pbmZvIiwiMzhydi5jb20iLCIzOWNhby5jb20iLCIzYTZhYXllci5jb20iLCIzYW5pbWFsc2V4LmNvbSIsIjNhbmltYWxzZXh0dWJlLmNvbSIsIjNhcmFidHYuY29tIiwiM2JveXMyZ2lybHMuY29tIiwiM2RheWJsaW5kcy5jb20iLCIzZHNleHZpbGxhLmNvbSIsIjNmbS5ubCIsIjNpOGkubmV0IiwiM2tpdS5pbmZvIiwiM2tpdS5uZXQiLCIzbDg3LmNvbSIsIjNvcm9kLmNvbSIsIjNwLWxpbmsuY29tIiwiM3Byb3h5LmRlIiwiM3JkLXBhcnR5Lm9yZy51ayIsIjNyZW4uY2EiLCIzc2F0LmRlIiwiM3NzZWUuY29tIiwiM3Nzbm4uY29tIiwiM3N1aXNzZXMuZnIiLCIzd2lzcC5jb20iLCI0MDBhaS5jb20iLCI0MzJwcHAuY29tIiwiNDQxbWkuY29tIiwiNDQxbWkubmV0IiwiNDQ0NGtrLmNvbSIsIjQ0NHhnLmNvbSIsIjQ0NTI1Mi5jb20iLCI0NDY2ay5jb20iLCI0NHFzLmNvbSIsIjQ1Ynl0ZXMuaW5mbyIsIjQ1d29vb2wuY29tIiwiNDYwZHZkLmNvbSIsIjQ3YWkuaW5mbyIsIjQ5Lmlkdi50dyIsIjRldmVycHJveHkuYml6IiwiNGV2ZXJwcm94eS5jb20iLCI0ZXZlcnByb3h5LmRlIiwiNGV2ZXJwcm94eS5vcmciLCI0ZnJlZXByb3h5LmNvbSIsIjRpay5ydSIsIjRqajRqai5jb20iLCI0a2tiYi5jb20iLCI0bXVzY2xlbWVuLmNvbSIsIjRuZXd0dWJlLmNvbSIsIjRwZGEudG8iLCI0cHJveHkuZGUiLCI0c2hhcmVkLmNvbSIsIjRzc25uLmNvbSIsIjR0dWJlLmNvbSIsIjUwMHB4Lm9yZyIsIjUwd2Vicy5jb20iLCI1MTQuY24iLCI1MWVvLmNvbSIsIjUxbHVvYmVuLmNvbSIsIjUxc29sZS5jb20iLCI1MXdha3UuY29tIiwiNTIwMGRkLmNvbSIsIjUyNjgyLmNvbSIsIjUyNzguY2MiLCI1Mmphdi5jb20iLCI1MnRsYmIuY29tIiwiNTJ3cGUuY29tIiwiNTJ5aW55aW4uaW5mbyIsIjUyeW91amkub3JnIiwiNTN5aW55aW4uaW5mbyIsIjU0MjcxLmNvbSIsIjU0M3d5dC5jb20iLCI1NGR5Lm5ldCIsIjU0eHVlLmNvbSIsIjU1Mzk5LmNvbSIsIjU1NWF0di5jb20iLCI1NzMuanAiLCI1Nzl1dS5jb20iLCI1OTI3LmNjIiwiNWQ3eS5uZXQiLCI1aGU1LmNvbSIsIjVpMDEuY29tIiwiNWlrLnR2IiwiNWlzb3RvaTUub3JnIiwiNW1hb2RhbmcuY29tIiwiNXByb3h5LmNvbSIsIjVxdWx1LmNvbSIsIjV1ZGFuaGFvLmNvbSIsIjV4eG4uY29tIiwiNXllOC5jb20iLCI2Mjk5Lm5ldCIsIjYzNTc3LmNvbSIsIjYzNjN3aW4uY29tIiwiNjNqamouY29tIiwiNjR0aWFud2FuZy5jb20iLCI2Ni5jYSIsIjY2NjgxNC5jb20iLCI2NjZrYi5jb20iLCI2NjZtaW1pLmNvbSIsIjY2Nm5mLmNvbSIsIjY2Z3JlZW4zLmNvbSIsIjY2cGVlcnMuaW5mbyIsIjY3MTYwLmNvbSIsIjY3OGhlLmNvbSIsIjY3OGtqLmNvbSIsIjY5Njk2Njk5Lm9yZyIsIjY5Z29vZHMuY29tIiwiNjlqaWFveW91LmNvbSIsIjY5a2lzcy5uZXQiLCI2OXR1YmVzZXguY29tIiwiNmFhb28uY29tIiwiNmpzcS5uZXQiLCI2ay5jb20udHciLCI2bGF3Lmlkdi50dyIsIjZsaWtvc3kuY29tIiwiNnBhcmsuY29tIiwiNnByb3h5LnB3IiwiNnY2ZG90YS5jb20iLCI3MDYwLmNvbSIsIjcwY2h1bi5jb20iLCI3MTBrbnVzLmNvbSIsIjcxMTFoaC5jb20iLCI3MTIxMDAuY29tIiwiNzFhYi5jb20iLCI3MjBkdmQuY29tIiwiNzIyMmhoLmNvbSIsIjcyc2FvLmNvbSIsIjczODg3Ny5jb20iLCI3NHh5LmNvbSIsIjc1M25uLmNvbSIsIjc2NjZoaC5jb20iLCI3NzQ0ZC5jb20iLCI3NzdkYWlsaS5jb20iLCI3NzdwZC5jb20iLCI3NzdybWIuY29tIiwiNzc3cnYuY29tIiwiNzdwaG9uZS5jb20iLCI3N3lvdXR1YmUuY29tIiwiNzg5c3Nzcy5jb20iLCI3OTk5aGguY29tIiwiN2Nvdy5jb20iLCI3ZGF5ZGFpbHkuY29tIiwiN2RvZy5jb20iLCI3bXNwb3J0LmNvbSIsIjduZXQuY29tLnR3IiwiN3NkeS5jb20iLCI3c3BpbnMuY29tIiwiN3R2Yi5jb20iLCI3eHg4LmNvbSIsIjd5N3kuY29tIiwiOC1kLmNvbSIsIjgwMDA4Ni5jb20iLCI4MDBxc3cuY29tIiwiODA4MDgwLmJpeiIsIjgwOTBray5jb20iLCI4MDkweGluZ25hbi5uZXQiLCI4MHNtcDQuY29tIiwiODUxZmFjZWJvb2suY29tIiwiODVjYW8uY29tIiwiODVjYy5uZXQiLCI4NWdhby5jb20iLCI4NXN0LmNvbSIsIjg3Ym9vay5jb20iLCI4ODExZC5jb20iLCI4ODE5MDMuY29tIiwiODg4LmNvbSIsIjg4ODA2LmNvbSIsIjg4Y2hpbmF0b3duLmNvbSIsIjg4bHVzZS5jb20iLCI4OHNxZHkuY29tIiwiODh3aW5zLmNvbSIsIjg4eGYuaW5mbyIsIjg4eG94by5jb20iLCI4OHhweHAuY29tIiwiOGFpLmluZm8iLCI4c3NlZS5jb20iLCI5MDAxNzAwLmNvbSIsIjkwOXp5Lm5ldCIsIjkwaGUuY29tIiwiOTBreHcuY29tIiwiOTBtaW4uY29tIiwiOTB0di5pciIsIjkxNTMwLmNvbSIsIjkxcG9ybi5jb20iLCI5MXBvcm4ubWUiLCI5MmNjYXYuY29tIiwiOTN0dmIubmV0IiwiOTQ5NTguY29tIiwiOTQ5d3l0LmNvbSIsIjk1d2VuLmNvbSIsIjk3MDA5Ny5jb20iLCI5NzdhaS5jb20iLCI5Nzh6LmNvbSIsIjk3YWkuY29tIiwiOTdsbS5jb20iLCI5N3NlcXUuY29tIiwiOTkwNTc4LmNvbSIsIjk5MS5jb20iLCI5OTk5Y24ub3JnIiwiOTliYnMub3JnIiwiOTlwcm94eS5jb20iLCI5OXUuY29tIiwiOTl1YmIuY29tIiwiOWI5YjliLmNvbSIsIjlibG93LmNvbSIsIjloYW93LmNuIiwiOWlyZW50aS5jb20iLCI5am5nLmNvbSIsIjlvZmEuY29tIiwiOXN2aXAuY29tIiwiOXR2Yi5jb20iLCI5dzkub3JnIiwiOXh5b3V0dWJlLmNvbSIsImEtZmVpLmlkdi50dyIsImE1LmNvbS5ydSIsImE2ODguaW5mbyIsImE4OC51cyIsImE4ODhiLmNvbSIsImE5OS5pbmZvIiwiYWFhazEuY29tIiwiYWFhazMuY29tIiwiYWFqamouY29tIiwiYWFtYWNhdS5jb20iLCJhYXByb3h5LnB3IiwiYWJjLmNvbSIsImFiYy5jb20ubGIiLCJhYmMuY29tLnBsIiwiYWJjLmNvbS5weSIsImFiYy5wcC5ydSIsImFibHdhbmcuY29tIiwiYWJuYW5kaHJhanlvdGh5LmNvbSIsImFib2x1b3dhbmcuY29tIiwiYWJvbmRhbmNlLmNvbSIsImFib3V0Z2Z3LmNvbSIsImFib3V0c2V4eHguY29tIiwiYWJwbGl2ZS5pbiIsImFicy5lZHUua3ciLCJhYnNva3UwNzIuY29tIiwiYWJ1c2VhdC5vcmciLCJhYy1yZW5uZXMuZnIiLCJhYy12ZXJzYWlsbGVzLmZyIiwiYWNhZGVteWFydC5lZHUiLCJhY2Nlc3NtZXByb3h5LmNvbSIsImFjY2V4YW0uY29tIiwiYWNlcm9zLWRlLWhpc3BhbmlhLmNvbSIsImFjZXNzb3RvdGFsLm5ldCIsImFjZXZwbi5jb20iLCJhY2hhdGRlc2lnbi5jb20iLCJhY2hpLmlkdi50dyIsImFjdGltZXMuY29tLmF1IiwiYWN0aW9ubmV0d29yay5vcmciLCJhY3RpdmVwcm94aWVzLm9yZyIsImFkLXRlY2guY29tIiwiYWRiLm9yZyIsImFkZGl0dWRlbWFnLmNvbSIsImFkZG1lZmFzdC5jb20iLCJhZGVleC5pbiIsImFkai5pZHYudHciLCJhZG9iZXd3Zm90cmFpbmluZy5jb20iLCJhZG9vcy5jb20iLCJhZG9wdGFwZXQuY29tIiwiYWRzY2FsZS5kZSIsImFkdGhyaXZlLmNvbSIsImFkdWx0Y29taWNzY2x1Yi5jb20iLCJhZHVsdGN5YmVyc2l0ZXMuY29tIiwiYWR1bHRmcmllbmRmaW5kZXIuY29tIiwiYWR1bHRnYWdhLmNvbSIsImFkdWx0bWVnYXBvcm4uY29tIiwiYWR1bHRwb3JudG9kYXkuY29tIiwiYWR1bHR0b3A1MC5ubCIsImFkdWx0dHViZS5pbmZvIiwiYWR2YW5jZWRmaWxlb3B0aW1pemVyLmNvbSIsImFkdmFyLW5ld3MuYml6IiwiYWR4aG9zdGluZy5uZXQiLCJhZTUwMDAucnUiLCJhZWlvdS5wdCIsImFlc3N1Y2Nlc3Mub3JnIiwiYWZmYS5heiIsImFmZmFyaXRhbGlhbmkuaXQiLCJhZmxhbWhxLmNvbSIsImFmcmFuZXQuY29tIiwiYWZyaWsuY29tIiwiYWdncmVzc2l2ZWJhYmVzLmNvbSIsImFncmFubnlwb3JuLmNvbSIsImFncmlkcnkuY29tIiwiYWhiaW1pLmNvbSIsImFobGFsaGRlZXRoLmNvbSIsImFobWlsZi5jb20iLCJhaHJjaGsubmV0IiwiYWhycS5nb3YiLCJhaWxhbi5pZHYudHciLCJhaW1pemkuY29tIiwiYWltb3JyaWRlc3VuZ2FicmFuY2EuY29tIiwiYWlvbjgub3JnIiwiYWlvcHJveHkuY29tIiwiYWlwLmlkdi50dyIsImFpcmFzaWFnby5jb20ubXkiLCJhaXJibmIuY28uaW4iLCJhaXJibmIuY29tIiwiYWlyYm5iLml0IiwiYWlydGlja2V0cy5nciIsImFpcnZwbi5vcmciLCJhaXNleC5jb20iLCJhaXQub3JnLnR3IiwiYWl3ZWl3ZWkuY29tIiwiYWl3ZWl3ZWlibG9nLmNvbSIsImFpeWVsbG93LmNvbSIsImFqYW5rYW1pbC5jb20iLCJhamFuc3Nwb3IuY29tIiwiYWpheGxvYWQuaW5mbyIsImFqc2FuZHMuY29tIiwiYWstZmFjZWJvb2suY29tIiwiYWthZGVtaWtwZXJzcGVrdGlmLmNvbSIsImFrYWhvc2hpdGFrdXlhLmNvbSIsImFrYW1haWhkLm5ldCIsImFraW5hdG9yLmNvbSIsImFrcmFkeW8ubmV0IiwiYWt0aWZoYWJlci5jb20iLCJhbC1mYWRqci5jb20iLCJhbC1zaGFycS5jb20iLCJhbC13YXRhbi5jb20iLCJhbDNhYnk4LmNvbSIsImFsYWFuLmNjIiwiYWxhYW4udHYiLCJhbGFib3V0LmNvbSIsImFsYWtoYmFhci5vcmciLCJhbGV4YTEwMC5jb20iLCJhbGV4YW5kcmVidWlzc2Uub3JnIiwiYWxleGRvbmcuY29tIiwiYWxpYmFiYWdyb3VwLmNvbSIsImFsaWNlLml0IiwiYWxpdHRsZWJpdGNoZWVreS5jb20iLCJhbGl2ZXByb3h5LmNvbSIsImFsbGFib3V0LmNvLmpwIiwiYWxsYXNpYW5zLmNvbSIsImFsbGJhbmtpbmdzb2x1dGlvbnMuY29tIiwiYWxsYm9uZXIuY29tIiwiYWxsY2FyLmlkdi50dyIsImFsbGRyYXduc2V4LmNvbSIsImFsbGZhY2Vib29rLmNvbSIsImFsbGhhcmRzZXh0dWJlLmNvbSIsImFsbGlhbmNlLm9yZy5oayIsImFsbGluZmEuY29tIiwiYWxsaW5tYWlsLmNvbS5iciIsImFsbGludmFuY291dmVyLmNvbSIsImFsbGphY2twb3RzY2FzaW5vLmNvbSIsImFsbG1vdmllLmNvbSIsImFsbHBvc3RlcnMuY29tIiwiYWxscHJvZHVjdHMuY29tLnR3IiwiYWxscHJveHlzaXRlcy5jb20iLCJhbGxyZWNpcGVzLmNvbS5teCIsImFsbHJ1c2FtYXRldXJzLmNvbSIsImFscGhhcG9ybm8uY29tIiwiYWxzYmJvcmEuY29tIiwiYWx0ZXJuYXRpdmVpbmNvbWVuZy5jb20iLCJhbHdhZGlmYS1tYXJvYy5jb20iLCJhbHdheXNkYXRhLmNvbSIsImFsd2VoZGEuZ292LnN5IiwiYWx5YW91bTI0LmNvbSIsImFtNzMwLmNvbS5oayIsImFtYWtpbmdzLmNvbSIsImFtYXJ1amFsYS5jb20iLCJhbWFyeWxsaXNzLmlkdi50dyIsImFtYXRldXJjb21tdW5pdHkuZGUiLCJhbWF0ZXVyZ2FsbHMuY29tIiwiYW1hdGV1cmhvbWV2aWRzLmNvbSIsImFtYXRldXJpdHkuY29tIiwiYW1hdGV1cnNleHkubmV0IiwiYW1hemluZ3N1cGVycG93ZXJzLmNvbSIsImFtYXpvbmF3cy5jb20iLCJhbWF6b25zdXBwbHkuY29tIiwiYW1lYmxvLmpwIiwiYW1lbGkuZnIiLCJhbWVudG90YXh1cy5pZHYudHciLCJhbWVyaWNhLXByb3h5LmNvbSIsImFtZXJpY2FuZXhwcmVzc29ubGluZS5jb20uYnIiLCJhbWVyaWNhbm11c2NsZS5jb20iLCJhbWVyaWNvcnBzLmdvdiIsImFtZXJpa2FuaW5zZXNpLmNvbSIsImFtaWFtaS5qcCIsImFtaW5zYWJldGkubmV0IiwiYW1tYXJuYW1lLmlyIiwiYW1uZXN0eS5jYSIsImFtbmVzdHkuY2giLCJhbW5lc3R5LmllIiwiYW1uZXN0eS5vcmciLCJhbW5lc3R5Lm9yZy5hdSIsImFtbmVzdHkub3JnLmdyIiwiYW1uZXN0eS5vcmcuaGsiLCJhbW5lc3R5Lm9yZy5pbiIsImFtbmVzdHkub3JnLm56IiwiYW1uZXN0eS5vcmcucnUiLCJhbW5lc3R5Lm9yZy50ciIsImFtbmVzdHkub3JnLnVhIiwiYW1uZXN0eS5vcmcudWsiLCJhbW5lc3R5dXNhLm9yZyIsImFtb2lpc3QuY29tIiwiYW1vdXJhbmdlbHMucHciLCJhbXoudHciLCJhbmEtd2hpdGUuY29tIiwiYW5hZG9sdWhhYmVyaW0uY29tIiwiYW5hai5pciIsImFuYWxpdGlrYmFraXMuY29tIiwiYW5hbHlzaXN3ZWJzaXRlcy5jb20iLCJhbmNob3JmcmVlLm5ldCIsImFuZGN5Y2xlLmlkdi50dyIsImFuZGhyYWp5b3RoeS5jb20iLCJhbmRocmFuZXdzLm5ldCIsImFuZHJvaWRwaXQuY29tLmJyIiwiYW5kcm9pZHB1Yi5jb20iLCJhbmdlbGUtcHJveHkuaW5mbyIsImFuZ3J5YmlyZHMuY29tIiwiYW5pa29yZS5qcCIsImFuaW1hbGhvc3QuY29tIiwiYW5pbWUtZG9qaW4uY29tIiwiYW5pbWUtZXJvZG91Z2EuY29tIiwiYW5pbWUtbWVkaWEuY29tIiwiYW5pbWVjcmF6eS5uZXQiLCJhbmltZXNoaXBwdXVkZW4uY29tIiwiYW5pbWVzcGlyaXQucnUiLCJhbmlzY2FydHVqby5jb20iLCJhbm5vbmNlLmN6IiwiYW5udHcuY29tIiwiYW5udW5jaS5uZXQiLCJhbm9uaWVtc3VyZmVuLmV1IiwiYW5vbnBhc3MuY29tIiwiYW5vbnByb3h5LmV1IiwiYW5vbnNlcnZlci5zZSIsImFub255bWl0eXByb3h5LmNvbSIsImFub255bWl6ZXIuY29tIiwiYW5vbnltb3VzLXByb3h5LmNvbS5kZSIsImFub255bW91cy1zdXJmaW5nLmV1IiwiYW5vbnltb3VzMjQucGwiLCJhbm9ueW1vdXNlLm1lIiwiYW5vbnltb3VzZS5vcmciLCJhbm9ueW1vdXNzdXJmLnVzIiwiYW5vbnltb3Vzd2VicHJveHkudXMiLCJhbm9ueW16LmNvbSIsImFub255c3VyZi5jb20iLCJhbnBvcG8uY29tIiwiYW5xdXllLmNvbSIsImFuc2FyLWFsaGFxcS5uZXQiLCJhbnN3ZXJpbmctaXNsYW0ub3JnIiwiYW50aS1ibG9jay5jb20iLCJhbnRpd2F2ZS5uZXQiLCJhbnRwb2tlci5jb20iLCJhbnVudGlvbWF0aWMuY29tIiwiYW51bnR1bC5ybyIsImFueXBvcm4uY29tIiwiYW55cG9ybi5pbmZvIiwiYW55c2V4LmNvbSIsImFueXUub3JnIiwiYW9hb2x1LmNjIiwiYW9hb2x1LmNvbSIsImFvYW9sdS5uZXQiLCJhb2Fvdm9kLmNvbSIsImFvYm8uY29tLmF1IiwiYW9sLmNhIiwiYW9sLmNvLnVrIiwiYW9sLmNvbSIsImFvbG5ld3MuY29tIiwiYW9taXdhbmcuY29tIiwiYXAub3JnIiwiYXBhcnRtZW50Z3VpZGUuY29tIiwiYXBhc3R5bGUub3JnIiwiYXBldHViZS5jb20iLCJhcGlnZWUuY29tIiwiYXBvbnRhZG9yLmNvbS5iciIsImFwb3J0LnJ1IiwiYXBwZWR1LmNvbS50dyIsImFwcGxlYmFsbGEuY29tIiwiYXBwbGVkYWlseS5jb20uaGsiLCJhcHBsZWRhaWx5LmNvbS50dyIsImFwcGxlaW5zaWRlci5jb20iLCJhcHBsZXR1YmUucnUiLCJhcHBzZnV0dXJlLmluZm8iLCJhcHBzcG90LmNvbSIsImFwcHZ1aWZhY2Vib29rLmNvbSIsImFwcHktZ2Vlay5jb20iLCJhcHJlc2xhY2hhdC5jb20iLCJhcS5jb20iLCJhcXIuaXIiLCJhcjE1LmNvbSIsImFyYWJvLmNvbSIsImFyYWJzLXlvdXR1YmUuY29tIiwiYXJhc2h6YWQubmV0IiwiYXJiaXRyYWdldG9wLmNvbSIsImFyY2hpcHJvZHVjdHMuY29tIiwiYXJjaGl2ZS5pcyIsImFyY2hpdmUub3JnIiwiYXJjaGl2ZS50b2RheSIsImFyY3Rvc2lhLmNvbSIsImFyZC5kZSIsImFyZHJvbmUtZm9ydW0uY29tIiwiYXJnZW5wcm9wLmNvbSIsImFyZ2VudGEuYmUiLCJhcmdlbnRpbmFiYXkuaW5mbyIsImFyaW9ubW92aWVzLmNvbSIsImFybWFkYWJvYXJkLmNvbSIsImFybWFuaWV4Y2hhbmdlLmNvbSIsImFybWVucHJlc3MuYW0iLCJhcnJvdy5jb20iLCJhcnNlbmFsLmNvbSIsImFydGNvbWl4LmNvbSIsImFydGljbGVzcGhlcmUuY29tIiwiYXJ0bGViZWRldi5ydSIsImFydml4ZWNsb3VkLmNvbSIsImFzYWhpY2hpbmVzZS5jb20iLCJhc2cudG8iLCJhc2doYXJhZ2hhLmNvbSIsImFzaGJ1cm5pY2VhbmdlbHMub3JnIiwiYXNobGV5cm5hZGlzb24uY29tIiwiYXNob29yYS5pciIsImFzaWFlLmNvLmtyIiwiYXNpYWhhcnZlc3Qub3JnIiwiYXNpYW4tYm95LW1vZGVscy5jb20iLCJhc2lhbi1kb2xscy5uZXQiLCJhc2lhbi1zbGF2ZS1ib3kuY29tIiwiYXNpYW5iZWF1dHl0dWJlLmNvbSIsImFzaWFuZXdzLml0IiwiYXNpYW54aGFtc3Rlci5jb20iLCJhc2lhc2V4dmlkZW9zLmNvbSIsImFzaWF0Z3AuY29tIiwiYXNrZnJhbmsubmV0IiwiYXNreW56Lm5ldCIsImFzcGRvdG5ldC1zdXJlc2guY29tIiwiYXNyZWRhcy5jb20iLCJhc3Jla2hvZHJvLmNvbSIsImFzc2VtYmxhLmNvbSIsImFzc2VtYmxlZS1uYXRpb25hbGUuZnIiLCJhc3Ryb21lbmRhYmFyYW5kLmNvbSIsImFzdXJla2F6YW5pLmNvbSIsImF0YXZpLmNvbSIsImF0Y2gubWUiLCJhdGNoaW5lc2UuY29tIiwiYXRlYml0cy5jb20iLCJhdGdmdy5vcmciLCJhdGhlbnNiYXJzLmdyIiwiYXRqLm9yZy50dyIsImF0bGFzcG9zdC5jb20iLCJhdG5leHQuY29tIiwiYXRyZXNwbGF5ZXIuY29tIiwiYXR0dW5sb2NrZXIudXMiLCJhdTEyMy5jb20iLCJhdWNmYW4uY29tIiwiYXVkaWJsZS5jby51ayIsImF1ZmZsaWNrLmNvbSIsImF1Z3NidXJnZXItYWxsZ2VtZWluZS5kZSIsImF1bmJsb2NrLmNvbSIsImF1bmJsb2NrLnBrIiwiYXVvZGEuY29tIiwiYXVzbnoubmV0IiwiYXVzc2llYWR1bHRmcmllbmRmaW5kZXIuY29tIiwiYXVzc2llcHJveHkuaW5mbyIsImF1c3RyYWxpYS1wcm94eS5jb20iLCJhdXRvZ3VpZGUuY29tIiwiYXV0b2hpZGVpcC5jb20iLCJhdXRvcG9zdGZhY2Vib29rLmNvbSIsImF1dG9wb3N0dG9mYWNlYm9vay5jb20iLCJhdXRvc290dG9jb3N0by5jb20iLCJhdXRvdHVuLm5ldCIsImF2LWFkdWx0LmNvbSIsImF2LW9rLmNvbSIsImF2MTAwZnVuLmNvbSIsImF2MTAxLm5ldCIsImF2MTA2OS5jb20iLCJhdjE4MS5uZXQiLCJhdjU5MS5jb20iLCJhdjc3Ny5jb20iLCJhdjkuY2MiLCJhdmFhei5vcmciLCJhdmF0cmFkZS5jb20iLCJhdmF4aG0uY29tIiwiYXZiYWJ5LmluZm8iLCJhdmJkc2hvcC5jb20iLCJhdmNpdHkudHYiLCJhdmNvbWUudHciLCJhdmNveS5jb20iLCJhdmRiLmluIiwiYXZkZC5uZXQiLCJhdmRpc2guY29tIiwiYXZkdmQubmV0IiwiYXZlbnVlLmNvbSIsImF2ZW51ZXN1cHBseS5jYSIsImF2ZXJ5LmNvLnVrIiwiYXZlcnkuY29tLm14IiwiYXZmYWNlYm9vay5jb20iLCJhdmhpZ2gubmV0IiwiYXZob21lLnR2IiwiYXZpc29zZGVvY2FzaW9uLmNvbSIsImF2bGFuZy5jb20iLCJhdmxhbmcyMi5jb20iLCJhdm5vbWEuY29tIiwiYXZvbmUudHYiLCJhdnNleDguY29tIiwiYXZzcDJwLmNvbSIsImF2dDExMS5jb20iLCJhdnR0Lm5ldCIsImF2dHQzLm5ldCIsImF2dHQzLm9yZyIsImF2dHViZS50diIsImF2dWx1LmNvbSIsImF3ZWJwcm94eS5jb20iLCJhd2ZsYXNoZXIuY29tIiwiYXhlLW5ldC5mciIsImF5YmlsZ2kubmV0IiwiYXplcmJheWNhbi50diIsImF6ZXJpbWl4LmNvbSIsImF6ZXJ0eTEyMy5jb20iLCJhem9oLmluZm8iLCJhenByb3hpZXMuY29tIiwiYjExN2Y4ZGEyMzQ0NmE5MTM4N2VmZWEwZTQyODM5MmEucGwiLCJiMXNlY3VyZS5jb20iLCJiYS1iYW1haWwuY29tIiwiYmFiLXVsLWlzbGFtLm5ldCIsImJhYmFrZGFkLmJsb2dzcG90LmZyIiwiYmFiZWxpby5jb20iLCJiYWJlc2FuZHN0YXJzLmNvbSIsImJhYnkta2luZ2RvbS5jb20iLCJiYWJ5bmV0LmNvbS5oayIsImJhY2tjaGluYS5jb20iLCJiYWNrcGFja2Vycy5jb20udHciLCJiYWNreWFyZGNoaWNrZW5zLmNvbSIsImJhZGpvam8uY29tIiwiYmFndWV0ZS5jb20uYnIiLCJiYWhpYW5vdGljaWFzLmNvbS5iciIsImJhaWQudXMiLCJiYWlnZXZwbi5jb20iLCJiYWlzZTY2Ni5jb20iLCJiYWphcmZhY2Vib29rLmNvbSIsImJhamFybXAzLm5ldCIsImJhamFyeW91dHViZS5jb20iLCJiYWxhdGFyaW4uY29tIiwiYmFsbHB1cmUuY29tIiwiYmFuY2FtYXJjaGUuaXQiLCJiYW5jb3Bvc3RhY2xpY2suaXQiLCJiYW5kaWNhbS5jb20iLCJiYW5nYnJvczEuY29tIiwiYmFua2Vyc2FkZGEuY29tIiwiYmFua2V4YW1zdG9kYXkuY29tIiwiYmFua2hhcG9hbGltLmNvLmlsIiwiYmFua25ldHBvd2VyLm5ldCIsImJhbmtwYXNhcmdhZC5jb20iLCJiYW5uZWRib29rLm9yZyIsImJhbm5lZGZ1Y2tlcnMuY29tIiwiYmFvLmxpIiwiYmFvemhpLnJ1IiwiYmFyZW5ha2VkaXNsYW0uY29tIiwiYmFybmFidS5jby51ayIsImJhcnJhY3VkYS5jb20iLCJiYXNlOTkuY29tIiwiYmFzaWwuaWR2LnR3IiwiYmFzd2FyZS5jb20iLCJiYXRpYWN0dS5jb20iLCJiYXlwcm94eS5vcmciLCJiYXl2b2ljZS5uZXQiLCJiYXl3b3Jkcy5jb20iLCJiYXphcmVrYXIuaXIiLCJiYjY2Y2Mub3JnIiwiYmJhdjM2MC5jb20iLCJiYmMuY28udWsiLCJiYmMuY29tIiwiYmJjLm9yZy5jbiIsImJiY2NoaW5lc2UuY29tIiwiYmJjaS5jby51ayIsImJiY2ltZy5jby51ayIsImJiZy5nb3YiLCJiYmd5eS5uZXQiLCJiYmguY29tIiwiYmJwcm94eS5wdyIsImJicy10dy5jb20iLCJiYnM4ODg4Lm5ldCIsImJiczk3LmNvbSIsImJic2luZGV4LmNvbSIsImJic2xhbmQuY29tIiwiYmJ0b3lzdG9yZS5jb20iLCJiYndwb3Jub3R1YmVzLmNvbSIsImJid3R1YmVwb3JuLnh4eCIsImJid3ZpZGVvc3R1YmUuY29tIiwiYmJ5eS5uYW1lIiwiYmMudmMiLCJiY2MuY29tLnR3IiwiYmNjaGluZXNlLm5ldCIsImJkZ2VzdC5jb20iLCJiZG1vdGUubmV0IiwiYmRzbS5jb20iLCJiZHNtLmNvbS50dyIsImJkc21iYW5nLmNvbSIsImJkc21mb3JhbGwuY29tIiwiYmRzbXZpZGVvcy5uZXQiLCJiZWF0ZmlsdGVyaW5nLmNvbSIsImJlYXV0eTg4LmNvbS50dyIsImJlYXV0eWdpcmwtc3RvcnkuY29tIiwiYmViby5jb20iLCJiZWN1b25saW5lYmFua2luZy5vcmciLCJiZWZ1Y2suY29tIiwiYmVnZWVrLmZyIiwiYmVoaW5ka2luay5jb20iLCJiZWlqaW5nc3ByaW5nLmNvbSIsImJlbGFqYXJpa2xhbmRpZmFjZWJvb2suY29tIiwiYmVsYXN0aW5nZGllbnN0Lm5sIiwiYmVsb3ZlLmpwIiwiYmVsdGEuYnkiLCJiZW1pZGppcGlvbmVlci5jb20iLCJiZXJsaW50d2l0dGVyd2FsbC5jb20iLCJiZXJtLmNvLm56IiwiYmVzdC1oYW5kam9iLmNvbSIsImJlc3QtcHJveHkuY29tLmRlIiwiYmVzdC12aWRlb3MteW91dHViZS5jb20iLCJiZXN0YW5kZnJlZS5jb20iLCJiZXN0Zm9yY2hpbmEub3JnIiwiYmVzdGZyZWV2cG4uY29tIiwiYmVzdGluc3RhZ3JhbS5jb20uYnIiLCJiZXN0aXoubmV0IiwiYmVzdG9meW91dHViZS5jb20iLCJiZXN0cG9ybi5jb20iLCJiZXN0cG9ybnN0YXJkYi5jb20iLCJiZXN0cHJveC5jb20iLCJiZXN0cHJveHlzaXRlcy5uZXQiLCJiZXN0cmVhbXMubmV0IiwiYmVzdHNlY3VyaXR5dGlwcy5jb20iLCJiZXN0c3B5Lm5ldCIsImJlc3RzdXJmaW5nLmluZm8iLCJiZXN0dWt2cG4uY29tIiwiYmVzdHZpZGVvb255b3V0dWJlLmNvbSIsImJlc3R2aWRlb3NvbnlvdXR1YmUuY29tIiwiYmVzdHZpbnRhZ2V0dWJlLmNvbSIsImJlc3R2cG4uY29tIiwiYmVzdHZwbnNlcnZpY2UuY29tIiwiYmVzdHZwbnVzYS5jb20iLCJiZXN0eHh4bGlzdC5jb20iLCJiZXN0eS5wbCIsImJlc3R5b3V0dWJlcHJveHkuaW5mbyIsImJldDM2NS5jb20iLCJiZXQzNjUuY29tLmF1IiwiYmV0YmFzZTEuaW5mbyIsImJldGNsb3VkLmNvbSIsImJldGZhaXIuY29tIiwiYmV0ZmFpci5jb20uYXUiLCJiZXR0d2Vlbi5jb20iLCJiZXR1cy5jb20ucGEiLCJiZXd3dy5uZXQiLCJiZXlvbmRmaXJld2FsbC5jb20iLCJiZm10di5jb20iLCJiZzY3LmNvbSIsImJnZW5lcmFsLmNvbSIsImJnZjU3LmNvbSIsImJncHJveHkub3JnIiwiYmd0b3JyZW50cy5pbmZvIiwiYmhhcmF0c3R1ZGVudC5jb20iLCJiaGxkbi5jb20iLCJiaWEyLmNvbSIsImJpYXVzYS5vcmciLCJiaWJpa2EucnUiLCJiaWJsZXNmb3JhbWVyaWNhLm9yZyIsImJpZ2xvYmUubmUuanAiLCJiaWduZXdzLm9yZyIsImJpZ29ueW91dHViZS5jb20iLCJiaWdwYXJhLmNvbSIsImJpZ3NvdW5kLm9yZyIsImJpZ3RpdG1vbW15LmNvbSIsImJpZ3RpdHMuY29tIiwiYmlndGl0c3Rva3lvLmNvbSIsImJpaC5uaWMuaW4iLCJiaWtlaS1uZXdoYWxmLmNvbSIsImJpbmFyeW1vbnN0ZXIubmV0IiwiYmluZDIuY29tIiwiYmluZ3BsYWNlcy5jb20iLCJiaW5ndXNob3AuY29tIiwiYmlvd2FyZS5jb20iLCJiaXBpYy5uZXQiLCJiaXJkaG91c2VhcHAuY29tIiwiYmlzZWxhaG9yZS5jb20iLCJiaXQuZG8iLCJiaXQubHkiLCJiaXRjb2ludGFsay5vcmciLCJiaXRodW1lbi5iZSIsImJpdGx5LmNvbSIsImJpdHNoYXJlLmNvbSIsImJpdHRvcnJlbnQuY29tIiwiYml6aGF0LmNvbSIsImJpem1hbi5jb20udHciLCJiaXpwb3dhLmNvbSIsImJqbmV3bGlmZS5vcmciLCJianpjLm9yZyIsImJsYWNrZGlhbW9uZC1haS5jb20iLCJibGFja2xvZ2ljLmNvbSIsImJsYWNrc2V4c2l0ZS5uZXQiLCJibGFja3Rvd2hpdGUubmV0IiwiYmxhY2t2aWR0dWJlLmNvbSIsImJsYWNrdnBuLmNvbSIsImJsYW5jaGVwb3J0ZS5mciIsImJsYW5jby5jb20iLCJibGJveXN0dWJlLmNvbSIsImJsZXdwYXNzLmNvbSIsImJsaWdvby5jb20iLCJibGluZ2JsaW5nc3F1YWQubmV0IiwiYmxpbmt4LmNvbSIsImJsaXAudHYiLCJibG9ja2NuLmNvbSIsImJsb2NrZWRzaXRlYWNjZXNzLmNvbSIsImJsb2cuY29tIiwiYmxvZy5pZHYudHciLCJibG9nY2F0YWxvZy5jb20iLCJibG9nZ2VyLmJqIiwiYmxvZ2dlci5jb20iLCJibG9nZ2VyLmNvbS5iciIsImJsb2dnZXIzY2Vyby5jb20iLCJibG9naW1nLmpwIiwiYmxvZ2xpbmVzLmNvbSIsImJsb2dsb3Zpbi5jb20iLCJibG9nbWFya3MubmV0IiwiYmxvZ21ldHJpY3Mub3JnIiwiYmxvZ25ldmVzaHQuY29tIiwiYmxvZ3Bob25ndGh1eS5jb20iLCJibG9ncy5jb20iLCJibG9nc3BvdC5hZSIsImJsb2dzcG90LmJlIiwiYmxvZ3Nwb3QuY2EiLCJibG9nc3BvdC5jaCIsImJsb2dzcG90LmNvLmlsIiwiYmxvZ3Nwb3QuY28ubnoiLCJibG9nc3BvdC5jby51ayIsImJsb2dzcG90LmNvbSIsImJsb2dzcG90LmNvbS5hciIsImJsb2dzcG90LmNvbS5hdSIsImJsb2dzcG90LmNvbS5iciIsImJsb2dzcG90LmNvbS5lcyIsImJsb2dzcG90LmNvbS50ciIsImJsb2dzcG90LmN6IiwiYmxvZ3Nwb3QuZGUiLCJibG9nc3BvdC5kayIsImJsb2dzcG90LmZpIiwiYmxvZ3Nwb3QuZnIiLCJibG9nc3BvdC5nciIsImJsb2dzcG90LmhrIiwiYmxvZ3Nwb3QuaHUiLCJibG9nc3BvdC5pZSIsImJsb2dzcG90LmluIiwiYmxvZ3Nwb3QuaXQiLCJibG9nc3BvdC5qcCIsImJsb2dzcG90Lm14IiwiYmxvZ3Nwb3QubmwiLCJibG9nc3BvdC5ubyIsImJsb2dzcG90LnB0IiwiYmxvZ3Nwb3QucmUiLCJibG9nc3BvdC5ybyIsImJsb2dzcG90LnJ1IiwiYmxvZ3Nwb3Quc2UiLCJibG9nc3BvdC5zZyIsImJsb2dzcG90LnNrIiwiYmxvZ3Nwb3QudHciLCJibG9ndGQub3JnIiwiYmxvb21iZXJnLmNuIiwiYmxvb21iZXJnLmNvbSIsImJsb29tYmVyZy5jb20uYnIiLCJibG9vbWJlcmcuY29tLm14IiwiYmxvd2pvYmNvbGxlY3Rpb24uY29tIiwiYmx1ZXN5c3RlbS5ydSIsImJsdWV4aGFtc3Rlci5jb20iLCJibHVycnktZXllcy5pbmZvIiwiYmx1cnRpdC5jb20iLCJibmI4OS5jb20iLCJib2FyZHJlYWRlci5jb20iLCJib2RvZzE2OC5jb20iLCJib2RvZzg4LmNvbSIsImJvZmFuZy5sYSIsImJvZ2dsZXVwLmNvbSIsImJvaGVtaWFuY29kaW5nLmNvbSIsImJvbGVodnBuLm5ldCIsImJvbGx5bWVhbmluZy5jb20iLCJib2xseXdvb2QtbXAzLmNvbSIsImJvbS5nb3YuYXUiLCJib25ib25tZS5jb20iLCJib25ib255b3UuY29tIiwiYm9uZGFnZWNvLmNvbSIsImJvbmRhZ2Vwb3NpdGlvbi5jb20iLCJib25kYWdlc2NhcGUuY29tIiwiYm9uZGVkb21haW4uY29tIiwiYm9ubnkuaWR2LnR3IiwiYm9ucG9ybi5jb20iLCJib251c3ZpZC5jb20iLCJib29rNHUuY29tLnR3IiwiYm9va2Jyb3dzZS5jb20iLCJib29rZmluZGVyLmNvbSIsImJvb2tpbmdidWRkeS5jb20iLCJib29rbWFya2luZ2hvc3QuY29tIiwiYm9va3MuY29tLnR3IiwiYm9va3Nmb3JldmVyeW9uZS5vcmciLCJib29sYmVycnkuYmx1ZSIsImJvb21wbGF5ZXIuY29tIiwiYm9vbXByb3h5LmNvbSIsImJvb210dW5uZWwuY29tIiwiYm9vb29icy5vcmciLCJib29zdHdpdHRlci5jb20iLCJib290c3RyYXB2YWxpZGF0b3IuY29tIiwiYm9yZGEucnUiLCJib3JlZG9tYmFzaC5jb20iLCJib3JzYWd1bmRlbS5jb20iLCJib3QubnUiLCJib3RhbmlrcmV5b24ub3JnIiwiYm90YW53YW5nLmNvbSIsImJvdGlkLm9yZyIsImJvdXlndWVzdGVsZWNvbS5jb20iLCJib3dlbnByZXNzLmNvbSIsImJvd2VyLmlvIiwiYm94LmNvbSIsImJveC5uZXQiLCJib3hjYXIuaW8iLCJib3hjbi5uZXQiLCJib3hvZmZpY2Vtb2pvLmNvbSIsImJveHBuLmNvbSIsImJveHVlc2t5LmNvbSIsImJveHVuLmNvbSIsImJveHVuLnR2IiwiYm95ZnJpZW5kdHYuY29tIiwiYm95Z2xvcnlob2xlLmNvbSIsImJveXNmb29kLmNvbSIsImJwZXJncm91cC5uZXQiLCJicHMxMDI1LmNvbSIsImJyLW9sc2hvcC5jb20iLCJicmFpbmdsZS5jb20iLCJicmFpbmp1aWNlci5jb20iLCJicmFpbnBvcC5mciIsImJyYW1rYS1wcm94eS5wbCIsImJyYW1rYXByb3h5Lm5ldC5wbCIsImJyYW5kaWJlbGxlLmNvbSIsImJyYXNzcmluZy5jb20iLCJicmF2ZWpvdXJuYWwuY29tIiwiYnJhdmljYS50diIsImJyYXZvZXJvdGljYS5jb20iLCJicmF2b3RlZW5zLmNvbSIsImJyYXZvdHViZS5jb20iLCJicmF2b3R1YmUubmV0IiwiYnJhemlscHJveHkuY29tIiwiYnJiLnRvIiwiYnJlYWsuY29tIiwiYnJlYWtpbmd0d2VldHMuY29tIiwiYnJpZWZkcmVhbS5jb20iLCJicmluby5pbmZvIiwiYnJpc3RvbHBvc3QuY28udWsiLCJicml0aXNobXVzZXVtLm9yZyIsImJyb2FkY2FzdHlvdXR1YmUuY29tIiwiYnJva2ViYWNrYXNpYW5zLmNvbSIsImJyb3duc3VnYXIuaWR2LnR3IiwiYnJvd3NlYy5jb20iLCJicnNib3guY29tIiwiYnJ0Lml0IiwiYnJ1dGFsdGdwLmNvbSIsImJzbmwuY28uaW4iLCJidC5jb20iLCJidGNjaGluYS5jb20iLCJidGRpZ2cub3JnIiwiYnRraXR0eS5jb20iLCJidG9sYXQuY29tIiwiYnRzbXRoLmNvbSIsImJ0dW5uZWwuY29tIiwiYnVidWt1YS5jb20iLCJidWRhLmlkdi50dyIsImJ1ZGFlZHUub3JnIiwiYnVkYWVkdS5vcmcudHciLCJidWRkaGFuZXQuaWR2LnR3IiwiYnVkZGhpc3RjaGFubmVsLnR2IiwiYnVkdGVyZW5jZS50ayIsImJ1bGxvZy5vcmciLCJidWxsb2dnZXIuY29tIiwiYnVubnlsdXN0LmNvbSIsImJ1cmF5ZGFoY2l0eS5uZXQiLCJidXJvMjQ3LnJ1IiwiYnVzYXlhcmkuY29tIiwiYnVzY2FwZS5jb20uYnIiLCJidXNpbmVzcy1nYXpldGEucnUiLCJidXNpbmVzcy5nb3YuYXUiLCJidXNpbmVzc2JhbGxhLmNvbSIsImJ1c2luZXNzb2ZjaW5lbWEuY29tIiwiYnVzaW5lc3NzcGVjdGF0b3IuY29tLmF1IiwiYnVzaW5lc3N0aW1lcy5jb20uY24iLCJidXNpbmVzc3dlZWsuY29tIiwiYnVzdHljYXRzLmNvbSIsImJ1c3liaXRzLmNvbSIsImJ1c3l0cmFkZS5jb20iLCJidXR0ZXJmdW5rLmNvbSIsImJ1dHRmdWNraW5nYnVuY2guY29tIiwiYnV4ZG90LmNvbSIsImJ1eS1pbnN0YWdyYW0uY29tIiwiYnV5aW5naXEuY29tIiwiYnV6emZlZWQuY29tIiwiYnV6em1hZy5qcCIsImJ1enpwcm94eS5jb20iLCJidXp6dHRlci5jb20iLCJieGRsdy5jb20iLCJieHd4Lm5ldCIsImJ4d3gub3JnIiwiYnljb250ZXh0LmNvbSIsImJ5ZXRob3N0OC5jb20iLCJieXBhc3MtYmxvY2suY29tIiwiYnlwYXNzMTIzLmNvbSIsImJ5cGFzc2FibGUuY29tIiwiYnlwYXNzc2Nob29sZmlsdGVyLmNvbSIsImJ5cGFzc3NpdGUuY29tIiwiYnlwYXNzdGhhdC5jb20iLCJieXBhc3N0aGUubmV0IiwiYnlwYXNzeS5jb20iLCJieXByb3h5c2VydmVyLmNvbSIsImJ5dGJpbC5jb20iLCJjLXNwYW52aWRlby5vcmciLCJjMDA5Lm5ldCIsImMyYnNhLmNvbSIsImNhY2Fvd2ViLm9yZyIsImNhY253LmNvbSIsImNhY3R1c3Zwbi5jb20iLCJjYWRkeS5pZHYudHciLCJjYWRlbmExMDAuZXMiLCJjYWRlbmEzLmNvbSIsImNhZmVibG9nLmh1IiwiY2FmZXByZXNzLmNvbSIsImNhZmVwcmVzcy5jb20uYXUiLCJjYWhhbC1tYW5pYS5jb20iLCJjYWxhbWVvLmNvbSIsImNhbGNpYXRvcmlicnV0dGkuY29tIiwiY2FsZ2FyeWNoaW5lc2UuY29tIiwiY2FsZ2FyeW5ld2xpZmUuY29tIiwiY2Fsb28uanAiLCJjYW00LmNvLnVrIiwiY2FtNC5jb20iLCJjYW00LmNvbS5hdSIsImNhbTQuY29tLmJyIiwiY2FtNC5jb20uY3kiLCJjYW00LmNvbS50ciIsImNhbTQuanAiLCJjYW00Lm5sIiwiY2FtZG91Z2guY29tIiwiY2FtZWxlby5ydSIsImNhbWVyYWNhcHR1cmVzLmNvbSIsImNhbWZyb2cuY29tIiwiY2FtcGFpZ25saXZlLmNvLnVrIiwiY2FtcGJlbGxza2l0Y2hlbi5jb20iLCJjYW1zLmNvbSIsImNhbXMuY29tLmF1IiwiY2FuYWRhLmNvbSIsImNhbmFkYW1lZXQubWUiLCJjYW5hbHBsdXMuZnIiLCJjYW5saXNrb3IuY29tIiwiY2FubGl5YXlpbi5vcmciLCJjYW5vZS5jYSIsImNhbm9uaWNhbC5jb20iLCJjYW50di5uZXQiLCJjYW55dS5vcmciLCJjYW8uaW0iLCJjYW8zMS5jb20iLCJjYW82NC5jb20iLCJjYW84OS5jb20iLCJjYW9iaWFuLmluZm8iLCJjYW9jaGFuZ3FpbmcuY29tIiwiY2FvbGl1c2hlcXU1MjAuaW5mbyIsImNhb3Bvcm4uY29tIiwiY2FwYWRlZmFjZWJvb2suY29tIiwiY2FwdGFpbnNxdWFydGVycy5jb20iLCJjYXB0aW9uc2ZvcnlvdXR1YmUuY29tIiwiY2FyLmNvbSIsImNhcmFiaW5hc3lwaXN0b2xhcy5jb20iLCJjYXJhZGlzaWFjLmNvbSIsImNhcmFuZGNsYXNzaWMuY28udWsiLCJjYXJlZXJsYXVuY2hlci5jb20iLCJjYXJpLmNvbS5teSIsImNhcm1vdG9yc2hvdy5jb20iLCJjYXJ0b29uYW5pbWVmYW5zLmNvbSIsImNhcnRvb25tb3ZlbWVudC5jb20iLCJjYXJ0b29ubmV0d29ya3Nob3AuY29tIiwiY2FydG9vbnNleHgubmV0IiwiY2FydG9vbnNxdWVlbi5jb20iLCJjYXJ6b25lLmllIiwiY2FzYXRpYmV0Lm9yZy5teCIsImNhc2hhZHByb3h5LmluZm8iLCJjYXNoZm9yc2V4dGFwZS5jb20iLCJjYXNpbm9iZWxsaW5pLmNvbSIsImNhc2lub2V1cm8uY29tIiwiY2FzaW5vbGFzdmVnYXMuY29tIiwiY2FzaW5vcml2YS5jb20iLCJjYXN0YW5ldC5uZXQiLCJjYXQtd29ybGQuY29tLmF1IiwiY2F0aG5ld3MuY29tIiwiY2F0aG9saWMub3JnLmhrIiwiY2F0aG9saWMub3JnLnR3IiwiY2F0aHZvaWNlLm9yZy50dyIsImNiYy5jYSIsImNic25ld3MuY29tIiwiY2J6czg4Ny5jbiIsImNjLWFuaW1lLmNvbSIsImNjZGFpbGkuY29tIiwiY2NkdHIub3JnIiwiY2NpbS5vcmciLCJjY3Byb3h5LnB3IiwiY2N0aGVyZS5jb20iLCJjY3RvbmdiYW8uY29tIiwiY2N0djV6Yi5jb20iLCJjY3VlLmNhIiwiY2N1ZS5jb20iLCJjY3lvdXR1YmUuY29tIiwiY2Rpc2NvdW50LmNvbS5jbyIsImNkbmV0LnR2IiwiY2RuZXdzLmNvbS50dyIsImNkbnMuY29tLnR3IiwiY2RvMjMuaWR2LnR3IiwiY2RzbXZvZC5jb20iLCJjZS5nb3YuYnIiLCJjZTRhcmFiLmNvbSIsImNlY2MuZ292IiwiY2VnYWxhcGl0YXNpcm9yc3phZ2Jhbi5pbmZvIiwiY2VsLnJvIiwiY2VsZWJyaXR5bW92aWVibG9nLmNvbSIsImNlbGxwaG9uZXNob3AubmV0IiwiY2VudGFuZXQuY29tIiwiY2VudGVyYmJzLmNvbSIsImNlbnRlcmJsb2cubmV0IiwiY2VudHJvbWV0ZW9pdGFsaWFuby5pdCIsImNlbnR1cnljaGluYS5jb20iLCJjZW50dXJ5cy5uZXQiLCJjZXJkYXMuY29tIiwiY2VzdW1hci5iciIsImNmaXNoLmlkdi50dyIsImNoYWJhZC5vcmciLCJjaGFuZGFuLm9yZyIsImNoYW5nZS5vcmciLCJjaGFuZ2U1MjEuY29tIiwiY2hhbmdldGhlaXAuY29tIiwiY2hhbmdwLmNvbSIsImNoYW5ueWVpbi5vcmciLCJjaGFwLnNjaC5pciIsImNoYXBtMjUuY29tIiwiY2hhcm9uYm9hdC5jb20iLCJjaGF0dXJiYXRlLmNvbSIsImNoYXR6eS5jb20iLCJjaGF5aWNpLmluZm8iLCJjaGVhcGVyc2Vla2VyLmNvbSIsImNoZWFweW91dHViZS5jb20iLCJjaGVja2VkcHJveHlsaXN0cy5jb20iLCJjaGVja215bWlsZi5jb20iLCJjaGVja3RoaXMuY29tIiwiY2hlZWt5LmNvbS5hciIsImNoZW5nbWluZ21hZy5jb20iLCJjaGVuZ3JlbmJhci5jb20iLCJjaGVuZ3VhbmdjaGVuZy5jb20iLCJjaGVzc2JvbWIuY29tIiwiY2hlemFzaXRlLmNvbSIsImNoaWNhZ29ub3cuY29tIiwiY2hpY2FzZmFjZWJvb2suY29tIiwiY2hpZWZzdW4ub3JnLnR3IiwiY2hpbmEtbGFib3VyLm9yZy5oayIsImNoaW5hLXByb3h5Lm9yZyIsImNoaW5hLXdlZWsuY29tIiwiY2hpbmExMDEuY29tIiwiY2hpbmE1MDAwLnVzIiwiY2hpbmFjaXR5LmJlIiwiY2hpbmFjaXR5aW5mby5iZSIsImNoaW5hZGlhbG9ndWUubmV0IiwiY2hpbmFkaWdpdGFsdGltZXMubmV0IiwiY2hpbmFlbGVjdGlvbnMub3JnIiwiY2hpbmFld2Vla2x5LmNvbSIsImNoaW5hZ2Z3Lm9yZyIsImNoaW5hZ3JlZW5wYXJ0eS5vcmciLCJjaGluYWdyb3dzLmNvbSIsImNoaW5haHVzaC5jb20iLCJjaGluYWlucGVyc3BlY3RpdmUuY29tIiwiY2hpbmFpbnBlcnNwZWN0aXZlLm9yZyIsImNoaW5hbGFib3J3YXRjaC5vcmciLCJjaGluYW11bGUuY29tIiwiY2hpbmFwcmVzcy5jb20ubXkiLCJjaGluYXByb3h5Lm1lIiwiY2hpbmFyZWFjdGlvbi5jb20iLCJjaGluYXJpZ2h0c2lhLm9yZyIsImNoaW5hc21pbGUubmV0IiwiY2hpbmF0b3BpeC5jb20iLCJjaGluYXRvd24uY29tLmF1IiwiY2hpbmF0dW5nc3Rlbi5jb20iLCJjaGluYXdvcmtlci5pbmZvIiwiY2hpbmF5b3V0aC5vcmcuaGsiLCJjaGluZXNlLWhlcm1pdC5uZXQiLCJjaGluZXNlLm5ldC5hdSIsImNoaW5lc2VkYWlseS5jb20iLCJjaGluZXNlaW5sYS5jb20iLCJjaGluZXNlbG92ZWxpbmtzLmNvbSIsImNoaW5lc2VuLmRlIiwiY2hpbmVzZXBlbi5vcmciLCJjaGluZXNlcG9ybndlYi5jb20iLCJjaGluZXNldGFsa3MubmV0IiwiY2hpbmdjaGVvbmcuY29tIiwiY2hpbmhwaHUudm4iLCJjaG9kaWVudHUudm4iLCJjaG9pc3Rlci5ydSIsImNob3N1bi5jb20iLCJjaHJpc3RhYmVsbGUuaWR2LnR3IiwiY2hyaXN0aWFubWF0Y2htYWtlci5jb20iLCJjaHJpc3RpYW5zdHVkeS5jb20iLCJjaHJpc3RpYW50aW1lcy5vcmcuaGsiLCJjaHJsYXd5ZXJzLmhrIiwiY2hyb21lLmNvbSIsImNodWJieXBhcmFkZS5jb20iLCJjaHViYnlwb3JuLnh4eCIsImNodWJ1bi5jb20iLCJjaHVuc2h1aXRhbmcuY29tLnR3IiwiY2llbnkuY29tIiwiY2luY29kaWFzLmNvbSIsImNpbmVtYXRpY2tldC5vcmciLCJjaW5lc3BvcnQuY29tIiwiY2lwcm94eS5kZSIsImNpcmNsZW9mbW9tcy5jb20iLCJjaXJjb3ZpcmFsLmNvbSIsImNpdGkuY29tIiwiY2l0aWJhbmsuY28uanAiLCJjaXRpemVubGFiLm9yZyIsImNpdHkzNjUuY2EiLCJjaXR5OXguY29tIiwiY2l0eWNsdWJjYXNpbm8uY29tIiwiY2l0eXZpYmUuY29tIiwiY2l2aWNwYXJ0eS5oayIsImNpdmlsbWVkaWEudHciLCJjaXZpc2VjLm9yZyIsImNpeHByb3h5LmNvbSIsImNrMTAxLmNvbSIsImNrZHZkLmNvbSIsImNrZjU4MC5jb20iLCJjbGFzc2ljYWxpdGUuY29tIiwiY2xhc3NpZmllZHM0bWUuY29tIiwiY2xhc3NpZmllZHNmb3JmcmVlLmNvbSIsImNsYXltb250LmNvbSIsImNsYi5vcmcuaGsiLCJjbGVhbmFkdWx0aG9zdC5jb20iLCJjbGVhbmZyZWVwb3JuLmNvbSIsImNsZWFyY2xpcHMuY29tIiwiY2xlYXJoYXJtb255Lm5ldCIsImNsZWFyaGlkZS5jb20iLCJjbGVhcnB4LmluZm8iLCJjbGVhcndpc2RvbS5uZXQiLCJjbGVvYm9vYnMuY29tIiwiY2xpY2ljLmNvbSIsImNsaWNrcHJvdGVjdHMuY29tIiwiY2xpY2t4dGkuY29tIiwiY2xpbmljYS10aWJldC5ydSIsImNsaXAuZGoiLCJjbGlwYXJ0cGFuZGEuY29tIiwiY2xpcGZpc2guZGUiLCJjbGlwaHVudGVyLmNvbSIsImNsaXBvbnl1LmNvbSIsImNsaXBzb255b3V0dWJlLmNvbSIsImNsaXB0b2RheS52biIsImNsaXB4b29tLmNvbSIsImNsaXB5b3V0dWJlLmNvbSIsImNsaXRnYW1lcy5jb20iLCJjbG9ha2VkLmV1IiwiY2xvb2IuY29tIiwiY2xvdWRmb3JjZS5jb20iLCJjbG91ZHZwbi5iaXoiLCJjbHQyMC5jb20iLCJjbHViLWUubmV0IiwiY2x1YmVkaW5oZWlyb25vZmFjZWJvb2suY29tIiwiY2x1YnRoYWljaGl4LmNvbSIsImNtc3RyYWRlci5jb20iLCJjbXVsZS5jb20iLCJjbXVsZS5uZXQiLCJjbjYuZXUiLCJjbmEuY29tIiwiY25hLmNvbS5iciIsImNuYS5jb20udHciLCJjbmFiYy5jb20iLCJjbmF2aXN0YS5jb20udHciLCJjbmQub3JnIiwiY25pdHRlci5jb20iLCJjbm4uY29tIiwiY25wcm94eS5jb20iLCJjbnRyYXZlbGxlci5jb20iLCJjbnllcy5jb20iLCJjbnp6LmNjIiwiY28udHYiLCJjb2NobGVhci5jb20iLCJjb2RlLWNsdWIuaWR2LnR3IiwiY29kZTE5ODQuY29tIiwiY29kZWFzaXRlLmNvbSIsImNvZGlnb2VzcGFndWV0aS5jb20iLCJjb2VucmFldHMub3JnIiwiY29mb28uY29tIiwiY29pbndhcnouY29tIiwiY29sYWNsYXNzaWMuY28udWsiLCJjb2xldmFsbGV5Y2hyaXN0aWFuLm9yZyIsImNvbGZpbmFuY2lhbC5jb20iLCJjb2xsZWdlYm9hcmQuY29tIiwiY29sb3JteWZhY2Vib29rLmNvbSIsImNvbS51ayIsImNvbWNhc3QuY29tIiwiY29tZG90Z2FtZS5jb20iLCJjb21lZnJvbWNoaW5hLmNvbSIsImNvbWljYm9vay5jb20iLCJjb21sdS5jb20iLCJjb21tYW5kYXJtcy5jb20iLCJjb21wYW55Y2hlY2suY28udWsiLCJjb21wYXJlcmFqYS5pbiIsImNvbXBhc3Mtc3R5bGUub3JnIiwiY29tcGxldGVsb3VuZ2UuY29tIiwiY29tcHJhcnNlZ3VpZG9yZXNpbnN0YWdyYW0uY29tIiwiY29tcHJlc3Nub3cuY29tIiwiY29tcHV0ZXJ2YWxsZXkuaXQiLCJjb21weXRob24ubmV0IiwiY29uZXhhb2tpbmdob3N0LmNvbS5iciIsImNvbmZpZ3VyYXJlcXVpcG9zLmNvbSIsImNvbm5lY3QuZmFjZWJvb2submV0IiwiY29uc3VtZXIuZXMiLCJjb250YWN0bXVzaWMuY29tIiwiY29udG9lcm90aWNvLmNvbSIsImNvbnZlcnNpb25oZXJvLmNvbS5iciIsImNvbnZlcnRpc3NldXIteW91dHViZS5jb20iLCJjb252ZXJ0b25saW5lZnJlZS5jb20iLCJjb252ZXJ0eW91dHViZS5jb20iLCJjb29iYWkuY29tIiwiY29vYmF5LmNvbSIsImNvb2tpZXBhcnRzLmNvbSIsImNvb2tpbmdsaWdodC5jb20iLCJjb29sYWxlci5jb20iLCJjb29sYml0cy5vcmciLCJjb29sbG91ZC5vcmcudHciLCJjb29sbmN1dGUuY29tIiwiY29vbHNwb3R0ZXJzLmNvbSIsImNvb2xzdW4uaWR2LnR3IiwiY29wZXJ0aW5hZmFjZWJvb2suY29tIiwiY29yY2hvZGVscGFpcy5jb20iLCJjb3JwYmFuay5jb20iLCJjb3JyZWN0LWluc3RhbGwuY29tIiwiY29ycmVpbzI0aG9yYXMuY29tLmJyIiwiY29ycmVpb2JyYXppbGllbnNlLmNvbS5iciIsImNvcnRlcmEuY29tIiwiY29zbW9oaXNwYW5vLmNvbSIsImNvdHdlZXQuY29tIiwiY291Z2FycG9ybi5jb20iLCJjb3VudHJ5dnBuLmNvbSIsImNvdXZlcnR1cmVmYWNlYm9vay5jb20iLCJjb3ZlcmVkY2EuY29tIiwiY292ZXJ0YnJvd3NpbmcuY29tIiwiY293Y290bGFuZC5jb20iLCJjcGoub3JnIiwiY3Byb3h5LmNvbSIsImNwcm94eWVyLmNvbSIsImNwdWlkLmNvbSIsImNxZW50Lm5ldCIsImNyYWNrZWQuY29tIiwiY3JhY2tsZS5jb20iLCJjcmFja2xlLmNvbS5hciIsImNyYWNrbGUuY29tLmJyIiwiY3JhY2tsZS5jb20uZG8iLCJjcmFja2xlLmNvbS5teCIsImNyYWNrbGUuY29tLnBhIiwiY3JhY2tsZS5jb20udmUiLCJjcmF6eXMuY2MiLCJjcmF6eXNoaXJ0cy5jb20iLCJjcmVhZGVycy5uZXQiLCJjcmVhcmxpc3RhY29ueW91dHViZS5jb20iLCJjcmVhcnRpZW5kYWVuZmFjZWJvb2suY29tIiwiY3JlYXRlaXQucGwiLCJjcmVhdGl2ZWJsb3EuY29tIiwiY3JpYXJpbnN0YWdyYW0uY29tIiwiY3JpYXJpbnN0YWdyYW0uY29tLmJyIiwiY3JtbHMub3JnIiwiY3JvY29ndWlkZS5jb20iLCJjcm9jb3R1YmUuY29tIiwiY3JvbmljYS5jb20uYXIiLCJjcm9zc3RoZXdhbGwubmV0IiwiY3Jvc3N2cG4ub3JnIiwiY3Jvd25wcm94eS5jb20iLCJjcm93bnJveWFsLmNvbSIsImNydWlzZWNyaXRpYy5jb20iLCJjc2hhYmMuY29tIiwiY3NzLXZhbGlkYXRvci5vcmciLCJjc3VjaGljby5lZHUiLCJjdGZyaWVuZC5uZXQiLCJjdGl0di5jb20udHciLCJjdHMuY29tLnR3IiwiY3R0c3J2LmNvbSIsImN0dW5uZWwuY29tIiwiY3UuZWR1LmVnIiwiY3ViYWNvbnRlbXBvcmFuZWEuY29tIiwiY3Voa2Fjcy5vcmciLCJjdWlodWEub3JnIiwiY3Vpd2VpcGluZy5uZXQiLCJjdWx0ZGVhZGNvdy5jb20iLCJjdWx0dXJlLnR3IiwiY3VtLWluLWFpci5jb20iLCJjdW1wb29sLmNvbSIsImN1bXBvcm50dWJlLmNvbSIsImN1cG9ub21pYS5jb20uYnIiLCJjdXJlam95LmNvbSIsImN1cmV6b25lLm9yZyIsImN1cnAuZ29iLm14IiwiY3VycnlzLmNvLnVrIiwiY3VydGluZG9pbWFnZW5zbm9mYWNlYm9vay5jb20iLCJjdXRlODIuY29tIiwiY3V0ZWRlYWRndXlzLm5ldCIsImN2cy5jb20iLCJjdy5jb20udHciLCJjd2FoaS5uZXQiLCJjd2IuZ292LnR3IiwiY3liZXItbmluamEuanAiLCJjeWJlcmN0bS5jb20iLCJjeWJlcmdob3N0dnBuLmNvbSIsImN5YmVydHJhbnNsYXRvci5pZHYudHciLCJjeWNsZXdvcmxkLmNvbSIsImN5bGV4LmNvbS5hdSIsImN5dHUuYmUiLCJjei5jYyIsImQtMDY0LmNvbSIsImQtYWdlbmN5Lm5ldCIsImQwei5uZXQiLCJkMTAwLm5ldCIsImQxZy5jb20iLCJkMmpzcC5vcmciLCJkM2pzLm9yZyIsImQ4LnR2IiwiZDljbi5jb20iLCJkOXZvZC5jb20iLCJkYWJyLmNvLnVrIiwiZGFkYXppbS5jb20iLCJkYWRlc2Nob29scy5uZXQiLCJkYWRpMzYwLmNvbSIsImRhZXBpc28uY29tIiwiZGFmYWhhby5jb20iLCJkYWlkb3N0dXAucnUiLCJkYWlsaWFuLmNvLmtyIiwiZGFpbGlkYWlsaS5jb20iLCJkYWlsaWxhLm5ldCIsImRhaWx5Lm1rIiwiZGFpbHlkb3QuY29tIiwiZGFpbHlmeC5jb20uaGsiLCJkYWlseW1lLmNvbSIsImRhaWx5bW90aW9uLmNvbSIsImRhaWx5bmV3cy5jb20iLCJkYWlseW5vcnNlbWFuLmNvbSIsImRhaWx5c3RyZW5ndGgub3JnIiwiZGFpbHl0ZWNoLmNvbSIsImRhaXJ5bWFyeS5jb20iLCJkYWl3YTIxLmNvbSIsImRhaml5dWFuLmNvbSIsImRhaml5dWFuLmV1IiwiZGFrZG93bi5uZXQiLCJkYWxhaWxhbWEtaGFtYnVyZy5kZSIsImRhbGFpbGFtYS5jb20iLCJkYWxhaWxhbWFjZW50ZXIub3JnIiwiZGFsYWlsYW1hd29ybGQuY29tIiwiZGFsaXVsaWFuLmNvbSIsImRhbWltaS51cyIsImRhbmNpbmdiZWFyLmNvbSIsImRhbmZvc3MuY29tIiwiZGFuZ2VycHJveHkuY29tIiwiZGFuanVyLmNvbSIsImRhbmtlNGNoaW5hLm5ldCIsImRhbnRlbncuY29tIiwiZGFud2VpLm9yZyIsImRhb2xhbi5uZXQiLCJkYXBldGR1aXRkYXJpdHdpdHRlci5jb20iLCJkYXJtb3dlLXByb3h5LnBsIiwiZGF0ZWZvcm1vcmUuZGUiLCJkYXZpZGd1by5pZHYudHciLCJkYXZpZG5ld3MuY29tIiwiZGF2aWR6aWVnbGVyLm5ldCIsImRhd2hvaXMuY29tIiwiZGF5YWJvb2suY29tIiwiZGF5bGlmZS5jb20iLCJkYXlvbmVhcHAuY29tIiwiZGJzLmNvbSIsImRjbWlsaXRhcnkuY29tIiwiZGQtcGVsaWN1bGFzLmNvbSIsImRkODU4LmNvbSIsImRkYy5jb20udHciLCJkZGZuZXR3b3JrLmNvbSIsImRkaHcuY29tIiwiZGRsdmFsbGV5LnJvY2tzIiwiZGRucy5tZSIsImRkbnMubWUudWsiLCJkZG9rYmFyby5jb20iLCJkZG9vLmNjIiwiZGRzb25neXkuY29tIiwiZGUtc2NpLm9yZyIsImRlYWxhbS5jb20iLCJkZWFyaG9uZXkuaWR2LnR3IiwiZGVhcm1vbmV5Lmlkdi50dyIsImRlYmlhbi5vcmciLCJkZWNhdGhsb24uY29tLmJyIiwiZGVjYXRobG9uLmluIiwiZGVkdWR1LmNvbSIsImRlZmF1bHQtc2VhcmNoLm5ldCIsImRlZmlsdGVyLnVzIiwiZGVmaW5lYmFiZS5jb20iLCJkZWtoby5pbiIsImRlbGV0ZWZhY2Vib29rLmNvbSIsImRlbGZpZWxkLmNvbSIsImRlbGhpLmdvdi5pbiIsImRlbHNoZWthc3RlLmNvbSIsImRlbWFuZHdhcmUubmV0IiwiZGVtb2NyYXRzLm9yZyIsImRlbW90aXZhdGlvbi5tZSIsImRlbm1hcmtiYXkuaW5mbyIsImRlbm9mZ2Vlay51cyIsImRlbnlzb2Z5YW4ud2ViLmlkIiwiZGVwby51YSIsImRlcHFjLmNvbSIsImRlcmVraHN1LmhvbWVpcC5uZXQiLCJkZXNjYXJnYXJpbnN0YWdyYW0uY29tIiwiZGVzY2FyZ2FydmlkZW9zZmFjZWJvb2suY29tIiwiZGVzY2FyZ2FyeW91dHViZS5jb20iLCJkZXNrYXBwbGljLmNvbSIsImRlc3RpbnljaGF0LmNvbSIsImRlc3Ryb3ltaWxmLmNvbSIsImRld2F3ZWIuY29tIiwiZGV6aXlvdS5pbiIsImRmLmdvYi5teCIsImRnbG9iZS5jb20iLCJkZ3NuanMuY29tIiwiZGhhZHMuY29tIiwiZGhsLmNvbSIsImRobC5pdCIsImRpYWdlby1jYXJlZXJzLmNvbSIsImRpYWdlby5jb20iLCJkaWFveXVpc2xhbmRzLm9yZyIsImRpYXJpb2RlY3ViYS5jb20iLCJkaWFyaW9kZWN1eW8uY29tLmFyIiwiZGlhcmlvZGVuYXZhcnJhLmVzIiwiZGlhcmlvbGFzYW1lcmljYXMuY29tIiwiZGlhcmlvdmFzY28uY29tIiwiZGlhcnkucnUiLCJkaWNhc2luc3RhZ3JhbS5jb20iLCJkaWdpc29jaWFsLmNvbSIsImRpZ2l0YWxjYW1lcmF3b3JsZC5jb20iLCJkaWdpdGFsam91cm5hbC5jb20iLCJkaWdpdGFsa2FtZXJhLmRlIiwiZGlnaXRhbGxpbmsuaW5mbyIsImRpZ2l0YWxyb29tLmNvbSIsImRpZ2l0YWxzcHkuY28udWsiLCJkaWdpdGFsdHJlbmRzLmNvbSIsImRpaWdvLmNvbSIsImRpbGJlcnQuY29tIiwiZGltZW5zaW9uZGF0YS5jb20iLCJkaW5hbWluYS5sayIsImRpbmRvLmNvbS5jbyIsImRpbm9kaXJlY3QuY29tIiwiZGlvLmlkdi50dyIsImRpcGl0eS5jb20iLCJkaXBseS5jb20iLCJkaXB1dGFkb3MuZ29iLm14IiwiZGlzY3Vzcy5jb20uaGsiLCJkaXNjdXNzNHUuY29tIiwiZGlzaXNlLmNvbSIsImRpc25leS5lcyIsImRpc25leS5wbCIsImRpc25leWp1bmlvci5jb20iLCJkaXNwLmNjIiwiZGlzcHV0LmF6IiwiZGl0LWluYy51cyIsImRpdGVub2suY29tIiwiZGl2ZWludG9odG1sNS5pbmZvIiwiZGl2eGRsLmluZm8iLCJkaXd1amkuY2MiLCJkaXlpZG0ubmV0IiwiZGl6aGlkaXpoaS5jb20iLCJkaXppZ29sZC5jb20iLCJkaXppd3UuY29tIiwiZGphemFpcmVzcy5jb20iLCJkampzcS5jb20iLCJkam9yei5jb20iLCJkanRlY2h0b29scy5jb20iLCJka3Byb3h5LmNvbSIsImRsLW9ubGluZS5jb20iLCJkbGluay5jb20iLCJkbTUuY29tIiwiZG1tMTgubmV0IiwiZG1uZXdzLmNvbSIsImRuYi5jb20iLCJkbnMyZ28uY29tIiwiZG5zY3J5cHQub3JnIiwiZG5zdHViZS50ayIsImRvM24uY29tIiwiZG9jYmFvLnZuIiwiZG9jc3RvYy5jb20iLCJkb2N0b3Jwcm94eS5jb20iLCJkb2N0b3J2b2ljZS5vcmciLCJkb2dicmVlZGluZm8uY29tIiwiZG9nZWNvaW4uY29tIiwiZG9naWRlYXNpdGUuY29tIiwiZG9neHh4dHViZS5jb20iLCJkb2ppbi5jb20iLCJkb2stZm9ydW0ubmV0IiwiZG9sYy5kZSIsImRvbWFpLm5yIiwiZG9tYWluLmNsdWIudHciLCJkb21haW40aWsucnUiLCJkb21pay51YSIsImRvbmdmYW5nc2hvdWxpZS5jb20iLCJkb25nbGUuY2MiLCJkb25ndGFpd2FuZy5jb20iLCJkb25ndGFpd2FuZy5uZXQiLCJkb25neWFuZ2ppbmcuY29tIiwiZG9ua3BhcnR5LmNvbSIsImRvbnRmaWx0ZXIudXMiLCJkb28uaWR2LnR3IiwiZG9vYml0LmluZm8iLCJkb3ByLm5ldCIsImRvcmplc2h1Z2Rlbi5jb20iLCJkb3NzaWVyZmFtaWxpYWwuY29tIiwiZG90cGxhbmUuY29tIiwiZG90c3ViLmNvbSIsImRvdHVwLm9yZyIsImRvdHZwbi5jb20iLCJkb3VibGVjbGljay5jb20iLCJkb3VwaGluZS5jb20iLCJkb3VzeW9rby5uZXQiLCJkb3V5dXR2LmNvbSIsImRvd25hdi5jb20iLCJkb3duZmFjZWJvb2suY29tIiwiZG93bmxvYWQtbm93LWZvci1wYy5uZXQiLCJkb3dubG9hZC5jb20iLCJkb3dubG9hZC5jb20udm4iLCJkb3lvdXRoaW5raW1wcm94eS5pbmZvIiwiZHBwLm9yZy50dyIsImRyYWdvbmJ5dGUtdGVjaC5jb20iLCJkcmFndGltZXMuY29tIiwiZHJhdWdhcy5sdCIsImRyZWFtbW92aWVzLmNvbSIsImRyZWFtbmV0LmNvbSIsImRyZWFteW91dHViZS5jb20iLCJkcmliYmJsZS5jb20iLCJkcm9pZHZwbi5jb20iLCJkcm9wYm94LmNvbSIsImRyb3Bib3guY29tLmJyIiwiZHJvcGJveHByb3h5LmNvbSIsImRyb3B0YXNrLmNvbSIsImRydHViZXIuY29tIiwiZHJ1bmtlbnRlZW5vcmdpZXMuY29tIiwiZHNwYXJraW5nLmNvbSIsImR0dW5uZWwuY29tIiwiZHR6LXVnbC5jb20iLCJkdS5hYy5pbiIsImR1YW50aWFuLmNvbSIsImR1YmludGVydmlld2VyLmNvbSIsImR1Y2suaGsiLCJkdWNrZHVja2dvLmNvbSIsImR1Y2tsb2FkLmNvbSIsImR1Z2EuanAiLCJkdWlodWEub3JnIiwiZHVueWFidWx0ZW5pLm5ldCIsImR1bnlhbmV3cy50diIsImR1b3dlaXRpbWVzLmNvbSIsImR1cGluZy5uZXQiLCJkdXNoaS5jYSIsImR1c3QtNTE0Lm9yZyIsImR1dGNocHJveHkubmwiLCJkdmQtNTAuY29tIiwiZHZkdmlkZW9zb2Z0LmNvbSIsImR3LXdvcmxkLmNvbSIsImR3LXdvcmxkLmRlIiwiZHcuZGUiLCJkd2hlZWxlci5jb20iLCJkd25ld3MuY29tIiwiZHhpb25nLmNvbSIsImR5MS5jYyIsImR5MjAxOC5jb20iLCJkeTc3ODguY29tIiwiZHk5MS5jb20iLCJkeWhsdy5jb20iLCJkeWxpYW5tZW5nLmNvbSIsImR5bmFtby5raWV2LnVhIiwiZHluZG5zLm9yZyIsImR6ZW1wbG9pLm9yZyIsImR6emUuY29tIiwiZS1kYXNoZXIuY29tIiwiZS1mYW1pbHluZXQuY29tIiwiZS1nb2xkLmNvbSIsImUtaW5mby5vcmcudHciLCJlLWtvZ2FsLmNvbSIsImUtc2hvcC5nciIsImUtc3BhY3kuY29tIiwiZS10cmFkZXJsYW5kLm5ldCIsImUtdHJhdmVsLmNvbSIsImUxLnJ1IiwiZTEyMy5oayIsImUyMDIwLmNvLm56IiwiZTk2LnJ1IiwiZWFnbGVwcm94eS5jb20iLCJlYXJ0aGxpbmt0ZWxlLmNvbSIsImVhc3Rjb2FzdG1hbWEuY29tIiwiZWFzdGdhbWUub3JnIiwiZWFzeS1oaWRlaXAuY29tIiwiZWFzeTYwNC5jb20iLCJlYXN5YWdlLm9yZyIsImVhc3licmFuY2hlcy5jb20iLCJlYXN5Y2EuY2EiLCJlYXN5ZmluYW5jZS5ydSIsImVhc3lwaWMuY29tIiwiZWFzeXNwYWNlLmNvbSIsImVhc3l2cG5zZXJ2aWNlLmNvbSIsImVhc3l3ZWIuaGsiLCJlYXpvbi5jb20iLCJlYmF5Y29tbWVyY2VuZXR3b3JrLmNvbSIsImVib255LWJlYXV0eS5jb20iLCJlYm9ueXR1YmV0di5jb20iLCJlYm9va2Jyb3dzZS5jb20iLCJlYnVka2EuY29tIiwiZWJ1eWNsdWIuY29tIiwiZWNlbnRlci5pZHYudHciLCJlY2hvLm1zay5ydSIsImVjaG9lY2hvLmNvbSIsImVjaG9mb24uY29tIiwiZWNvbW1hdGUuaW5mbyIsImVjb21tZXJjZWJyYXNpbC5jb20uYnIiLCJlY29ub215Lmdvdi5heiIsImVjc3RhcnQuY29tIiwiZWN1bWVuaWNhbG5ld3MuY29tIiwiZWN1cmVkLmN1IiwiZWN4cy5hc2lhIiwiZWRlYml5YXRkZWZ0ZXJpLmNvbSIsImVkZ2VjYXN0Y2RuLm5ldCIsImVkaWN5cGFnZXMuY29tIiwiZWRpbGx5LmNvbSIsImVkbW9udG9uY2hpbmEuY29tIiwiZWRvbWV4LmdvYi5teCIsImVkb29ycy5jb20iLCJlZHAyNC5jby51ayIsImVkdGd1aWRlLmNvbSIsImVkdWJyaWRnZS5jb20iLCJlZmNjLm9yZy5oayIsImVmZS5jb20iLCJlZmYub3JnIiwiZWZwZmFuZmljLm5ldCIsImVmdWt0LmNvbSIsImVmeXRpbWVzLmNvbSIsImVnaXRpbXl1dmFzaS5jb20iLCJlZ3lpZy5jb20iLCJlaGFuZGVsLnNlIiwiZWoucnUiLCJlay51YSIsImVsLWFubnVhaXJlLmNvbSIsImVsLW1leGljYW5vLmNvbS5teCIsImVsYXJ0ZWRlc2FiZXJ2aXZpci5jb20iLCJlbGNvbG9tYmlhbm8uY29tIiwiZWxkaWFyaW9tb250YW5lcy5lcyIsImVsZGlhcmlvbnkuY29tIiwiZWxlY3Ryb25pY3NjbHViLmluZm8iLCJlbGVmYW50LnJvIiwiZWxlcGhhbnRqb3VybmFsLmNvbSIsImVsZXZpb3IuY29tIiwiZWxoZXJhbGRvLmNvIiwiZWxpYXNjbGVhbmVycy5jby51ayIsImVsaWZlLmlyIiwiZWxpc2FiZXR0YWJlcnRvbGluaS5jb20iLCJlbGl0ZXByb3NwZWN0cy5jb20iLCJlbGl6YWJldGhhdmVudWV3ZXN0LmNvbSIsImVsbGVudHYuY29tIiwiZWxsZXVrLmNvbSIsImVsbWVtZS5tZSIsImVsbWVyY3VyaW8uY29tIiwiZWxtdW5kby5jb20udmUiLCJlbG5vcnRlLmNvbSIsImVsb24uZWR1IiwiZWxwYWlzLmNvbSIsImVscGFpcy5jb20uY28iLCJlbHBhaXMuY29tLmRvIiwiZWxwYWlzLmNvbS51eSIsImVsc2FoZnkuY29tIiwiZWxzYWx2YWRvcnR2Lm9yZyIsImVsc2VwdGltb2FydGUubmV0IiwiZWx0b25kaXNuZXkuY29tIiwiZWx3YXRhbi5jb20iLCJlbWFsbHMuaXIiLCJlbWJlZGluc3RhZ3JhbS5jb20iLCJlbWVkZW11amVyLmNvbSIsImVtZWRpYXRlLmV1IiwiZW1mb3JjZS5jby5rciIsImVtZ29nLmNvbSIsImVtaWx5cy1jbG9zZXQuY29tIiwiZW1vamlzdHdpdHRlci5jb20iLCJlbW9yeS5lZHUiLCJlbW90aWNvbi1mYWNlYm9vay5jb20iLCJlbXBpcmVvbmxpbmUuY29tIiwiZW1wbG95bWVudGxhd2FsbGlhbmNlLmNvbSIsImVtcG9ybml1bS5tZSIsImVtcHR5bWlycm9yYm9va3MuY29tIiwiZW11bGUtZWQyay5jb20iLCJlbXZpZGVpcmEuY29tLmJyIiwiZW5iYW5rLm5ldCIsImVuY2FiZXphZG9zdHdpdHRlci5jb20iLCJlbmNhci5jb20iLCJlbmN5Y2xvLm5sIiwiZW5kZWF2b3Iub3JnLmJyIiwiZW5lbC5jb20iLCJlbmVyZ3kuZ292IiwiZW5mYWwuZGUiLCJlbmdhZGdldC5jb20iLCJlbmdoZWxhYmUtZXNsYW1pLmNvbSIsImVuZ2xpc2hmb3JldmVyeW9uZS5vcmciLCJlbmdsaXNoZnJvbWVuZ2xhbmQuY28udWsiLCJlbmdsaXNocGVuLm9yZyIsImVuZ2xpc2h0ZWFzdG9yZS5jb20iLCJlbmpveWZyZWV3YXJlLm9yZyIsImVuam95cHJveHkuY29tIiwiZW5qdWljZS5jb20iLCJlbmxhZGlzY28uY29tIiwiZW5zdGFyei5jb20iLCJlbnRlbC5jbCIsImVudGVydGFpbm1lbnR3aXNlLmNvbSIsImVudGlyZWx5cGV0cy5jb20iLCJlbnRudC5jb20iLCJlb2dsaS5vcmciLCJlb2lkYy5uZXQiLCJlb25saW5lLmNvbSIsImVwZmluZGlhLmNvbSIsImVwaG90b3ppbmUuY29tIiwiZXBpY3Bvcm50dWJlLmNvbSIsImVwaXNjb3BhbGNodXJjaC5vcmciLCJlcG9jaGhrLmNvbSIsImVwb2NodGltZXMtYmcuY29tIiwiZXBvY2h0aW1lcy1yb21hbmlhLmNvbSIsImVwb2NodGltZXMuY28uaWwiLCJlcG9jaHRpbWVzLmNvLmtyIiwiZXBvY2h0aW1lcy5jb20iLCJlcG9jaHRpbWVzLmNvbS5iciIsImVwb2NodGltZXMuY29tLmhrIiwiZXBvY2h0aW1lcy5jb20udHciLCJlcG9jaHRpbWVzLmNvbS51YSIsImVwb2NodGltZXMuZGUiLCJlcG9jaHRpbWVzLmZyIiwiZXBvY2h0aW1lcy5pZSIsImVwb2NodGltZXMuaXQiLCJlcG9jaHRpbWVzLmpwIiwiZXBvY2h0aW1lcy5ydSIsImVwb2NodGltZXNjaGljYWdvLmNvbSIsImVwb2Nod2Vla2x5LmNvbSIsImVwc3BvcnQuaWR2LnR3IiwiZXF1aW5lbm93LmNvbSIsImVyYWJhcnUubmV0IiwiZXJlbW5ld3MuY29tIiwiZXJlcHVibGlrLmNvbSIsImVyZ2Vibmlzc2VsaXZlLmNvbSIsImVybnN0aW5ncy1mYW1pbHkuZGUiLCJlcm9oYXJ1aGkubmV0IiwiZXJvaWxvZy5jb20iLCJlcm9qdW1wLm5ldCIsImVyb3NoaW5iby5jb20iLCJlcm90aWNzYWxvb24ubmV0IiwiZXJzbGlzdC5jb20iLCJlc2VjdXJlLmNvbS50dyIsImVzaGFrdGkuY29tIiwiZXNwMjUwNS5pbmZvIiwiZXNwcmVzby50diIsImVzcXVpcmUuZXMiLCJlc3Rla2h0YW0uY29tIiwiZXN0d2l0dGVyLmNvbSIsImV0YWR1bHQuY29tIiwiZXRhaXdhbm5ld3MuY29tIiwiZXR5bW9ubGluZS5jb20iLCJldWNhc2luby5jb20iLCJldWdlbmRvcmYubmV0IiwiZXVsYW0uY29tIiwiZXVybzJkYXkuZ3IiLCJldXJvbWlsaG9lcy5jb20iLCJldXJvcGFnZXMuY29tLnJ1IiwiZXVyb3BhZ2VzLnBsIiwiZXVyb3BhbGFjZS5jb20iLCJldXJvcGVhbmNoZXNzY2x1YmN1cDIwMTQuY29tIiwiZXVyb3Byb3h5LmV1IiwiZXVyb3RyYXZlbC5pZHYudHciLCJldXJvd29uLmNvbSIsImV2YS52biIsImV2ZW50dXJlLmNvbSIsImV2ZXN0aGVyZW55b3V0dWJlLmNvbSIsImV2aXRlLmNvbSIsImV2b3VzLmZyIiwiZXhibG9nLmNvLmpwIiwiZXhjZWxzaW9yLmNvbS5teCIsImV4Y2l0ZS5lcyIsImV4Y25uLmNvbSIsImV4Z2ZzaGVhdmVuLmNvbSIsImV4cGF0LWRha2FyLmNvbSIsImV4cGF0cHJveHkuY29tIiwiZXhwZWRpYS5jYSIsImV4cGVkaWEuY28ua3IiLCJleHBlZGlhLmNvbSIsImV4cGVkaWEuY29tLnNnIiwiZXhwZWRpYS5kZSIsImV4cGVkaWEuZnIiLCJleHBla3QuY29tIiwiZXhwZXJ0cy11bml2ZXJzLmNvbSIsImV4cGxvYWRlci5uZXQiLCJleHBvZnV0dXJlcy5jb20iLCJleHByZXNzLXZwbi5jb20iLCJleHByZXNzLmJlIiwiZXhwcmVzc3Zwbi5jb20iLCJleHByZXNzdnBuLm9yZyIsImV4dG1hdHJpeC5jb20iLCJleHRvbGUuY29tIiwiZXh0cmFwcm94eS5jb20iLCJleHRyYXZpZC5jb20iLCJleHRyZW1lZXh0cmVtZWV4dHJlbWUuY29tIiwiZXh0cmVtZWZ0dmdpcmxzLmNvbSIsImV4dHJlbWVmdXNlLmNvbSIsImV4dHJlbWV0dWJlLmNvbSIsImV4dmFnb3MuY29tIiwiZXh3b2xmLmNvbSIsImV5bnkuY29tIiwiZXpib3guaWR2LnR3IiwiZXpjb21tZXJjZS5jb20uYnIiLCJlenBjLnRrIiwiZXpwZWVyLmNvbSIsImY0aC5jb20iLCJmYWJvcm94eS5jb20iLCJmYWJ1bG91c2Zvb2RzLmNvbSIsImZhY2Vib29rLmNvbSIsImZhY2Vib29rLmNvbS5iciIsImZhY2Vib29rLmNvbS5wayIsImZhY2Vib29rLmNvbS52biIsImZhY2Vib29rLm5ldCIsImZhY2VoaWRkZW4uY29tIiwiZmFjZWxlc3MubWUiLCJmYWN0bW9uc3Rlci5jb20iLCJmYWN0c2xpZGVzLmNvbSIsImZhaWwuaGsiLCJmYWlsem9vbS5jb20iLCJmYWtrdS5uZXQiLCJmYWt0eS51YSIsImZhbHNlZmlyZS5jb20iLCJmYWx1bmRhZmEub3JnIiwiZmFtaWxqZWxpdi5zZSIsImZhbWlseWZlZC5vcmciLCJmYW1pbHlmcmllbmRwb2Vtcy5jb20iLCJmYW4tcWlhbmcuY29tIiwiZmFuZGVqdWVnb3MuY29tIiwiZmFuZmljdGlvbi5uZXQiLCJmYW5nYmlueGluZy5jb20iLCJmYW5wYWdla2FybWEuY29tIiwiZmFucWlhbmdob3UuY29tIiwiZmFuczRwcm94eS5jb20iLCJmYW50YXN5LWhhbmRqb2IuY29tIiwiZmFueWF5bGMuY29tIiwiZmFueXVlLmluZm8iLCJmYXBkdS5jb20iLCJmYXB2aWQuY29tIiwiZmFyc3R3aXR0ZXIuY29tIiwiZmFyd2VzdGNoaW5hLmNvbSIsImZhc2hpb25ob3Rib3guY29tIiwiZmFzaGlvbm5zdHlsZS5jb20iLCJmYXNoaW9ucHVsaXMuY29tIiwiZmFzaGlvbnNuYXAuY29tIiwiZmFzdC1wcm94eS5jb20uZGUiLCJmYXN0ZXN0LXByb3h5LmNvbS5kZSIsImZhc3RmcmVlcHJveHkuaW5mbyIsImZhc3RmcmVlcHJveHkub3JnIiwiZmFzdGZyZXNoLmluZm8iLCJmYXN0cHJpdi5jb20iLCJmYXN0cHJveHlmcmVlLmluZm8iLCJmYXN0cHJveHluZXR3b3JrLmNvbSIsImZhc3R1c2Fwcm94eS5jb20iLCJmYXQtYXNzLXR1YmUuY29tIiwiZmF0YnJvd25ndXkuY29tIiwiZmF0ZXN0LmdhIiwiZmF0Z2lybHNleC5uZXQiLCJmYXRwb3JuLnh4eCIsImZhdHByb3h5LmNvbSIsImZhdm90dGVyLm5ldCIsImZhdnN0YXIuZm0iLCJmYXdhbmdodWlodWkub3JnIiwiZmJjZG4uY29tIiwiZmJjZG4ubmV0IiwiZmJ1bmJsb2NrZXIubmV0IiwiZmMyLmNvbSIsImZjMmJsb2cubmV0IiwiZmMyY2hpbmEuY29tIiwiZmNjYXNoLmNvbSIsImZjZGFsbGFzLmNvbSIsImZkYm94LmNvbSIsImZkYzg5LmpwIiwiZmVhdGhlcnNpdGUuY29tIiwiZmViZXIuc2UiLCJmZWRuZXRiYW5rLmNvbSIsImZlZWRidXJuZXIuY29tIiwiZmVuZ2ZpcmUuaW5mbyIsImZlbmd6aGVuZ2h1LmNvbSIsImZlcnJhcml3b3JsZC5jb20iLCJmZXNjb21haWwubmV0IiwiZmV0aXNoYm94LmNvbSIsImZldGlzaHBvcm5maWxtcy5jb20iLCJmZXV2ZXJ0LmZyIiwiZmZmZmYuYXQiLCJmZnByb3h5LnB3IiwiZmZzdXJmLm5ldCIsImZnbXR2Lm9yZyIsImZobi5nb3YuYXoiLCJmaTUudXMiLCJmaWF0Lml0IiwiZmliaGFiZXIuY29tIiwiZmlnYXJldC5jb20iLCJmaWdodG5ld3MuY29tIiwiZmlsZS5zaCIsImZpbGVmYWN0b3J5LmNvbSIsImZpbGVmYXAuY29tIiwiZmlsZWZseWVyLmNvbSIsImZpbGVnaXIuY29tIiwiZmlsZXMybWUuY29tIiwiZmlsZXNkb3dubG9hZGVyLmNvbSIsImZpbGVzZXJ2ZS5jb20iLCJmaWxtNGlrLnJ1IiwiZmlsbWFmZmluaXR5LmNvbSIsImZpbG1lc2RveW91dHViZS5jb20iLCJmaWxtZmFyZS5jb20iLCJmaWxtdWxldHVsLXppbGVpLnJvIiwiZmlsbXV4Lm5ldCIsImZpbHRlcmJ5cGFzcy5tZSIsImZpbHRoZHVtcC5jb20iLCJmaW5hbGZhbnRhc3l4aXYuY29tIiwiZmluYW5jZXR3aXR0ZXIuY29tIiwiZmluYW56YXNwZXJzb25hbGVzLmNvbS5jbyIsImZpbmFuemZyYWdlLm5ldCIsImZpbmFuem5hY2hyaWNodGVuLmRlIiwiZmluY2h2cG4uY29tIiwiZmluZGFtby5jb20iLCJmaW5kaXQuZmkiLCJmaW5kbWVzcG90LmNvbSIsImZpbmVwcm94eS5vcmciLCJmaW5ldmlkcy5jb20iLCJmaW5sYW5kYmF5LmluZm8iLCJmaW5tYXJrZXQucnUiLCJmaXJlb2ZsaWJlcnR5Lm9yZyIsImZpcmVwbGFjZWNvdW50cnkuY29tIiwiZmlyZXR3ZWV0LmlvIiwiZmlyc3RhbmFscXVlc3QuY29tIiwiZmlyc3RtZXJjaGFudHMuY29tIiwiZmlyc3Ryb3dwcm94eS5vcmciLCJmaXJzdHJvd3B0LmV1IiwiZmlyc3R0b2tub3cuY29tIiwiZml0NGxpZmUucnUiLCJmaXRuZXNzZmlyc3QuY28udGgiLCJmaXRuZXNzZmlyc3QuY28udWsiLCJmaXRuZXNzZmlyc3QuY29tLmF1IiwiZml0cHJlZ25hbmN5LmNvbSIsImZpeHByb3h5LmNvbSIsImZpenppay5jb20iLCJmbGFnbWEucnUiLCJmbGFnc29ubGluZS5pdCIsImZsYW1lZmFucy5jb20iLCJmbGFzaHBvaW50LWludGVsLmNvbSIsImZsYXNocG9ybm1vdnMuY29tIiwiZmxhc2hzY29yZS5ybyIsImZsYXR1aWNvbG9ycy5jb20iLCJmbGlja3IuY29tIiwiZmxpcGl0LmNvbSIsImZsaXBvcmEuY29tIiwiZmxpdHRvLmNvbSIsImZsbmV0Lm9yZyIsImZsdWVnZS5kZSIsImZsdWVudHUuY29tIiwiZmx5NGV2ZXIubWUiLCJmbHltZW93Lmlkdi50dyIsImZseXBncy5jb20iLCJmbHlwcm94eS5jb20iLCJmbHl2cG4uY29tIiwiZmx5dnBuLm5ldCIsImZtOTQ5c2QuY29tIiwiZm9jdXN2cG4uY29tIiwiZm9sa2ZhY2Vib29rLmNvbSIsImZvbGxvdy1pbnN0YWdyYW0uY29tIiwiZm9sbG93ZXJzaW5zdGFncmFtLmNvbSIsImZvbnRyaXZlci5jb20iLCJmb29kYW5kd2luZS5jb20iLCJmb29kYmxvZ2dlcnByby5jb20iLCJmb29maW5kLmNvbSIsImZvb2xzbW91bnRhaW4uY29tIiwiZm9vb29vLmNvbSIsImZvb3Rlby5jb20iLCJmb290d2VhcmV0Yy5jb20iLCJmb3JjZS5jb20iLCJmb3JjZWRmbGl4LmNvbSIsImZvcmZyZWVzdXJmaW5nLm5ldCIsImZvcm9pcGhvbmUuY29tIiwiZm9ycmVudC5qcCIsImZvcnVtNGhrLmNvbSIsImZvcnVtcy1mcmVlLmNvbSIsImZvcnVteC5jb20uYnIiLCJmb3RvLWdpcmwuY29tIiwiZm90b3NwYXJhdHdpdHRlci5jb20iLCJmb3ZhbmVzYS5jb20iLCJmb3hidXNpbmVzcy5jb20iLCJmcG10Lm9yZyIsImZwc2MuZ292LnBrIiwiZnBzZXhnYWxzLmNvbSIsImZxcm91dGVyLmNvbSIsImZyLmNyIiwiZnJhbmNlMjQuY29tIiwiZnJhbmNlOTkuY29tIiwiZnJhbmNlYmxldS5mciIsImZyYW5jZWluZm8uZnIiLCJmcmFuY2VpbnRlci5mciIsImZyYW5jZXByb3h5Lm5ldCIsImZyYW5jZXByb3h5Lm9yZyIsImZyYXNlZmFjZWJvb2suY29tIiwiZnJhc2VzY2VsZWJyZXMubmV0IiwiZnJhdC1wYXJ0eS1zbHV0cy5jb20iLCJmcmF6cGMucGwiLCJmcmNuYi5jb20iLCJmcmVha29ub21pY3MuY29tIiwiZnJlYWtzaGFyZS5jb20iLCJmcmVkYXN2b2ljZS5jb20iLCJmcmVlLS1wcm94eS5uZXQiLCJmcmVlLWVib29rcy5uZXQiLCJmcmVlLWhpZGVpcC5jb20iLCJmcmVlLW1wMy1kb3dubG9hZC5vcmciLCJmcmVlLW9ubGluZXByb3h5LmNvbSIsImZyZWUtcHJveHktb25saW5lLmNvbSIsImZyZWUtcHJveHkuY29tLmRlIiwiZnJlZS1wcm94eXNlcnZlci5jb20iLCJmcmVlLXByb3h5c2l0ZS5jb20iLCJmcmVlLXNleHZpZGVvc2ZjMi5jb20iLCJmcmVlLXNzaC5jb20iLCJmcmVlLXRlZW4tcHVzc3kuY29tIiwiZnJlZS11bmJsb2NrLmNvbSIsImZyZWUtd2ViLXByb3h5LmRlIiwiZnJlZS13ZWJwcm94eS5jb20iLCJmcmVlLXh4eC1wb3JuLm9yZyIsImZyZWUuZnIiLCJmcmVlMTgubmV0IiwiZnJlZTRwcm94eS50diIsImZyZWU0dS5jb20uYXIiLCJmcmVlOC5jb20iLCJmcmVlYW5pbWFsc2V4dHViZS5uZXQiLCJmcmVlYW5pbWVzb25saW5lLmNvbSIsImZyZWViYXNlLmNvbSIsImZyZWViZWFyYmxvZy5vcmciLCJmcmVlYmllLWFjLmNvbSIsImZyZWVieXBhc3MuY29tIiwiZnJlZWJ5cGFzc3Byb3h5LmNvbSIsImZyZWVjYW5hZGF2cG4uY29tIiwiZnJlZWNoYWwuY29tIiwiZnJlZWRvbS1pcC5jb20iLCJmcmVlZG9taG91c2Uub3JnIiwiZnJlZWZjMi5jb20iLCJmcmVlZ2FvLmNvbSIsImZyZWVoZW50YWltYW5nYS5uZXQiLCJmcmVlaG9zdGlhLmNvbSIsImZyZWVrYXRsaXR0ZXIuY29tIiwiZnJlZWxpYnMub3JnIiwiZnJlZWxvdHRvLmNvbSIsImZyZWVtcDNpbi5jb20iLCJmcmVlbXAzdmlkZW8ubmV0IiwiZnJlZW1wM3gub3JnIiwiZnJlZW5ldC1jaGluYS5vcmciLCJmcmVlbmV0cHJvamVjdC5vcmciLCJmcmVlbmluamFwcm94eS5jb20iLCJmcmVlbmluamFwcm94eS5pbmZvIiwiZnJlZW9kYS5jb20iLCJmcmVlb3BlbnByb3h5LmNvbSIsImZyZWVvcGVudnBuLmNvbSIsImZyZWVvei5vcmciLCJmcmVlcGVuLmdyIiwiZnJlZXBlb3BsZS5jb20iLCJmcmVlcGhvdG9zZXJpZXMuY29tIiwiZnJlZXBvcm4udG8iLCJmcmVlcG9ybm9mcmVlcG9ybi5jb20iLCJmcmVlcHB0cHZwbi5uZXQiLCJmcmVlcHJveHktc2VydmVyLm5ldCIsImZyZWVwcm94eS5pbyIsImZyZWVwcm94eS5uZXQiLCJmcmVlcHJveHkucm8iLCJmcmVlcHJveHk0eW91LmNvbSIsImZyZWVwcm94eWxpc3RzLmNvbSIsImZyZWVwcm94eXNlcnZlci5jYSIsImZyZWVwcm94eXNlcnZlci5uZXQiLCJmcmVlcHJveHlzZXJ2ZXIudWsiLCJmcmVlcHJveHl0b2NoYW5nZWlwLmNvbSIsImZyZWVwcm94eXdlYmxpc3QuY29tIiwiZnJlZXNhZmVpcC5jb20iLCJmcmVlc2VydmUuY28udWsiLCJmcmVlc2V4OC5jb20iLCJmcmVlc2V4dHViZS5jb20iLCJmcmVlc29mdC5ydSIsImZyZWVzcGVlY2hkZWJhdGUuY29tIiwiZnJlZXNzdHB2cG4uY29tIiwiZnJlZXRpYmV0Lm9yZyIsImZyZWV2aWRlb3Byb3h5LmNvbSIsImZyZWV2aWV3bW92aWVzLmNvbSIsImZyZWV2cG4uY2MiLCJmcmVldnBuc2FrdXJhLmNvbSIsImZyZWV2cG5zcG90LmNvbSIsImZyZWV2cG5zc2guY29tIiwiZnJlZXZwbnNzaC5vcmciLCJmcmVldnBud29ybGQuY29tIiwiZnJlZXdlYnByb3h5LmFzaWEiLCJmcmVld2VicHJveHkuY29tIiwiZnJlZXdlYnByb3h5LmluIiwiZnJlZXdlYnByb3h5LmluZm8iLCJmcmVld2VicHJveHkudXMiLCJmcmVld2Vicy5jb20iLCJmcmVld2VidGVtcGxhdGVzLmNvbSIsImZyZWV3ZWliby5jb20iLCJmcmVld3NvZG93bmxvYWRzLm5ldCIsImZyZWV4aGFtc3Rlci5jb20iLCJmcmVleGlud2VuLmNvbSIsImZyZWV5ZWxsb3cuY29tIiwiZnJlZXlvdXR1YmVwcm94eS5vcmciLCJmcmVuY2h3ZWIuZnIiLCJmcmVzaC1wcm94aWVzLm5ldCIsImZyZXNoYXNpYW50aHVtYnMuY29tIiwiZnJlc2hkZXNrLmNvbSIsImZyZXNoZXJzdm9pY2UuY29tIiwiZnJlc2htYWlsLnBsIiwiZnJlc2hwcm94eS5udSIsImZyZXNocHJveHlsaXN0LmNvbSIsImZyZXNodGVlbnoubmV0IiwiZnJlc2h4eHh0dWJlLmNvbSIsImZyaWVuZGZlZWQuY29tIiwiZnJpbmcuY29tIiwiZnJvbWJhci5jb20iLCJmcm9tcGxheS5jb20iLCJmcm9udGxpbmVkZWZlbmRlcnMub3JnIiwiZnJvb3R2cG4uY29tIiwiZnN1cmYuY29tIiwiZnRhaC5pZHYudHciLCJmdWNkLmNvbSIsImZ1Y2tjbm5pYy5uZXQiLCJmdWNrZWQtdHViZS5jb20iLCJmdWNrZW5ib3JlZC5jb20iLCJmdWNrZW5ib3JlZC5uZXQiLCJmdWNrbXlyZWFsd2lmZS5jb20iLCJmdWNrbnZpZGVvcy5jb20iLCJmdWltcG9zdGluZ2l0LmNvbSIsImZ1bGlrb25nLmNvbSIsImZ1bGxjZWx1bGFyZXMuY29tIiwiZnVsbGRscy5jb20iLCJmdWxsbW92aWV5b3V0dWJlLmNvbSIsImZ1bGx0aWx0cG9rZXIuY29tIiwiZnVsbHR1YmVtb3ZpZXMuY29tIiwiZnVsbHhoYW1zdGVyLmNvbSIsImZ1bGx5cG9ybi5jb20iLCJmdW5jaW9ucHVibGljYS5nb2IubXgiLCJmdW5mLnR3IiwiZnVucC5jb20iLCJmdW5wLmNvbS50dyIsImZ1bnByb3h5Lm5ldCIsImZ1cmZ1ci5tZSIsImZ1dGVib2xhb3Zpdm8ubmV0IiwiZnV0ZmFuYXRpY3MuY29tLmJyIiwiZnV0dWJlLm5ldCIsImZ1dHVyZWNoaW5hZm9ydW0ub3JnIiwiZnV0dXJlcHJveHkuY29tIiwiZnV1Z2xlLm5ldCIsImZ1dXpva3UuaW5mbyIsImZ1eC5jb20iLCJmdnBuLmNvbSIsImZ4Z20uY29tIiwiZnh4Z3cuY29tIiwiZnlkb3dubG9hZC5jb20iLCJmemxtLmNvbSIsImctY2FzaC5iaXoiLCJnYWVwcm94eS5jb20iLCJnYWZvcnVtLm9yZyIsImdhZ2FtYXRjaC5jb20iLCJnYWdnZWR0b3AuY29tIiwiZ2FncmVwb3J0LmNvbSIsImdhbHlhLnJ1IiwiZ2FtY29yZS5jb20iLCJnYW1lYmFzZS5jb20udHciLCJnYW1lY29weXdvcmxkLmNvbSIsImdhbWVqb2x0LmNvbSIsImdhbWVyLmNvbS50dyIsImdhbWVzLmdyIiwiZ2FtZXNvZmRlc2lyZS5jb20iLCJnYW1lc3RsYmIuY29tIiwiZ2FtZXouY29tLnR3IiwiZ2Ftb3VzYS5jb20iLCJnYW5nYmFuZy1hcmVuYS5jb20iLCJnYW5nZXMuY29tIiwiZ2FuaGFyZXVyb21pbGhvZXMuY29tIiwiZ2FvMDEuY29tIiwiZ2FvNDEuY29tIiwiZ2FvY2h1bnYuY29tIiwiZ2FvbWluZy5uZXQiLCJnYW9waS5uZXQiLCJnYW95YW5nc2wuY29tIiwiZ2FwLmNvLnVrIiwiZ2FwLmV1IiwiZ2FyYW5jZWRvcmUuZnIiLCJnYXN0cm9ub20ucnUiLCJnYXRndW5zLmNvbSIsImdhdGhlci5jb20iLCJnYXRoZXJwcm94eS5jb20iLCJnYXRpLm9yZy50dyIsImdhd2tlcmFzc2V0cy5jb20iLCJnYXkteW91dHViZS5jb20iLCJnYXliZWVmLmNvbSIsImdheWNvY2twb3JuLmNvbSIsImdheW0uanAiLCJnYXlwb3JucGljcG9zdC5jb20iLCJnYXpldGEucGwiLCJnYXpldGEucnUiLCJnYXpldGFkaXRhLmFsIiwiZ2F6ZXRhZXNwb3J0aXZhLm5ldCIsImdhemV0ZTIwMjMuY29tIiwiZ2F6ZXRlbGVyLmNvbSIsImdhem8tY2gubmV0IiwiZ2F6b3R1YmUuY29tIiwiZ2F6emV0dGFkaXBhcm1hLml0IiwiZ2Jsb2NrZXIuaW5mbyIsImdjbGwuaW5mbyIsImdjcG5ld3MuY29tIiwiZ2RidC5uZXQiLCJnZGtleGVyY2lzZXRoZXJhcHkuY29tIiwiZ2VibmVnb3ppb25saW5lLmNvbSIsImdlZWttYWRlLmNvLnVrIiwiZ2Vla3NhcmVzZXh5Lm5ldCIsImdlZWtzbnVkZS5jb20iLCJnZWdhc3VyZi5nYSIsImdlbXNjb29sLmNvbSIsImdlbi54eXoiLCJnZW5lcmFsLWVib29rcy5jb20iLCJnZW5lcmFsLXBvcm4uY29tIiwiZ2VuZ2Z1Lm5ldCIsImdlbnltb3Rpb24uY29tIiwiZ2VvY2l0aWVzLmNvLmpwIiwiZ2VvY2l0aWVzLmNvbSIsImdlb21lZGlhbi5jb20iLCJnZW9yZ2lhLmdvdiIsImdlcm1hbi1wcm94eS5jb20uZGUiLCJnZXJtYW4tcHJveHkuZGUiLCJnZXJtYW4td2VicHJveHkuZGUiLCJnZXJtYW55LXByb3h5LmNvbS5kZSIsImdlcm1hbnliYXkuaW5mbyIsImdlc3VuZGhlaXRzZnJhZ2UubmV0IiwiZ2V0Y2h1LmNvbSIsImdldGNsb2FrLmNvbSIsImdldGRvZ3NleC5jb20iLCJnZXRmb3h5cHJveHkub3JnIiwiZ2V0ZnJlZWR1ci5jb20iLCJnZXRpdC5pbiIsImdldGl0b24uY29tIiwiZ2V0amV0c28uY29tIiwiZ2V0bGFudGVybi5vcmciLCJnZXRtZW1hLmNvbSIsImdldHNvY2lhbHNjb3BlLmNvbSIsImdldHVzdnBuLmNvbSIsImdldHlvdXJhbS5jb20iLCJnZmFjZWJvb2suY29tIiwiZ2Zidi5kZSIsImdmc2FsZS5jb20iLCJnZnNvc28uY29tIiwiZ2Z3Lm9yZy51YSIsImdmd2hpa2luZy5vcmciLCJnZ2Rhb2hhbmcuY29tIiwiZ2dwaHQuY29tIiwiZ2dwcm94eS5wdyIsImdnc3NsLmNvbSIsImdob3N0ZXJ5LmNvbSIsImdpNTUuY29tIiwiZ2lhbi5pZHYudHciLCJnaWFudHRpZ2VyLmNhIiwiZ2lkZGVucy5pZHYudHciLCJnaWZ0MDAxLmNvbSIsImdpZnlvdXR1YmUuY29tIiwiZ2lnYWJ5dGUuY29tIiwiZ2lnYW50aXRzLmNvbSIsImdpZ2FudHRpLmZpIiwiZ2lncG9ybm8ucnUiLCJnaWdzYW5kZmVzdGl2YWxzLmNvLnVrIiwiZ2lwc3l0ZWFtLnJ1IiwiZ2lybHNnb2dhbWVzLmNvLnVrIiwiZ2lybHNwbGF5LmNvbSIsImdpdGEuaWR2LnR3IiwiZ2l0Ym9va3MuaW8iLCJnaXRodWIuY29tIiwiZ2l6Ym90LmNvbSIsImdpemxlbi5uZXQiLCJnaXptb2RvLmNvbSIsImdsYW1vdXIucnUiLCJnbGFzc29mcG9ybi5jb20iLCJnbGF2Y29tLnVhIiwiZ2xnb28uY29tIiwiZ2xvYmFsLXByb3h5LmNvbSIsImdsb2JhbC11bml0eS5uZXQiLCJnbG9iYWxld2FsbGV0LmNvbSIsImdsb2JhbGludmVudGlvbnMuY28udWsiLCJnbG9iYWxzb3VyY2VzLmNvbS5jbiIsImdsb2JhbHZvaWNlc29ubGluZS5vcmciLCJnbG9ic2F5YXN5dGVzLm5ldCIsImdsb2NrLmNvbSIsImdsb3J5aG9sZS5jb20iLCJnbHlwZS1wcm94eS5pbmZvIiwiZ21haWxwcm94eS5jb20iLCJnbWFuZS5vcmciLCJnbWVpaHVhLmNvbSIsImdud2F5Lm5ldCIsImdvLWZ1em9rdS50diIsImdvLXBraS5jb20iLCJnbzJhdi5jb20iLCJnb2FnZW50LmJpeiIsImdvYWxhZC5jb20iLCJnb2IudmUiLCJnb2QudHYiLCJnb2RzZGlyZWN0Y29udGFjdC5jb20iLCJnb2RzZGlyZWN0Y29udGFjdC5pbmZvIiwiZ29kc2RpcmVjdGNvbnRhY3Qub3JnIiwiZ29kc2RpcmVjdGNvbnRhY3Qub3JnLnR3IiwiZ29maXJlZmx5Lm9yZyIsImdvZm9sbG93LmZyIiwiZ29mb2xsb3cuaW5mbyIsImdvZ2FtZXMubWUiLCJnb2dvMnNleC5jb20iLCJnb2hhcHB5dGltZS5jb20iLCJnb2hhd2FpaS5jb20iLCJnb2liaWJvLmNvbSIsImdvaW5ndGhlcmUub3JnIiwiZ29sYW5nLm9yZyIsImdvbGRhc2lhbnMuY29tIiwiZ29sZGJldC5jb20iLCJnb2xkaml6ei5jb20iLCJnb2xkcHJveHlsaXN0LmNvbSIsImdvbGZkaWdlc3QuY29tIiwiZ29ta28ubmV0IiwiZ29uZ3d0LmNvbSIsImdvbnpvby5jb20iLCJnb28uZ2wiLCJnb29kYnllbXlkYXJsaW5nLmNvbSIsImdvb2RuZXdzLm9yLmtyIiwiZ29vZHJlYWRlcnMuY29tIiwiZ29vZHJlYWRzLmNvbSIsImdvb2R0aGFpZ2lybC5jb20iLCJnb29kdHYudHYiLCJnb29nbGUtYW5hbHl0aWNzLmNvbSIsImdvb2dsZS5hZCIsImdvb2dsZS5hZSIsImdvb2dsZS5hbCIsImdvb2dsZS5hbSIsImdvb2dsZS5hcyIsImdvb2dsZS5hdCIsImdvb2dsZS5heiIsImdvb2dsZS5iYSIsImdvb2dsZS5iZSIsImdvb2dsZS5iZiIsImdvb2dsZS5iZyIsImdvb2dsZS5iaSIsImdvb2dsZS5iaiIsImdvb2dsZS5icyIsImdvb2dsZS5idCIsImdvb2dsZS5ieSIsImdvb2dsZS5jYSIsImdvb2dsZS5jYXQiLCJnb29nbGUuY2QiLCJnb29nbGUuY2YiLCJnb29nbGUuY2ciLCJnb29nbGUuY2giLCJnb29nbGUuY2kiLCJnb29nbGUuY2wiLCJnb29nbGUuY20iLCJnb29nbGUuY24iLCJnb29nbGUuY28uYW8iLCJnb29nbGUuY28uYnciLCJnb29nbGUuY28uY2siLCJnb29nbGUuY28uY3IiLCJnb29nbGUuY28uaWQiLCJnb29nbGUuY28uaWwiLCJnb29nbGUuY28uaW4iLCJnb29nbGUuY28uanAiLCJnb29nbGUuY28ua2UiLCJnb29nbGUuY28ua3IiLCJnb29nbGUuY28ubHMiLCJnb29nbGUuY28ubWEiLCJnb29nbGUuY28ubXoiLCJnb29nbGUuY28ubnoiLCJnb29nbGUuY28udGgiLCJnb29nbGUuY28udHoiLCJnb29nbGUuY28udWciLCJnb29nbGUuY28udWsiLCJnb29nbGUuY28udXoiLCJnb29nbGUuY28udmUiLCJnb29nbGUuY28udmkiLCJnb29nbGUuY28uemEiLCJnb29nbGUuY28uem0iLCJnb29nbGUuY28uenciLCJnb29nbGUuY29tIiwiZ29vZ2xlLmNvbS5hZiIsImdvb2dsZS5jb20uYWciLCJnb29nbGUuY29tLmFpIiwiZ29vZ2xlLmNvbS5hciIsImdvb2dsZS5jb20uYXUiLCJnb29nbGUuY29tLmJkIiwiZ29vZ2xlLmNvbS5iaCIsImdvb2dsZS5jb20uYm4iLCJnb29nbGUuY29tLmJvIiwiZ29vZ2xlLmNvbS5iciIsImdvb2dsZS5jb20uYnoiLCJnb29nbGUuY29tLmNvIiwiZ29vZ2xlLmNvbS5jdSIsImdvb2dsZS5jb20uY3kiLCJnb29nbGUuY29tLmRvIiwiZ29vZ2xlLmNvbS5lYyIsImdvb2dsZS5jb20uZWciLCJnb29nbGUuY29tLmV0IiwiZ29vZ2xlLmNvbS5maiIsImdvb2dsZS5jb20uZ2giLCJnb29nbGUuY29tLmdpIiwiZ29vZ2xlLmNvbS5ndCIsImdvb2dsZS5jb20uaGsiLCJnb29nbGUuY29tLmptIiwiZ29vZ2xlLmNvbS5raCIsImdvb2dsZS5jb20ua3ciLCJnb29nbGUuY29tLmxiIiwiZ29vZ2xlLmNvbS5seSIsImdvb2dsZS5jb20ubW0iLCJnb29nbGUuY29tLm10IiwiZ29vZ2xlLmNvbS5teCIsImdvb2dsZS5jb20ubXkiLCJnb29nbGUuY29tLm5hIiwiZ29vZ2xlLmNvbS5uZiIsImdvb2dsZS5jb20ubmciLCJnb29nbGUuY29tLm5pIiwiZ29vZ2xlLmNvbS5ucCIsImdvb2dsZS5jb20ub20iLCJnb29nbGUuY29tLnBhIiwiZ29vZ2xlLmNvbS5wZSIsImdvb2dsZS5jb20ucGciLCJnb29nbGUuY29tLnBoIiwiZ29vZ2xlLmNvbS5wayIsImdvb2dsZS5jb20ucHIiLCJnb29nbGUuY29tLnB5IiwiZ29vZ2xlLmNvbS5xYSIsImdvb2dsZS5jb20uc2EiLCJnb29nbGUuY29tLnNiIiwiZ29vZ2xlLmNvbS5zZyIsImdvb2dsZS5jb20uc2wiLCJnb29nbGUuY29tLnN2IiwiZ29vZ2xlLmNvbS50aiIsImdvb2dsZS5jb20udHIiLCJnb29nbGUuY29tLnR3IiwiZ29vZ2xlLmNvbS51YSIsImdvb2dsZS5jb20udXkiLCJnb29nbGUuY29tLnZjIiwiZ29vZ2xlLmNvbS52biIsImdvb2dsZS5jdiIsImdvb2dsZS5jeiIsImdvb2dsZS5kZSIsImdvb2dsZS5kaiIsImdvb2dsZS5kayIsImdvb2dsZS5kbSIsImdvb2dsZS5keiIsImdvb2dsZS5lZSIsImdvb2dsZS5lcyIsImdvb2dsZS5maSIsImdvb2dsZS5mbSIsImdvb2dsZS5mciIsImdvb2dsZS5nYSIsImdvb2dsZS5nZSIsImdvb2dsZS5nZyIsImdvb2dsZS5nbCIsImdvb2dsZS5nbSIsImdvb2dsZS5ncCIsImdvb2dsZS5nciIsImdvb2dsZS5neSIsImdvb2dsZS5obiIsImdvb2dsZS5ociIsImdvb2dsZS5odCIsImdvb2dsZS5odSIsImdvb2dsZS5pZSIsImdvb2dsZS5pbSIsImdvb2dsZS5pcSIsImdvb2dsZS5pcyIsImdvb2dsZS5pdCIsImdvb2dsZS5qZSIsImdvb2dsZS5qbyIsImdvb2dsZS5rZyIsImdvb2dsZS5raSIsImdvb2dsZS5reiIsImdvb2dsZS5sYSIsImdvb2dsZS5saSIsImdvb2dsZS5sayIsImdvb2dsZS5sdCIsImdvb2dsZS5sdSIsImdvb2dsZS5sdiIsImdvb2dsZS5tZCIsImdvb2dsZS5tZSIsImdvb2dsZS5tZyIsImdvb2dsZS5tayIsImdvb2dsZS5tbCIsImdvb2dsZS5tbiIsImdvb2dsZS5tcyIsImdvb2dsZS5tdSIsImdvb2dsZS5tdiIsImdvb2dsZS5tdyIsImdvb2dsZS5uZSIsImdvb2dsZS5ubCIsImdvb2dsZS5ubyIsImdvb2dsZS5uciIsImdvb2dsZS5udSIsImdvb2dsZS5wbCIsImdvb2dsZS5wbiIsImdvb2dsZS5wcyIsImdvb2dsZS5wdCIsImdvb2dsZS5ybyIsImdvb2dsZS5ycyIsImdvb2dsZS5ydSIsImdvb2dsZS5ydyIsImdvb2dsZS5zYyIsImdvb2dsZS5zZSIsImdvb2dsZS5zaCIsImdvb2dsZS5zaSIsImdvb2dsZS5zayIsImdvb2dsZS5zbSIsImdvb2dsZS5zbiIsImdvb2dsZS5zbyIsImdvb2dsZS5zciIsImdvb2dsZS5zdCIsImdvb2dsZS50ZCIsImdvb2dsZS50ZyIsImdvb2dsZS50ayIsImdvb2dsZS50bCIsImdvb2dsZS50bSIsImdvb2dsZS50biIsImdvb2dsZS50byIsImdvb2dsZS50dCIsImdvb2dsZS52ZyIsImdvb2dsZS52dSIsImdvb2dsZS53cyIsImdvb2dsZWFkc2VydmljZXMuY29tIiwiZ29vZ2xlYXBpcy5jb20iLCJnb29nbGVjb2RlLmNvbSIsImdvb2dsZXBhZ2VzLmNvbSIsImdvb2dsZXNpbGUuY29tIiwiZ29vZ2xldXNlcmNvbnRlbnQuY29tIiwiZ29vZ2xldmlkZW8uY29tIiwiZ29veWEuY29tIiwiZ29wZXRpdGlvbi5jb20iLCJnb3Byb3hpbmcuY29tIiwiZ29wcm94eXNlcnZlci5jb20iLCJnb3JzdWNoLmNvbSIsImdvc3BlbGhlcmFsZC5jb20iLCJnb3NwZWxoZXJhbGQuY29tLmhrIiwiZ29zc2lwLXR2LmdyIiwiZ29zdG9zYW5vdmluaGEuY29tIiwiZ29zdXJmLmFzaWEiLCJnb3RvcC5pZHYudHciLCJnb3RydXN0ZWQuY29tIiwiZ291ZGVuZ2lkcy5iZSIsImdvdi5hciIsImdvdm9yaW1wcm8udXMiLCJnb3dhbGxhLmNvbSIsImdvd25pZGVhc2l0ZS5jb20iLCJnb3hmYy5jb20iLCJncG8uZ292IiwiZ3BvZGRlci5uZXQiLCJncHguaWR2LnR3IiwiZ3IyNC51cyIsImdyYWRlci5jb20iLCJncmFmaWthcnQuZnIiLCJncmFuZGFzY2VudC5jb20iLCJncmFuZGVwcmVtaW8uY29tLmJyIiwiZ3Jhbmdvcnoub3JnIiwiZ3JhcGhpcy5uZS5qcCIsImdyZWF0ZmlyZS5vcmciLCJncmVhdGZpcmV3YWxsb2ZjaGluYS5vcmciLCJncmVlY2ViYXkuaW5mbyIsImdyZWVuaG91c2VjaHVyY2gub3JnIiwiZ3JlZW5wYXJ0eS5vcmcudHciLCJncmVlbnByb3h5Lm5ldCIsImdyZWVudnBuLm5ldCIsImdyZWVudnBuLm9yZyIsImdyZW1saW5qdWljZS5jb20iLCJncmpzcS5tZSIsImdyanNxLnR2IiwiZ3JvdXAtZmFjaWFscy5jb20iLCJnc3Aucm8iLCJnc3RhdGljLmNvbSIsImdzdzc3Ny5jb20iLCJndDN0aGVtZXMuY29tIiwiZ3VhbnlpbmNpdHRhLmNvbSIsImd1YXJkc3Rlci5jb20iLCJndWZmaW5zLmNvbSIsImd1aWhhbmcub3JnIiwiZ3VpbGlub2suY29tIiwiZ3VpdGFyd29ybGQuY29tIiwiZ3VqYXJhdC5nb3YuaW4iLCJndW4td29ybGQubmV0IiwiZ3VuLmluLnRoIiwiZ3Vuc2FtZXJpY2EuY29tIiwiZ3Vuc2FuZGFtbW8uY29tIiwiZ3VzdHR1YmUuY29tIiwiZ3V1YmlpLmluZm8iLCJndmh1bnRlci5jb20iLCJndm0uY29tLnR3IiwiZ3lhbHdhcmlucG9jaGUuY29tIiwiZ3lwc3l4eHguY29tIiwiZ3l3eXMuY29tIiwiZ3pib2xpbmt0di5jb20iLCJnemRzc2YuY29tIiwiZ3ptLnR2IiwiaDFkZS5uZXQiLCJoMzFidC5uZXQiLCJoMzN0LnRvIiwiaDUyOC5jb20iLCJoYWFyZXR6LmNvLmlsIiwiaGFhcmV0ei5jb20iLCJoYWJlcjUuY29tIiwiaGFiZXJjaW0xOS5jb20iLCJoYWJlcm5hbWUuY29tIiwiaGFjazg1LmNvbSIsImhhY2tlbi5jYyIsImhhY2tlci5vcmciLCJoYWNraW5nLWZhY2Vib29rLmNvbSIsImhhY2tpbmd1bml2ZXJzaXR5LmluIiwiaGFnYWguY29tLmJyIiwiaGFpcnktYmVhdXR5LmNvbSIsImhhaXJ5LW51ZGlzdC5jb20iLCJoYWtjaG91Zi5jb20iLCJoYWtrYXR2Lm9yZy50dyIsImhhbGxlbHMuY29tIiwiaGFtYS1rLmNvbSIsImhhbmFtb2t1LmNvbSIsImhhbmRicmFrZS5mciIsImhhbmkuY28ua3IiLCJoYW51bnlpLmNvbSIsImhhbzAwMS5uZXQiLCJoYW8xMjMuY29tLmJyIiwiaGFvMTIzMTE0LmNvbSIsImhhb2thbjk0Ni5jbiIsImhhb3NmLmNvbSIsImhhb3NmLmNvbS5jbiIsImhhb3l1bjAxLmNmIiwiaGFwcHljYW1wdXMuY29tIiwiaGFwcHl0cmlwcy5jb20iLCJoYXByb3h5Lm9yZyIsImhhcmRzZXh0dWJlLmNvbSIsImhhcmR3YXJlLmNvbS5iciIsImhhcmR4aGFtc3Rlci5jb20iLCJoYXJyeXBvdHRlcnNob3AuY29tIiwiaGFydW55YWh5YS5jb20iLCJoYXR0cmljay5vcmciLCJoYXZlOC5jb20iLCJoZC1ibG93LmNvbSIsImhkLWZlZXQuY29tIiwiaGQtcG9ybi1tb3ZpZXMuY29tIiwiaGQteGhhbXN0ZXIuY29tIiwiaGRiLmdvdi5zZyIsImhkYmlyZC5jb20iLCJoZHRlZW5wb3JubW92aWVzLmNvbSIsImhkd2FsbHBhcGVyc2lubi5jb20iLCJoZHdpbmcuY29tIiwiaGR6aW9uLmNvbSIsImhlYWx0aGdlbmllLmluIiwiaGVhcnN0bWFncy5jb20iLCJoZWFydC15b3V0dWJlLmNvbSIsImhlYmFvLm5ldCIsImhlY2FpdG91Lm5ldCIsImhlY2hhamkuY29tIiwiaGVncmUtYXJ0LmNvbSIsImhlaXNob3UuY24iLCJoZWl4LnBwLnJ1IiwiaGVqaTcwMC5jb20iLCJoZWxsb2NvdG9uLmZyIiwiaGVsbG90eHQuY29tIiwiaGVsbG91ay5vcmciLCJoZWxscG9ybm8uY29tIiwiaGVsbHMucGwiLCJoZWxwZWFjaHBlb3BsZS5jb20iLCJoZWxwdGFvYmFvLmNuIiwiaGVscHpodWxpbmcub3JnIiwiaGVuaGVucGVuZzguY29tIiwiaGVubmFibG9nc3BvdC5jb20iLCJoZW50YWktaGlnaC1zY2hvb2wuY29tIiwiaGVudGFpbWFuZ2FvbmxpbmUuY29tIiwiaGVudGFpdHViZS50diIsImhlbnRhaXZpZGVvd29ybGQuY29tIiwiaGVudGFpemEubmV0IiwiaGVyaXRhZ2Uub3JnIiwiaGVybWFuemVnZXJtYW4uY29tIiwiaGVyb2Vzd20ucnUiLCJoZXJva3VhcHAuY29tIiwiaGVyb3Byb3h5LmNvbSIsImhlcm96ZXJvZ2FtZS5jb20iLCJoZXJyZW5hdXNzdGF0dGVyLmRlIiwiaGV4aS1oYS5jb20iLCJoZXhpZXNoZS5jb20iLCJoZXlwcm94eS5jb20iLCJoZXl3aXJlLmNvbSIsImhmYWNlYm9vay5jb20iLCJoZ3NlYXYuY29tIiwiaGhwcm94eS5wdyIsImhpLWtpc3MuY29tIiwiaGktb24ub3JnLnR3IiwiaGliaXlhLWxzcC5jb20iLCJoaWRkZW5nb29kLmluZm8iLCJoaWRlLW1lLm9yZyIsImhpZGUubWUiLCJoaWRlLnBsIiwiaGlkZWFuZGdvLmNvbSIsImhpZGVidXguY29tIiwiaGlkZWJ1enouY29tIiwiaGlkZWJ1enoudXMiLCJoaWRlZG9vci5jb20iLCJoaWRlaXAuY28iLCJoaWRlaXBmcmVlLmNvbSIsImhpZGVpcHByb3h5LmNvbSIsImhpZGVpcHZwbi5jb20iLCJoaWRlbWFuLm5ldCIsImhpZGVtZS5iZSIsImhpZGVtZS5pbyIsImhpZGVtZTEwMS5pbmZvIiwiaGlkZW1lMTAyLmluZm8iLCJoaWRlbWUxMDguaW5mbyIsImhpZGVtZTExMC5pbmZvIiwiaGlkZW1lbm93Lm5ldCIsImhpZGVteWFzcy5jb20iLCJoaWRlbXlib3guY29tIiwiaGlkZW15aXBhZGRyZXNzLm9yZyIsImhpZGVteXRyYXhwcm94eS5jYSIsImhpZGVuaW5qYS5jb20iLCJoaWRlb3h5LmNvbSIsImhpZGV0aGVpbnRlcm5ldC5jb20iLCJoaWRldGhpc2lwLm5ldCIsImhpZGV2cG4uYXNpYSIsImhpZGV3ZWJzaXRlLmNvbSIsImhpZGV4eS5jb20iLCJoaWRpbmdub3cub3JnIiwiaGlmaS1mb3J1bS5kZSIsImhpZ2Z3LmNvbSIsImhpZ2gtc3RvbmUtZm9ydW0uY29tIiwiaGloaWZvcnVtLmNvbSIsImhpbGl2ZS50diIsImhpbG9hZC5vcmciLCJoaWxvYWQucGsiLCJoaW1hbGF5YW5nbGFjaWVyLmNvbSIsImhpbWUubWUiLCJoaW1la3VyaWNhbGVuZGFyLmNvbSIsImhpbWVtaXguY29tIiwiaGluZXQubmV0IiwiaGlyb3NoaW1hLXUuYWMuanAiLCJoaXNwZWVkcHJveHkuY29tIiwiaGlzdXBwbGllci5jb20iLCJoaXRmaXguY29tIiwiaGl0Z2Vsc2luLmNvbSIsImhpdHByb3h5LmNvbSIsImhpd2loaGkuY29tIiwiaGl6Yi11dC10YWhyaXIuaW5mbyIsImhpemItdXQtdGFocmlyLm9yZyIsImhqY2x1Yi5pbmZvIiwiaGstcHViLmNvbSIsImhrLm5leHRtZWRpYS5jb20iLCJoazMyMTY4LmNvbSIsImhrNS5jYyIsImhrYXR2bmV3cy5jb20iLCJoa2JmLm9yZyIsImhrYmlnbWFuLm5ldCIsImhrYm9va2NpdHkuY29tIiwiaGtkYWlseW5ld3MuY29tLmhrIiwiaGtlai5jb20iLCJoa2VwYy5jb20iLCJoa2ZvcnVtLmluZm8iLCJoa2ZyZWV6b25lLmNvbSIsImhrZ29sZGVuLmNvbSIsImhrZ3JlZW5yYWRpby5vcmciLCJoa2hlYWRsaW5lLmNvbSIsImhraHJtLm9yZy5oayIsImhranAub3JnIiwiaGtwdHUub3JnIiwiaGtyZXBvcnRlci5jb20iLCJoa3UuaGsiLCJoa3p6OC5jb20iLCJobG9saS5uZXQiLCJobWhhY2suY29tIiwiaG1vbmdhcHAuY29tIiwiaG1vbmdob3QuY29tIiwiaG1vbmdqb2IuY29tIiwiaG1vbmdwbGF5LmNvbSIsImhtb25ncGx1cy5jb20iLCJobXR3ZWIuY29tIiwiaG9iYnlsb2JieS5jb20iLCJob2NrZXlhcHAubmV0IiwiaG9ob3NleC5jb20iLCJob2xhLm9yZyIsImhvbGlkYXlhdXRvcy5kZSIsImhvbGxhbmQuaWR2LnR3IiwiaG9tY29tLXNob3AuZGUiLCJob21lY2luZW1hLWZyLmNvbSIsImhvbWVmdHAubmV0IiwiaG9tZWdyb3duZnJlYWtzLm5ldCIsImhvbWVtYWRlbW92aWV6LmNvbSIsImhvbWVtYWRldHViZXouY29tIiwiaG9tZW5ldC5vcmciLCJob21lcGVydmVyc2lvbi5jb20iLCJob21lcG9ybmFkdmVudHVyZXMuY29tIiwiaG9tZXN0YXlpbi5jb20iLCJob21ldGVlbm1vdnMuY29tIiwiaG9tZXRpZWQuY29tIiwiaG9uZ3poaS5saSIsImhvb3BwYXkuY29tIiwiaG9vdGxldC5jb20iLCJob290c3VpdGUuY29tIiwiaG9wdG8ub3JnIiwiaG9ybnliYnd0dWJlLmNvbSIsImhvcm8uaWR2LnR3IiwiaG9ydWthbi5jb20iLCJob3N0MWZyZWUuY29tIiwiaG9zdDRwb3N0LnB3IiwiaG9zdGVscy5jb20iLCJob3N0aW5nYnVsay5jb20iLCJob3N0aW5nZXIuYWUiLCJob3N0aW5nZXIuY28udWsiLCJob3N0aW5nZXIuY29tLmJyIiwiaG9zdGluZ2VyLmVzIiwiaG9zdGxvdmUuY29tIiwiaG90LXNleC10dWJlLmNvbSIsImhvdC5lZSIsImhvdDUwcGx1cy5jb20iLCJob3Rhdi50diIsImhvdGJveC5jb20iLCJob3Rjb3Vwb253b3JsZC5jb20iLCJob3RmcmVldnBuLmNvbSIsImhvdGZyb2cuY29tLnR3IiwiaG90Z2FtZXNmb3JnaXJscy5jb20iLCJob3Rnb28uY29tIiwiaG90aG1vbmcuY29tIiwiaG90aG91c2UuY29tIiwiaG90bGluZS51YSIsImhvdG5ha2VkbWVuLmNvbSIsImhvdHBlcHBlci5qcCIsImhvdHBvcm5zaG93LmNvbSIsImhvdHBvdGF0by5jb20iLCJob3RzYWxlLmNvbS5teCIsImhvdHNoYW1lLmNvbSIsImhvdHNwb3RzaGllbGQuY29tIiwiaG90dGVlbm1vdmllLmNvbSIsImhvdHR5c3RvcC5jb20iLCJob3R4aGFtc3Rlci5jb20iLCJob3VzZXRvaG9tZS5jby51ayIsImhvdXNld2ViLmNvbS50dyIsImhvdy10by1kaXkub3JnIiwiaG93cHJveHkuY29tIiwiaG93c3R1ZmZ3b3Jrcy5jb20iLCJob3l0cy5jb20uYXIiLCJocC1lei5jb20iLCJocS1zZXgtdHViZS5jb20iLCJocS14aGFtc3Rlci5jb20iLCJocS14bnh4LmNvbSIsImhxY2RwLm9yZyIsImhxZmVtZG9tLmNvbSIsImhxbW92aWVzLmNvbSIsImhxc2V4eWdpcmxzLmNvbSIsImhyYmxvY2suY29tIiwiaHJjaXIuY29tIiwiaHJlYS5vcmciLCJocmljaGluYS5vcmciLCJocm5hYmkuY29tIiwiaHJ3Lm9yZyIsImhzbi5jb20iLCJoc3NlbGl0ZS5jb20iLCJoc3QubmV0LmJyIiwiaHN0YnIubmV0IiwiaHN0ZC5uZXQiLCJoc3Rlcm4ubmV0IiwiaHN0dC5uZXQiLCJodC1hZmdoYW5pc3Rhbi5jb20iLCJodGZjbi5jb20iLCJodGtvdS5uZXQiLCJodG1scHVibGlzaC5jb20iLCJodHRwcHJveHkuc2UiLCJodWFnbGFkLmNvbSIsImh1YW5naHVhZ2FuZy5vcmciLCJodWFyZW4udXMiLCJodWFyZW5saWZlLmNvbSIsImh1ZGF0b3JpcS53ZWIuaWQiLCJodWdlaW5jLmNvbSIsImh1aGFpdGFpLmNvbSIsImh1bGtzaGFyZS5jb20iLCJodW1hbml0ZS5wcmVzc2UuZnIiLCJodW1hbm1ldHJpY3MuY29tIiwiaHVtYW5zZXJ2aWNlcy5nb3YuYXUiLCJodW1taW5nYmlyZDR0d2l0dGVyLmNvbSIsImh1bmcteWEuY29tIiwiaHVudHVyay5uZXQiLCJodW9taWUuY29tIiwiaHVzYWltZW5nLmNvbSIsImh1c3N5dHViZS5jb20iLCJodXN0bGVyaHVucy5jb20iLCJodXQyLnJ1IiwiaHV0b25nOS5uZXQiLCJodXlhbi53ZWIuaWQiLCJodXlhbmRleC5jb20iLCJody5hYy51ayIsImh4aWFvc2h1by5uZXQiLCJoeXBlcmdhbWVzLm5ldCIsImh5cGVyaW56ZXJjZS5jeiIsImktY2FibGUuY29tIiwiaS1zdXguY29tIiwiaS13cml0ZS5pZHYudHciLCJpYW0ubWEiLCJpYW1hYmlnYm9zcy5pbmZvIiwiaWFua2VyLmNvbSIsImlhc2suY2EiLCJpYmMxMjguY29tIiwiaWJlcmlhLmNvbSIsImliaWJvLmNvbSIsImlibGlzdC5jb20iLCJpYm9uZXIuY29tIiwiaWJvb2suaWR2LnR3IiwiaWJvcGUuY29tLmJyIiwiaWJvdHViZS5jb20iLCJpYnRpbWVzLmNvbSIsImlidnBuLmNvbSIsImljYW1zLmNvbSIsImljZXBvcm4uY29tIiwiaWNlcm9ja2V0LmNvbSIsImljaWNpYmFuay5jby5pbiIsImljaWoub3JnIiwiaWNydC5jdSIsImljdHNlY3VyaXR5LmlyIiwiaWN5bGVhZi5jb20iLCJpZDQuaWR2LnR3IiwiaWRhaXdhbi5jb20iLCJpZGFtZXJpY2FueS5jb20iLCJpZGRhYS5jb20iLCJpZGVhbGlzdGEuaXQiLCJpZGVudGkuY2EiLCJpZGVza3RvcC50diIsImlkZmFjZWJvb2suY29tIiwiaWRob3N0aW5nZXIuY29tIiwiaWRva2VwLmh1IiwiaWRvbmV1bS5jb20iLCJpZG91Z2EuY29tIiwiaWRvd25sb2FkcGxheS5jb20iLCJpZG93bmxvYWRzbm93LmNvbSIsImlkcmVhbXguY29tIiwiaWRzYW0uY29tIiwiaWR2LnR3IiwiaWR4LmNvLmlkIiwiaWU2NjYubmV0IiwiaWVkMmsubmV0IiwiaWZhLWJlcmxpbi5kZSIsImlmYW5xaWFuZy5jb20iLCJpZmFuci5jb20iLCJpZmMuY29tIiwiaWZjb25maWcubWUiLCJpZmNzcy5vcmciLCJpZmlsbS5jb20iLCJpZmlsbS5jb20udHciLCJpZnR0dC5jb20iLCJpZy5jb20uYnIiLCJpZ2FmZW5jdS5jb20iLCJpZ2Z3Lm5ldCIsImlnb2dvLmVzIiwiaWdvdG1haWwuY29tLnR3IiwiaWhhdmVhbmlkZWEub3JnIiwiaWhhdmVzbWFsbHRpdHMuY29tIiwiaWhoLm9yZy50ciIsImlpYmVycnkuY29tIiwiaWlqNHUub3IuanAiLCJpaXByb3h5LnB3IiwiaWphcGFuZXNlcG9ybi5jb20iLCJpa2VhLnJ1IiwiaWtlZXBib29rbWFya3MuY29tIiwiaWtsYW5sYWguY29tIiwiaWxnaW9ybmFsZWRpZmFjZWJvb2suY29tIiwiaWxpcmVnLmlyIiwiaWxrZWhhYmVyYWphbnNpLmNvbS50ciIsImlsbWF0dGluby5pdCIsImlsb3ZlY291Z2Fycy5jb20iLCJpbG92ZW1hdHVyZS5uZXQiLCJpbG92ZW1vYmkuY29tIiwiaWxvdmV1dS5pbmZvIiwiaWxvdmV4aGFtc3Rlci5jb20iLCJpbHNvZnR3YXJlLml0IiwiaWx2cG4uY29tIiwiaW04OC50dyIsImltYWdlZmFwLmNvbSIsImltYWdlZmxlYS5jb20iLCJpbWFnZW0tcGFyYS1mYWNlYm9vay5jb20iLCJpbWFnZW5lc2VuZmFjZWJvb2suY29tIiwiaW1hZ2VuZXN5ZnJhc2VzcGFyYWZhY2Vib29rLmNvbSIsImltYWdlbnBhcmFlbGZhY2Vib29rLmNvbSIsImltYWdlbnBhcmFmYWNlYm9vay5jb20iLCJpbWFnZW5zZW1lbnNhZ2Vuc3BhcmFmYWNlYm9vay5jb20iLCJpbWFnZXNmYWNlYm9vay5jb20iLCJpbWFnZXNsb3ZlLm5ldCIsImltYXN0ZXJ5b3V0dWJlLmNvbSIsImltYXppbmcuaWR2LnR3IiwiaW1iLm9yZyIsImltZW5hLnVhIiwiaW1nLmx5IiwiaW1nZXR0aW5ndGhlcmUuY29tIiwiaW1nZmFybS5jb20iLCJpbWdsb3J5LmNvbSIsImlta2V2LmNvbSIsImltbGl2ZS5jb20iLCJpbW1pZ3JhdGlvbi5nb3YudHciLCJpbW1vcmFsLmpwIiwiaW1wb3RzLmdvdXYuZnIiLCJpbXByb3h5LmluZm8iLCJpbXNzLmdvYi5teCIsImltdWplci5jb20iLCJpbi1kaXNndWlzZS5jb20iLCJpbjk5Lm9yZyIsImluYmFuYmFuLmNvbSIsImluYmxvZ3Nwb3QuY29tIiwiaW5jZXp0Lm5ldCIsImluY2xvYWsuY29tIiwiaW5jcmVhc2UteW91dHViZS5jb20iLCJpbmRhbWFpbC5odSIsImluZGVwZW5kZW50LmllIiwiaW5kaWEuY29tIiwiaW5kaWFiYXkuaW5mbyIsImluZGlhY29tLmNvbSIsImluZGlhZnJlZXN0dWZmLmluIiwiaW5kaWFucHJveHkuYml6IiwiaW5kaWFwb3N0Lmdvdi5pbiIsImluZGlhcHJveHkub3JnIiwiaW5kaWVtZXJjaC5jb20iLCJpbmRpZ28uY2EiLCJpbmRvbmVzaWFiYXkuaW5mbyIsImluZG9uZXNpYW5tb3RvcnNob3cuY29tIiwiaW5kb3Bvcy5jby5pZCIsImluZG9zYXQuY29tIiwiaW5keW1lZGlhLm9yZyIsImluZWVkaGl0cy5jb20iLCJpbmZpbml0dW1tb3ZpbC5uZXQiLCJpbmZvLWdyYWYuZnIiLCJpbmZvLW5ldC5jb20ucGwiLCJpbmZvbGlicmUuZXMiLCJpbmZvbi5ydSIsImluZm9ybWFjaW9uLWVtcHJlc2FzLmNvbSIsImluZm9ybWF0aW9uaXNiZWF1dGlmdWwubmV0IiwiaW5mb3dvcmxkLmNvbSIsImluZy5kayIsImluZ3Jlc29zZXh0cmFzY29ueW91dHViZS5jb20iLCJpbmtjbHViLmNvbSIsImlua3VpLmNvbSIsImlubWVkaWFoay5uZXQiLCJpbm5vZ2FtZXMuY29tIiwiaW5vc21pLnJ1IiwiaW5vdGUudHciLCJpbnNhbmV0cmFmZmljbm93LmluZm8iLCJpbnNlZS5mciIsImluc2lkZWZhY2Vib29rLmNvbSIsImluc2lnaHQuY28ua3IiLCJpbnNvbW5pYTI0Ny5ubCIsImluc3RhZ3JhbS5jb20iLCJpbnN0YWdyYW0uY29tLmJyIiwiaW5zdGFncmFtcHJveHkuY29tIiwiaW5zdGFsbG1hYy5jb20iLCJpbnN0YXBhcGVyLmNvbSIsImludGVyZXNuaXRlZS5pbmZvIiwiaW50ZXJtYXJnaW5zLm5ldCIsImludGVybmV0YnJhbmRzLmNvbSIsImludGVybmV0Y2xvYWsuY29tIiwiaW50ZXJvcGVyYWJpbGl0eWJyaWRnZXMuY29tIiwiaW50ZXJzdGF0cy5vcmciLCJpbnRlcnRlbGVjb20udWEiLCJpbnRlcnR3aXR0ZXIuY29tIiwiaW50ZXJ3ZWF2ZXN0b3JlLmNvbSIsImlueW91cnBvY2tldC5jb20iLCJpby51YSIsImlvYml0LmNvbSIsImlvc2hvdy5jb20iLCJpb3h5LmRlIiwiaXBhZGl6YXRlLmVzIiwiaXBhbmVsb25saW5lLmNvbSIsImlwYXNzZXhhbS5jb20iLCJpcGNoYW5nZXByb3h5LmNvbSIsImlwY2hhbmdpbmcuY29tIiwiaXBjbG9hay51cyIsImlwY29uY2VhbC5jb20iLCJpcGdpemxlLm5ldCIsImlwaGlkZXIub3JnIiwiaXBoaWRlcnByby5jb20iLCJpcGhvbi5mciIsImlwaG9uZS1kZXYub3JnIiwiaXBob25lZGV2LmNvLmtyIiwiaXBob3N0ZXIubmV0IiwiaXBqZXRhYmxlLm5ldCIsImlwbGFtYS5jb20iLCJpcG1hc2sudXMiLCJpcHJlZGF0b3Iuc2UiLCJpcHJvZHVjdHMuY29tLnR3IiwiaXByb3h5MDcuY29tIiwiaXB0di5jb20udHciLCJpcHY2cHJveHkubmV0IiwiaXB2YW5pc2guY29tIiwiaXJhbmJheS5pbmZvIiwiaXJhbmdyZWVudm9pY2UuY29tIiwiaXJhbmlhbi5jb20iLCJpcmFuaXByb3h5LmNvbSIsImlyYW5wcm91ZC5jb20iLCJpcmFudm9sbGV5YmFsbC5jb20iLCJpcmFzdXRveWEuY29tIiwiaXJlZG1haWwub3JnIiwiaXJlbGFuZGJheS5pbmZvIiwiaXJpYm5ld3MuaXIiLCJpcm9uZnguY29tIiwiaXNhYWNtYW8uY29tIiwiaXNiLmVkdSIsImlzZXQuY29tLnR3IiwiaXNmcG51LmFjLmlyIiwiaXNpa2luc2FhdGx0ZC5jb20udHIiLCJpc2xhbS5vcmcuaGsiLCJpc2xhbWhvdXNlLmNvbSIsImlzbGFtaWNhd2FrZW5pbmcuY29tIiwiaXNsYW1pY2l0eS5jb20iLCJpc2xhbXRvZGF5Lm5ldCIsImlzbGFuZC5sayIsImlzbWFsbHRpdHMuY29tIiwiaXNub3RvbmZhY2Vib29rLmNvbSIsImlzcC11bmJsb2NrZXIuY29tIiwiaXNwYmFuLmNvbSIsImlzcG9ydC5pciIsImlzcG90LnR2IiwiaXNyYWVsbmF0aW9uYWxuZXdzLmNvbSIsImlzdGFycy5jby5ueiIsImlzdGVmLmluZm8iLCJpc3RpcWxhbGhhYmVyLmNvbSIsImlzdG9ja3Bob3RvLmNvbSIsImlzdW50di5jb20iLCJpdGFseWJheS5pbmZvIiwiaXRhci10YXNzLmNvbSIsIml0YXZpc2VuLm5vIiwiaXRiYXphci5jb20iLCJpdGVzcHJlc3NvLmZyIiwiaXRoYWNhdm9pY2UuY29tIiwiaXRob21lLmNvbS50dyIsIml0d2VldC5uZXQiLCJpdTQ1LmNvbSIsIml1bXNvbmxpbmUub3JnIiwiaXVubG9ja2VyLm5ldCIsIml1c3QuYWMuaXIiLCJpdmFjeS5jb20iLCJpdmMtb25saW5lLmNvbSIsIml3ZWJwcm94eS5jb20iLCJpeGlnby5jb20iLCJpeHF1aWNrLXByb3h5LmNvbSIsIml4cXVpY2suY29tIiwiaXlpbi5uZXQiLCJpemFvYmFvLnVzIiwiaXppaG9zdC5vcmciLCJpemxlcy5uZXQiLCJpemxlc2VtLm9yZyIsIml6bGVzZW5lLmNvbSIsImotYS1wLWEtbi5jb20iLCJqLm1wIiwiajd3eXQuY29tIiwiamFja2ppYS5jb20iLCJqYWNrcG90LnR2IiwiamFjc28uaGsiLCJqYWdvaW52ZXN0b3IuY29tIiwiamFocHJveHkuY29tIiwiamFpbWVsb3Zlc3N0dWZmLmNvbSIsImphbWFhdC5vcmciLCJqYW1lc2VkaXRpb24uY29tIiwiamFuZXMuY29tIiwiamFwYW4td2hvcmVzLmNvbSIsImphcGFuZXNlc3BvcnRjYXJzLmNvbSIsImphcGFucG9zdC5qcCIsImphcGFud2ViLmluZm8iLCJqYXBhbndlYnByb3h5LmNvbSIsImphc2FvcHRpbWFzaXR3aXR0ZXIuY29tIiwiamFzb25hbGRlYW4uY29tIiwiamF2MTg4LmNvbSIsImphdmRvd25sb2FkZXIuaW5mbyIsImphdmhvdC5vcmciLCJqYXZtZS5jb20iLCJqYXZwZWUuY29tIiwiamF2em9vLmNvbSIsImpidGFsa3MuY2MiLCJqZGJicy5jb20iLCJqZWVwb2ZmZXJzLmNhIiwiamVldmFuc2F0aGkuY29tIiwiamVydXNhbGVtLmNvbSIsImpldHp0c3BpZWxlbi5kZSIsImpldXguZnIiLCJqaGFsZGVybS5jb20iLCJqaWVodWEuY3oiLCJqaWVqaWVzaGUuY29tIiwiamllcGFpb2suY29tIiwiamllcGFuZy5jb20iLCJqaWhhZG9sb2d5Lm5ldCIsImppamkuY29tIiwiamltb3BhcnR5LmNvbSIsImppbjExNS5jb20iLCJqaW5ncGluLm9yZyIsImppbmhhaS5kZSIsImppcnVhbi5uZXQiLCJqaXN1ZGFuLm9yZyIsImppdm9zaXRlLmNvbSIsImppenpiby5jb20iLCJqaXp6aHV0LmNvbSIsImpqZ2lybHMuY29tIiwiamtiLmNjIiwiamtmb3J1bS5uZXQiLCJqbGdjeXkuY29tIiwiam1hLW5ldC5nby5qcCIsImptYnVsbGlvbi5jb20iLCJqb2Jpam9iYS5jb20iLCJqb2JyYXBpZG8uY29tIiwiam9icy5uZXQiLCJqb2JzdHJlZXQuY29tLnBoIiwiam9lcHJveHkuY28udWsiLCJqb2V5cm9iZXJ0Lm9yZyIsImpvZm9nYXMuaHUiLCJqb25ueS5pZHYudHciLCJqb29ibGUucnUiLCJqb29tbGEtbW9uc3Rlci5jb20iLCJqb3NlcHZpbmFpeGEuY29tIiwiam91cm5hbGF1dG8uY29tIiwiam91cm5hbGNocmV0aWVuLm5ldCIsImpvdXJuYWxkdWdhbWVyLmNvbSIsImpvdXJuYWxkdWdlZWsuY29tIiwiam95ZW50LmNvbSIsImpwbG9wc29mdC5pZHYudHciLCJqcG13YXJyYW50cy5jb20uaGsiLCJqcXVlcnl1aS5jb20iLCJqci1zaGlrb2t1LmNvLmpwIiwianJhLmpwIiwianNkcG4uY29tIiwianVhbGFrdW50d2l0dGVyLmNvbSIsImp1ZGlzLm5pYy5pbiIsImp1ZWdvcy5jb20iLCJqdWVnb3NkZWNoaWNhcy5jb20iLCJqdWVnb3NkaWFyaW9zLmNvbSIsImp1ZWdvc2p1ZWdvcy5jb20iLCJqdWdnd29ybGQuY29tIiwianVqdWZlZWQuY29tIiwianVsYWliYW8uY29tIiwianVsZXNqb3JkYW4uY29tIiwianVuZWZvdXJ0aC0yMC5uZXQiLCJqdW5nbGVlLmNvbSIsImp1bmhvbmdibG9nLmluZm8iLCJqdXJpc3dheS5vcmcuYnIiLCJqdXN0LWNvb2wubmV0IiwianVzdC5ybyIsImp1c3Rmb3J0aW55cGVvcGxlLmNvbSIsImp1c3RmcmVldnBuLmNvbSIsImp1c3Rpbi50diIsImp1c3Rvbmp1aWNlLmNvbSIsImp1c3RwYXN0ZS5pdCIsImp1c3Rwcm94eS5jby51ayIsImp5eGYubmV0IiwiazEycmVhZGVyLmNvbSIsImsxNzh4LmNvbSIsIms5c2FmZXNlYXJjaC5jb20iLCJrYWd5dS5vcmciLCJrYWd5dS5vcmcuemEiLCJrYWd5dW9mZmljZS5vcmciLCJrYWd5dW9mZmljZS5vcmcudHciLCJrYWl5dWFuLmRlIiwia2FrYW8uY28ua3IiLCJrYWthby5jb20iLCJrYWxlbWUuY29tIiwia2FsZW5kZXJ3b2NoZS5uZXQiLCJrYW4tYmUuY29tIiwia2FuYWw1LmNvbS5tayIsImthbmNiLmNvbSIsImthbmd5ZS5vcmciLCJrYW5vb24uaXIiLCJrYW5zaGlmYW5nLmNvbSIsImthbnpob25nZ3VvLmNvbS5hdSIsImthbzE2NS5pbmZvIiwia2FvdGljLmNvbSIsImthcG9vay5jb20iLCJrYXFpLmNjIiwia2FyYWthcnRhbC5jb20iLCJrYXJtYWxvb3AuY29tIiwia2FybWFwYS5vcmciLCJrYXNpa29ybmJhbmtncm91cC5jb20iLCJrYXQtcHJveHkub3JnIiwia2F0ZWRyYWxhLmN6Iiwia2F0aGltZXJpbmkuZ3IiLCJrYXVmbWljaC5jb20iLCJrYXVuc2EuY29tIiwia2F3YW5sYWguY29tIiwia2F5aGFubG9uZG9uLmNvbSIsImthemUtdHJhdmVsLmNvLmpwIiwia2JsbGR5LmluZm8iLCJrY2V0Lm9yZyIsImtjb21lLm9yZyIsImtlYmFic3RhbGwuY29tIiwia2VicnVtLmNvbSIsImtlY2hhcmEuY29tIiwia2VlcGFuZHNoYXJlLmNvbSIsImtlZXBhd2F5ZnJvbW15LmluZm8iLCJrZWVwbWVzLmNvbSIsImtlaW8uY28uanAiLCJrZWl0aHVyYmFuLm5ldCIsImtlbXBpbnNraS5jb20iLCJrZW4tc3R1ZGlvLmlkdi50dyIsImtlbmRpbmNvcy5uZXQiLCJrZW5lbmdiYS5jb20iLCJrZW5taW5nLmlkdi50dyIsImtlbnRvbmxpbmUuY28udWsiLCJrZXBhcmQuY29tIiwia2VyYWxhLmdvdi5pbiIsImtlcmphbnlhLm5ldCIsImtlc28uY24iLCJrZXRub29pLmNvbSIsImtoYWJkaGEub3JnIiwia2htZXIzMzMuY29tIiwia2htdXNpYy5jb20udHciLCJraG91LmNvbSIsImtpY2thc3Nwcm94eS5jb20iLCJraWNrc3RhcnRlci5jb20iLCJraWNreW91dHViZS5jb20iLCJraWRsb2dnZXIubmV0Iiwia2lraW5vdGUuY29tIiwia2lsbHdhbGwuY29tIiwia2lteS5jb20udHciLCJraW5nNS5jb20iLCJraW5nYmlnLmlkdi50dyIsImtpbmdjb3VudHkuZ292Iiwia2luZ2RvbXNhbHZhdGlvbi5vcmciLCJraW5naG9zdC5jb20iLCJraW5naG9zdC5jb20uYnIiLCJraW5nc3RvbmUuY29tLnR3Iiwia2lub3N0YXIudXoiLCJraXByb3h5LmNvbSIsImtpci5qcCIsImtpc3NiYmFvLmNuIiwia2lzc3lvdXR1YmUuY29tIiwia2l0YWdhd2EtcHJvLmNvbSIsImtpdGNoZW5kZXNpZ25zaWRlYXNpdGUuY29tIiwia2l3aS5reiIsImtrNS5iaXoiLCJra3Byb3h5LmNvbSIsImtrcHJveHkucHciLCJrbC5hbSIsImtsYXJ0LnNlIiwia2xlaW5lemVpdHVuZy5hdCIsImtsaWNrLXRpcHAuY29tIiwia2xpY2ttZW1iZXJzcHJvamVjdC5jb20uYnIiLCJrbGlwLm1lIiwia20ucnUiLCJrbTc3LmNvbSIsImttdGxiYi5jb20iLCJrbmlmZWhvbWUuY29tLnR3Iiwia25taS5ubCIsImtub3dsYXJpdHkuY29tIiwia25vd2xlZGdlcnVzaC5jb20iLCJrbm93eW91cm1vYmlsZS5jb20iLCJrb2FjaS5jb20iLCJrb2JlLW5wLmNvLmpwIiwia29iZS5pZHYudHciLCJrb2NoYmFyLmRlIiwia29taXh4eC5jb20iLCJrb21wYXMuY29tIiwia29tcGFzaWFuYS5jb20iLCJrb21wYXNzLmNvbSIsImtvbXVuaXRhc2ZhY2Vib29rLmNvbSIsImtvb3Juay5jb20iLCJrb296YWkuY29tIiwia29yZWEtdHdpdHRlci5jb20iLCJrb3J6aWsubmV0Iiwia294eS5kZSIsImtwb3AtaW5zdGFncmFtLmNvbSIsImtwb3BzdGFyei5jb20iLCJrcHJveHkuY29tIiwia3Byb3h5LmluIiwia3Bycy5jb20iLCJrcXZvZC5uZXQiLCJrcmFmdGZ1dHRlcm1pc2Nod2Vyay5kZSIsImtyZWF0aW9uanVpY2UuY29tIiwia3Jpc3RpbmFuZGNvcnkuY29tIiwia3NuZXdzLmNvbS50dyIsImt0cm1yLmNvbSIsImt0c2NjLmlkdi50dyIsImt0dW5uZWwuY29tIiwia3R1bm5lbC5uZXQiLCJrdHYuanAiLCJrdHY1Mi5jb20iLCJrdThtLmNvbSIsImt1YWlibzQ0NC5jb20iLCJrdWFpaGVpLmNvbSIsImt1YWl0di5uZXQiLCJrdWFpenVpLm9yZyIsImt1aS5uYW1lIiwia3VtYW8uY2MiLCJrdW4uaW0iLCJrdW5nZnVib2FyZC5jb20iLCJrdXJ6d2VpbGFpLm5ldCIsImt1c2Mub3JnIiwia3V2ZXl0dHVyay5jb20udHIiLCJrdXlvdXdvLmNuIiwia3dlc3RpYXNtYWt1LmNvbSIsImt3b25nd2FoLmNvbS5teSIsImt4bGYuY29tIiwia3hsaC5jb20iLCJreTMuY29tIiwia3lpdnBvc3QuY29tIiwia3lvYm9ib29rLmNvLmtyIiwia3lvaGsubmV0Iiwia3lvdG8tdS5hYy5qcCIsImt6ZW5nLmluZm8iLCJsLWFub24uY29tIiwibGEtZm9ydW0ub3JnIiwibGFiaW9ndWlhLmNvbSIsImxhYnV0YWNhLm5ldCIsImxhZGJyb2tlcy5iZSIsImxhZGJyb2tlcy5jb20iLCJsYWRicm9rZXMuY29tLmF1IiwibGFkeS1zb25pYS5jb20iLCJsYWR5Ym95Z2xvcnlob2xlLmNvbSIsImxhZHljaGVla3kuY29tIiwibGFkeWxpa2UuZ3IiLCJsYWdvbWV0ZXIuZGUiLCJsYWdyYW5lcG9jYS5jb20iLCJsYWliYS5jb20uYXUiLCJsYWttZWluZGlhLmNvbSIsImxhbHVsYWx1LmNvbSIsImxhbWEuY29tLnR3IiwibGFtYXllc2hlLmNvbSIsImxhbWlzc3R3aXR0ZXIuY29tIiwibGFuYWNpb24uY29tLmFyIiwibGFuY2VuZXQuY29tLmJyIiwibGFuZG9mbm9kLmNvbSIsImxhbmczMy5jb20iLCJsYW5ncHJpc20uY29tIiwibGFua2Fob3RuZXdzLmluZm8iLCJsYW9sYTEuYXQiLCJsYW9sYTEudHYiLCJsYW95YW5nLmluZm8iLCJsYXBhZ2luYS5jb20uc3YiLCJsYXBleXJlLmZyIiwibGFwczMuY29tIiwibGFwdG9wc2RpcmVjdC5jby51ayIsImxhcWluZ2Rhbi5uZXQiLCJsYXJhem9uLmVzIiwibGFyZXB1YmxpcXVlZGVzcHlyZW5lZXMuZnIiLCJsYXJnZXBvcm50dWJlLmNvbSIsImxhcmdleGhhbXN0ZXIuY29tIiwibGFzdGZtLmVzIiwibGF0YWF5b3V0dWJlLmNvbSIsImxhdGltZXMuY29tIiwibGF0aW5iYWJlc2xpbmtzLmNvbSIsImxhdHJpYnVuZS5mciIsImxhdXJhZy50diIsImxhdmVyZGFkLmNvbSIsImxhdm9peGR1bm9yZC5mciIsImxhdm9yYXRvcmlvLml0IiwibGF2b3JpY3JlYXRpdmkuY29tIiwibGF2b3p0eC5jb20iLCJsYXcuY29tIiwibGF5bmVnbGFzcy5jb20iLCJsYXphZGEuY29tLnBoIiwibGF6eW1pa2UuY29tIiwibGNhZHMucnUiLCJsZG1zdHVkaW8uY29tIiwibGUtZGljdGlvbm5haXJlLmNvbSIsImxlYWQuaWR2LnR3IiwibGVhZGZlcnJldC5jb20iLCJsZWFmbHkuY29tIiwibGVhcm50b2hhY2tmYWNlYm9vay5jb20iLCJsZWVkcy5hYy51ayIsImxlZWxhLnR2IiwibGVmanNxLmNvbSIsImxlZm9yYS5jb20iLCJsZWdneWNhc2guY29tIiwibGVtYXRpbi5jaCIsImxlbW1vbmp1aWNlLmNvbSIsImxlbW9uZGUuZnIiLCJsZW1vbml0ZXVyLmZyIiwibGVuZGluZ2NsdWIuY29tIiwibGVucy5oayIsImxlbnpvci5jb20iLCJsZXByb2dyZXMuZnIiLCJsZXNvaXIuYmUiLCJsZXN0ZXI4NTAuaW5mbyIsImxldHJhc2ZhY2Vib29rLmNvbSIsImxldHNhbGxoaWRlLmluZm8iLCJsZXRzY29ycC5jb20iLCJsZXRzY29ycC5uZXQiLCJsZXhpbG9nb3MuY29tIiwibGV6Y3V0aWVzLmNvbSIsImxnZS5jb20iLCJsaWFuMzMuY29tIiwibGlhbnl1ZS5uZXQiLCJsaWFvdGkubmV0IiwibGlhb3dhbmd4aXphbmcubmV0IiwibGliZXJhbC5vcmcuaGsiLCJsaWJlcnR5dGltZXMuY29tLnR3IiwibGlicmVtZXJjYWRvLmNvbSIsImxpZGVjaGVuZy5jb20iLCJsaWRsLWhlbGxhcy5nciIsImxpZmUuaHUiLCJsaWZlaGFja2VyLmNvLmluIiwibGlmZWhhY2tlci5jb20iLCJsaWZlc2NyaXB0LmNvbSIsImxpZ2h0Ym94LmNvbSIsImxpZ2h0aG91c2V0ZWVuc2VyaWVzLmNvbSIsImxpZ2h0aW5nZGlyZWN0LmNvbSIsImxpaWdhLmZpIiwibGlpc3RmYWNlYm9vay5jb20iLCJsaWtlZGRvdC5jb20iLCJsaW5jb2xuZnAuY29tIiwibGluZGFpa2VqaWJsb2dzcG90LmNvbSIsImxpbmUubWUiLCJsaW5lMjUuY29tIiwibGluZ2xpbmdmYS5jb20iLCJsaW5neWkuY2MiLCJsaW5rNjY2LmluZm8iLCJsaW5rYWRlaC5jb20iLCJsaW5rZWRpbi5jb20iLCJsaW5rZWRpbmp1aWNlLmNvbSIsImxpbmtpZGVvLmNvbSIsImxpbmttZWZyZWUubmV0IiwibGlua21ldHViZS5jb20iLCJsaW5rcy5vcmcuYXUiLCJsaW5rc2FscGhhLmNvbSIsImxpbmt1c3dlbGwuY29tIiwibGlua3dvcnRoLmNvbSIsImxpbmt4Y2hhbmdlci5tb2JpIiwibGlua3lvdXR1YmUuY29tIiwibGlucGllLmNvbSIsImxpcHN5LmNvLnVrIiwibGlwdW1hbi5jb20iLCJsaXF1aWRhLml0IiwibGlzdDRwcm94eS5kZSIsImxpc3RlbnRveW91dHViZS5jb20iLCJsaXN0b3RpYy5jb20iLCJsaXN0cHJveHkuaW5mbyIsImxpdHRsZXNob290Lm9yZyIsImxpdHRsZXdlYmRpcmVjdG9yeS5jb20iLCJsaXVoYW55dS5jb20iLCJsaXVqaWFuc2h1LmNvbSIsImxpdmUtcHJveHkuY29tIiwibGl2ZWNoZW5uYWkuY29tIiwibGl2ZWpvdXJuYWwuY29tIiwibGl2ZWxlYWsuY29tIiwibGl2ZXNjaWVuY2UuY29tIiwibGl2ZXNjb3JlLmNvLmtyIiwibGl2ZXNjb3JlLmluIiwibGl2ZXNleHZvZC5jb20iLCJsaXZlc21pLmNvbSIsImxpdmVzcG9ydHMucGwiLCJsaXZlc3RhdGlvbi5jb20iLCJsaXZldmlkZW8uY29tIiwibGl5Y2huLmNuIiwibGl6aGlkeS5jb20iLCJsbHByb3h5LnB3IiwibG16ai5uZXQiLCJsb2FkLnRvIiwibG9jYWxkb21haW4ud3MiLCJsb2NhbGhvc3QuY29tIiwibG9jYWxwcmVzc2hrLmNvbSIsImxvY2Fsc3RyaWtlLm5ldCIsImxvZ2dseS5jb20iLCJsb2dpYy1pbW1vLmJlIiwibG9naW5wcm94eS5jb20iLCJsb2dvZGVzaWduanVpY2UuY29tIiwibG9nb2dlbmllLm5ldCIsImxvZ3Nva3UuY29tIiwibG9pY2xlbWV1ci5jb20iLCJsb2phMi5jb20uYnIiLCJsb2xjbGFzc2ljLmNvbSIsImxvbGxpcG9wLW5ldHdvcmsuY29tIiwibG9tYWRlZS5jb20iLCJsb25kb25jaGluZXNlLmNhIiwibG9uZXN0YXJuYXVnaHR5Z2lybHMuY29tIiwibG9uZ2hhaXIuaGsiLCJsb25nd2Fyam91cm5hbC5vcmciLCJsb25ueS5jb20iLCJsb29rYXRnYW1lLmNvbSIsImxvb3BuZXQuY29tIiwibG9veHkuY29tIiwibG9wYW5hLmNvbS5iciIsImxvcmRvZnR1YmUuY29tIiwibG9zYW5kZXMuY29tLmFyIiwibG9zcmlvcy5lZHUiLCJsb3VwYWsuY3oiLCJsb3V2cmUuZnIiLCJsb3ZlYmFrZXNnb29kY2FrZXMuY29tIiwibG92ZWQuaGsiLCJscnR5cy5jb20iLCJsc2Qub3JnLmhrIiwibHNmb3J1bS5uZXQiLCJsc20ub3JnIiwibHNtY2hpbmVzZS5vcmciLCJsdG4uY29tLnR3IiwibHRzaHUuY29tIiwibHViZXR1YmUuY29tIiwibHVqdW5ob25nMm9yLmNvbSIsImx1anVuaG9uZzJvci5vcmciLCJsdWtlNTQub3JnIiwibHVrZXNibG9nc3BvdC5jb20iLCJsdWx1bHUuY2MiLCJsdWx1bHV3YW5nLmNvbSIsImx1bmxpeXMuY29tIiwibHVwbS5vcmciLCJsdXN0ZnVsLWdpcmxzLmNvbSIsImx1c3RmdWwzZGdpcmxzLmNvbSIsImx1dGF0YWEuY29tIiwibHV4ZWJjLmNvbSIsImx1eHVyeWVzdGF0ZS5jb20iLCJsdXh1cnlnaXJscy5jb20iLCJsdmlucHJlc3MuY29tIiwibHZ2Mi5jb20iLCJseWRka2FydGNpcmN1aXQuY29tIiwibHlmaGsubmV0IiwibHlvbmVzcy50diIsImx5cnNlbnNlLmNvbSIsIm0zcmYuY29tIiwibTgwMDguY29tIiwibTg4YS5jb20iLCJtODhhc2lhLmNvbSIsIm1hYXJpcC5vcmciLCJtYWJsZXQuY29tIiwibWFjYXUtc2xvdC5jb20iLCJtYWNhdXNsb3QuY29tIiwibWFjb2JzZXJ2ZXIuY29tIiwibWFjcm92cG4uY29tIiwibWFkLmNvbSIsIm1hZGFkMi5jb20iLCJtYWRlYm9ueS5jb20iLCJtYWRzZXh0dWJlLmNvbSIsIm1hZ2F6aW5lbWFuYWdlci5jb20iLCJtYWdpY2JyaWNrcy5jb20iLCJtYWdpcmFuLmNvbSIsIm1haG5vci5jb20iLCJtYWljaHVudGFuZy5pbmZvIiwibWFpaW8ubmV0IiwibWFpbC1hcmNoaXZlLmNvbSIsIm1haWxob3N0Ym94LmNvbSIsIm1haWxwLmluIiwibWFpbHhtYWlsLmNvbSIsIm1haW5saW5rYWRzLmNvbSIsIm1haW5wcm94LmNvbSIsIm1ha2Vyc3R1ZGlvcy5jb20iLCJtYWt1cmUuY29tIiwibWFsYXZpZGEuY29tIiwibWFsYXlzaWFiYXkuaW5mbyIsIm1hbHRhd2VhdGhlcnNpdGUuY29tIiwibWFsdGVzZS5jb20iLCJtYW1pc2l0ZS5jb20iLCJtYW5hZ2VyeW91dHViZS5jb20iLCJtYW5hZ2Vyem9uZS5jb20iLCJtYW5hdGVsdWd1LmluIiwibWFuZ292cG4uY29tIiwibWFuaWFzaC5jb20iLCJtYW5pY3VyNGlrLnJ1IiwibWFub3RvMS5jb20iLCJtYW5zLmVkdS5lZyIsIm1hbnNpb24uY29tIiwibWFuc2lvbnBva2VyLmNvbSIsIm1hbnRhbi13ZWIuanAiLCJtYW55cGljdHVyZS5jb20iLCJtYW9kYWlsaS51cyIsIm1hb21hb3RsYmIuY29tIiwibWFvbWVpLmluZm8iLCJtYXBzb2ZpbmRpYS5jb20iLCJtYXJjYW1hcmNhLmNvbS50ciIsIm1hcmNoZS5mciIsIm1hcmNvdmFzY28uZnIiLCJtYXJkb21hay5vcmciLCJtYXJndWVyaXRlLnN1IiwibWFyaWFubmUubmV0IiwibWFya2V0Zm9yY2UuY29tIiwibWFya2V0cm9uLmNvbSIsIm1hcmtldHNhbmRtYXJrZXRzLmNvbSIsIm1hcnhpc3QuY29tIiwibWFyeGlzdHMub3JnIiwibWFzaGFibGUuY29tIiwibWFzaWhhbGluZWphZC5jb20iLCJtYXN0ZXJhcGxheWVyLmNvbSIsIm1hc3RlcmNpdHkucnUiLCJtYXRjaGVuZGlyZWN0LmZyIiwibWF0aGNvdXJzZS5uZXQiLCJtYXRobGFuZC5pZHYudHciLCJtYXRvbWVhbnRlbmEuY29tIiwibWF0cml4dGVlbnMuY29tIiwibWF0c3UuaWR2LnR3IiwibWF0dDEubmV0IiwibWF0dXJlLWdsb3J5aG9sZS5jb20iLCJtYXR1cmVnbm9tZS5jb20iLCJtYXR1cmV2aWRzdHViZS5jb20iLCJtYXR1cmV4ZnVjay5jb20iLCJtYXR1cmV4aGFtc3Rlci5jb20iLCJtYXZlbi5vcmciLCJtYXhpY2VwLmNvbSIsIm1heGlkaXZ4LmNvbSIsIm1heGlvY2lvLm5ldCIsIm1heHByZXBzLmNvbSIsIm1heWFqby5jb20iLCJtYmMubmV0IiwibWJ1c2EuY29tIiwibWNkb25hbGRzLmNvbSIsIm1jcmVhc2l0ZS5jb20iLCJtY3QuZ292LmF6IiwibWQtdC5vcmciLCJtZGp1bmN0aW9uLmNvbSIsIm1lY3plMjQucGwiLCJtZWRpYWZpcmUuY29tIiwibWVkaWFmcmVha2NpdHkuY29tIiwibWVkaWF5b3UubmV0IiwibWVkaWNhbGRhaWx5LmNvbSIsIm1lZGljYW1lbnRvcy5jb20ubXgiLCJtZWRpY2FyZS5nb3YiLCJtZWR1LmlyIiwibWVkeWF0d2l0dGVyLmNvbSIsIm1lZW1pLmNvbSIsIm1lZXRpYy5jb20iLCJtZWV0aWMuZXMiLCJtZWV0aWMuaXQiLCJtZWZlZWRpYS5jb20iLCJtZWdhLXhoYW1zdGVyLmNvbSIsIm1lZ2FieWV0Lm5ldCIsIm1lZ2FpbmRleC50diIsIm1lZ2FtaWRpYS5jb20uYnIiLCJtZWdhcG9ybi5jb20iLCJtZWdhcHJveHkuY29tIiwibWVnYXByb3h5LmNvbS5hciIsIm1laW1laWR5LmNvbSIsIm1laW5laWhhbi5jb20iLCJtZWludnNoaXBpbi5uZXQiLCJtZWpvcnByb3h5LmNvbSIsIm1lbGltYXpoYWJpLmNvbSIsIm1lbGlzc2FkYXRhLmNvbSIsIm1lbWJ1YXRmYWNlYm9vay5jb20iLCJtZW1laGsuY29tIiwibWVtcmlqdHRtLm9yZyIsIm1lbmtpLmlkdi50dyIsIm1lbm92ZXIzMC5jb20iLCJtZW5zYWdlbnNjb21hbW9yLmNvbSIsIm1lbnRhbGZsb3NzLmNvbSIsIm1lcmN5cHJvcGhldC5vcmciLCJtZXJpZGlhbm8uY29tLnZlIiwibWVyaXQtdGltZXMuY29tLnR3IiwibWVyaXZpZXcuaW4iLCJtZXJsaW9uLmNvbSIsIm1lc290dy5jb20iLCJtZXQtYXJ0LmNvbSIsIm1ldGEudWEiLCJtZXRhY2FmZS5jb20iLCJtZXRhY3JpdGljLmNvbSIsIm1ldGFmZmlsaWF0aW9uLmNvbSIsIm1ldGFydDEuY29tIiwibWV0YXJ0ei5jb20iLCJtZXRlby5jYXQiLCJtZXRlb2NvbnN1bHQuZnIiLCJtZXRlb21lZGlhLmNvbSIsIm1ldGlmYXIuY29tIiwibWV0cmljLWNvbnZlcnNpb25zLm9yZyIsIm1ldHJvcG9saS5jb20iLCJtZXRyb3JhZGlvLmNvbS5oayIsIm1ldHJvdWkub3JnLnVhIiwibWV0c2VydmljZS5jb20iLCJtZXlvdS5qcCIsIm1mYWNlYm9vay5jb20iLCJtZmJpei5jb20iLCJtZm9yb3MuY29tIiwibWdvb24uY29tIiwibWdzdGFnZS5jb20iLCJtaDcwMC5jZiIsIm1oYS5uaWMuaW4iLCJtaHJhZGlvLm9yZyIsIm1pYnJ1anVsYS5jb20iLCJtaWRpYW1heC5jb20iLCJtaWRpbGlicmUuZnIiLCJtaWRuaWdodGZldmVyLmNvbSIsIm1pZ2h0eWRlYWxzLmNvbSIsIm1paGFuYmxvZy5jb20iLCJtaWhhbm1hcmtldC5jb20iLCJtaWhrLmhrIiwibWloci5jb20iLCJtaWxhbm90b2RheS5pdCIsIm1pbGl0YXJ5ZmFjdG9yeS5jb20iLCJtaWxsaXBpeWFuZ28uZ292LnRyIiwibWlsc3VycHMuY29tIiwibWlsdHQuY29tIiwibWltaXZpcC5jb20iLCJtaW5kYW5kbGlmZS5vcmciLCJtaW5kc3BhcmsuY29tIiwibWluZ2h1aS1zY2hvb2wub3JnIiwibWluZ2h1aS5kZSIsIm1pbmdodWkub3JnIiwibWluZ2ppbmdsaXNoaS5jb20iLCJtaW5namluZ25ld3MuY29tIiwibWluZ3Bhby5jb20iLCJtaW5ncGFvY2FuYWRhLmNvbSIsIm1pbmdwYW9mdW4uY29tIiwibWluZ3Bhb21vbnRobHkuY29tIiwibWluZ3Bhb25ld3MuY29tIiwibWluZ3Bhb255LmNvbSIsIm1pbmdwYW9zZi5jb20iLCJtaW5ncGFvdG9yLmNvbSIsIm1pbmdwYW92YW4uY29tIiwibWluZ3NoZW5nYmFvLmNvbSIsIm1pbmkxODkuY29tIiwibWluaW5vdmEub3JnIiwibWluaXNpemViaWtpbmkuY29tIiwibWluaXN0cnlib29rcy5vcmciLCJtaW5rY2hhbi5jb20iLCJtaW51dGVidXp6LmNvbSIsIm1pbnV0b2RpZ2l0YWwuY29tIiwibWluemh1aHVhLm5ldCIsIm1pbnpodXpob25nZ3VvLm9yZyIsIm1pcWlxdm9kLmNvbSIsIm1pcnJvcmJvb2tzLmNvbSIsIm1pc2lvbmVzY3VhdHJvLmNvbSIsIm1pc3Mtbm8xLmNvbSIsIm1pc3R5LXdlYi5jb20iLCJtaXRiYnMuY2EiLCJtaXRiYnMuY28ubnoiLCJtaXRiYnMuY28udWsiLCJtaXRiYnMuY29tIiwibWl0YmJzLm9yZyIsIm1pdGJic2F1LmNvbSIsIm1pdGJic2hrLmNvbSIsIm1pdGJic2pwLmNvbSIsIm1pdGJic3NnLmNvbSIsIm1pdGJic3R3LmNvbSIsIm1pdG1wcm94eS5vcmciLCJtaXhlcm8uY29tIiwibWl4bHIuY29tIiwibWl4cG9kLmNvbSIsIm1peHR1cmVjbG91ZC5jb20iLCJtaXh4LmNvbSIsIm1peHh4eC5jb20iLCJtay5ydSIsIm1rNTAwMC5jb20iLCJta3Rtb2JpLmNvbSIsIm1sY29vbC5jb20iLCJtbS0xMS5jb20iLCJtbS1jZy5jb20iLCJtbTZ5dC5jb20iLCJtbWNvby5jbiIsIm1tZGF5cy5jb20iLCJtbW1jYS5jb20iLCJtb2JpbGUwMS5jb20iLCJtb2JpbGVsYWJ5LmNvbSIsIm1vYmlsZXNtb3ZpZS5pbiIsIm1vYnlwaWN0dXJlLmNvbSIsIm1vYnpvLm5ldCIsIm1vY2tibG9jay5jb20iLCJtb2NvdmlkZW8uanAiLCJtb2RhcmVzLmFjLmlyIiwibW9kZWwtdG9reW8uY29tIiwibW9kZWxscy5jb20iLCJtb2UuZ292Lm15IiwibW9lZ2lybC5vcmciLCJtb2Zvc2V4LmNvbSIsIm1vaW5zLWRlcGVuc2VyLmNvbSIsIm1vamFuZy5jb20iLCJtb2ppbS5jb20iLCJtb2p0di5ociIsIm1va2E5LmNvbSIsIm1vbGlodWEub3JnIiwibW9tbXlzbGl0dGxlY29ybmVyLmNvbSIsIm1vbW8tZC5qcCIsIm1vbW9uLWdhLmNvbSIsIm1vbXNleGNsaXB6LmNvbSIsIm1vbXR1YmVjbGlwei5jb20iLCJtb25kZWJhcnJhcy5mciIsIm1vbmRlbXAzLmNvbSIsIm1vbmV5LWxpbmsuY29tLnR3IiwibW9uZXltYWtlcmdyb3VwLmNvbSIsIm1vbmdvZGIub3JnIiwibW9uaXRvcmludmVzdC5ydSIsIm1vbm9wcml4LmZyIiwibW9uc3RlcnByb3h5LmluZm8iLCJtb250cmVhbGdhemV0dGUuY29tIiwibW9vby5jb20iLCJtb29zZWphdy5jb20iLCJtb3JiZWxsLmNvbSIsIm1vcmlhLmNvLm56IiwibW9ybmluZ3N0YXIuY29tIiwibW9ycGhpdW0uaW5mbyIsIm1vc3RmYXN0cHJveHkub3JnIiwibW90aHFmYW4uY29tIiwibW90by5pdCIsIm1vdG9yNGlrLnJ1IiwibW90b3Jpb25saW5lLmNvbSIsIm1vdG9ybGlmZS5pdCIsIm1vdXNlYnJlYWtlci5jb20iLCJtb3ZpZS1qYW1yb2NrLmNvbSIsIm1vdmllMmtwcm94eS5jb20iLCJtb3ZpZTRrLnRvIiwibW92aWU0a3Byb3h5LmNvbSIsIm1vdmllOGsudG8iLCJtb3ZpZWdhbGxlcmkubmV0IiwibW92aWV0aXRhbi5jb20iLCJtb3Zpc3Rhci5jb20udmUiLCJtb3p0dy5vcmciLCJtcDNidXNjYWRvci5jb20iLCJtcDNkYXlzLmNvbSIsIm1wM2p1aWNlcy5jb20iLCJtcDNva2F5LmNvbSIsIm1wM3F1LmNvbSIsIm1wM3JoaW5vLmNvbSIsIm1wM3NrdWxsLmNvbSIsIm1wM3NrdWxsLnR2IiwibXAzc3RyYW5hLmNvbSIsIm1wM3RydWNrLm5ldCIsIm1wM3llLmV1IiwibXA0bW92aWVzLmluZm8iLCJtcGdnYWxheHkuY29tIiwibXBpbmV3cy5jb20iLCJtcHJuZXdzLm9yZyIsIm1yNy5ydSIsIm1yYnJvd3Nlci5jb20iLCJtcmdyZWVuLmNvbSIsIm1yc3RpZmYuY29tIiwibXJ1bmJsb2NrLmNvbSIsIm1zODgxLmNvbSIsIm1zZ3VhbmNoYS5jb20iLCJtc2hjZG4uY29tIiwibXNpLmNvbSIsIm1zay5zdSIsIm1zbi5jb20iLCJtc24uY29tLnR3IiwibXQuZ292LmJyIiwibXRtLm9yLmpwIiwibXRubGRlbGhpLmluIiwibXR2YXYuY29tIiwibXVhYmFuLm5ldCIsIm11YW1iYXRvci5jb20uYnIiLCJtdWNob3N1Y2tvLmNvbSIsIm11bGhhay5jb20iLCJtdWxsdmFkLm5ldCIsIm11bHRpcHJveHkub3JnIiwibXVsdGl1cGxvYWQuY29tIiwibXVtbXlzZ29sZC5jb20iLCJtdW5kb2Rlc2Nvbm9jaWRvLmVzIiwibXVuZG9kb21hcmtldGluZy5jb20uYnIiLCJtdW5kb3NleGFudW5jaW8uY29tIiwibXVuZG90b3JvLmNvbSIsIm11cm11ci50dyIsIm11cnlvdWF2Lm5ldCIsIm11c2VsaW5rcy5jby5qcCIsIm11c2ljYWRlLm5ldCIsIm11c2ljdGltZXMuY29tIiwibXVzaWN2aWRlb21wMy5jb20iLCJtdXNpay12aWRlb3MuZGsiLCJtdXNpbXVuZG8uY29tIiwibXVzbGltdmlkZW8uY29tIiwibXVzbG0ub3JnIiwibXVzdGFxaW0ubmV0IiwibXV6b2Zvbi5jb20iLCJtd29say5jb20iLCJteS1mb3Jtb3NhLmNvbSIsIm15LXBlcnNvbmFsdHJhaW5lci5pdCIsIm15LXByaXZhdGUtbmV0d29yay5jby51ayIsIm15LXByb3h5LmNvbSIsIm15MXR1YmUuY29tIiwibXkzeHh4LmNvbSIsIm15OTAzLmNvbSIsIm15YXBwLmNvbS50dyIsIm15YXVkaW9jYXN0LmNvbSIsIm15YXYuY29tLnR3IiwibXliYWJ5cHJveC5pbmZvIiwibXliZHNtdmlkZW9zLm5ldCIsIm15YmVzdHZwbi5jb20iLCJteWJldC5jb20iLCJteWJsb2cuaXQiLCJteWJuYi50dyIsIm15Y2ExNjguY29tIiwibXljaGF0LnRvIiwibXljaGluYW15aG9tZS5jb20iLCJteWNoaW5hbmV3cy5jb20iLCJteWNsb3VkLmlkdi50dyIsIm15Y291bGQuY29tIiwibXlkYXRpLmNvbSIsIm15ZGxpbmsuY29tIiwibXllYXN5dHYuY29tIiwibXlleC5jb20iLCJteWZhc2hpb25qdWljZS5jb20iLCJteWZvcnVtLmNvbS5oayIsIm15Zm9ydW0uY29tLnVrIiwibXlmcmVlcGF5c2l0ZS5jb20iLCJteWZyZXNobmV0LmNvbSIsIm15aGFyZHBob3Rvcy50diIsIm15aGhnLm9yZyIsIm15aG90c2l0ZS5uZXQiLCJteWlwLm1zIiwibXlpcC5uZXQiLCJteWlwaGlkZS5jb20iLCJteWxpdHRsZWJsb2dzcG90LmNvbSIsIm15bWFpbHNydnIuY29tIiwibXltYWppLmNvbSIsIm15bW92aWVzLml0IiwibXlwYWdlcmFuay5uZXQiLCJteXBhc3MuZGUiLCJteXByb3h5c2l0ZS5vcmciLCJteXJlY2lwZXMuY29tIiwibXlyZXRyb3R1YmUuY29tIiwibXlzaW5hYmxvZy5jb20iLCJteXNvZnQuaWR2LnR3IiwibXlzcGFjZS5jb20iLCJteXNwYWNldW5ibG9ja2l0LmNvbSIsIm15c3BhY2V1bmxvY2suY29tIiwibXl0ZWRhdGEubmV0IiwibXl2aWRvMS5jb20iLCJteXZuYy5jb20iLCJteXZvdWNoZXJjb2Rlcy5jby51ayIsIm15d2VicHJveHkuYXNpYSIsIm15d2Vic2VhcmNoLmNvbSIsIm15d2VuZHlzbXVzaWMuY29tIiwibXotd2ViLmRlIiwibmFiYmxlLmNvbSIsIm5haWxpZGVhc2l0ZS5jb20iLCJuYWpsZXBzemUubmV0IiwibmFrZWQtbnVkZS5jb20iLCJuYWtpZG8uY29tIiwibmFsdW9uZS5iaXoiLCJuYWx1b25lLm5ldCIsIm5hbWVzdGF0aW9uLmNvbSIsIm5hbm9wcm94eS5jb20iLCJuYW5vcHJveHkuZGUiLCJuYW55YW5nLmNvbSIsIm5hbnlhbmcuY29tLm15IiwibmFueWFuZ3Bvc3QuY29tIiwibmFuemFvLmNvbSIsIm5hb2wuY2EiLCJuYXJpbWFuLm1lIiwibmFzaW1vbmxpbmUuaXIiLCJuYXQuZ292LnR3IiwibmF0YWRvLmNvbSIsIm5hdGlvbndpZGUuY29tIiwibmF0bGNvbnNlcnZhdGl2ZS5jb20iLCJuYXR1cmFsbHljdXJseS5jb20iLCJuYXR1cmUuY29tIiwibmF1Z2h0eWFtZXJpY2EuY29tIiwibmF1Z2h0eXR1YmUubmV0IiwibmF5YXJpdGVubGluZWEubXgiLCJuYmN3YXNoaW5ndG9uLmNvbSIsIm5jY3dhdGNoLm9yZy50dyIsIm5jaC5jb20udHciLCJuY24ub3JnIiwibmNvbC5jb20iLCJuZHIuZGUiLCJuZWNjbGFzc2ljbW90b3JzaG93LmNvbSIsIm5lZC5vcmciLCJuZWRpeW9yLmNvbSIsIm5laWdoYm9yaG9vZHIuY29tIiwibmVpeGlvbmcuY29tIiwibmVsbmV0Lm5ldCIsIm5lb2xlZS5jbiIsIm5lcmZub3cuY29tIiwibmV0LWEtcG9ydGVyLmNvbSIsIm5ldC5ociIsIm5ldGJpcmRzLmNvbSIsIm5ldGZpcm1zLmNvbSIsIm5ldGhlcmxhbmRzYmF5LmluZm8iLCJuZXRpLmVlIiwibmV0bG9nLmNvbSIsIm5ldHNwZW5kLmNvbSIsIm5ldHdvcms1NC5jb20iLCJuZXR3b3JrZWRibG9ncy5jb20iLCJuZXR3b3Jrdmlldy5ydSIsIm5ldHhlZS5jb20iLCJuZXV0cm9nZW5hLmNvbSIsIm5ldmVybnVtYi5jb20iLCJuZXctYWtpYmEuY29tIiwibmV3LXhoYW1zdGVyLmNvbSIsIm5ld2NlbnR1cnltYy5jb20iLCJuZXdjaGVuLmNvbSIsIm5ld2ZyZWVwcm94eS5jb20iLCJuZXdncm91bmRzLmNvbSIsIm5ld2lwbm93LmNvbSIsIm5ld2thbGluaW5ncmFkLnJ1IiwibmV3bGFuZG1hZ2F6aW5lLmNvbS5hdSIsIm5ld25ld3MuY2EiLCJuZXdwcm94eS5wdyIsIm5ld3Byb3h5bGlzdC5uZXQiLCJuZXdyaWNobW9uZC1uZXdzLmNvbSIsIm5ld3MtbWVkaWNhbC5uZXQiLCJuZXdzLXhoYW1zdGVyLmNvbSIsIm5ld3MuYXQiLCJuZXdzMS5rciIsIm5ld3MxMDAuY29tLnR3IiwibmV3czI0Ny5nciIsIm5ld3M0YW5kaHJhLmNvbSIsIm5ld3M0amF4LmNvbSIsIm5ld3M1MjAuaWR2LnR3IiwibmV3c2FuY2FpLmNvbSIsIm5ld3NhcmFtYS5jb20iLCJuZXdzY24ub3JnIiwibmV3c2RoLmNvbSIsIm5ld3NleHR1YmUub3JnIiwibmV3c2l0LmdyIiwibmV3c2l0ZXByb3h5LmluZm8iLCJuZXdzcGVhay5jYyIsIm5ld3NwaWNrdXAuY29tIiwibmV3c3IuaW4iLCJuZXdzdGFwYS5vcmciLCJuZXdzdGFybmV0LmNvbSIsIm5ld3N0dWJlLnJ1IiwibmV3c3R3aXR0ZXIuY29tIiwibmV3dGFpd2FuLmNvbS50dyIsIm5ld3RhbGsudHciLCJuZXd1bmJsb2NrZXIuY29tIiwibmV4dDExLmNvLmpwIiwibmV4dGNvbnRlbnQucGwiLCJuZXh0bWVkaWEuY29tIiwibmV4dHR2LmNvbS50dyIsIm5mLmlkLmF1IiwibmZ4eG9vLmNvbSIsIm5oYWNzby5uZXQiLCJuaHJhLmNvbSIsIm5pY2V5b3VuZ3RlZW5zLmNvbSIsIm5pY2hlZ2Fsei5jb20iLCJuaWNvdHdpdHRlci5jb20iLCJuaWNvdmlkZW8uanAiLCJuaWNvdmlld2VyLm5ldCIsIm5pZWR6aWVsYS5ubCIsIm5pZ2h0d2Fsa2VyLmNvLmpwIiwibmlrb24uY29tIiwibmlsb25nZGFvLmNvbS5jbiIsIm5pbWVuaHV1dG8uY29tIiwibmluZy5jb20iLCJuaW5pc2l0ZS5jb20iLCJuaW5qYWJyb3dzZS5jb20iLCJuaW5qYWNsb2FrLmNhIiwibmluamFjbG9ha3Byb3h5LmNvbSIsIm5pbmphcHJveHkuZXUiLCJuaW5qYXByb3h5LmluZm8iLCJuaW5raXBhbC5jb20iLCJuam1hcS5jb20iLCJua29uZ2ppYW4uY29tIiwibmxiLnNpIiwibmxmcmVldnBuLmNvbSIsIm5sb2cuY2MiLCJubHByb3h5LnJ1Iiwibm4tbnltcGhldHMuY29tIiwibm4yMDE0LmNvbSIsIm5ubGlhbi5pbmZvIiwibm5wcm94eS5wdyIsIm5vLWlwLm9yZyIsIm5vYmxlY2FzaW5vLmNvbSIsIm5vZGVzbm9vcC5jb20iLCJub2wuaHUiLCJub2xhZ3MucGwiLCJub21pbmV0Lm9yZy51ayIsIm5vbW9yZXJhY2suY29tIiwibm9udG9uODguY29tIiwibm9vei5nciIsIm5vcmF1dG8uZnIiLCJub3Jkc3Ryb20uY29tIiwibm9ydGhhbWVyaWNhbnByb3h5LmNvbSIsIm5vcnRoamVyc2V5LmNvbSIsIm5vcndheWJheS5pbmZvIiwibm9zdHJpbmdzYXR0YWNoZWQuY29tIiwibm90YWVscGFpcy5jb20iLCJub3RpY2VzLXBkZi5jb20iLCJub3RpY2lhcy5pbmZvIiwibm90aWNpYXNyY24uY29tIiwibm90aWNpZXJvZGlnaXRhbC5jb20iLCJub3RqdXN0b2suY29tIiwibm90dGluZ2hhbXBvc3QuY29tIiwibm92ZWRhZGVzZmFjZWJvb2suY29tIiwibm92ZWxhc2ZhY2Vib29rLmNvbSIsIm5vdm9zdGltaXJhLmJpeiIsIm5vd25ld3MuY29tIiwibm93dG9ycmVudHMuY29tIiwibm95cGYuY29tIiwibnBhLmdvLmpwIiwibnBnLmlkdi50dyIsIm5wcy5nb3YiLCJucW1hLm5ldCIsIm5xdXJhbi5jb20iLCJucmsubm8iLCJuc2MuZ292LnR3IiwibnNmd3lvdXR1YmUuY29tIiwibnN0YXJpa292LnJ1IiwibnN0ZWVucy5vcmciLCJudGQudHYiLCJudGR0di5jb20iLCJudGR0di5qcCIsIm50ZHR2LnJ1IiwibnRkdHZsYS5jb20iLCJudHUuZWR1LnR3IiwibnUubmwiLCJudWJlbG8uY29tIiwibnViaWxlcy5uZXQiLCJudWJpbGV3b3JsZC5jb20iLCJudWRlLmh1IiwibnVkZWdpcmxzLnBybyIsIm51ZGV0dWJlLmNvbSIsIm51ZG9ncmFwaHkuY29tIiwibnVvdm9tZWdhdmlkZW8uY29tIiwibnV0ZWNoLm5sIiwibnV2aWQuY29tIiwibnZpZXdlci5tb2JpIiwibnd6b25saW5lLmRlIiwibnljZ28uY29tIiwibnlkdXMuY2EiLCJueWthYS5jb20iLCJueWxvbi1hbmdlbC5jb20iLCJueWxvbnN0b2NraW5nc29ubGluZS5jb20iLCJueXRpbWVzLmNvbSIsIm55eGNvc21ldGljcy5mciIsIm56Y2hpbmVzZS5uZXQubnoiLCJvMnlvdXR1YmUuY29tIiwibzRlLnB3Iiwib2F1dGgubmV0Iiwib2Jlcm9uLW1lZGlhLmNvbSIsIm9idXR1LmNvbSIsIm9jYXNwcm8uY29tIiwib2NjdXBpZXIuaGsiLCJvY2tzLm9yZyIsIm9jbHAuaGsiLCJvY3JlYW1waWVzLmNvbSIsIm9jdGFuZS50diIsIm9jdGFuZXZwbi5jb20iLCJvY3dlZWtseS5jb20iLCJvY3dlbmN1c3RvbWVycy5jb20iLCJvZWtlci5uZXQiLCJvZW0uY29tLm14Iiwib2ZmaWNlaG9sZGluZ3MuY28udWsiLCJvZmZpY2VyLmNvbSIsIm9mZnNob3JlaXAuY29tIiwib2dhb2dhLm9yZyIsIm9nZ2xpc3QuY29tIiwib2dsYWYuY29tIiwib2dsaS5vcmciLCJvZ3AubWUiLCJvaGl4LmNvbSIsIm9oamFwYW5wb3JuLmNvbSIsIm9odHVsZWh0LmVlIiwib2lrb3MuY29tLnR3Iiwib2lrdHYuY29tIiwib2s4NjY2LmNvbSIsIm9rYXlmcmVlZG9tLmNvbSIsIm9rZXpvbmUuY29tIiwib2tpcC5pbmZvIiwib2trLnR3Iiwib2ttYWxsLnR3Iiwib2twcm94eS5jb20iLCJvbGRlci1iZWF1dHkuY29tIiwib2xkaS5ydSIsIm9sZHh5LmluZm8iLCJvbGl2ZW9pbHNlZ2EuaWR2LnR3Iiwib2xvYmxvZ2dlci5jb20iLCJvbHguY29tLm5nIiwib2x4LmNvbS5zdiIsIm9seW1waWN3YXRjaC5vcmciLCJvbS5uZXQiLCJvbWFwYXNzLmNvbSIsIm9tZWdhd2F0Y2hlcy5jb20udHciLCJvbWd1YnVudHUuY28udWsiLCJvbWxvZ2lzdGljcy5jby5pbiIsIm9tbml0YWxrLmNvbSIsIm9tcm9lcGJyYWJhbnQubmwiLCJvbXNrLnN1Iiwib215LnNnIiwib24uY2MiLCJvbmVnYXlkYWRkeS5jb20iLCJvbmVnby5ydSIsIm9uZW1lZGljYWwuY29tIiwib25lbmV3c3BhZ2UuY29tIiwib25lcGllY2VvZmJsZWFjaC5jb20iLCJvbmV5b3V0dWJlLmNvbSIsIm9uaW5zdGFncmFtLmNvbSIsIm9uaXNlcC5mciIsIm9uanVpY2UuY29tIiwib25saW5lLWFub255bWl6ZXIuY29tIiwib25saW5lLWNhc2luby5kZSIsIm9ubGluZWFjY2VzczEuY29tIiwib25saW5lYW5kaHJhYmFuay5uZXQuaW4iLCJvbmxpbmVhbm9ueW1pemVyLmNvbSIsIm9ubGluZWJhbmsuY29tIiwib25saW5lYml6Z3VpZGUubmV0Iiwib25saW5lY2hhLmNvbSIsIm9ubGluZWZpbG14LnJ1Iiwib25saW5laW5zdGFncmFtLmNvbSIsIm9ubGluZWlwY2hhbmdlci5jb20iLCJvbmxpbmVtYXR1cmV0dWJlLmNvbSIsIm9ubGluZW1lZGlhZ3JvdXBsbGMuY29tIiwib25saW5lcHJveHkuY28udWsiLCJvbmxpbmVwcm94eS51cyIsIm9ubGluZXByb3h5ZnJlZS5jb20iLCJvbmxpbmV2aWRlb2NvbnZlcnRlci5jb20iLCJvbmxpbmV5b3V0dWJlLmNvbSIsIm9ubHktbS15b3V0dWJlLmNvbSIsIm9ubHliZXN0c2V4LmNvbSIsIm9ubHlqaXp6LmNvbSIsIm9ubHlsYWR5LmNuIiwib25tb29uLmNvbSIsIm9uczIyLmNvbSIsIm9uczk2LmNvbSIsIm9udGhlaHVudC5jb20iLCJvbnRyYWMuY29tIiwib28uY29tLmF1Iiwib29vLXNleC5jb20iLCJvb3BzZm9ydW0uY29tIiwib3AuZmkiLCJvcGVsLmRlIiwib3Blbi13ZWJzaXRlcy51cyIsIm9wZW4uY29tLmhrIiwib3BlbmRlbW9jcmFjeS5uZXQiLCJvcGVuZG9vcnMubmwiLCJvcGVuZG9vcnMub3JnLmF1Iiwib3Blbmlua3BvdC5vcmciLCJvcGVubGVha3Mub3JnIiwib3Blbm5ldC5ydSIsIm9wZW5wcm94eS5jby51ayIsIm9wZW5yaWNlLmNvbSIsIm9wZW5zdXJmLmluZm8iLCJvcGVudGhpcy5wbCIsIm9wZW52cG4ubmV0Iiwib3BlcmEtbWluaS5uZXQiLCJvcGVyYS5jb20iLCJvcG0uZ292Iiwib3B0bWQuY29tIiwib3B2aWV3LmNvbS50dyIsIm9yYWt1bC51YSIsIm9yYW5nZS5jb20iLCJvcmFuZ2VzLmlkdi50dyIsIm9yY2hpZGJicy5jb20iLCJvcmdhc20uY29tIiwib3JnZnJlZS5jb20iLCJvcmllbnRhbGJ1dHRzLmNvbSIsIm9yaWVudGFsZGFpbHkuY29tLm15Iiwib3JrdXQuY29tIiwib3JveHkuY29tIiwib3J6ZHJlYW0uY29tIiwib3Npa2tvLmpwIiwib3N5YW4ubmV0Iiwib3RubmV0d29yay5uZXQiLCJvdWVzdC1mcmFuY2UuZnIiLCJvdWxvdmUub3JnIiwib3VwLmNvbSIsIm91ci10d2l0dGVyLmNvbSIsIm91cmdhbWVzLnJ1Iiwib3VycHJveHkub3JnIiwib3Vyc29nby5jb20iLCJvdXJzdGVwcy5jb20uYXUiLCJvdXRkb29ybGlmZS5jb20iLCJvdXRlcmxhbmRzc2YuY29tIiwib3V2YWxhbGdlcmllLmNvbSIsIm92YWNpb25kaWdpdGFsLmNvbS51eSIsIm92ZXItYmxvZy5jb20iLCJvdmVyLXRpbWUuaWR2LnR3Iiwib3ZlcmNsb2NrZXJzLmNvbS5hdSIsIm92ZXJwbGF5Lm5ldCIsIm92aC5pZSIsIm92aC5pdCIsIm92aS5jb20iLCJvdy5seSIsIm93aW5kLmNvbSIsIm94aWNhbXMuY29tIiwib3lheC5jb20iLCJveW90YS5uZXQiLCJvemNoaW5lc2UuY29tIiwib3p5b3lvLmNvbSIsInAxMnAuY29tIiwicGFjaWZpY3Bva2VyLmNvbSIsInBhY2tldGl4Lm5ldCIsInBhZ2UycnNzLmNvbSIsInBhZ2V1cHBlb3BsZS5jb20iLCJwYWdpbmVnaWFsbGUuaXQiLCJwYWlkMnR3aXR0ZXIuY29tIiwicGFpZDJ5b3V0dWJlLmNvbSIsInBhaW5sZXNzLmlkdi50dyIsInBhaXBhbi1nYXpvLmNvbSIsInBhaXNkZWxvc2p1ZWdvcy5lcyIsInBha2ZhY2Vib29rLmNvbSIsInBha2lzdGFuLnR2IiwicGFraXN0YW5qb2JzYmFuay5jb20iLCJwYWtpc3RhbnByb3h5LmNvbSIsInBha3Byb3h5LmNvbSIsInBhbGFjZW1vb24uY29tIiwicGFsYWt1YW4ub3JnIiwicGFsZXJtby5lZHUiLCJwYWxtLmNvbSIsInBhbG1pc2xpZmUuY29tIiwicGFuZG9yYS50diIsInBhbmRvcmF2b3RlLm5ldCIsInBhbmx1YW4ubmV0IiwicGFub3JhbWlvLmNvbSIsInBhbnR5Zml4YXRpb24uY29tIiwicGFvLXBhby5uZXQiLCJwYW83Ny5jb20iLCJwYXAuZnIiLCJwYXBhcHJveHkuY29tIiwicGFwYXByb3h5Lm5ldCIsInBhcGVybGVzc3Bvc3QuY29tIiwicGFwZXJzaXplcy5vcmciLCJwYXB5LmNvLmpwIiwicGFyYWRlLmNvbSIsInBhcmNlbGZvcmNlLmNvbSIsInBhcnNpdGViLmNvbSIsInBhcnRuZXJjYXNoLmNvbSIsInBhcnR5Y2FzaW5vLmNvbSIsInBhcnR5cG9rZXIuY29tIiwicGFzc2Fkb3Byb3h5LmNvbSIsInBhc3Npb24uY29tIiwicGFzc2lvbmZydWl0YWRzLmNvbSIsInBhc3Npb250aW1lcy5oayIsInBhc3NrZXkuY29tIiwicGFzdGViaW4uY29tIiwicGFzdGllLm9yZyIsInBhdGguY29tIiwicGF0aGVvcy5jb20iLCJwYXRodG9zaGFyZXBvaW50LmNvbSIsInBhdHJpa2EuY29tIiwicGF2aWV0bmFtLnZuIiwicGF5LWNsaWNrLnJ1IiwicGF5bGluZS5pciIsInBheXBhbC5jb20iLCJwYXlwYWxvYmplY3RzLmNvbSIsInBheXJvbGxhcHAyLmNvbSIsInBiYXNlLmNvbSIsInBieGVzLmNvbSIsInBjYW5hbHlzaXMubmV0IiwicGNhcHBzcG90LmNvbSIsInBjZGlzY3Vzcy5jb20iLCJwY2R2ZC5jb20udHciLCJwY2hvbWUuY29tLnR3IiwicGNpai5vcmciLCJwY25ldC5pZHYudHciLCJwY3Jldmlldy5jby51ayIsInBjc2MuY29tLnR3IiwicGNzby5nb3YucGgiLCJwY3Qub3JnLnR3IiwicGN2ZWN0b3IubmV0IiwicGRmMmpwZy5uZXQiLCJwZHByb3h5LmNvbSIsInBlYWNlZmlyZS5vcmciLCJwZWFjZWhhbGwuY29tIiwicGVha2Rpc2NvdW50bWF0dHJlc3MuY29tIiwicGVhcnNvbmNtZy5jb20iLCJwZWRpZG9zeWEuY29tLmFyIiwicGVlYXNpYW4uY29tIiwicGVlcGluZy1ob2xlcy5jb20iLCJwZWVycHJveHkuY29tIiwicGVqd2FueW91dHViZS5jb20iLCJwZWtpbmdkdWNrLm9yZyIsInBlbGljdWxhczIxLmNvbSIsInBlbGljdWxhc2RleW91dHViZS5jb20iLCJwZWxpY3VsYXNlbnlvdXR1YmUuY29tIiwicGVsaWN1bGFzbGFuZC5jb20iLCJwZWxpc21hc2VyaWVzLmNvbSIsInBlbmNoaW5lc2UubmV0IiwicGVuY2hpbmVzZS5vcmciLCJwZW5pc2JvdC5jb20iLCJwZW50YWxvZ2ljLm5ldCIsInBlbnRob3VzZS5jb20iLCJwZW50aG91c2ViYWJlc3dvcmxkLmNvbSIsInBlb3BsZS5iZyIsInBlb3BsZW5ld3MudHciLCJwZW9wbGVwZXRzLmNvbSIsInBlb3BvLm9yZyIsInBlcmN5LmluIiwicGVyZmVjdGdpcmxzLm5ldCIsInBlcmZlY3R2cG4ubmV0IiwicGVyZmVrdGVnaXJscy5jb20iLCJwZXJmc3BvdC5jb20iLCJwZXJuaWFzcG9wdXBzaG9wLmNvbSIsInBlcnNlY3V0aW9uLm5ldCIsInBlcnNpYW5ibG9nLmlyIiwicGVyc2lhbmZhY2Vib29rLmNvbSIsInBlcnNpYW5raXR0eS5jb20iLCJwZXQwMS50dyIsInBldGFyZGFzLXlvdXR1YmUuY29tIiwicGV0YXJkYXNoZC5jb20iLCJwZXRmaWxtLmJpeiIsInBld2hpc3BhbmljLm9yZyIsInBld2ludGVybmV0Lm9yZyIsInBld3Jlc2VhcmNoLm9yZyIsInBld3NvY2lhbHRyZW5kcy5vcmciLCJwaDE1OC5jb20iLCJwaDg0Lmlkdi50dyIsInBoYW5kYW5na2hvYS5jb20iLCJwaGFwbHVhbi5vcmciLCJwaGF0b2dyYXBoLmNvbSIsInBoYXl1bC5jb20iLCJwaGljYS5uZXQiLCJwaGlsbHluZXdzLmNvbSIsInBoaW0xOS5jb20iLCJwaGltc2V4dmlwLmNvbSIsInBoaW12aWRlby5vcmciLCJwaG9uZWdhcC5jb20iLCJwaG90aHV0YXcuY29tIiwicGhvdG8tYWtzLmNvbSIsInBob3RvYm94LmNvbSIsInBob3RvZ2Fscy5jb20iLCJwaHAtcHJveHkubmV0IiwicGhwNS5pZHYudHciLCJwaHBiaWRvLmNvbSIsInBocG15cHJveHkuY29tIiwicGhyZWUtcG9ybi5jb20iLCJwaHl3b3JsZC5pZHYudHciLCJwaWIyNC5jb20iLCJwaWNkbi5uZXQiLCJwaWNpZGFlLm5ldCIsInBpY3NtYXN0ZXIubmV0IiwicGlkb3duLmNvbSIsInBpZWNlc2V0cG5ldXMuY29tIiwicGlnbi5uZXQiLCJwaWtjaHVyLmNvbSIsInBpbGlvLmlkdi50dyIsInBpbG90bW9vbi5jb20iLCJwaW1wdHViZWQuY29tIiwicGluNi5jb20iLCJwaW5nLmZtIiwicGluZ3Rlc3QubmV0IiwicGlucHJveHkuY29tIiwicGludGFuZy5pbmZvIiwicGludXBmaWxlcy5jb20iLCJwaXBlcG9ybnR1YmUuY29tIiwicGlwZXByb3h5LmNvbSIsInBpcG9zYXkuY29tIiwicGlwcGl0cy5jb20iLCJwaXJhYXR0aWxhaHRpLm9yZyIsInBpcmF0Yml0Lm5ldCIsInBpcmF0ZWJheXByb3h5LmNvIiwicGlyYXRlYmF5cHJveHkuY28udWsiLCJwaXJhdGVicm93c2VyLmNvbSIsInBpcmF0ZXJmYWNlYm9vay5jb20iLCJwaXJlbGxpLmNvbSIsInBpcmluZy5jb20iLCJwaXNzLmpwIiwicGl4YXJ0cHJpbnRpbmcuZXMiLCJwaXhlbGJ1ZGRoYS5uZXQiLCJwaXhuZXQuaW4iLCJwaXhuZXQubmV0IiwicGsuY29tIiwicGtvYnAucGwiLCJwa3NwaXl1bmdhbi5vcmciLCJwa3QucGwiLCJwbGFuZXRzdXp5Lm9yZyIsInBsYW5wcm94eS5jb20iLCJwbGFudC1zZWVkcy5pZHYudHciLCJwbGFudGlsbGFzLWJsb2dnZXItYmxvZ3Nwb3QuY29tIiwicGxhdGludW1oaWRlaXAuY29tIiwicGxhdHVtLmtyIiwicGxheWJveS5ybyIsInBsYXllci5wbCIsInBsYXlmb3JjZW9uZS5jb20iLCJwbGF5ZnVuLm1vYmkiLCJwbGF5bG92ZXIubmV0IiwicGxheXMuY29tLnR3IiwicGxpYWdlLXNlcnZpZXR0ZS1wYXBpZXIuaW5mbyIsInBsaXhpLmNvbSIsInBsbS5vcmcuaGsiLCJwbG5kci5jb20iLCJwbHVuZGVyLmNvbSIsInBsdXJrLmNvbSIsInBsdXMuZXMiLCJwbHVzMjguY29tIiwicGx1c2JiLmNvbSIsInBtYXRlcy5jb20iLCJwbWkuaXQiLCJwb2NrZXRjYWxjdWxhdG9yc2hvdy5jb20iLCJwb2RjYXN0Ymxhc3Rlci5jb20iLCJwb2dsZWRhai5uYW1lIiwicG9pbnQubWQiLCJwb2ludGJsYW5rLnJ1IiwicG9pbnRzdHJlYWsuY29tIiwicG9rZXJzdGFycy5jb20iLCJwb2tlcnN0YXJzLmV1IiwicG9rZXJzdGFycy5uZXQiLCJwb2tlcnN0cmF0ZWd5LmNvbSIsInBvbGljamEuZ292LnBsIiwicG9saXRpY28uY29tIiwicG9saXRvYnpvci5uZXQiLCJwb2xsc3Rhci5jb20iLCJwb2x5Z2FtaWEucGwiLCJwb2x5c29sdmUuY29tIiwicG9vbmZhcm0uY29tIiwicG9wbmV4c3VzLmNvbSIsInBvcHBob3RvLmNvbSIsInBvcHVsYXIteW91dHViZS5jb20iLCJwb3B5YXJkLmNvbSIsInBvcHlhcmQub3JnIiwicG9ybi14aGFtc3Rlci5jb20iLCJwb3JuLmNvbSIsInBvcm4yLmNvbSIsInBvcm45OS5uZXQiLCJwb3JuYmFzZS5vcmciLCJwb3JuZXJicm9zLmNvbSIsInBvcm5ob21lLmNvbSIsInBvcm5ob3N0LmNvbSIsInBvcm5odWIuY29tIiwicG9ybmh1Yi5jb20uYnoiLCJwb3JuaHVia2luZy5jb20iLCJwb3JuaHVibGl2ZS5jb20iLCJwb3JuaWNvbS5jb20iLCJwb3Jub3NoYXJhLnR2IiwicG9ybm94by5jb20iLCJwb3JucGluLmNvbSIsInBvcm5wbGF5cy5jb20iLCJwb3JucmFwaWRzaGFyZS5jb20iLCJwb3Juc3RhcmNsdWIuY29tIiwicG9ybnRpdGFuLmNvbSIsInBvcm50dWJlLmNvbSIsInBvcm50dWJlbmV3cy5jb20iLCJwb3JudHViZXhoYW1zdGVyLmNvbSIsInBvcm52aXNpdC5jb20iLCJwb3JzaC5pZHYudHciLCJwb3J0YWRhc3BhcmFmYWNlYm9vay5jb20iLCJwb3J0b3NlZ3Vyby5jb20uYnIiLCJwb3NiLmNvbS5zZyIsInBvc2VzdGFjaW8uY29tLmJyIiwicG9zdDg1Mi5jb20iLCJwb3N0YWRzMjQuY29tIiwicG9zdGVyb3VzLmNvbSIsInBvc3Rtb25leS5jb20uYnIiLCJwb3N0b25mYWNlYm9vay5jb20iLCJwb3VycXVvaWRvY3RldXIuZnIiLCJwb3Zwbi5vcmciLCJwb3ZwbjMuY29tIiwicG93ZXIuY29tIiwicG93ZXJtYXBwZXIuY29tIiwicG93ZXJwb2ludG5pbmphLmNvbSIsInBveHkucGwiLCJwb3puYXZhdGVsbm9lLnR2IiwicHBwcm94eS5wdyIsInBwc3Mua3IiLCJwci5nb3YiLCJwcmFiaGF0a2hhYmFyLmNvbSIsInByYXNpbmFuZWEuZ3IiLCJwcmF2ZGEucnUiLCJwcmVkcHJpZW1hY2guY29tIiwicHJlc2lkZW50LmF6IiwicHJlc2lkZW50Lmdvdi50dyIsInByZXN0aWdlY2FzaW5vLmNvbSIsInByZXR0eXZpcmdpbi5jb20iLCJwcmV0dHl3aWZlcy5jb20iLCJwcmV2aWRlbmNpYS5nb3YuYnIiLCJwcmljZS51YSIsInByaWNlbWluaXN0ZXIuY29tIiwicHJpY2V0cmF2ZWwuY29tLm14IiwicHJpZGVzaXRlcy5jb20iLCJwcmltZXBvcm50dWJlLmNvbSIsInByaW1pY2lhLmNvbS52ZSIsInByaXNvbmVyYWxlcnQuY29tIiwicHJpdHVubC5jb20iLCJwcml2YXRlLWludGVybmV0LmluZm8iLCJwcml2YXRlYnJvd3NpbmcuaW5mbyIsInByaXZhdGVpbnRlcm5ldGFjY2Vzcy5jb20iLCJwcml2YXRlcHJveHlyZXZpZXdzLmNvbSIsInByaXZhdGVzZXJ2ZXIubnUiLCJwcml2YXRldHVubmVsLmNvbSIsInByaXZhdGV2b3lldXIuY29tIiwicHJpdmF0ZXZwbi5jb20iLCJwcm8tNHUuY29tIiwicHJvLXVuYmxvY2suY29tIiwicHJvY2Vzby5jb20ubXgiLCJwcm9maXR0YXNrLmNvbSIsInByb2dyYW1hc3R3aXR0ZXIuY29tIiwicHJvZ3JhbW1hYmxld2ViLmNvbSIsInByb2plY3RoLnVzIiwicHJva3NpYWsucGwiLCJwcm9rc3lmcmVlLmNvbSIsInByb2xpbmsucGwiLCJwcm9tLnVhIiwicHJvbW9wcm8uY29tIiwicHJvbi5jb20iLCJwcm9ub3N0aWNvcy5nb2IubXgiLCJwcm9wcm94eS5tZSIsInByb3NwZXIuY29tIiwicHJvdGVjdG15aWQuY29tIiwicHJvdGVjdHByb3h5LmNvbSIsInByb3R2LnJvIiwicHJvdW5sb2NrLm9yZyIsInByb3ZlcmJpYS5uZXQiLCJwcm92aWRlb2NvYWxpdGlvbi5jb20iLCJwcm92aW56LmJ6Lml0IiwicHJvd2FuZy5pZHYudHciLCJwcm94LnB3IiwicHJveGF5LmNvLnVrIiwicHJveGVyeS5jb20iLCJwcm94Zmx5LmNvbSIsInByb3hmcmVlLmNvbSIsInByb3hmcmVlLnBrIiwicHJveGlmaWVyLmNvbSIsInByb3hpZnkuY28udWsiLCJwcm94aWZ5LmNvbSIsInByb3hpdGUubWUiLCJwcm94aXRlLm5ldCIsInByb3hpdGUub3JnIiwicHJveGl0ZS51cyIsInByb3hsZXQuY29tIiwicHJveG15YXNzLmNvbSIsInByb3hwbi5jb20iLCJwcm94dGlrLmNvbSIsInByb3h1cmYuY29tIiwicHJveHh5LmNvIiwicHJveHktMjAxNC5jb20iLCJwcm94eS1iZy5jb20iLCJwcm94eS1ieXBhc3MuY29tIiwicHJveHktaXAtbGlzdC5jb20iLCJwcm94eS1vbmxpbmUubmV0IiwicHJveHktcm9tYW5pYS5pbmZvIiwicHJveHktc2VydmVyLmF0IiwicHJveHktc2VydmljZS5jb20uZGUiLCJwcm94eS1zZXJ2aWNlLmRlIiwicHJveHktc2l0ZS5jb20uZGUiLCJwcm94eS10b3AuY29tIiwicHJveHktdW5sb2NrLmNvbSIsInByb3h5LmFsIiwicHJveHkuY29tLmRlIiwicHJveHkub3JnIiwicHJveHkueXQiLCJwcm94eTAxLmNvbSIsInByb3h5MTQuY29tIiwicHJveHkyMDE0Lm5ldCIsInByb3h5NGZyZWUuY2YiLCJwcm94eTRmcmVlLmNvbSIsInByb3h5NGZyZWUucGwiLCJwcm94eTR1c2EuY29tIiwicHJveHk0eW91dHViZS5jb20iLCJwcm94eTR5b3V0dWJlLmluZm8iLCJwcm94eTguYXNpYSIsInByb3h5YW5vbmltby5lcyIsInByb3h5YW5vbnltaXplci5uZXQiLCJwcm94eWFwcC5vcmciLCJwcm94eWJheS5pbmZvIiwicHJveHliYXkubmwiLCJwcm94eWJpZy5uZXQiLCJwcm94eWJpdGNvaW4uY29tIiwicHJveHlib29zdC5uZXQiLCJwcm94eWJyb3dzZS5uZXQiLCJwcm94eWJyb3dzZXIub3JnIiwicHJveHlicm93c2Vyb25saW5lLmNvbSIsInByb3h5YnJvd3NpbmcuY29tIiwicHJveHlidXRsZXIuY28udWsiLCJwcm94eWJ1dHRvbi5jb20iLCJwcm94eWNhYi5jb20iLCJwcm94eWNoaW5hLm5ldCIsInByb3h5Y2x1YmUub3JnIiwicHJveHljbi5jbiIsInByb3h5Y3JpbWUuY29tIiwicHJveHlkYWRhLmNvbSIsInByb3h5ZG9nZy5jb20iLCJwcm94eWZvci5ldSIsInByb3h5Zm9ycGxheS5jb20iLCJwcm94eWZvcnlvdXR1YmUubmV0IiwicHJveHlmb3VuZGVyLmNvbSIsInByb3h5Zm94eS5jb20iLCJwcm94eWZyZWUub3JnIiwicHJveHlnaXpsZW4uY29tIiwicHJveHlnb2dvLmluZm8iLCJwcm94eWd1cnUuaW5mbyIsInByb3h5aGFzaC5pbmZvIiwicHJveHlodWIuZXUiLCJwcm94eWtpY2thc3MuY29tIiwicHJveHlrb3JlYS5pbmZvIiwicHJveHlsaXN0Lm9yZy51ayIsInByb3h5bGlzdC5zZSIsInByb3h5bGlzdGluZy5vcmciLCJwcm94eWxpc3Rwcm8uY28udWsiLCJwcm94eWxpc3Rwcm8uY29tIiwicHJveHlsaXN0cy5tZSIsInByb3h5bGlzdHkuY29tIiwicHJveHltZXNoLmNvbSIsInByb3h5bWV4aWNvLmNvbSIsInByb3h5bXVzLmRlIiwicHJveHlub2lkLmNvbSIsInByb3h5b2suY29tIiwicHJveHlvbmxpbmUucm8iLCJwcm94eW9vLmNvbSIsInByb3h5cGsuY29tIiwicHJveHlwcm9udG8uY29tIiwicHJveHlweS5uZXQiLCJwcm94eXB5Lm9yZyIsInByb3h5cmVnaXN0ZXIuY29tIiwicHJveHlyb2FkLmNvbSIsInByb3h5cy5wdyIsInByb3h5c2FuLmluZm8iLCJwcm94eXNhbmR5LmNvbSIsInByb3h5c2Vuc2F0aW9uLmNvbSIsInByb3h5c2VydmVyLmFzaWEiLCJwcm94eXNlcnZlci5jb20iLCJwcm94eXNlcnZlci5wayIsInByb3h5c2VydmVyLnB3IiwicHJveHlzaGllbGQub3JnIiwicHJveHlzaXRlLmNvbSIsInByb3h5c2l0ZS5wdyIsInByb3h5c2l0ZS53cyIsInByb3h5c2l0ZWxpc3Qub3JnIiwicHJveHlzaXRlcy5jb20iLCJwcm94eXNpdGVzLmluIiwicHJveHlzaXRlcy5uZXQiLCJwcm94eXNpeC5jb20iLCJwcm94eXNtdXJmLm5sIiwicHJveHlzbmVsLm5sIiwicHJveHlzcGFpbi5jb20iLCJwcm94eXNzbHVuYmxvY2tlci5jb20iLCJwcm94eXN0cmVhbWluZy5jb20iLCJwcm94eXN1Ym1pdC5jb20iLCJwcm94eXN1cmZpbmcub3JnIiwicHJveHlzdXJmcy5jb20iLCJwcm94eXR1LmNvbSIsInByb3h5dHVubmVsLmluIiwicHJveHl0dW5uZWwubmV0IiwicHJveHl0dXJiby5jb20iLCJwcm94eXVuYmxvY2tlci5vcmciLCJwcm94eXVzYS5vcmciLCJwcm94eXZpZGVvcy5jb20iLCJwcm94eXZwbi5ldSIsInByb3h5d2ViLmNvbS5lcyIsInByb3h5d2ViLm5ldCIsInByb3h5d2VicHJveHkuaW5mbyIsInByb3h5d2Vic2l0ZS5jby51ayIsInByb3h5d2Vic2l0ZS5vcmciLCJwcm94eXdlYnNpdGUudXMiLCJwcm94eXphbi5pbmZvIiwicHJ1ZGVudG1hbi5pZHYudHciLCJwcndlZWsuY29tIiwicHJ4LmltIiwicHJ4bWUuY29tIiwicHMzeW91dHViZS5jb20iLCJwc2UxMDBpLmlkdi50dyIsInBzaS5nb3Yuc2ciLCJwc3UuYWMudGgiLCJwc3ljaGNlbnRyYWwuY29tIiwicHN5Y2hvbG9naWVzLnJ1IiwicHRpdGNoZWYuY29tIiwicHRzLm9yZy50dyIsInB0dC5jYyIsInB0dC5nb3YudHIiLCJwdS5lZHUucGsiLCJwdWJsaWMubHUiLCJwdWJsaXN1aXRlcy5jb20iLCJwdWJsaXguY29tIiwicHVidS5jb20udHciLCJwdWZmaW5icm93c2VyLmNvbSIsInB1ZmZzdG9yZS5jb20iLCJwdWppYWhoLmNvbSIsInB1bHNlcG9pbnQuY29tIiwicHVuaXNodHViZS5jb20iLCJwdXJlYW5kc2V4eS5vcmciLCJwdXJlY2ZubS5jb20iLCJwdXJlaW5zaWdodC5vcmciLCJwdXJlbG92ZXJzLmNvbSIsInB1cmVwZW9wbGUuY29tLmJyIiwicHVyZXZwbi5jb20iLCJwdXJpZnltaW5kLmNvbSIsInB1c2hwc2F2ZXJhLmNvbSIsInB1c2h0cmFmZmljLm5ldCIsInB1c3N5Lm9yZyIsInB1dGF6LmNvbSIsInB1dGlob21lLm9yZyIsInB1dGxvY2tlci5jb20iLCJwdXRwcm94eS5jb20iLCJwdXVrby5jb20iLCJwd255b3V0dWJlLmNvbSIsInB4LmNvLm5sIiwicHhhYS5jb20iLCJweXBuYS5jb20iLCJweXJhbXlkYWlyLmNvbSIsInB5dGhvbi5jb20iLCJweXRob24uY29tLnR3IiwicTIydy5jb20iLCJxYW5vdGUuY29tIiwicWdhaXJzb2Z0LmNvbS5iciIsInFpNDEuY29tIiwicWlhbmdiLmNvbSIsInFpZGlhbi5jYSIsInFpZW5rdWVuLm9yZyIsInFpbHVtb3ZpZS5jb20iLCJxaW5taW44LmNvbSIsInFpcWlzZWEuY29tIiwicWlyZTEyMy5jb20iLCJxaXNleGluZy5jb20iLCJxaXN1dS5jb20iLCJxaXUubGEiLCJxaXdlbi5sdSIsInFrc2hhcmUuY29tIiwicW16ZGQuY29tIiwicW9vcy5jb20iLCJxb296YS5oayIsInFxLWF2LmNvbSIsInFxLmNvLnphIiwicXFwcm94eS5wdyIsInFxcTMyMS5jb20iLCJxcnVxLmNvbSIsInF1YWNraXQuY29tIiwicXVlYW50dWJlLmNvbSIsInF1ZWNob2lzaXIub3JnIiwicXVlZXJtZW5vdy5uZXQiLCJxdWlja3Byb3guY29tIiwicXVpY2twcm94eS5jby51ayIsInF1aWV0eW91dHViZS5jb20iLCJxdWthbmR5LmNvbSIsInF2Yy5kZSIsInF2Y3VrLmNvbSIsInF2b2QxMjMubmV0IiwicXZvZDYubmV0IiwicXZvZGNkLmNvbSIsInF2b2RoZS5jb20iLCJxdm9kenkub3JnIiwicXdleXkuY29tIiwicXdxc2hvdy5jb20iLCJxeC5uZXQiLCJyMnNhLm5ldCIsInJhY2NvbnRpbWlsdS5jb20iLCJyYWNpbmd2cG4uY29tIiwicmFkYS5nb3YudWEiLCJyYWRhcmlzLmNvbSIsInJhZGlvYXVzdHJhbGlhLm5ldC5hdSIsInJhZGlvZmFyZGEuY29tIiwicmFkaW90aW1lLmNvbSIsInJhZGlvemFtYW5laC5jb20iLCJyYWR5b3R1cmtpc3Rhbi5jb20iLCJyYWVsLm9yZyIsInJhaWRjYWxsLmNvbSIsInJhaWRjYWxsLmNvbS5iciIsInJhaWRjYWxsLmNvbS5ydSIsInJhaWRjYWxsLmNvbS50dyIsInJhaWRlcnMuY29tIiwicmFpZHRhbGsuY29tLnR3IiwicmFpZmZlaXNlbnBvbGJhbmsuY29tIiwicmFqYW5ld3MuY29tIiwicmFqYXlvdXR1YmUuY29tIiwicmFrdXRlbi1iYW5rLmNvLmpwIiwicmFtYmxpbmdzb2ZtYW1hLmNvbSIsInJhbmR5Ymx1ZS5jb20iLCJyYW50ZW5uYS5uZXQiLCJyYW9vby5jb20iLCJyYXBidWxsLm5ldCIsInJhcGVmb3JjZWQuY29tIiwicmFwaWRwcm94eS5vcmciLCJyYXBpZHByb3h5LnVzIiwicmFwaWRzaGFyZWRhdGEuY29tIiwicmFwcGxlci5jb20iLCJyYXFxLmNvbSIsInJhdGVnZi5jb20iLCJyYXpyYWJvdDRpay5ydSIsInJjLWZhbnM4OC5jb20iLCJyY2luZXQuY2EiLCJyZWFjaGxvY2FsLm5ldCIsInJlYWQxMDAuY29tIiwicmVhZGVyc2RpZ2VzdC5kZSIsInJlYWRlcnNkaWdlc3RkaXJlY3QuY29tLmF1IiwicmVhZGluZ3RpbWVzLmNvbS50dyIsInJlYWRtb28uY29tIiwicmVhZG5ld3MuZ3IiLCJyZWFkdGlnZXIuY29tIiwicmVhZHlkb3duLmNvbSIsInJlYWxjbGVhcnNwb3J0cy5jb20iLCJyZWFsZXN0YXRlLmNvbSIsInJlYWxob21lc2V4Lm5ldCIsInJlYWxpdHkuaGsiLCJyZWFsbWFkcmlkLmNvbSIsInJlYWxtYWRyeXQucGwiLCJyZWFsbWF0dXJlc3dpbmdlcnMuY29tIiwicmVhbG1hdHVyZXR1YmUuY29tIiwicmVhbHJhcHRhbGsuY29tIiwicmVhbHNleHBhc3MuY29tIiwicmVhbHNpbXBsZS5jb20iLCJyZWFsc3BlZWQuaW5mbyIsInJlY2V0YXNncmF0aXMubmV0IiwicmVjZXR0ZXMuZGUiLCJyZWNpcGRvbm9yLmNvbSIsInJlY29ubmVjdGlvbnRhaXdhbi50dyIsInJlY29yZGhpc3Rvcnkub3JnIiwicmVjb3Zlcnkub3JnLnR3IiwicmVkY3JhY2tsZS5jb20iLCJyZWRkaXQuY29tIiwicmVkaWFuLnRvZGF5IiwicmVkbGlnaHRjZW50ZXIuY29tIiwicmVkc29jaWFsZmFjZWJvb2suY29tIiwicmVkdHViZS5jb20iLCJyZWR0dWJlLmNvbS5iciIsInJlZHR1YmUuY29tLmVzIiwicmVkdHViZS5uZXQucGwiLCJyZWR0dWJlLm9yZy5wbCIsInJlZWQuY28udWsiLCJyZWVsemhvdC5jb20iLCJyZWZlcmVuY2V1ci5iZSIsInJlZmVyZXIudXMiLCJyZWcucnUiLCJyZWdpb25hbC1maW5kZXIuY29tIiwicmVpYmVydC5pbmZvIiwicmVpZmVmcmF1ZW4uY29tIiwicmVsYXhiYnMuY29tIiwicmVsZXZhbmNlLmNvbSIsInJlbGlhbmNlZGlnaXRhbC5pbiIsInJlbGlhbmNlbmV0Y29ubmVjdC5jby5pbiIsInJlbWF4LmNvbSIsInJlbW9udGthLnBybyIsInJlbW92ZWZpbHRlcnMubmV0IiwicmVuZmUuZXMiLCJyZW5taW5iYW8uY29tIiwicmVucmVucGVuZy5jb20iLCJyZW50YWxjYXJzLmNvbSIsInJlbnl1cmVucXVhbi5vcmciLCJyZXBsYXl5b3V0dWJlLmNvbSIsInJlcm91dGVkLm9yZyIsInJlc2N1ZW1lLm9yZyIsInJlc3BvbnNlLmpwIiwicmV0cmFuc3R3aXR0ZXIuY29tIiwicmV0d2VldHJhbmsuY29tIiwicmV1dGVycy5jb20iLCJyZXZpZXdjZW50cmUuY29tIiwicmV2aXZlb3VyaGVhcnRzLmNvbSIsInJldmxlZnQuY29tIiwicmV2dmVyLmNvbSIsInJleG9zcy5jb20iLCJyZmEub3JnIiwicmZhY2hpbmEuY29tIiwicmZhbW9iaWxlLm9yZyIsInJmYXdlYi5vcmciLCJyZmVybC5vcmciLCJyZmkuZnIiLCJyZ2ZhY2Vib29rLmNvbSIsInJnaG9zdC5ydSIsInJoY2xvdWQuY29tIiwicmk4Ni5jb20iLCJyaWIxMDAuY29tIiwicmliaWFvemkuaW5mbyIsInJpY2FyZG9lbGV0cm8uY29tLmJyIiwicmljaG1lZGlhZ2FsbGVyeS5jb20iLCJyaWdwYS5vcmciLCJyaWpubW9uZC5ubCIsInJpa3UubWUiLCJyaWxleWd1aWRlLmNvbSIsInJpcGxleS5jb20ucGUiLCJyaXZlZ2F1Y2hlLnJ1Iiwicmx3bHcuY29tIiwicm5jeW91dHViZS5jb20iLCJybncubmwiLCJyb2FkYmlrZXJldmlldy5jb20iLCJyb2FkcnVubmVyLmNvbSIsInJvYWRzaG93LmhrIiwicm9ja21lbHQuY29tIiwicm9kLmlkdi50dyIsInJvbWFuYW5kcmVnLmNvbSIsInJvb2RvLmNvbSIsInJvb2dlbi5jb20iLCJyb296b25saW5lLmNvbSIsInJvc2kubW4iLCJyb3NpbW4uY29tIiwicm9zdGVsZWNvbS5ydSIsInJvdHRlbi5jb20iLCJyb3VuZGN1YmUubmV0Iiwicm91dGVzZXJ2ZXIuc2UiLCJyb3lhbGxlcGFnZS5jYSIsInJvemV0a2EuY29tLnVhIiwicm96aGxhcy5jeiIsInJvenJ5ZmthLnBsIiwicm96emxvYmVuaW11emkuY29tIiwicnBwLmNvbS5wZSIsInJyYmFsZC5nb3YuaW4iLCJycnNvc28uY29tIiwicnJ5b3V0dWJlLmNvbSIsInJzYi5ydSIsInJzZi1jaGluZXNlLm9yZyIsInJzZi5vcmciLCJyc3NpbmcuY29tIiwicnNzbWVtZS5jb20iLCJydGhrLmhrIiwicnRoay5vcmcuaGsiLCJydGkub3JnLnR3IiwicnR5c20ubmV0IiwicnVhbnlpZmVuZy5jb20iLCJydWJpYXMxOS5jb20iLCJydWJ5Zm9ydHVuZS5jb20iLCJydWxlcnR1YmUuY29tIiwicnVubmluZ3dhcmVob3VzZS5jb20iLCJydXBvcm4udHYiLCJydXNjYW1zLmNvbSIsInJ1c2hiZWUuY29tIiwicnVzc2lhbnByb3h5Lm9yZyIsInJ1c3hoYW1zdGVyLmNvbSIsInJ1dG9yLm9yZyIsInJ1dHdpdHRlci5jb20iLCJydXVoLmNvbSIsInJ1di5pcyIsInJ1dnIucnUiLCJydXlpc2Vlay5jb20iLCJyeGhqLm5ldCIsInJ4cHJveHkuY29tIiwicnllcnNvbi5jYSIsInMtZHJhZ29uLm9yZyIsInMxMzUuY29tIiwiczhzb3NvLmNvbSIsInNhY2l0YXNsYW4uY29tIiwic2FmYXJpcHJveHkuY29tIiwic2FmZW5mcmVlLmNvbSIsInNhZmVwcm94eS5vcmciLCJzYWZlcnZwbi5jb20iLCJzYWZldW5ibG9jay5jb20iLCJzYWdlb25lLmNvbSIsInNhZ2VwYXkuY29tIiwic2FpcS5tZSIsInNhaXNodXUtaGVudGFpLm5ldCIsInNha3VyYWxpdmUuY29tIiwic2FsYW1uZXdzLm9yZyIsInNhbGVzZm9yY2VsaXZlYWdlbnQuY29tIiwic2FsaXIuY29tIiwic2Fsb25taWNyb2VudHJlcHJpc2VzLmNvbSIsInNhbHZhdGlvbi5vcmcuaGsiLCJzYW1haXIucnUiLCJzYW1hbnlvbHVoYWJlci5jb20iLCJzYW1iYW1lZGlhc2wuY29tIiwic2FuY2FkaWxsYS5uZXQiLCJzYW5nZXNhYm9yLmlyIiwic2Fuaml0dS5jb20iLCJzYW5rZWliaXouanAiLCJzYW5taW4uY29tLnR3Iiwic2FudGFiYW50YS5jb20iLCJzYW53YXB1Yi5jb20iLCJzYW80Ny5jb20iLCJzYW85Mi5jb20iLCJzYXBvbmV3b3JsZC5jb20iLCJzYXNhMTIzLmNvbSIsInNhdDI0LmNvbSIsInNhdDR0aGUuY28udWsiLCJzYXVkZS5nb3YuYnIiLCJzYXVkaS10d2l0ZXIudGsiLCJzYXVkaWFyYWJpYWJheS5pbmZvIiwic2F2ZWludGVyLm5ldCIsInNhdmVteXJ1cGVlLmNvbSIsInNhdmV0aWJldC5vcmciLCJzYXZldGliZXQucnUiLCJzYXZldmlkLmNvbSIsInNhdmV5b3V0dWJlLmNvbSIsInNhd21pbGxjcmVlay5vcmciLCJzYXktbW92ZS5vcmciLCJzYXlwcm94eS5pbmZvIiwic2JvMTI4LmNvbSIsInNibzIyMi5jb20iLCJzYy5nb3YuYnIiLCJzY2FtYXVkaXQuY29tIiwic2NhbWJvb2suY29tIiwic2Nhc2luby5jb20iLCJzY2NjMS5jb20iLCJzY2hlbWEub3JnIiwic2Nob29sLXByb3h5Lm9yZyIsInNjaG9vbC11bmJsb2NrLmNvbSIsInNjaG9vbC11bmJsb2NrLm5ldCIsInNjaG9vbHByb3h5LmNvIiwic2Nob29scHJveHkuaW5mbyIsInNjaG9vbHByb3h5LnBrIiwic2Nob29sdHVubmVsLm5ldCIsInNjaHVoLmNvLnVrIiwic2NpZW5jZXNldGF2ZW5pci5mciIsInNjaXJwLm9yZyIsInNjbHViLnR3Iiwic2NtcC5jb20iLCJzY21wY2hpbmVzZS5jb20iLCJzY29wZXNhbmRhbW1vLmNvbSIsInNjcmFweS5vcmciLCJzY3JlZW40dS5uZXQiLCJzY3JpYmQuY29tIiwic2NyaXB0aW5ndmlkZW9zLmluZm8iLCJzY3J1emFsbC5jb20iLCJzY3RpbWVzbWVkaWEuY29tIiwic2N0djMxLmNvbSIsInNkYmx1ZXBvaW50LmNvbSIsInNlLWR1Yy10aXZlLmNvbSIsInNlOTRzZS5vcmciLCJzZTk2NzguY29tIiwic2U5OTlzZS5jb20iLCJzZWFoYXdrcy5jb20iLCJzZWFyY2gtbXAzLmNvbSIsInNlYXJjaC5jb20iLCJzZWFyY2g0cGFzc2lvbi5jb20iLCJzZWFyY2hibG9nc3BvdC5jb20iLCJzZWFyY2hnZWVrLmNvbSIsInNlYXJjaGluc3RhZ3JhbS5jb20iLCJzZWFyY2h0cnV0aC5jb20iLCJzZWNyZXQubHkiLCJzZWNyZXRjaGluYS5jb20iLCJzZWNyZXRvc2RlZmFjZWJvb2suY29tIiwic2VjcmV0c2xpbmUuYml6Iiwic2VjcmV0c29mdGhlZmVkLmNvbSIsInNlY3N1cmZpbmcuY29tIiwic2VjdHVyLmdvYi5teCIsInNlY3VyZWJveC5hc2lhIiwic2VjdXJlZm9yLmNvbSIsInNlY3VyZXByby5hc2lhIiwic2VjdXJlc2VydmVyLm5ldCIsInNlY3VyZXN1cmYucHciLCJzZWN1cml0eS5jbCIsInNlY3VyaXR5aW5hYm94Lm9yZyIsInNlY3VyaXR5a2lzcy5jb20iLCJzZWVrYmFiZXMuY29tIiwic2Vla3BhcnQuY29tIiwic2Vla3RvZXhwbG9yZS5jb20iLCJzZWdvdTQ1Ni5pbmZvIiwic2VndWlkb3Jlc2luc3RhZ3JhbS5jb20iLCJzZWd5ZS5jb20iLCJzZWgxLmNvbSIsInNlaDUuY29tIiwic2VoNy5jb20iLCJzZWlrYXRzdXphY2NhLmNvbSIsInNlbGFuZy5jYSIsInNlbGFuZzMzLmNvbSIsInNlbGFvZXIuY29tIiwic2VsZWN0b3JuZXdzLmNvbSIsInNlbGVrd2ViLmNvbSIsInNlbWFvbWkuY29tIiwic2Vtb25rLmluZm8iLCJzZW5kZ3JpZC5uZXQiLCJzZW5kaW5nY24uY29tIiwic2VuZHNwYWNlLmNvbSIsInNlbmlvcnNvdWxtYXRlcy5jb20iLCJzZW5zb3J0b3dlci5jb20iLCJzZW5zdWFsZ2lybHMub3JnIiwic2VuenVyaXR2LmNvbSIsInNlb2NoZWtpLm5ldCIsInNlb3BhbmRhLm5ldCIsInNlb3NwaGVyZS5jb20iLCJzZW92YWxsZXkuY29tIiwic2VxdW9pYWNhcC5jb20iLCJzZXJhcGgubWUiLCJzZXJ2ZWJsb2cubmV0Iiwic2VydmVpcmMuY29tIiwic2VydmVwaWNzLmNvbSIsInNlcnZlcm1hbmlhLmNvbSIsInNlcnZlcm1hdHJpeC5vcmciLCJzZXJ2aWNlLmdvdi51ayIsInNlczk5OS5jb20iLCJzZXNhd2Uub3JnIiwic2VzZTk3OTcuY29tIiwic2VzZWFhLmNvbSIsInNlc2VyZW50aS5jb20iLCJzZXR0eS5jb20udHciLCJzZXZlbmxvYWQuY29tIiwic2V2ZW50ZWVuYmFiZXMuY29tIiwic2V4LTExLmNvbSIsInNleC1zbXMtY29udGFjdHMuY29tIiwic2V4LmNvbSIsInNleDE2OS5vcmciLCJzZXgzLmNvbSIsInNleDUyMC5uZXQiLCJzZXg4LmNjIiwic2V4YW5kc3VibWlzc2lvbi5jb20iLCJzZXhib3QuY29tIiwic2V4ZGVsaXZlcnkuY29tIiwic2V4ZG91Z2FibG9nNzdmYzIuY29tIiwic2V4Zm9ydHYuY29tIiwic2V4Z2FtZXBhcmsuY29tIiwic2V4Z28yYXYuY29tIiwic2V4Z29lc21vYmlsZS5jb20iLCJzZXhodS5jb20iLCJzZXhodWFuZy5jb20iLCJzZXhpbnNleC5uZXQiLCJzZXhqYXBhbnBvcm4uY29tIiwic2V4bW01MjAuY29tIiwic2V4bW9uZXkuY29tIiwic2V4bmhhbmguY29tIiwic2V4b3RlcmljLmNvbSIsInNleHByZXZpZXdzLmV1Iiwic2V4c3RhcnNvbmx5LmNvbSIsInNleHRyYWNrZXIuY29tIiwic2V4dHViZXNldC5jb20iLCJzZXh0dnguY29tIiwic2V4dWFsaGVudGFpLm5ldCIsInNleHhzZS5jb20iLCJzZXh5LWJhYmUtcGljcy5jb20iLCJzZXh5LWxpbmdlcmllLndzIiwic2V4eS5jb20iLCJzZXh5YW5kZnVubnkuY29tIiwic2V4eWZ1Y2tnYW1lcy5jb20iLCJzZXllZG1vanRhYmEtdmFoZWRpLmJsb2dzcG90LmNvLnVrIiwic2Y1NTguY29tIiwic2ZhY2Vib29rLmNvbSIsInNmaWxleWR5LmNvbSIsInNmb3JhLnBsIiwic2dibG9ncy5jb20iLCJzZ2NoaW5lc2UubmV0Iiwic2djcGFuZWwuY29tIiwic2d0dHQuY29tIiwic2hhYmxvbC5jb20iLCJzaGFibmFtbmV3cy5jb20iLCJzaGFja25ld3MuY29tIiwic2hhZG93c29ja3Mub3JnIiwic2hhaGFtYXQtZW5nbGlzaC5jb20iLCJzaGFoYW1hdC1tb3ZpZS5jb20iLCJzaGFtYmhhbGFzdW4uY29tIiwic2hhbmdmYW5nLm9yZyIsInNoYW9rYS5jb20iLCJzaGFwZS5pbi50aCIsInNoYXBlc2VydmljZXMubmV0Iiwic2hhcmViZWUuY29tIiwic2hhcnBkYWlseS5jb20uaGsiLCJzaGFycGRhaWx5LmhrIiwic2hhdW50aGVzaGVlcC5jb20iLCJzaGF2ZWRwdXNzeS5wcm8iLCJzaGF5dWpzcS5vcmciLCJzaGVmYWNlYm9vay5jb20iLCJzaGVpa3llcm1hbWkuY29tIiwic2hlbGRvbnNmYW5zLmNvbSIsInNoZWxmM2QuY29tIiwic2hlbGxmaXJlLmRlIiwic2hlbWFsZS1hc2lhLmNvbSIsInNoZW1hbGV0aW1wby5jb20iLCJzaGVuZ2h1b25ldC5jb20iLCJzaGVueXVuLmNvbSIsInNoZW55dW5wZXJmb3JtaW5nYXJ0cy5vcmciLCJzaGVyZG9nLmNvbSIsInNoaWF0di5uZXQiLCJzaGlmdGRlbGV0ZS5uZXQiLCJzaGlueWktYWlraWRvLmlkdi50dyIsInNoaXJheHh4LmNvbSIsInNoaXJleWlzaHVuamlhbi5jb20iLCJzaGlyZXlpc2h1bmppYW4ub3JnIiwic2hpc3RsYmIuY29tIiwic2hpdGFvdHYub3JnIiwic2hpemhhby5vcmciLCJzaG9jay1uZXdzLm5ldCIsInNob29zaHRpbWUuY29tIiwic2hvb3RxLmNvbSIsInNob3AuYnkiLCJzaG9wMjAwMC5jb20udHciLCJzaG9wY2FkZS5jb20iLCJzaG9wcGluZy5jb20iLCJzaG9wcGluZy5jb20uYm4iLCJzaG9wcGluZy5jb20udWEiLCJzaG9ydGhhaXJzdHlsZWlkZWFzaXRlLmNvbSIsInNob3V0dXNzYWxhbS5jb20iLCJzaG93dGhpcy5wbCIsInNob3d0aW1lLmpwIiwic2hxaXBsaXZlLmluZm8iLCJzaHVhbmd0di5uZXQiLCJzaHVsb3UuY29tIiwic2h1dHRlcnN0b2NrLmNvbSIsInNodm9vbmcuY29tIiwic2h3Y2h1cmNoMy5jb20iLCJzaWFrYXBrZWxpLmNvbSIsInNpYW1zcG9ydC5jby50aCIsInNpa3MuY29tIiwic2lsa2Jvb2suY29tIiwic2lsdmVyZmFjZWJvb2suY29tIiwic2lsdmVyc3RyaXBlLm9yZyIsInNpbWEtbGFuZC5ydSIsInNpbWJvbGktZmFjZWJvb2suY29tIiwic2ltYm9sb3NwYXJhZmFjZWJvb2suY29tIiwic2ltYm9sb3N0d2l0dGVyLmNvbSIsInNpbWlsc2l0ZXMuY29tIiwic2luYS5jb20iLCJzaW5hLmNvbS5oayIsInNpbmEuY29tLnR3Iiwic2luZ2Fwb3JlYmF5LmluZm8iLCJzaW5nYXBvcmVwb29scy5jb20uc2ciLCJzaW5ndGFvLmNhIiwic2luZ3Rhby5jb20iLCJzaW5ndGFvLmNvbS5hdSIsInNpbmd0YW91c2EuY29tIiwic2luZ3lvdXR1YmUuY29tIiwic2luaWNhLmVkdS50dyIsInNpbm9hbnRzLmNvbSIsInNpbm9jaXNtLmNvbSIsInNpbm9uZXQuY2EiLCJzaW5vcGl0dC5pbmZvIiwic2lub3F1ZWJlYy5jb20iLCJzaXMwMDEuY29tIiwic2lzMDAxLnVzIiwic2lzOC5jb20iLCJzaXRhLmFlcm8iLCJzaXRlMnVuYmxvY2suY29tIiwic2l0ZWJsb2NrZWQub3JnIiwic2l0ZWJyby50dyIsInNpdGVleHBsb3Jlci5pbmZvIiwic2l0ZWZpbGUub3JnIiwic2l0ZWdldC5uZXQiLCJzaXRlbWFucHJvLmNvbSIsInNpdGVuYWJsZS5jb20iLCJza2ltdHViZS5jb20iLCJza2lubnl0YXN0ZS5jb20iLCJza3kuY29tLmJyIiwic2t5YmV0LmNvbSIsInNreWtpd2kuY29tIiwic2t5cGUuY29tIiwic2t5c2Nhbm5lci5jb20uYnIiLCJza3lzY2FubmVyLmZyIiwic2t5c2Nhbm5lci5ubCIsInNreXdvcmQuY29tIiwic2t5em9uZS5jb20iLCJzbGFuZHIubmV0Iiwic2xkYW8udXMiLCJzbGlja2FtZXJpY2Fucy5jb20iLCJzbGlja3Zwbi5jb20iLCJzbGlkZXNoYXJlLm5ldCIsInNsaW1lLmNvbS50dyIsInNsaW5rc2V0LmNvbSIsInNsaXBzdGljay5jb20iLCJzbG9nZ2kuY29tIiwic2xvcHB5dHViZS5jb20iLCJzbHV0bG9hZC5jb20iLCJzbHV0cm91bGV0dGUuY29tIiwic2x1dHNvZmluc3RhZ3JhbS5jb20iLCJzbHlzb2Z0LmNvbSIsInNseXVzZXIuY29tIiwic21hYXRvLmNvbSIsInNtYXJ0dXNhLmNvbSIsInNtYXNoaW5ncmVhZGVyLmNvbSIsInNtaWxlLmNvLnVrIiwic21pdGhtaWNyby5jb20iLCJzbWxtLmluZm8iLCJzbW9rZXByb3h5LmNvbSIsInNtdWRlLmVkdS5pbiIsInNteGlubGluZy5jb20iLCJzbmFrZWNhc3RsZS5jb20iLCJzbmFwZGVhbG1haWwuaW4iLCJzbmFwdHUuY29tIiwic25lYWt6b3J6LmNvbSIsInNuaXB1cmwuY29tIiwic25uLmlyIiwic25vYi5ydSIsInNub29wZXIuY28udWsiLCJzbm93cHJveHkuY29tIiwic28tZ2EubmV0Iiwic28tbmV0Lm5ldC50dyIsInNvLW5ld3MuY29tIiwic29iZWVzLmNvbSIsInNvY2NlcmJhc2UuY29tIiwic29jY2Vyc3RhbmQuY29tIiwic29jaWFsLWJvb2ttYXJraW5nLXNpdGUuY29tIiwic29jaWFsYXBwc3BvdC5jb20iLCJzb2NpYWxzZWN1cml0eS5nb3YiLCJzb2NpYWx0aGVhdGVyLmNvbSIsInNvY2lzeW5kLmNvbSIsInNvY2tldC5pbyIsInNvY2tzbGlzdC5uZXQiLCJzb2NyZWMub3JnIiwic29kLmNvLmpwIiwic29mdC5pZHYudHciLCJzb2Z0NGZ1bi5uZXQiLCJzb2Z0YW5vbnkuaW5mbyIsInNvZnRldGhlci1kb3dubG9hZC5jb20iLCJzb2Z0ZXRoZXIuY28uanAiLCJzb2Z0ZXRoZXIub3JnIiwic29mdG9uaWMuaXQiLCJzb2Z0b25pYy5wbCIsInNvZnRzdXJyb3VuZGluZ3NvdXRsZXQuY29tIiwic29mdHRpbWUucnUiLCJzb2djbHViLmNvbSIsInNvZ28zNjAuY29tIiwic29nb28ub3JnIiwic29oY3JhZGlvLmNvbSIsInNvaGZyYW5jZS5vcmciLCJzb2lmaW5kLmNvbSIsInNvbG9naXJscHVzc3kuY29tIiwic29sb3BlbGljdWxhc2dyYXRpcy5jb20iLCJzb2xvcG9zLmNvbSIsInNvbG9zdG9ja3MuY29tIiwic29sb3h5LmNvbSIsInNvbWVlLmNvbSIsInNvbmRldmlyLmNvbSIsInNvbmdqaWFuanVuLmNvbSIsInNvbmdzZmFjZWJvb2suY29tIiwic29uZ3RleHRlLmNvbSIsInNvbnkuY28uanAiLCJzb255Lm5ldCIsInNvbnl5b3V0dWJlLmNvbSIsInNvcGNhc3QuY29tIiwic29wY2FzdC5vcmciLCJzb3BpdGFzLmNvbSIsInNvcnJ5Lmlkdi50dyIsInNvcnRpcmFwYXJpcy5jb20iLCJzb3J0b2wuY29tIiwic29zdGF2LnJ1Iiwic29zeWFsbWVkeWEuY28iLCJzb3Vib3J5LmNvbSIsInNvdW1vLmluZm8iLCJzb3VuZGNsb3VkLmNvbSIsInNvdW5kb2Zob3BlLm9yZyIsInNvdXJjZWZvcmdlLm5ldCIsInNvdXJjZXdhZGlvLmNvbSIsInNvdXRobmV3cy5jb20udHciLCJzb3dlcnMub3JnLmhrIiwic293aWtpLm5ldCIsInNwYWNlYmF0dGxlcy5jb20iLCJzcGFnZ2lhcmkuZXUiLCJzcGFua2luZ3R1YmUuY29tIiwic3Bhbmtpbmd0dWJlLmNvbS5hciIsInNwYW5rd2lyZS5jb20iLCJzcGFya2Fzc2Uta3JlZmVsZC5kZSIsInNwYXJyb3dtYWlsYXBwLmNvbSIsInNwYi5jb20iLCJzcGJvLmNvbSIsInNwZWFrZWFzeS5uZXQiLCJzcGVha2VyZGVjay5jb20iLCJzcGVjdHJhbHR1YmUuY29tIiwic3BlZWQtcHJveHkuY29tIiwic3BlZWRhbmFseXNpcy5uZXQiLCJzcGVlZHBsdXNzLm9yZyIsInNwZWVkdGVzdC5uZXQiLCJzcGVsYS5zZSIsInNwaWNlamV0LmNvbSIsInNwaWN5eHh4dHViZS5jb20iLCJzcGllbGVuLmNvbSIsInNwaWtlLmNvbSIsInNwaXJpdDEwNTMuY29tIiwic3BvaWxlZHZpcmdpbnMuY29tIiwic3BvbnNvcml6emFjb25mYWNlYm9vay5jb20iLCJzcG9ydC5iZSIsInNwb3J0LmN6Iiwic3BvcnQudWEiLCJzcG9ydGJveC5ydSIsInNwb3J0Y2hhbGV0LmNvbSIsInNwb3J0aW5nbGlmZS5jb20iLCJzcG9ydG93ZWZha3R5LnBsIiwic3BvcnRzbWFuc291dGRvb3JzdXBlcnN0b3JlLmNvbSIsInNwb3J0c25ldC5jYSIsInNwb3J0c3dvcmxkaS5jb20iLCJzcG9ydHR1LmNvbSIsInNwb3RmbHV4LmNvbSIsInNwcmluZy5vcmcudWsiLCJzcHJveHkubmV0Iiwic3B5LW1vYmlsZS1waG9uZS5jb20iLCJzcHkuY28ubmwiLCJzcHkybW9iaWxlLmNvbSIsInNweXN1cmZpbmcuY29tIiwic3JlYWxpdHkuY3oiLCJzcmlzbGFuZC5jb20iLCJzcnlvdXR1YmUuY29tIiwic3NhYS5pciIsInNzZmFjZWJvb2suY29tIiwic3NreW4uY29tIiwic3NscHJveHkuaW4iLCJzc2xzZWN1cmVwcm94eS5jb20iLCJzc3Byb3h5LnB3Iiwic3NzLmNvbSIsInNzc2hoaDguY29tIiwic3NzeW91dHViZS5jb20iLCJzc3RtbHQubmV0Iiwic3N5b3V0dWJlLmNvbSIsInN0YWNrZmlsZS5jb20iLCJzdGFyZG9sbHByb3h5LmNvLnVrIiwic3RhcnAycC5jb20iLCJzdGFycHJpdmFjeS5jb20iLCJzdGFydHBhZ2UuY29tIiwic3RhdGUuZ292Iiwic3RhdGUxNjguY29tIiwic3RhdGVvZnRoZW1lZGlhLm9yZyIsInN0YXRzLmNvbSIsInN0ZWFsdGhpbmVzcy5vcmciLCJzdGVhbHRod2ViLm5ldCIsInN0ZWVwdG8uY29tIiwic3RlZ2Fub3MuY29tIiwic3RldmVzLWRpZ2ljYW1zLmNvbSIsInN0aGVhZGxpbmUuY29tIiwic3RpY2thbS5jb20iLCJzdGlja2FtLmpwIiwic3RpbGVwcm9qZWN0LmNvbSIsInN0aXJpbGVwcm90di5ybyIsInN0am9icy5zZyIsInN0b2NraW5nc2ZvcnNleC5jb20iLCJzdG9vcS5wbCIsInN0b3AtYmxvY2suY29tIiwic3RvcHRpYmV0Y3Jpc2lzLm5ldCIsInN0b3JtbWVkaWFncm91cC5jb20iLCJzdHJhaWdodHRhbGsuY29tIiwic3RyYW5hYmcuY29tIiwic3RyYW5hbWFzdGVyb3YucnUiLCJzdHJlYW1hdGUuY29tIiwic3RyZWFtaWNhLmNvbSIsInN0cmVhbWluZ21lZGlhLmNvbSIsInN0cmVhbXBvY2tldC5jb20iLCJzdHJlZXR2b2ljZS5jb20iLCJzdHJpcHByb3h5LmluZm8iLCJzdHJvbmd2cG4uY29tIiwic3R0bGJiLmNvbSIsInN0dWRlbnQudHciLCJzdHVkZW50cmF0ZS5jb20iLCJzdHVkeXN1cmYuY29tIiwic3R1bWJsZXVwb24uY29tIiwic3R1cGlkY2Ftcy5jb20iLCJzdHVwaWR2aWRlb3MuY29tIiwic3R5bGViaXN0cm8uY29tIiwic3R5bGVib29rLmRlIiwic3ViaXRvLml0Iiwic3VibWFyaW5vLmNvbS5iciIsInN1YnNjcmliZS5ydSIsInN1ZGFuZmFjZWJvb2suY29tIiwic3VkYW5pLnNkIiwic3Vkb3Vlc3QuZnIiLCJzdWdhcnN5bmMuY29tIiwic3VtLmNvbS50dyIsInN1bW1pZnkuY29tIiwic3VtcmFuZG8uY29tIiwic3VuLm12Iiwic3VuMTExLmNvbSIsInN1bmJpcmRhcml6b25hLmNvbSIsInN1bmNpdHkuY29tLm14Iiwic3VucG9ybm8uY29tIiwic3VvbHVvLm9yZyIsInN1cGVyZG93bmxvYWRzLmNvbS5iciIsInN1cGVyZnJlZXZwbi5jb20iLCJzdXBlcmdsYW0uY29tIiwic3VwZXJncmVlbnN0dWZmLmNvbSIsInN1cGVyaGlkZWlwLmNvbSIsInN1cGVyaHFwb3JuLmNvbSIsInN1cGVycGFnZXMuY29tIiwic3VwZXJ0di5jb20udHciLCJzdXBlcnR3ZWV0Lm5ldCIsInN1cGVydnBuLm5ldCIsInN1cHJlbWVtYXN0ZXJ0di5jb20iLCJzdXJlc29tZS5jb20iLCJzdXJmLWFub255bW91cy5pbmZvIiwic3VyZi1mb3ItZnJlZS5jb20iLCJzdXJmMTAwLmNvbSIsInN1cmY1NS5jb20iLCJzdXJmYWxsLm5ldCIsInN1cmZjb3ZlcnRseS5jb20iLCJzdXJmZWFzeS5jb20iLCJzdXJmZWFzeS5jb20uYXUiLCJzdXJmZXJ0Lm5sIiwic3VyZmhpZGRlbi5jb20iLCJzdXJmaW50ZXJuZXQub3JnIiwic3VyZml0LmluZm8iLCJzdXJmbWEubnUiLCJzdXJmdHVubmVsLmNvbSIsInN1cmZ0dW5uZWwub3JnIiwic3VyZndlYnNpdGUub3JnIiwic3VydmV5YnlwYXNzLmNvbSIsInN1c2plZC5jb20iLCJzdXR1bmhhYmVyLmNvbSIsInN1enVraS5jby5qcCIsInN3YXBpcC5jb20iLCJzd2VkZW5iYXkuaW5mbyIsInN3ZWV0YW5kcHVzc3kuY29tIiwic3dpYXRsb3BhbmEuY29tIiwic3dpc3N2cG4ubmV0Iiwic3dpc3N3ZWJwcm94eS5jaCIsInN3aXRjaGFkaHViLmNvbSIsInN3aXRjaHZwbi5uZXQiLCJzd29yZHNvZnQuaWR2LnR3Iiwic3lkbmV5dG9kYXkuY29tIiwic3ltcGF0aWNvLmNhIiwic3lvdXR1YmUuY29tIiwic3lyaWFudHViZS5uZXQiLCJzeXRlcy5uZXQiLCJzeXg4Ni5jbiIsInN5eDg2LmNvbSIsInN6YmJzLm5ldCIsInN6ZXRvd2FoLm9yZy5oayIsInQtYmFzaWMuY29tIiwidC1tb2JpbGViYW5rb3dlLnBsIiwidC5jbyIsInQzM25pZXMuY29tIiwidDM1LmNvbSIsInQ2NnkuYml6IiwidDY2eS5jb20iLCJ0YWEtdXNhLm9yZyIsInRhYXplLnR3IiwidGFibGV0cGNyZXZpZXcuY29tIiwidGFibG9pZG5vdmEuY29tIiwidGFib29mdWNrdHViZS5jb20iLCJ0YWJ0dGVyLmpwIiwidGFjZW0ub3JnIiwidGFjb25ldC5jb20udHciLCJ0YWVkcC5vcmcudHciLCJ0YWdlc3NwaWVnZWwuZGUiLCJ0YWdneS5qcCIsInRhaXBlaS5nb3YudHciLCJ0YWlwZWlzb2NpZXR5Lm9yZyIsInRhaXNlbGUuY29tIiwidGFpc2VsZS5uZXQiLCJ0YWl3YW4tc2V4LmNvbSIsInRhaXdhbmJpYmxlLmNvbSIsInRhaXdhbmNvbi5jb20iLCJ0YWl3YW5kYWlseS5uZXQiLCJ0YWl3YW5qdXN0aWNlLmNvbSIsInRhaXdhbmtpc3MuY29tIiwidGFpd2FubmF0aW9uLmNvbS50dyIsInRhaXdhbm5ld3MuY29tLnR3IiwidGFpd2FudHQub3JnLnR3IiwidGFpd2FudXMubmV0IiwidGFpeWFuZ2Jhby5jYSIsInRha2FyYXRvbXkuY28uanAiLCJ0YWtib29rLmNvbSIsInRha2UyaG9zdGluZy5jb20iLCJ0YWtlc3BvcnQuaWR2LnR3IiwidGFrdmFoYWJlci5uZXQiLCJ0YWxrZW5nbGlzaC5jb20iLCJ0YW1pemh5b3V0dWJlLmNvbSIsInRhbXBhYmF5LmNvbSIsInRhbXRheS52biIsInRhbmVhLmdyIiwidGFuZ2EuY29tIiwidGFvaHVhenUudXMiLCJ0YW9pc20ubmV0IiwidGFvbHVuLmluZm8iLCJ0YXAuYXoiLCJ0YXBjaGlkYW5vbmcub3JnIiwidGFwdXouY28uaWwiLCJ0YXJhZ2FuYS5jb20iLCJ0YXJpbmdhLm5ldCIsInRhc3RlLmNvbS5hdSIsInRhdGFtb3RvcnMuY29tIiwidGF0YXIucnUiLCJ0YXdlZXQuY29tIiwidGF3aGVkLndzIiwidGF4ZXMuZ292LmF6IiwidGF5ZWx1LmNvbSIsInRic2VjLm9yZyIsInRic24ub3JnIiwidGJzc2VhdHRsZS5vcmciLCJ0Y2Eub3JnLnR3IiwidGNseXkuY29tIiwidGVhc2hhcmsuY29tIiwidGVjaG5vcG9pbnQucnUiLCJ0ZWNob250aGVuZXQuY29tIiwidGVjaHNvbmlhbi5jb20iLCJ0ZWNodGFyZ2V0LmNvbSIsInRlY2h0aW1lcy5jb20iLCJ0ZWNodWxhdG9yLmNvbSIsInRlY21pbGVuaW8uZWR1Lm14IiwidGVjbWludC5jb20iLCJ0ZWVuLWhhbmRqb2IuY29tIiwidGVlbmJyYXRzLmNvbSIsInRlZW5jb3JlY2x1Yi5jb20iLCJ0ZWVuaG90dHViZS5jb20iLCJ0ZWVucGlua3ZpZGVvcy5jb20iLCJ0ZWVucG9ydC5jb20iLCJ0ZWVucy1waWMuY29tIiwidGVlbnNpbmFzaWEuY29tIiwidGVlbnNvbmNvdWNoLmNvbSIsInRlZW5zeW91dHViZS5jb20iLCJ0ZWVuenZpZHouY29tIiwidGVmYWwuY28udWsiLCJ0ZWZhbC5jb20iLCJ0ZWhhZ28uY29tIiwidGVqYXJhdGFzYW4uaXIiLCJ0ZWpqaS5jb20iLCJ0ZWtub3NhLmNvbSIsInRla3N0b3dvLnBsIiwidGVsZWNoYXJnZXItdmlkZW9zLXlvdXR1YmUuY29tIiwidGVsZWNvbWl0YWxpYS5pdCIsInRlbGVjb21zcGFjZS5jb20iLCJ0ZWxlZm9uaWNhLmNvbSIsInRlbGVmb25pbm8ubmV0IiwidGVsZWdyYXBoLmNvLnVrIiwidGVsZW11bmRvLmNvbSIsInRlbGVuZXQuYmUiLCJ0ZWxldHJhYy5jb20iLCJ0ZWxleGZyZWUuY29tIiwidGVsa29tLmNvLmlkIiwidGVtcGxhdGUtYmxvZ3Nwb3QuY29tIiwidGVtcGxhdGUubXkuaWQiLCJ0ZW1wbGF0ZXBhcmFibG9nc3BvdC5jb20iLCJ0ZW5hY3kuY28udWsiLCJ0ZW5hY3kuY29tIiwidGVuYW50c2FjdC5vcmcuYXUiLCJ0ZW5uaXMtd2FyZWhvdXNlLmNvbSIsInRoMzguY29tIiwidGhhaWNuLmNvbSIsInRoYWlkb2ppbi5jb20iLCJ0aGUtY2xvYWsuY29tIiwidGhlYWZyaWNhdm9pY2UuY29tIiwidGhlYmVzdGRhaWx5cHJveHkuY29tIiwidGhlYmVzdG9mcHJveHkuaW5mbyIsInRoZWJlc3Rwcm94eS5pbmZvIiwidGhlYmVzdHByb3h5c2VydmVyLmNvbSIsInRoZWJsYXplLmNvbSIsInRoZWJvYnMuY29tIiwidGhlY2hpbmFiZWF0Lm9yZyIsInRoZWNvdmV0ZXVyLmNvbSIsInRoZWNyZXcuY29tIiwidGhlZGVzaWduaW5zcGlyYXRpb24uY29tIiwidGhlZXBvY2h0aW1lcy5jb20iLCJ0aGVmYWNlYm9vay5jb20iLCJ0aGVmYWNlc29mZmFjZWJvb2suY29tIiwidGhlZmFzdGJheS5jb20iLCJ0aGVmdXRvbmNyaXRpYy5jb20iLCJ0aGVnbG9iYWxtYWlsLm9yZyIsInRoZWd1YXJkaWFuLmNvIiwidGhlZ3VhcmRpYW4uY29tIiwidGhlaG91c2VuZXdzLmNvbSIsInRoZWh1bi5jb20iLCJ0aGVpbnF1aXJlci5uZXQiLCJ0aGVsYXdkaWN0aW9uYXJ5Lm9yZyIsInRoZWxvb3AuY2EiLCJ0aGVtaWZ5Lm1lIiwidGhlbW92cy5jb20iLCJ0aGVuZXdjaXZpbHJpZ2h0c21vdmVtZW50LmNvbSIsInRoZW5pbmphcHJveHkuY29tIiwidGhlbm90ZS5jb20udHciLCJ0aGVvcGVucHJveHkubmV0IiwidGhlcGVhY2hwYWxhY2UuY29tIiwidGhlcGlyYXRlYmF5Lm9yZyIsInRoZXBpcmF0ZWJheS5vcmcuZXMiLCJ0aGVwaXJhdGViYXkub3JnLmluIiwidGhlcGlyYXRlbWlycm9yLmNvbSIsInRoZXByb3h5LmV1IiwidGhlcHJveHlmcmVlLmNvbSIsInRoZXByb3h5cGlyYXRlLmNvbSIsInRoZXJlZ2lzdGVyLmNvLnVrIiwidGhlcm9jay5uZXQubnoiLCJ0aGVzYXJ0b3JpYWxpc3QuY29tIiwidGhlc2ltcGxlZG9sbGFyLmNvbSIsInRoZXN1bi5uZXQiLCJ0aGV0ZW5kLmNvbSIsInRoZXRpYmV0cG9zdC5jb20iLCJ0aGV0cmFja3IuY29tIiwidGhldW5pcXVlcHJveHkuY29tIiwidGhldmlzaW9udGltZXMuY2EiLCJ0aGV3ZWF0aGVybmV0d29yay5jb20iLCJ0aGV3aXNlZ3Vpc2UuY29tIiwidGhld3JhcC5jb20iLCJ0aGV4aXRlLmNvbSIsInRoaW5rYWR2aXNvci5jb20iLCJ0aGlua2ZsYS5jb20iLCJ0aGlua2luZ3RhaXdhbi5jb20iLCJ0aGlzYXYuY29tIiwidGhpc2lzZ29vZHNoaXQuaW5mbyIsInRob25nZHJlYW1zLmNvbSIsInRob29mLmNvbSIsInRocWFmYXdlM2xvbS5jb20iLCJ0aHNjb3JlLmNjIiwidGh1bWJ6aWxsYS5jb20iLCJ0aWFuZGl4aW5nLm9yZyIsInRpYW5odWF5dWFuLmNvbSIsInRpYW50aWJvb2tzLm9yZyIsInRpYW56aHUub3JnIiwidGlhdmEuY29tIiwidGliZXQuY29tIiwidGliZXQuY29tLmF1IiwidGliZXQuZGUiLCJ0aWJldC5uZXQiLCJ0aWJldC5vcmcudHciLCJ0aWJldGFueW91dGhjb25ncmVzcy5vcmciLCJ0aWJldGhvdXNlLmpwIiwidGliZXRvbmxpbmUudHYiLCJ0aWJldHN1bi5jb20iLCJ0aWJldHRpbWVzLm5ldCIsInRpY2JlYXQuY29tIiwidGlja2V0LmNvbS50dyIsInRpZW1wby5jb20iLCJ0aWVtcG8uaG4iLCJ0aWZmYW55YXJtZW50LmNvbSIsInRpZ2VyZHJvcHBpbmdzLmNvbSIsInRpZ28uY29tLmNvIiwidGlraS1vbmxpbmUuY29tIiwidGltLmNvbS5iciIsInRpbS5pdCIsInRpbWRpci5jb20iLCJ0aW1lLmNvbSIsInRpbWVzaW50ZXJuZXQuaW4iLCJ0aW1ldHVyay5jb20iLCJ0aW1zYWguY29tIiwidGltc2hhbi5pZHYudHciLCJ0aW10YWRkZXIuY29tIiwidGluZXkuY29tIiwidGluZ3RpbmdqaWRpLmNvbSIsInRpbnRoZXRoYW8uY29tLnZuIiwidGludHVjMTAxLmNvbSIsInRpbnR1Y29ubGluZS5jb20udm4iLCJ0aW55LmNjIiwidGlueTE4Lm5ldCIsInRpbnljaGF0LmNvbSIsInRpbnlqdWdzLmNvbSIsInRpbnlzdWJ2ZXJzaW9ucy5jb20iLCJ0aXBwMy5hdCIsInRpc2NhbGkuaXQiLCJ0aXN0b3J5LmNvbSIsInRqc3AuanVzLmJyIiwidG1hZ2F6aW5lLmNvbSIsInRtZDM4LmluZm8iLCJ0bWkubWUiLCJ0bW4uaWR2LnR3IiwidG4uZ292LmluIiwidG5hZmxpeC5jb20iLCJ0bmV3cy5jYyIsInRucHNjLmdvdi5pbiIsInRuc2NnLmNvbSIsInRvLmx5IiwidG9idS5jby5qcCIsInRvZGF5Lml0IiwidG9kb2luc3RhZ3JhbS5jb20iLCJ0b2dldHRlci5jb20iLCJ0b2tmbS5wbCIsInRva3lvLTI0Ny5jb20iLCJ0b2t5by1ob3QuY29tIiwidG9reW8tbW90b3JzaG93LmNvbSIsInRva3lvMmhvdC5jb20iLCJ0b2t5b2NuLmNvbSIsInRvbTM2NS5jYyIsInRvbWljYS5ydSIsInRvbW95YXguY29tIiwidG9vZG9jLmNvbSIsInRvb2xzbGliLm5ldCIsInRvcC1wYWdlLnJ1IiwidG9wLXByb3hpZXMuY28udWsiLCJ0b3AteGhhbXN0ZXIuY29tIiwidG9wMWhlYWx0aC5jb20iLCJ0b3A1NS5uZXQiLCJ0b3A4MS53cyIsInRvcGNoaW5lc2VuZXdzLmNvbSIsInRvcGNocmV0aWVuLmNvbSIsInRvcGlmeS5jb20iLCJ0b3BpbmNlc3R2aWRlby5jb20iLCJ0b3BuZXdzLmluIiwidG9wbmV3cy5qcCIsInRvcG9yNGlrLnJ1IiwidG9wc2hhcmV3YXJlLmNvbSIsInRvcHNwZWVkLmNvbSIsInRvcHN5LmNvbSIsInRvcHR3ZWV0Lm9yZyIsInRvcHVuYmxvY2suY29tIiwidG9wem9uZS5uZXQiLCJ0b3JjZWRvcmVzLmNvbSIsInRvcmNuLmNvbSIsInRvcmd1YXJkLm5ldCIsInRvcm9udG8uY2EiLCJ0b3Jwcm9qZWN0Lm9yZyIsInRvcnByb2plY3Qub3JnLmluIiwidG9ycmVudC10di5ydSIsInRvcnJlbnRjcmF6eS5jb20iLCJ0b3JyZW50ZGF5LmNvbSIsInRvcnJlbnRraXR0eS5jb20iLCJ0b3JyZW50cHJpdmFjeS5jb20iLCJ0b3JyZW50cHJveGllcy5jb20iLCJ0b3JyZW50cm9vbS5jb20iLCJ0b3JyaW5vbWVkaWNhLml0IiwidG9ydG9pc2Vzdm4ubmV0IiwidG9ydnBuLmNvbSIsInRvc2FyYW5nLm5ldCIsInRvc2hpYmEuY29tIiwidG90YWxmaWxtLmNvbSIsInRvdGFsd2FyLmNvbSIsInRvdWtvdWdhem91Lm5ldCIsInRvdXNsZXNkcml2ZXJzLmNvbSIsInRvdmVyLm5ldCIsInRvdmltYS5nciIsInRvd25nYWluLmNvbSIsInRveXBhcmsuaW4iLCJ0cC1saW5rcnUuY29tIiwidHAxLmpwIiwidHBhcmVudHMub3JnIiwidHBlLmdvdi50ciIsInRwaS5vcmcudHciLCJ0cGltYWdlLmNvbSIsInRwdXNlci5pZHYudHciLCJ0ci15b3V0dWJlLmNvbSIsInRyLmltIiwidHJhYmFqYW5kby5jbCIsInRyYWJhamFuZG8uY29tIiwidHJhYmFqb3MuY29tIiwidHJhY2tpZC5pbmZvIiwidHJhY2t5b3V0dWJlLmNvbSIsInRyYWZmaWMtZGVsaXZlcnkuY29tIiwidHJhZmZpY2hhdXMuY29tIiwidHJhZnRvbi5vcmciLCJ0cmFmdml6LmNvbSIsInRyYW5zZG9jLmNvbS5ndCIsInRyYW5zZmVybWFya3QuY28udWsiLCJ0cmFuc2Zlcm1hcmt0LmNvbSIsInRyYW5zZmVybWFya3QuZXMiLCJ0cmFuc3dvcmxkLm5ldCIsInRyYXZlbGxpbmd0aGVyZS5jb20iLCJ0cmVuZC5heiIsInRyZW5kaW5nd2ViLm5ldCIsInRyZW5kc21hcC5jb20iLCJ0cmlhbG9mY2NwLm9yZyIsInRyaWFscHJveHkuY29tIiwidHJpYnVuZS5nciIsInRyaWNrc2ZhY2Vib29rLmNvbSIsInRyaW9ud29ybGRzLmNvbSIsInRyaXBwaW53aXRodGFyYS5jb20iLCJ0cml1bXBoLmNvbSIsInRyaXZhZ28uY28udWsiLCJ0cml2YWdvLmRlIiwidHJvdXcubmwiLCJ0cm92YXByZXp6aS5pdCIsInRydGMuY29tLnR3IiwidHJ1Y2NoaWZhY2Vib29rLmNvbSIsInRydXN0LnJ1IiwidHJ1c3RlZXIuY29tIiwidHJ1c3RwaWxvdC5ubCIsInRydXZlby5jb20iLCJ0cnl0ZWVuc3R1YmUuY29tIiwidHM4ODg4Lm5ldCIsInRzYi5jby51ayIsInRzY3R2Lm5ldCIsInRzZW10dWxrdS5jb20iLCJ0c2VwLmluZm8iLCJ0c24uY2EiLCJ0c3VuYWdhcnVtb24uY29tIiwidHQyMi5pbmZvIiwidHRqMTIzLmNvbSIsInR0czg4ODgubmV0IiwidHR0YW4uY29tIiwidHR2LmNvbS50dyIsInR1YW56dC5jb20iLCJ0dWJhaG9saWMuY29tIiwidHViZS5jb20iLCJ0dWJlOC5jb20iLCJ0dWJlOC5jb20uY28iLCJ0dWJlY2FvLmNvbSIsInR1YmVkdXBlLmNvbSIsInR1YmVnYWxzLmNvbSIsInR1YmVpc2xhbS5jb20iLCJ0dWJlbnQuY29tIiwidHViZW51YmUuY29tIiwidHViZW9mbXVzaWMuY29tIiwidHViZW94eS5jb20iLCJ0dWJlcGxlYXN1cmUuY29tIiwidHViZXByb3h5Lm51IiwidHViZXByb3h5LnNlIiwidHViZXRvcDY5LmNvbSIsInR1YmV1bS5jb20iLCJ0dWJld2hhbGUuY29tIiwidHViZXdvbGYuY29tIiwidHViZXhwbG9yZXIuY29tIiwidHVlbXByZXNhZW5mYWNlYm9vay5jb20iLCJ0dWlkYW5nLm9yZyIsInR1aXR1aS5pbmZvIiwidHVtYmxyLmNvbSIsInR1bXV0YW56aS5jb20iLCJ0dW5lLnBrIiwidHVuZWluLmNvbSIsInR1bmV1cG15bWFjLmNvbSIsInR1bm5lbGJlYXIuY29tIiwidHVvY2hhdC5jb20iLCJ0dXJhbnNhbS5vcmciLCJ0dXJhbnNlc2kuY29tIiwidHVyYm9iaXQubmV0IiwidHVyYm9oaWRlLmNvbSIsInR1cmtleWJheS5pbmZvIiwidHVya2V5Zm9ydW0uY29tIiwidHVya2lzaG5ld3MuY29tIiwidHVya2lzdC5vcmciLCJ0dXJraXN0YW5tYWFyaXAub3JnIiwidHVya3RpY2FyZXQubmV0IiwidHVybmVyLmNvbSIsInR1c2ZyYXNlc3BhcmFmYWNlYm9vay5jb20iLCJ0dXNoeWNhc2guY29tIiwidHVzcGVsaXMuY28iLCJ0dXRvcmlhbGJsb2dzcG90LmNvbSIsInR1dHRvYW5udW5jaS5vcmciLCJ0dXR0b25hcG9saS5uZXQiLCJ0dXZwbi5jb20iLCJ0di1ncmVlay5jb20iLCJ0di5jb20iLCJ0di5jb20ucGsiLCJ0djAwNy50diIsInR2Mi5wdyIsInR2NTAwMC5jb20iLCJ0djg4ODgubmV0IiwidHY5ay5uZXQiLCJ0dmIuY29tIiwidHZib3hub3cuY29tIiwidHZnYXkucnUiLCJ0dm4uY2wiLCJ0dnBsYXl2aWRlb3MuY29tIiwidHZ2Y2QuY29tIiwidHZ6dmV6ZGEucnUiLCJ0dzAxLm9yZyIsInR3MS5jb20iLCJ0dzEwMHMuY29tIiwidHcxMTYuY29tIiwidHc3ODkubmV0IiwidHdhaXR0ZXIuY29tIiwidHdhdHRlci5jb20iLCJ0d2F1ZC5pbyIsInR3YXZpLmNvbSIsInR3YXZ0di5jb20iLCJ0d2Jicy5uZXQudHciLCJ0d2Jicy5vcmciLCJ0d2Jicy50dyIsInR3ZWFrbXl0d2l0dGVyLmNvbSIsInR3ZWVtYXRpYy5jb20iLCJ0d2VlcGkuY29tIiwidHdlZXBtbC5vcmciLCJ0d2VldGJhY2t1cC5jb20iLCJ0d2VldGJpbmRlci5jb20iLCJ0d2VldGJvbmVyLmJpeiIsInR3ZWV0ZGVjay5jb20iLCJ0d2VldGUubmV0IiwidHdlZXRtZW1lLmNvbSIsInR3ZWV0cGhvdG8uY29tIiwidHdlZXRzd2luZC5jb20iLCJ0d2VldHR1bm5lbC5jb20iLCJ0d2VldHZhbHVlLmNvbSIsInR3ZWV0eW1haWwuY29tIiwidHdlbS5pZHYudHciLCJ0d2hpcmwub3JnIiwidHdpYi5qcCIsInR3aWJibGUuZGUiLCJ0d2ljc3kuY29tIiwidHdpZW5kcy5jb20iLCJ0d2lmYW4uY29tIiwidHdpbGlnaHRzZXguY29tIiwidHdpbGlvLmNvbSIsInR3aW1nLmNvbSIsInR3aW1pLm5ldCIsInR3aW5zdi5jb20iLCJ0d2lwcGxlLmpwIiwidHdpc2hvcnQuY29tIiwidHdpc3Rhci5jYyIsInR3aXN0ZXIubmV0LmNvIiwidHdpc3RlcmlvLmNvbSIsInR3aXQyZC5jb20iLCJ0d2l0aXAuY29tIiwidHdpdGxvbmdlci5jb20iLCJ0d2l0bWFuaWEuY29tIiwidHdpdHBpYy5jb20iLCJ0d2l0c3RhdC5jb20iLCJ0d2l0dGFuaWMuY29tIiwidHdpdHRib3QubmV0IiwidHdpdHRlci1pY29uLmNvbSIsInR3aXR0ZXIuY29tIiwidHdpdHRlci5jb20uYnIiLCJ0d2l0dGVyNGoub3JnIiwidHdpdHRlcmNlbnRyYWwuY29tLmJyIiwidHdpdHRlcmNvdW50ZXIuY29tIiwidHdpdHRlcmZhbGwuY29tIiwidHdpdHRlcmZlZWQuY29tIiwidHdpdHRlcmdhZGdldC5jb20iLCJ0d2l0dGVya3IuY29tIiwidHdpdHRoYXQuY29tIiwidHdpdHR1cmsuY29tIiwidHdpdHR1cmx5LmNvbSIsInR3aXR2aWQuY29tIiwidHdpdHphcC5jb20iLCJ0d29wY2hhcnRzLmNvbSIsInR3cmVnLmluZm8iLCJ0d3NreXBlLmNvbSIsInR3dGtyLmNvbSIsInR3dG1hc3Rlci5jb20iLCJ0d3RybGFuZC5jb20iLCJ0d3VuYmJzLmNvbSIsInR3dXJsLm5sIiwidHd5YWMub3JnIiwidHd5bGFoLmNvbSIsInR5Y29vbC5jb20iLCJ0eXBlbWFnLmpwIiwidHlwaW5ndGVzdC5jb20iLCJ1MTUudHYiLCJ1YXMyLmNvbSIsInViZXJjb25mZXJlbmNlLmNvbSIsInViZXJ2dS5jb20iLCJ1YmludC5uZXQiLCJ1YmxkaXJlY3QuY29tIiwidWJ0b3ouY29tIiwidWJ1bnR1LnJ1IiwidWNhbS5vcmciLCJ1Y296LnJ1IiwidWNyLmFjLmNyIiwidWNzYy5lZHUiLCJ1ZG4uY29tIiwidWRuLmNvbS50dyIsInVmcmVldnBuLmNvbSIsInVmcmV0LmpwIiwidWZ1eC5wbCIsInVnZ2F1c3RyYWxpYS5jb20iLCJ1Z28uY29tIiwidWlnaHVyYml6Lm5ldCIsInVrLXByb3h5LmNvLnVrIiwidWsudG8iLCJ1a2JheS5pbmZvIiwidWtsaWZlcmFkaW8uY28udWsiLCJ1a3Byb3h5c2VydmVyLmNvbSIsInVrcnlqLmluZm8iLCJ1a3VzdnBuLmNvbSIsInVrd2VicHJveHkuZXUiLCJ1bGlrZS5uZXQiLCJ1bGt1b2Nha2xhcmkub3JnLnRyIiwidWx0aW1hdGVwcm8uZXUiLCJ1bHRyYWJlc3Rwcm94eS5jb20iLCJ1bHRyYWZhc3Rwcm94eS5jb20iLCJ1bHRyYXB1c3N5LmNvbSIsInVsdHJhc3VyZi5wbCIsInVsdHJhc3VyZi51cyIsInVsdHJhc3VyZmluZy5jb20iLCJ1bHVzYWxrYW5hbC5jb20udHIiLCJ1bW1ldGlzbGFtLm5ldCIsInVuYmxrLm5ldCIsInVuYmxvY2stZXZlcnl0aGluZy5jb20iLCJ1bmJsb2NrLWZhY2Vib29rcHJveHkuY29tIiwidW5ibG9jay1tZS5vcmciLCJ1bmJsb2NrLXByb3h5LmNvbSIsInVuYmxvY2stcHJveHlidW5rZXIuY28udWsiLCJ1bmJsb2NrLXVzLmNvbSIsInVuYmxvY2suY24uY29tIiwidW5ibG9jay5udSIsInVuYmxvY2sucGsiLCJ1bmJsb2NrLnBsIiwidW5ibG9jazEyMy5jb20iLCJ1bmJsb2NrMjQuZGUiLCJ1bmJsb2NrNGV2ZXIuY29tIiwidW5ibG9jazRldmVyLmluZm8iLCJ1bmJsb2NrYWNjZXNzLmNvbSIsInVuYmxvY2thbnlzaXRlLm5ldCIsInVuYmxvY2thbnl0aGluZy5jb20iLCJ1bmJsb2NrYXByb3h5LmNvbSIsInVuYmxvY2tjbGljay5pbmZvIiwidW5ibG9ja2RtbS5jb20iLCJ1bmJsb2NrZWQuaW4iLCJ1bmJsb2NrZWRmYWNlYm9vay5uZXQiLCJ1bmJsb2NrZWRwcm94eS5uZXQiLCJ1bmJsb2NrZWRwcm94eS51cyIsInVuYmxvY2tlci5iaXoiLCJ1bmJsb2NrZXIubWUiLCJ1bmJsb2NrZXJzdXJmLmNvbSIsInVuYmxvY2tlcnoubmV0IiwidW5ibG9ja2luZ3dlYnNpdGUuY29tIiwidW5ibG9ja2luc3RhZ3JhbS5jb20iLCJ1bmJsb2NrbWVnYS5jb20iLCJ1bmJsb2NrbXl3ZWIuY29tIiwidW5ibG9ja3BpcmF0ZS5jb20iLCJ1bmJsb2NrcG9ybnNpdGVzLmNvbSIsInVuYmxvY2twcm94eS5wayIsInVuYmxvY2twcm94eS51cyIsInVuYmxvY2tyZWFsLmluZm8iLCJ1bmJsb2Nrc2l0LmVzIiwidW5ibG9ja3NpdGUuaW5mbyIsInVuYmxvY2tzb2NpYWxtZWRpYS5jb20iLCJ1bmJsb2NrdGhhdHNpdGUubmV0IiwidW5ibG9ja3ZpZGVvcy5jb20iLCJ1bmJsb2NrdnBuLmNvbSIsInVuYmxvY2t3ZWJzaXRlLm5ldCIsInVuYmxvY2t3ZWJzaXRlcy5zZSIsInVuYmxvY2t5b3V0dWJlLmNvIiwidW5ibG9ja3lvdXR1YmUuY28udWsiLCJ1bmJsb2NreW91dHViZS5jb20iLCJ1bmJsb2NreW91dHViZS5jb20ucGsiLCJ1bmJsb2NreW91dHViZS5ldSIsInVuYmxvY2t5b3V0dWJlLm1lIiwidW5ibG9ja3lvdXR1YmUub3JnIiwidW5ibG9ja3lvdXR1YmUudXMiLCJ1bmJsb2NreW91dHViZXIuY29tIiwidW5ibG9nLmZyIiwidW5ibG9vY2suY29tIiwidW5jZW5zb3JlZGphcGFucG9ybi5jb20iLCJ1bmN3LmVkdSIsInVuY3ljbG9wZWRpYS5pbmZvIiwidW5jeWNsb3BlZGlhLnR3IiwidW5kZXJzY29yZWpzLm9yZyIsInVuZGVyd29vZGFtbW8uY29tIiwidW5pLWdvZXR0aW5nZW4uZGUiLCJ1bmkuY2MiLCJ1bmljYS5ybyIsInVuaWNvcm4teC5jb20iLCJ1bmlmaS5pdCIsInVuaWZpY2F0aW9uLm5ldCIsInVuaW9uZXNhcmRhLml0IiwidW5pcGEuaXQiLCJ1bmlwcm94eS5jb20iLCJ1bml0ZWRkYWlseS5jb20ubXkiLCJ1bml0ZWRzdGF0ZXNwcm94eS5pbmZvIiwidW5pdG4uaXQiLCJ1bml2ZXJzYWwtbXVzaWMuZGUiLCJ1bml2ZXJzaXR5cHJveHkubmV0IiwidW5peDEwMC5jb20iLCJ1bml4bWVuLmNvbSIsInVua25vd25wcm94eS5jb20iLCJ1bmxvY2ttZXRoaXMuY29tIiwidW5vZGVkb3MuY29tIiwidW5wby5vcmciLCJ1bnJlc3RyaWN0ZWQuYml6IiwidW5zZWVuLmlzIiwidW5zb2xvY2xpYy5pbmZvIiwidW50cmFjZWFibGUudXMiLCJ1b2wuY29tLmJyIiwidXAuYWMuemEiLCJ1cGNvbWluZy5ubCIsInVwZGF0ZXNtYXJ1Z3VqYXJhdC5pbiIsInVwZGF0ZXN0YXIuY29tIiwidXBsb2FkNHUuaW5mbyIsInVwbG9hZGVkLnRvIiwidXBsb2FkaGVyby5jbyIsInVwbG9hZHMucnUiLCJ1cGxvYWRzdGF0aW9uLmNvbSIsInVyN3MuY29tIiwidXJhLmdvdi5zZyIsInVyYXN1bmRheS5jb20iLCJ1cmJhbm91dGZpdHRlcnMuY29tIiwidXJjaGVla3kuY29tIiwidXJnZW50ZnVyeS5jb20iLCJ1cmwuY29tLnR3IiwidXJsMTAub3JnIiwidXJsbS5kZSIsInVybHZvaWQuY29tIiwidXMtcHJveHkub3JnIiwidXMtd2VicHJveHkuY29tIiwidXMudG8iLCJ1c2EtcHJveHkub3JnIiwidXNhLmdvdiIsInVzYWZhc3Rwcm94eS5pbmZvIiwidXNhaXAuZXUiLCJ1c2Fwcm94eS5vcmciLCJ1c2F1cGxvYWQubmV0IiwidXNhd2VicHJveHkuY29tIiwidXNhem0ubmV0IiwidXNjaXMuZ292IiwidXNlanVtcC5jb20iLCJ1c2VybG9jYWwuanAiLCJ1c2VydHViZS5jb20iLCJ1c2YuZWR1IiwidXNncy5nb3YiLCJ1c2hpcC5jb20iLCJ1c2hpc3Rvcnkub3JnIiwidXNwcm94eS5udSIsInVzcHJveHk0ZnJlZS5pbmZvIiwidXNwcnh5LmNvbSIsInVzdHJlYW0udHYiLCJ1c3dlYnByb3h5LmNvbSIsInVzd2VicHJveHkudXMiLCJ1dGFoLmdvdiIsInV0b20udXMiLCJ1dHViZS15b3V0dWJlLmNvbSIsInV0dWJlY2xhc3NpYy5jb20iLCJ1dS5ubCIsInV1MTU4LmNvbSIsInV1ODk4LmNvbSIsInV1ZXl5LmNvbSIsInV1a3QuaWR2LnR3IiwidXVydC5jYyIsInV1c2hhcmUuY29tIiwidXZhLm5sIiwidXZpZGkuY29tIiwidXcuaHUiLCJ1d2FudHMuY29tIiwidXdhbnRzLm5ldCIsInV3aS5lZHUiLCJ1eWJiYi5jb20iLCJ1eWdodXIuY28udWsiLCJ1eWdodXJhbWVyaWNhbi5vcmciLCJ1eWdodXJjb25ncmVzcy5vcmciLCJ1eWdodXJuZXQub3JnIiwidXlnaHVycHJlc3MuY29tIiwidXloZXdlci5iaXoiLCJ1eW1hYXJpcC5jb20iLCJ1eXNhbS5vcmciLCJ1eXR0dC5jb20iLCJ1emJla3dlYi5uZXQiLCJ1em9yNGlrLnJ1IiwidXp1bS50diIsInYwNzkxLmNvbSIsInY1eXkuY29tIiwidjYuZmFjZWJvb2suY29tIiwidmFuNjk4LmNvbSIsInZhbmNpdHlidXp6LmNvbSIsInZhbmRlcmJpbHQuZWR1IiwidmFuZW11LmNuIiwidmFuZ3VhcmRpYS5jb20ubXgiLCJ2YW5pbGxhLWpwLmNvbSIsInZhbml0eWZhaXIuY29tIiwidmFucGVvcGxlLmNvbSIsInZhbnNreS5jb20iLCJ2Y2QwMS5jb20iLCJ2Y3JpY2tldC5jb20iLCJ2Y3VwYm9zcy5jb20iLCJ2Y3VwYm94Lm5ldCIsInZkeW91dHViZS5jb20iLCJ2ZWI0LmluZm8iLCJ2ZWNsaXAuY29tIiwidmVjdHJvcHJveHkuY29tIiwidmVnYWRpc2suY29tIiwidmVnYXNtcGVncy5jb20iLCJ2ZWdhc3JlZC5jb20iLCJ2ZWdvcnBlZGVyc2VuLmNvbSIsInZlaW5mb3BvcnRhbC5jb20iLCJ2ZWxrYWVwb2NoYS5zayIsInZlb2guY29tIiwidmVyaXpvbi5uZXQiLCJ2ZXJwZWxpY3VsYXlvdXR1YmUuY29tIiwidmVyc2F2cG4uY29tIiwidmVyc3VzLmNvbSIsInZlcnRiYXVkZXQuZnIiLCJ2ZXJ5eGUuY29tIiwidmZ0LmNvbS50dyIsInZnMjQ3LmNvbSIsInZpLm5sIiwidmlhamFyLmNvbSIsInZpYW1pY2hlbGluLmRlIiwidmlhbWljaGVsaW4uZnIiLCJ2aWJlci5jb20iLCJ2aWNreWZ1Y2suY29tIiwidmljdGltc29mY29tbXVuaXNtLm9yZyIsInZpZGFsLmZyIiwidmlkZW8taG5lZC5jb20iLCJ2aWRlby1wcm94eS5jb20iLCJ2aWRlb2JhbS5jb20iLCJ2aWRlb2JveC5jb20iLCJ2aWRlb2NoYXRmb3JmYWNlYm9vay5jb20iLCJ2aWRlb2RldGVjdGl2ZS5jb20iLCJ2aWRlb2VnZy5jb20iLCJ2aWRlb2l4aXIubmV0IiwidmlkZW9tYXhpbXVtLmNvbSIsInZpZGVvbW8uY29tIiwidmlkZW9wZWRpYXdvcmxkLmNvbSIsInZpZGVvcGhpbS5uZXQiLCJ2aWRlb3NxLmNvbSIsInZpZGVvdHJha2VyLmNvbSIsInZpZGVvdXJpLmNvbSIsInZpZG9ldm8uY29tIiwidmlkb3Nlci5jb20udWEiLCJ2aWRvc2VyLm9yZyIsInZpZHByb3h5LmNvbSIsInZpZHNmdWNrZXIuY29tIiwidmlkdWJhLmNvbSIsInZpZHVsZS5jb20iLCJ2aWVtby5jb20iLCJ2aWV0YmV0LmNvbSIsInZpZXRkYWlreW5ndXllbi5jb20iLCJ2aWV0ZnVuLmNvbSIsInZpZXRuYW1wbHVzLnZuIiwidmlldHZpZGVvcy50diIsInZpZXR2aWRlb3Mudm4iLCJ2aW1lby5jb20iLCJ2aW50YWdlY3V0aWVzLmNvbSIsInZpbnRhZ2VmZXRpc2gubmV0IiwidmludGFnZXhoYW1zdGVyLmNvbSIsInZpcC16b25hLmJpeiIsInZpcHR1YmUuY29tIiwidmlyYWxwb3JuLmNvbSIsInZpcmFsdGFnLmNvbSIsInZpcmdpbnRyYWlucy5jby51ayIsInZpc2libGV0d2VldHMuY29tIiwidmlzaXRvcnN3ZWF0ZXIuZXUiLCJ2aXNpdHNjb3RsYW5kLmNvbSIsInZpc3VhbGxpZ2h0Ym94LmNvbSIsInZpdC5hYy5pbiIsInZpdHV0b3IuY29tIiwidml2YXR1YmUuY29tIiwidml2dGhvbWFzLmNvbSIsInZpeW91dHViZS5jb20iLCJ2aXphcmRzZ3Vuc2FuZGFtbW8uY29tIiwidml6bGUudHYiLCJ2am1lZGlhLmNvbS5oayIsInZraW5vdGlrLm5ldCIsInZrdXNzb3ZldC5ydSIsInZvYS5tb2JpIiwidm9hY2FudG9uZXNlLmNvbSIsInZvYWNoaW5lc2UuY29tIiwidm9hY2hpbmVzZWJsb2cuY29tIiwidm9hZmFudGkuY29tIiwidm9hbmV3cy5jb20iLCJ2b2F0aWJldGFuLmNvbSIsInZvZG91bWVkaWEuY29tIiwidm9ndWUuY28udWsiLCJ2b2d1ZS5jb20iLCJ2b2ljaS1uZXdzLmZyIiwidm9sYW50aW5vZmFjaWxlLml0Iiwidm9sdm9jYXJzLmNvbSIsInZvcnRlcml4LmNvbSIsInZvdC5vcmciLCJ2b3ZvMjAwMC5jb20iLCJ2b3Zva2FuLmNvbSIsInZveGVyLmNvbSIsInZveS5jb20iLCJ2b3lldXJkYWlseS5jb20iLCJ2cGFsc3lzdGVtLmNvbSIsInZwbjMzMy5jb20iLCJ2cG40YWxsLmNvbSIsInZwbmFjY291bnQub3JnIiwidnBuYWNjb3VudHMuY29tIiwidnBuYm9vay5jb20iLCJ2cG5icm93c2UuY29tIiwidnBuY2xvdWQubWUiLCJ2cG5jdXAuY29tIiwidnBuZGFxdWFuLmNvbSIsInZwbmRlbHV4ZS5jb20iLCJ2cG5nYXRlLmpwIiwidnBuZ2F0ZS5uZXQiLCJ2cG5pbnRvdWNoLmNvbSIsInZwbmlwLm5ldCIsInZwbm1hc3Rlci5jb20iLCJ2cG5tYXN0ZXIub3JnIiwidnBub25lY2xpY2suY29tIiwidnBucGljay5jb20iLCJ2cG5yZWFjdG9yLmNvbSIsInZwbnNlY3VyZS5tZSIsInZwbnNwLmNvbSIsInZwbnN0ZXIuY29tIiwidnBudGltZS5jb20iLCJ2cG50cmFmZmljLmNvbSIsInZwbnR1bm5lbC5jb20iLCJ2cG50dW5uZWwubmV0IiwidnBudWsuaW5mbyIsInZwbnVubG9jay5hc2lhIiwidnBudmlwLmNvbSIsInZwb3JuLmNvbSIsInZzbG92ZXR2LmNvbSIsInZ0dW5uZWwuY29tIiwidnR1bm5lbC5wayIsInZ1LmVkdS5hdSIsInZ1Y2xpcC5jb20iLCJ2dWZhY2Vib29rLmNvbSIsInZ1a2FqbGlqYS5jb20iLCJ2dWt1LnJ1IiwidnVuYmxvY2suY29tIiwidnVyYm1vdG8uY29tIiwidnVzYXkuY29tIiwidnV6ZS5jb20iLCJ2d3YuY24iLCJ3My5vcmciLCJ3YS1jb20uY29tIiwid2FkdHViZS5jb20iLCJ3YWhhcy5jb20iLCJ3YWlnYW9idS5jb20iLCJ3YWlrZXVuZy5pbmZvIiwid2Fpd2FpZXIuY29tIiwid2FrZWdvdi5jb20iLCJ3YWt3YWstZmFjZWJvb2suY29tIiwid2FsbG9uaWUuYmUiLCJ3YWxscGFwZXIuY29tIiwid2FtZGEuY29tIiwid2FuLXByZXNzLm9yZyIsIndhbmdqaW5iby5vcmciLCJ3YW5nby5vcmciLCJ3YW5ncnVvd2FuZy5vcmciLCJ3YW5rLnRvIiwid2Fua3R1YmUuY29tIiwid2FubW0uaW5mbyIsIndhbnQtZGFpbHkuY29tIiwid2FudGNoaW5hdGltZXMuY29tIiwid2FudHlvdXR1YmUuY29tIiwid2Fwcm94eS5jb20iLCJ3YXFuLmNvbSIsIndhcmF0YWhzLmNvbS5hdSIsIndhcmVob3VzZTMzMy5jb20iLCJ3YXJnYW1leWF1Lm5ldCIsIndhc2VscHJvLmNvbSIsIndhdGNoaW5lc2UuY29tIiwid2F0Y2hteWdmLm5ldCIsIndhdGNocHJveHkuY29tIiwid2F0Y2h4dHViZS5jb20iLCJ3YXR0cGFkLmNvbSIsIndhdHRwYWQuY29tLmJyIiwid2F1d2FhLmNvbSIsIndhd3dwcm94eS5vcmciLCJ3YXliaWcuY29tIiwid2J4aWEuY29tIiwid2Rhei5jb20iLCJ3ZGNkbi5uZXQiLCJ3ZWFyZS5oayIsIndlYXJlaGFpcnkuY29tIiwid2Vhcm4uY29tIiwid2ViNHByb3h5LmNvbSIsIndlYmFub255bWUuY29tIiwid2ViYW5vbnltaXplci5vcmciLCJ3ZWJhcHBlcnMuY29tIiwid2ViYXVsYS5jb20uYnIiLCJ3ZWJiZWUuaW5mbyIsIndlYmNhbXByb3h5LmNvbSIsIndlYmNhbXMuY29tIiwid2ViZGVzaWducmVjaXBlcy5jb20iLCJ3ZWJlbXByZXNhLmNvbSIsIndlYmV2YWRlLmNvbSIsIndlYmZsb3cuY29tIiwid2ViZnJlZXIuY29tIiwid2VibGFndS5jb20iLCJ3ZWJsb2dzcG90LmNvbSIsIndlYm1haWwuY28uemEiLCJ3ZWJtYXN0YS5vcmciLCJ3ZWJuZXdjcmVkaXQubmFtZSIsIndlYnBhZ2UuaWR2LnR3Iiwid2VicHJveGllcy5vcmciLCJ3ZWJwcm94eS1zZXJ2ZXIuY29tIiwid2VicHJveHktc2VydmljZS5kZSIsIndlYnByb3h5LmF0Iiwid2VicHJveHkuY2EiLCJ3ZWJwcm94eS5jb20uZGUiLCJ3ZWJwcm94eS5ldSIsIndlYnByb3h5Lmh1Iiwid2VicHJveHkubGEiLCJ3ZWJwcm94eS5uZXQiLCJ3ZWJwcm94eS5wayIsIndlYnByb3h5LnJ1Iiwid2VicHJveHkudG8iLCJ3ZWJwcm94eS55dCIsIndlYnByb3h5ZnJlZS5uZXQiLCJ3ZWJwcm94eWZyZWUub3JnIiwid2VicHJveHlsaXN0LmJpeiIsIndlYnByb3h5c2VydmVyLm5ldCIsIndlYnByb3h5dXNhLmNvbSIsIndlYnJ1c2gubmV0Iiwid2Vic2l0ZXByb3h5c2l0ZS5jb20iLCJ3ZWJzdGVyb25saW5lLmNvbSIsIndlYnN1cmYuaW4iLCJ3ZWJ0YWxrZm9ydW1zLmNvbSIsIndlYnRlYi5jb20iLCJ3ZWJ0dW5uZWwub3JnIiwid2Vid2FycGVyLm5ldCIsIndlYnplc3R5Lm5ldCIsIndlZWJseS5jb20iLCJ3ZWVrbWFnLmluZm8iLCJ3ZWZpZ2h0Y2Vuc29yc2hpcC5vcmciLCJ3ZWZvbmcuY29tIiwid2Vnby5jby5pbiIsIndlaWJvbGVhay5jb20iLCJ3ZWlkdWkuY24iLCJ3ZWlnZWdlYnljLmRyZWFtaG9zdGVycy5jb20iLCJ3ZWltaW5nLmluZm8iLCJ3ZWlyZGhlbnRhaS5jb20iLCJ3ZWxvdmVjb2NrLmNvbSIsIndlbG92ZXR1YmUuY29tIiwid2VuLnJ1Iiwid2VuZ2V3YW5nLm9yZyIsIndlbmh1aS5jaCIsIndlbnh1ZWNpdHkuY29tIiwid2VueHVld3UuY29tIiwid2VueXVuY2hhby5jb20iLCJ3ZXJpY2guaWR2LnR3Iiwid2Vyay5ubCIsIndlcm1hY2h0d2FzLmluZm8iLCJ3ZXN0Y2EuY29tIiwid2VzdGpldC5jb20iLCJ3ZXN0a2l0Lm5ldCIsIndldDEyMy5jb20iLCJ3ZXRtaWtlLmNvbSIsIndldHBsYWNlLmNvbSIsIndld2VoaS5pbmZvIiwid2Ztei5jb20iLCJ3Zm9ydW0uY29tIiwid2dhbC5jb20iLCJ3aGFha3kuY28uaW4iLCJ3aGF0YmxvY2tlZC5jb20iLCJ3aGF0aGlmaS5jb20iLCJ3aGF0aXNteWlwYWRkcmVzcy5jb20iLCJ3aGF0c3RoZXNjb3JlLmNvbSIsIndoZW5kaWR5b3Vqb2ludHdpdHRlci5jb20iLCJ3aGlwcGVkYXNzLmNvbSIsIndoaXRlYmxhY2tzZXgubmV0Iiwid2hvaXMuY29tIiwid2hvbGUzMC5jb20iLCJ3aG9vd25zZmFjZWJvb2suY29tIiwid2hvcmVzb2ZpbnN0YWdyYW0uY29tIiwid2hvcmVzdHViZS5jb20iLCJ3aG90YWxraW5nLmNvbSIsIndoeWRvbnR5b3V0cnl0aGlzLmNvbSIsIndoeWV3YW5nLmNvbSIsIndoeXByb3h5LmNvbSIsIndpZWZ5LmNvbSIsIndpZmUteHh4LmNvbSIsIndpZmVtb3ZpZXMubmV0Iiwid2lmaS5nb3YuaGsiLCJ3aWtpYS5jb20iLCJ3aWtpYXJ0Lm9yZyIsIndpa2lib29rcy5vcmciLCJ3aWtpbGVha3Mub3JnIiwid2lraW1lZGlhLm9yZyIsIndpa2ltZWRpYS5vcmcubW8iLCJ3aWtpbmV3cy5vcmciLCJ3aWtpcGVkaWEub3JnIiwid2lraXNvdXJjZS5vcmciLCJ3aWtzYS5jb20iLCJ3aWxkYW1tby5jb20iLCJ3aWxkZmFjZWJvb2suY29tIiwid2lsZGphcGFucG9ybi5jb20iLCJ3aWxkcHJveHkubmV0Iiwid2lsZHRvcnR1cmUuY29tIiwid2lsZHpvb3NleC5uZXQiLCJ3aWxsaWFtaGlsbC5jb20iLCJ3aW5kLmdyIiwid2luZG93cy1jb2RlYy5jb20iLCJ3aW5kb3dzbWVkaWEuY29tIiwid2lucG9ybi5jb20iLCJ3aXBvLmludCIsIndpc2RvbXB1YnMub3JnIiwid2lzZXZpZC5jb20iLCJ3aXRvcGlhLm5ldCIsIndpemFyZG9mb2Rkcy5jb20iLCJ3bXpvbmEuY29tIiwid28udGMiLCJ3b2FpY2FvY2FvLmNvbSIsIndvYWl3b2Fpc2UxLmluZm8iLCJ3b2ZhY2FpLmNvbSIsIndvbGZvZmxhZGJyb2tlcy5jb20iLCJ3b2xmcmFtYWxwaGEuY29tIiwid29tZW5vbmx5LmdyIiwid29tZW5zcmlnaHRzb2ZjaGluYS5vcmciLCJ3b21lbndlYi5kZSIsIndvb29sMTIzLmNvbSIsIndvb3BpZS5qcCIsIndvb3Zwbi5jb20iLCJ3b3JkcHJlc3MuY29tIiwid29yZHByZXNzLm9yZyIsIndvcmRyZWZlcmVuY2UuY29tIiwid29ya2lucmV0YWlsLmNvbSIsIndvcmxkY2F0Lm9yZyIsIndvcmxkam91cm5hbC5jb20iLCJ3b3JsZG1hcmtldC5jb20iLCJ3b3JsZG5ld3MwMS5jb20iLCJ3b3JsZHNleDguY29tIiwid29ybGR2cG4ubmV0Iiwid29ydGgxMDAwLmNvbSIsIndvc2ViYXIuY29tIiwid293LWNsZWFyLnJ1Iiwid295YW8uY2wiLCJ3cGl0YWx5Lml0Iiwid3Bsci5jb20iLCJ3cG5ldy5ydSIsIndwb2ZvcnVtLmNvbSIsIndxbGh3LmNvbSIsIndxeWQub3JnIiwid3JhZGlvLmNvbS5teCIsIndyYy5jb20iLCJ3cmVzdGxpbmdpbmMuY29tIiwid3JldGNoLmNjIiwid3JpdGVsb25nZXIuY29tIiwid3NqLWFzaWEuY29tIiwid3NqLmNvbSIsIndzd3Mub3JnIiwid3RmcGVvcGxlLmNvbSIsInd1YWl0eHQuY29tIiwid3VhbGEuY29tIiwid3VmaS5vcmcudHciLCJ3dWppZWxpdWxhbi5jb20iLCJ3dXl1ZXRhbi5jb20iLCJ3dXpob3VjbGljay5jb20iLCJ3d2UuY29tIiwid3dpdHYuY29tIiwid3d3LmFtNzMwLmNvbS5oayIsInd3dy5mcmVlcHJveHlzZXJ2ZXIudWsiLCJ3d3cuaG9tZWZ0cC5uZXQiLCJ3d3cuaW5mby52biIsInd3dy5uZXdjZW50dXJ5bmV3cy5jb20iLCJ3d3cucmhjbG91ZC5jb20iLCJ3eWJvcmN6YS5iaXoiLCJ3eWZmNC5jb20iLCJ3eW1mdy5vcmciLCJ3eXQ3NTAuY29tIiwieC1maWxlLmNvbS5hciIsIngtbnVkaXNtLm5ldCIsIngtdG9ycmVudHMub3JnIiwieDJkcy5jb20iLCJ4MzY1eC5jb20iLCJ4M3h0dWJlLmNvbSIsIng0eDYuY29tIiwieDc3MjAxNS5uZXQiLCJ4Nzc4MC5uZXQiLCJ4Nzc4Ni5jb20iLCJ4ODMxLmNvbSIsIng4Y2N0di5uZXQiLCJ4OHNlby5jb20iLCJ4YXJ0Z2lybHMuY29tIiwieGFydGh1bnRlci5jb20iLCJ4YXJ0bnVkZXMuY29tIiwieGF2MS5pbmZvIiwieGF2My5pbmZvIiwieGF4dHViZS5jb20iLCJ4YmFiZS5jb20iLCJ4Ym9va2NuLmNvbSIsInhib29rY24ubmV0IiwieGNoYW5nZS5jYyIsInhjaXR5LmpwIiwieGNveW90ZS5jb20iLCJ4Y3JpdGljLmNvbSIsInhjdHNnLm9yZyIsInhmY3VuLmNvbSIsInhmaWxlcy50byIsInhmbS5wcC5ydSIsInhmcmVlaG9zdGluZy5jb20iLCJ4ZnlzLmluZm8iLCJ4ZnlzbHUuY29tIiwieGhhbXN0ZXIuY29tIiwieGhhbXN0ZXIudmMiLCJ4aGFtc3RlcmNhbXMuY29tIiwieGhhbXN0ZXJocS5jb20iLCJ4aWFhdi5jYyIsInhpYW83Ny5jYyIsInhpYW83NzYuY29tIiwieGlhb2NodW5jbmpwLmNvbSIsInhpYW9kLmluIiwieGlhb2hleGllLmNvbSIsInhpYW9saS5jYyIsInhpYW9taS5pbi51YSIsInhpYW9zZWdlLmNvbSIsInhpZG9uZy5uZXQiLCJ4aWVzaHVsb3UuY29tIiwieGllemkudXMiLCJ4aWh1YS5lcyIsInhpbmcuY29tIiwieGluZ2Jhbm8xLmNvbSIsInhpbmdiYXlvdW5pLm5ldCIsInhpbm1pYW8uY29tLmhrIiwieGluc2hlbmcubmV0IiwieGlud2Vuc2hvdy5jb20iLCJ4aW54aTMzNjYuY29tIiwieGlueXViYnMubmV0IiwieGl0ZW5vdy5jb20iLCJ4amF2LnR2IiwieGppenouY29tIiwieGwucHQiLCJ4bW9kZWxwaWNzLmNvbSIsInhudmlldy5jb20iLCJ4bnh4LmJsb2cuYnIiLCJ4bnh4ZmFjZWJvb2suY29tIiwieG9jYXQuY29tIiwieHBvcm5raW5nLmNvbSIsInhxaWRpYW4uY29tIiwieHFpdW1pLmNvbSIsInhyYmEubmV0IiwieHJlYS5jb20iLCJ4cmVudGR2ZC5jb20iLCJ4cmVzdC5uZXQiLCJ4c2VqaWUuaW5mbyIsInhza3l3YWxrZXIuY29tIiwieHRlYy5jYXQiLCJ4dGhvc3QuaW5mbyIsInh0bGJiLmNvbSIsInh0cmFzaXplLnBsIiwieHR1YmUuY29tIiwieHU0Lm5ldCIsInh1aXRlLm5ldCIsInh1bmJsb2NrLmNvbSIsInh1eHVsZS5uZXQiLCJ4dmlkZW8uY2MiLCJ4dmlkZW9zLWZjMi5jb20iLCJ4dmlkZW9zLmNvbSIsInh2aWRlb3MuY29tLmJyIiwieHZpZGVvcy5jb20uYnoiLCJ4dmlkZW9zLmNvbS5lcyIsInh2aWRlb3MuY29tLm14IiwieHZpZGVvcy5lcyIsInh2aWRlb3MuanAiLCJ4dmlkZW9zZmMyLmNvbSIsInh2aWRlb3NxLmNvbSIsInh4MzMudXMiLCJ4eGJieC5jb20iLCJ4eGVyb25ldHh4LmluZm8iLCJ4eGt4dy5jb20iLCJ4eGxtb3ZpZXMuY29tIiwieHhvb3l5Lm9yZyIsInh4eC14aGFtc3Rlci5jb20iLCJ4eHgteW91dHViZS5jb20iLCJ4eHguY29tIiwieHh4LmNvbS5lcyIsInh4eC5jb20ubXgiLCJ4eHguY29tLnB5IiwieHh4Lnh4eCIsInh4eGRlc3NlcnQuY29tIiwieHh4aG9zdC5tZSIsInh4eHBhbmRhLmNvbSIsInh4eHN0YXNoLmNvbSIsInh4eHR2Lm1lIiwieHh4eC5jb20uYXUiLCJ4eHh4c2V4dHViZS5jb20iLCJ4eHh5bW92aWVzLmNvbSIsInh4eXMuY2MiLCJ4eHl5MTIzLmNvbSIsInh5cy5vcmciLCJ4eXo1NjYubmV0IiwieHpnb2QubmV0IiwieWFiZWIuY29tIiwieWFja2l0eS15YWsuY29tIiwieWFkMi5jby5pbCIsInlhaG9vLmNvLmpwIiwieWFob28uY29tIiwieWFob28uY29tLmhrIiwieWFob28uY29tLnR3IiwieWFob28uanAiLCJ5YWttb3ZpZXMuY29tIiwieWFsYWZhY2Vib29rLmNvbSIsInlhbmVuLm9yZyIsInlhcHJveHkuY29tIiwieWFzYWtsaS5uZXQiLCJ5YXNuaS5jby51ayIsInlhc25pLmNvbSIsInlhc3VrdW5pLm9yLmpwIiwieWF5YWJheS5uZXQiLCJ5YXlwZXRpdGV0ZWVucy5jb20iLCJ5ZHhrLmNuIiwieWR5LmNvbSIsInllaHVhLm9yZyIsInllbGxvd3Byb3h5Lm5ldCIsInllbmlkZW5lcmdlbmVrb24uY29tIiwieWVwdHViZS5jb20iLCJ5ZXNiYW5rLmNvLmluIiwieWVzd2FyZS5jb20iLCJ5ZXllbHUuY29tIiwieWkub3JnIiwieWliYWRhLmNvbSIsInlpYmlhbi5pZHYudHciLCJ5aWRpby5jb20iLCJ5aWZ5LXRvcnJlbnQub3JnIiwieWlnZXNlLnVzIiwieWlreWFrYXBwLmNvbSIsInlpbGRpei5lZHUudHIiLCJ5aW1nLmNvbSIsInlpbmdjaGFvOC5jb20iLCJ5aW5sYW9oYW4udXMiLCJ5aW5yZW5zZS5jb20iLCJ5aXB1Yi5jb20iLCJ5aXd1Z291LmNvbSIsInlpeGluZ2ppYS5pbmZvIiwieWl5aS5jYyIsInlsZS5maSIsInltYWlsLmNvbSIsInlta2EudHYiLCJ5bW9iaWxlLmpwIiwieW8xNjgubmV0IiwieW9idC5jb20iLCJ5b2J0LnR2IiwieW9nZXNoYmxvZ3Nwb3QuY29tIiwieW9naWNoZW4ub3JnIiwieW9qaXp6LmNvbSIsInlvbHRhcmlmaS5jb20iLCJ5b25raXMuY29tIiwieW9ya2Jicy5jYSIsInlvdS15b3V0dWJlLmNvbSIsInlvdWNhbmhpZGUubmV0IiwieW91Y2VmODUuY29tIiwieW91ZGVzaXIuY29tIiwieW91ZGlhbi5pbiIsInlvdWZjay5jb20iLCJ5b3VqaWthbjEuY29tIiwieW91aml6ei5jb20iLCJ5b3VqaXp6Lm5ldCIsInlvdWpvb21sYS5pbmZvIiwieW91am90dWJlLmNvbSIsInlvdWxpa2V0ZWVucy5jb20iLCJ5b3VtYWtlci5jb20iLCJ5b3VtcDMubW9iaSIsInlvdW5nYXRoZWFydG1vbW15LmNvbSIsInlvdW5nZmF0dGllcy5jb20iLCJ5b3VuZ2dpcmxzLXNleC5jb20iLCJ5b3VuZ2xlYWZzLmNvbSIsInlvdW5ncG9ybnR1YmUuY29tIiwieW91bmd0ZWVuc2V4aGQuY29tIiwieW91bml2ZXJzYWxtZWRpYS5jb20iLCJ5b3VwYWkub3JnIiwieW91cG9ybi5jb20iLCJ5b3Vwb3JuLmNvbS5ieiIsInlvdXByb3h5Lm9yZyIsInlvdXByb3h5dHViZS5jb20iLCJ5b3VyLWZyZWVkb20ubmV0IiwieW91cmx1c3QuY29tIiwieW91cm1lZGlhaHEuY29tIiwieW91cnR2LmNvbS5hdSIsInlvdXNlbmRpdC5jb20iLCJ5b3V0aG5ldHJhZGlvLm9yZyIsInlvdXRod2FudC5jb20udHciLCJ5b3V0cmFubnl0dWJlLmNvbSIsInlvdXR1LmJlIiwieW91dHViZS1tcDMuY29tIiwieW91dHViZS1ub2Nvb2tpZS5jb20iLCJ5b3V0dWJlLXVubG9jay5jb20iLCJ5b3V0dWJlLmJlIiwieW91dHViZS5jb20iLCJ5b3V0dWJlLmNvbS5iciIsInlvdXR1YmUuY29tLmNvIiwieW91dHViZWNuLmNvbSIsInlvdXR1YmVmcmVlcHJveHkuY29tIiwieW91dHViZXByb3h5LmNvIiwieW91dHViZXByb3h5Lm9yZyIsInlvdXR1YmVwcm94eS5wayIsInlvdXR1YmV1bmJsb2NrZWQub3JnIiwieW91dHViZXVuYmxvY2tlci5vcmciLCJ5b3V0dWJleHlvdXR1YmUuY29tIiwieW91dmVyc2lvbi5jb20iLCJ5b3V3YXRjaC5vcmciLCJ5b3V4dS5pbmZvIiwieXBtYXRlLmNvbSIsInlzbGFuZy5jb20iLCJ5dGltZy5jb20iLCJ5dGouZmkiLCJ5dHMucmUiLCJ5dWthLmlkdi50dyIsInl1a2lueWFuLmluZm8iLCJ5dW5ibG9jay5jb20iLCJ5dW5ibG9ja2VyLmluZm8iLCJ5dXRhb2tlamkuY29tIiwieXV2dXR1LmNvbSIsInl2ZXNyb2NoZXJ1c2EuY29tIiwieXZ2LmNuIiwieXd0eC5jYyIsInl4NTEubmV0IiwieXloYWNrZXIuY29tIiwieXlpaS5vcmciLCJ5eXBlbmcuY29tIiwieXlzZWR5LmNvbSIsInl6emsuY29tIiwiejk1My5jYSIsInphY2Vib29rLmNvbSIsInphY2Vib29rcGsuY29tIiwiemFsbW9zLmNvbSIsInphbG1vcy5wayIsInphb2Jhby5jb20iLCJ6YW9iYW8uY29tLnNnIiwiemFvem9uLmNvbSIsInphcC5jby5pbCIsInphcGp1ZWdvcy5jb20iLCJ6YXVydXMub3JnLnVrIiwiemJpb3JuaWsuY29tIiwiemRuZXQuY29tLnR3IiwiemRuZXQuZGUiLCJ6ZWxkYXdpa2kub3JnIiwiemVsa2Eub3JnIiwiemVsbG8uY29tIiwiemVuLWNhcnQuY29tIiwiemVuZDIuY29tIiwiemVuZHByb3h5LmNvbSIsInplbmdqaW55YW4ub3JnIiwiemVuaXRob3B0aW9ucy5jb20iLCJ6ZXJvdHVubmVsLmNvbSIsInplcnR1YmUuY29tIiwiemVyeC50diIsInpldGFnYWxsZXJpZXMuY29tIiwiemYucm8iLCJ6ZnJlZXQuY29tIiwiemZyZWV6LmNvbSIsInpoYW5iaW4ubmV0Iiwiemhhb2thaWZhbmcuY29tIiwiemhhb3luLmNvbSIsInpoZS5sYSIsInpoZW5namlhbi5vcmciLCJ6aGVuZ3d1bmV0Lm9yZyIsInpoZW5qay5jb20iLCJ6aGlibzguY2MiLCJ6aGluZW5nbHV5b3UuY29tIiwiemhvbmcucHAucnUiLCJ6aG9uZ2d1b2Jhby5uZXQiLCJ6aHVpY2hhZ3Vvamkub3JnIiwiemh1bGl1NTIwLmNvbSIsInppZGR1LmNvbSIsInppZGVvLm5sIiwiemluaW8uY29tIiwiemlwYWk5OS5uZXQiLCJ6aXBhbmdjYXNpbm8uY29tIiwiemlwb3JuLmNvbSIsInppcHR0LmNvbSIsInppeXVhbjUuY29tIiwiempiZHQuY29tIiwiemp5cHcuY29tIiwiemthaXAuY29tIiwiemxsLmluIiwiemx2Yy5uZXQiLCJ6bi51YSIsInpvaG8uY29tIiwiem9sbC1hdWt0aW9uLmRlIiwiem9tYmllZ2EuZ2EiLCJ6b25hZXVyb3BhLmNvbSIsInpvbmJsZS5uZXQiLCJ6b28tZnVjay5uZXQiLCJ6b29tYnkucnUiLCJ6b29zaG9jay5jb20iLCJ6b290b2RheS5jb20iLCJ6b290b29sLmNvbSIsInpvb3psZS5uZXQiLCJ6b3JwaWEuY29tIiwienF6ai5uZXQiLCJ6czgwODAuY29tIiwienNoYXJlLm5ldCIsInpzcmhhby5jb20iLCJ6dHVubmVsLmNvbSIsInp1YXJ5LmNvbSIsInp1by5sYSIsInp1b2xhLmNvbSIsInp1eW91dHViZS5jb20iLCJ6dXphenUuY29tIiwienZlbnRzLmNvbSIsInp3aW5reS5jb20iLCJ6eGMyMi5pZHYudHciLCJ6ejgwODAuY29tIiwienpiLmJ6Il0="
 var defaultProxiedSites []string
 
+var base64ProxiedSitesByCountry = "e30="
+var defaultProxiedSitesByCountry map[string][]string
+
+var base64ProxiedSiteExclusionsByCountry = "eyJJUiI6WyIyZ2hlcm9vbi5pciIsIjkwdHYuaXIiLCJhbW1hcm5hbWUuaXIiLCJhbmFqLmlyIiwiYXFyLmlyIiwiYXNob29yYS5pciIsImJhemFyZWthci5pciIsImNoYXAuc2NoLmlyIiwiZWxpZmUuaXIiLCJlbWFsbHMuaXIiLCJpY3RzZWN1cml0eS5pciIsImlsaXJlZy5pciIsImlyaWJuZXdzLmlyIiwiaXNmcG51LmFjLmlyIiwiaXNwb3J0LmlyIiwiaXVzdC5hYy5pciIsImthbm9vbi5pciIsIm1lZHUuaXIiLCJtb2RhcmVzLmFjLmlyIiwibmFzaW1vbmxpbmUuaXIiLCJwYXlsaW5lLmlyIiwicGVyc2lhbmJsb2cuaXIiLCJzYW5nZXNhYm9yLmlyIiwic25uLmlyIiwic3NhYS5pciIsInRlamFyYXRhc2FuLmlyIl19"
+var defaultProxiedSiteExclusionsByCountry map[string][]string
+
 func init() {
 	raw, err := base64.StdEncoding.DecodeString(base64ProxiedSites)
 	if err != nil {
@@ -17,4 +23,20 @@ func init() {
 	if err != nil {
 		panic("Failed to unmarshal proxied sites")
 	}
+	raw, err = base64.StdEncoding.DecodeString(base64ProxiedSitesByCountry)
+	if err != nil {
+		panic("Failed to decode country-specific proxied sites")
+	}
+	err = json.Unmarshal(raw, &defaultProxiedSitesByCountry)
+	if err != nil {
+		panic("Failed to unmarshal country-specific proxied sites")
+	}
+	raw, err = base64.StdEncoding.DecodeString(base64ProxiedSiteExclusionsByCountry)
+	if err != nil {
+		panic("Failed to decode country-specific proxied site exclusions")
+	}
+	err = json.Unmarshal(raw, &defaultProxiedSiteExclusionsByCountry)
+	if err != nil {
+		panic("Failed to unmarshal country-specific proxied site exclusions")
+	}
 }
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getlantern/proxiedsites"
+)
+
+// ProxiedSitesConfig configures which sites are proxied. It's like
+// proxiedsites.Config, but in addition to the global list of sites the cloud
+// provides lists for particular countries, so that for example domestic sites
+// aren't proxied in the country where they're hosted.
+type ProxiedSitesConfig struct {
+	// Delta is the user's changes to the cloud lists.
+	Delta *proxiedsites.Delta
+	// Schedules limit when some of the sites that the user added are
+	// proxied, keyed by site.
+	Schedules map[string]*SiteSchedule
+	// Cloud is the list of sites that are proxied everywhere.
+	Cloud []string
+	// CloudByCountry are the lists of sites that are proxied in addition to
+	// Cloud in particular countries, keyed by ISO country code.
+	CloudByCountry map[string][]string
+	// CloudExclusionsByCountry are the sites in Cloud that aren't proxied in
+	// particular countries, keyed by ISO country code.
+	CloudExclusionsByCountry map[string][]string
+	// Subscriptions are lists of sites that the user subscribed to, which are
+	// treated like additional cloud lists.
+	Subscriptions []*ProxiedSitesSubscription
+}
+
+// ProxiedSitesSubscription is a list of sites that's published at a URL and
+// refreshed periodically.
+type ProxiedSitesSubscription struct {
+	// URL is where the list is published.
+	URL string
+	// FrontedURL, if set, is a domain-fronted URL of the list that's fetched
+	// in parallel to URL.
+	FrontedURL string
+	// Format is the format of the list, blank to detect it.
+	Format string
+	// Delta holds the sites that the list proxies as additions and the ones
+	// that it exempts from them as deletions, as of the last successful fetch.
+	Delta *proxiedsites.Delta
+	// LastFetched is when the list was last fetched successfully, in seconds
+	// since the epoch.
+	LastFetched int64
+}
+
+// Subscription returns the subscription with the given URL, or nil if there
+// isn't one.
+func (c *ProxiedSitesConfig) Subscription(url string) *ProxiedSitesSubscription {
+	for _, sub := range c.Subscriptions {
+		if sub.URL == url {
+			return sub
+		}
+	}
+	return nil
+}
+
+// ForCountry returns the proxiedsites.Config that applies in the given
+// country, whose cloud list is the global list without the country's
+// exclusions, plus the country's list and the subscribed lists. If the country
+// isn't known, only the global list and the subscribed lists apply. A
+// subscribed list's exemptions only apply to the list itself, so that a list
+// can't unproxy the sites of the cloud lists or of other lists.
+func (c *ProxiedSitesConfig) ForCountry(country string) *proxiedsites.Config {
+	cfg := &proxiedsites.Config{
+		Delta: c.Delta,
+		Cloud: c.Cloud,
+	}
+	var countrySites []string
+	if country != "" {
+		country = strings.ToUpper(country)
+		countrySites = c.CloudByCountry[country]
+		if excluded := c.CloudExclusionsByCountry[country]; len(excluded) > 0 {
+			cfg.Cloud = withoutSites(c.Cloud, excluded)
+		}
+	}
+	var subscribed []string
+	for _, sub := range c.Subscriptions {
+		if sub.Delta != nil {
+			subscribed = append(subscribed, withoutSites(sub.Delta.Additions, sub.Delta.Deletions)...)
+		}
+	}
+	if len(countrySites) == 0 && len(subscribed) == 0 {
+		return cfg
+	}
+
+	sites := make([]string, 0, len(cfg.Cloud)+len(countrySites)+len(subscribed))
+	sites = append(sites, cfg.Cloud...)
+	sites = append(sites, countrySites...)
+	sites = append(sites, subscribed...)
+	cfg.Cloud = dedupSites(sites)
+	return cfg
+}
+
+// withoutSites returns a copy of sites without the ones in excluded.
+func withoutSites(sites []string, excluded []string) []string {
+	exclude := make(map[string]bool, len(excluded))
+	for _, site := range excluded {
+		exclude[site] = true
+	}
+	kept := make([]string, 0, len(sites))
+	for _, site := range sites {
+		if !exclude[site] {
+			kept = append(kept, site)
+		}
+	}
+	return kept
+}
+
+// normalize upper-cases the country codes and removes duplicate sites.
+func (c *ProxiedSitesConfig) normalize() {
+	if len(c.Cloud) > 0 {
+		c.Cloud = dedupSites(c.Cloud)
+	}
+	c.CloudByCountry = normalizeByCountry(c.CloudByCountry)
+	c.CloudExclusionsByCountry = normalizeByCountry(c.CloudExclusionsByCountry)
+}
+
+func normalizeByCountry(sitesByCountry map[string][]string) map[string][]string {
+	if len(sitesByCountry) == 0 {
+		return sitesByCountry
+	}
+	byCountry := make(map[string][]string, len(sitesByCountry))
+	for country, sites := range sitesByCountry {
+		country = strings.ToUpper(country)
+		byCountry[country] = dedupSites(append(byCountry[country], sites...))
+	}
+	return byCountry
+}
+
+// dedupSites returns the given sites sorted and without duplicates.
+func dedupSites(sites []string) []string {
+	unique := make(map[string]bool, len(sites))
+	for _, site := range sites {
+		unique[site] = true
+	}
+	deduped := make([]string, 0, len(unique))
+	for site := range unique {
+		deduped = append(deduped, site)
+	}
+	sort.Strings(deduped)
+	return deduped
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/getlantern/proxiedsites"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+func TestProxiedSitesForCountry(t *testing.T) {
+	delta := &proxiedsites.Delta{Additions: []string{"user.com"}}
+	cfg := &ProxiedSitesConfig{
+		Delta: delta,
+		Cloud: []string{"b.com", "a.com"},
+		CloudByCountry: map[string][]string{
+			"CN": []string{"c.cn", "a.com"},
+		},
+		CloudExclusionsByCountry: map[string][]string{
+			"IR": []string{"b.com"},
+		},
+	}
+
+	cn := cfg.ForCountry("cn")
+	assert.Equal(t, []string{"a.com", "b.com", "c.cn"}, cn.Cloud, "Should include country's sites")
+	assert.Equal(t, delta, cn.Delta)
+	assert.Equal(t, []string{"b.com", "a.com"}, cfg.Cloud, "Global list should be untouched")
+
+	assert.Equal(t, []string{"a.com"}, cfg.ForCountry("ir").Cloud, "Should exclude country's exclusions")
+	assert.Equal(t, []string{"b.com", "a.com"}, cfg.Cloud, "Global list should be untouched")
+	assert.Equal(t, []string{"b.com", "a.com"}, cfg.ForCountry("DE").Cloud, "Country without lists should get global list")
+	assert.Equal(t, []string{"b.com", "a.com"}, cfg.ForCountry("").Cloud, "Unknown country should get global list")
+}
+
+func TestProxiedSitesSubscriptions(t *testing.T) {
+	cfg := &ProxiedSitesConfig{
+		Cloud: []string{"a.com", "b.com"},
+		Subscriptions: []*ProxiedSitesSubscription{
+			&ProxiedSitesSubscription{
+				URL:   "http://lists.example.com/hosts",
+				Delta: &proxiedsites.Delta{Additions: []string{"c.com", "d.com"}, Deletions: []string{"b.com", "d.com", "e.com"}},
+			},
+			&ProxiedSitesSubscription{
+				URL:   "http://lists.example.com/other",
+				Delta: &proxiedsites.Delta{Additions: []string{"e.com"}},
+			},
+		},
+	}
+	assert.Equal(t, []string{"a.com", "b.com", "c.com", "e.com"}, cfg.ForCountry("").Cloud, "Exemptions should only apply to the list's own sites")
+	assert.Equal(t, []string{"a.com", "b.com"}, cfg.Cloud, "Global list should be untouched")
+	assert.Equal(t, cfg.Subscriptions[0], cfg.Subscription("http://lists.example.com/hosts"))
+	assert.Nil(t, cfg.Subscription("http://lists.example.com/missing"))
+}
+
+func TestUpdateFromProxiedSitesByCountry(t *testing.T) {
+	cfg := &Config{Client: &client.ClientConfig{}}
+	cfg.ApplyDefaults()
+	cfg.ProxiedSites.CloudByCountry = map[string][]string{"IR": []string{"old.com"}}
+	cfg.ProxiedSites.CloudExclusionsByCountry = map[string][]string{"CN": []string{"old.com"}}
+
+	err := cfg.updateFrom([]byte(`
+proxiedsites:
+  cloud:
+  - b.com
+  - a.com
+  - b.com
+  cloudbycountry:
+    cn:
+    - c.cn
+    - c.cn
+  cloudexclusionsbycountry:
+    ir:
+    - b.com
+`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"a.com", "b.com"}, cfg.ProxiedSites.Cloud)
+	assert.Equal(t, map[string][]string{"CN": []string{"c.cn"}}, cfg.ProxiedSites.CloudByCountry, "Country lists should be replaced and normalized")
+	assert.Equal(t, map[string][]string{"IR": []string{"b.com"}}, cfg.ProxiedSites.CloudExclusionsByCountry, "Country exclusions should be replaced and normalized")
+}
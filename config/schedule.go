@@ -0,0 +1,169 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getlantern/proxiedsites"
+)
+
+// SiteSchedule limits when a site that the user added is proxied.
+type SiteSchedule struct {
+	// Expires is when the site stops being proxied for good, in seconds since
+	// the epoch, 0 for never.
+	Expires int64
+	// Windows are the times during which the site is proxied. If there are
+	// none, the site is proxied all the time until it expires.
+	Windows []*TimeWindow
+}
+
+// TimeWindow is a daily period of time in the local time zone.
+type TimeWindow struct {
+	// Start and End are times of day like "09:00" and "17:30". A window whose
+	// End isn't after its Start spans midnight.
+	Start string
+	End   string
+	// Days are the days of the week on which the window starts, like "Mon",
+	// or every day if there are none.
+	Days []string
+}
+
+// Validate checks that the schedule can be applied.
+func (s *SiteSchedule) Validate() error {
+	for _, w := range s.Windows {
+		if _, err := parseTimeOfDay(w.Start); err != nil {
+			return err
+		}
+		if _, err := parseTimeOfDay(w.End); err != nil {
+			return err
+		}
+		for _, day := range w.Days {
+			if _, ok := parseWeekday(day); !ok {
+				return fmt.Errorf("Invalid day of the week %q", day)
+			}
+		}
+	}
+	return nil
+}
+
+// Expired checks whether the site has expired at the given time.
+func (s *SiteSchedule) Expired(now time.Time) bool {
+	return s.Expires != 0 && now.Unix() >= s.Expires
+}
+
+// Active checks whether the site is to be proxied at the given time.
+func (s *SiteSchedule) Active(now time.Time) bool {
+	if s.Expired(now) {
+		return false
+	}
+	if len(s.Windows) == 0 {
+		return true
+	}
+	for _, w := range s.Windows {
+		if w.contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *TimeWindow) contains(now time.Time) bool {
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return false
+	}
+	t := now.Hour()*60 + now.Minute()
+	if start < end {
+		return start <= t && t < end && w.onDay(now.Weekday())
+	}
+	// Spans midnight, so the window may have started yesterday
+	yesterday := (now.Weekday() + 6) % 7
+	return (t >= start && w.onDay(now.Weekday())) || (t < end && w.onDay(yesterday))
+}
+
+func (w *TimeWindow) onDay(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if parsed, ok := parseWeekday(d); ok && parsed == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeOfDay parses a time like "17:30" into minutes since midnight.
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid time of day %q, expected something like 17:30", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := day.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// ScheduledDelta returns the user's Delta without the added sites whose
+// schedules aren't active at the given time.
+func (c *ProxiedSitesConfig) ScheduledDelta(now time.Time) *proxiedsites.Delta {
+	inactive := c.InactiveSites(now)
+	if c.Delta == nil || len(inactive) == 0 {
+		return c.Delta
+	}
+	skip := make(map[string]bool, len(inactive))
+	for _, site := range inactive {
+		skip[site] = true
+	}
+	delta := &proxiedsites.Delta{
+		Additions: make([]string, 0, len(c.Delta.Additions)),
+		Deletions: c.Delta.Deletions,
+	}
+	for _, site := range c.Delta.Additions {
+		if !skip[site] {
+			delta.Additions = append(delta.Additions, site)
+		}
+	}
+	return delta
+}
+
+// InactiveSites returns the sorted added sites whose schedules aren't active
+// at the given time. Sites with a null schedule, as in a hand-edited config,
+// are always active.
+func (c *ProxiedSitesConfig) InactiveSites(now time.Time) []string {
+	var inactive []string
+	for site, schedule := range c.Schedules {
+		if schedule != nil && !schedule.Active(now) {
+			inactive = append(inactive, site)
+		}
+	}
+	sort.Strings(inactive)
+	return inactive
+}
+
+// ExpiredSites returns the sorted added sites that have expired at the given
+// time.
+func (c *ProxiedSitesConfig) ExpiredSites(now time.Time) []string {
+	var expired []string
+	for site, schedule := range c.Schedules {
+		if schedule != nil && schedule.Expired(now) {
+			expired = append(expired, site)
+		}
+	}
+	sort.Strings(expired)
+	return expired
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getlantern/proxiedsites"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiteSchedule(t *testing.T) {
+	// Friday
+	friday := func(hour, min int) time.Time {
+		return time.Date(2015, 10, 16, hour, min, 0, 0, time.Local)
+	}
+
+	office := &SiteSchedule{Windows: []*TimeWindow{
+		&TimeWindow{Start: "09:00", End: "17:30", Days: []string{"mon", "Friday"}},
+	}}
+	assert.NoError(t, office.Validate())
+	assert.True(t, office.Active(friday(9, 0)))
+	assert.True(t, office.Active(friday(17, 29)))
+	assert.False(t, office.Active(friday(17, 30)))
+	assert.False(t, office.Active(friday(8, 59)))
+	assert.False(t, office.Active(friday(10, 0).AddDate(0, 0, 1)), "Should not be active on Saturday")
+
+	night := &SiteSchedule{Windows: []*TimeWindow{
+		&TimeWindow{Start: "22:00", End: "02:00", Days: []string{"Fri"}},
+	}}
+	assert.True(t, night.Active(friday(23, 0)))
+	assert.True(t, night.Active(friday(1, 0).AddDate(0, 0, 1)), "Window starting Friday should span into Saturday")
+	assert.False(t, night.Active(friday(1, 0)), "Window starting Thursday isn't scheduled")
+	assert.False(t, night.Active(friday(12, 0)))
+
+	expiring := &SiteSchedule{Expires: friday(12, 0).Unix()}
+	assert.True(t, expiring.Active(friday(11, 59)))
+	assert.False(t, expiring.Active(friday(12, 0)))
+	assert.True(t, expiring.Expired(friday(12, 0)))
+	assert.False(t, (&SiteSchedule{}).Expired(friday(12, 0)), "Should never expire without expiry")
+
+	assert.Error(t, (&SiteSchedule{Windows: []*TimeWindow{&TimeWindow{Start: "9am", End: "17:00"}}}).Validate())
+	assert.Error(t, (&SiteSchedule{Windows: []*TimeWindow{&TimeWindow{Start: "09:00", End: "17:00", Days: []string{"Someday"}}}}).Validate())
+}
+
+func TestScheduledDelta(t *testing.T) {
+	now := time.Date(2015, 10, 16, 12, 0, 0, 0, time.Local)
+	cfg := &ProxiedSitesConfig{
+		Delta: &proxiedsites.Delta{
+			Additions: []string{"a.com", "b.com", "c.com", "d.com"},
+			Deletions: []string{"e.com"},
+		},
+		Schedules: map[string]*SiteSchedule{
+			"b.com": &SiteSchedule{Expires: now.Add(-1 * time.Minute).Unix()},
+			"c.com": &SiteSchedule{Windows: []*TimeWindow{&TimeWindow{Start: "13:00", End: "14:00"}}},
+			"d.com": &SiteSchedule{Expires: now.Add(time.Hour).Unix()},
+			// Like "a.com: null" in a hand-edited config
+			"a.com": nil,
+		},
+	}
+	delta := cfg.ScheduledDelta(now)
+	assert.Equal(t, []string{"a.com", "d.com"}, delta.Additions)
+	assert.Equal(t, []string{"e.com"}, delta.Deletions)
+	assert.Equal(t, []string{"a.com", "b.com", "c.com", "d.com"}, cfg.Delta.Additions, "User's delta should be untouched")
+	assert.Equal(t, []string{"b.com", "c.com"}, cfg.InactiveSites(now))
+	assert.Equal(t, []string{"b.com"}, cfg.ExpiredSites(now))
+
+	assert.Equal(t, []string{"a.com", "c.com"}, cfg.ScheduledDelta(now.Add(90*time.Minute)).Additions, "Should apply windows and expiry")
+}
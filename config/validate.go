@@ -0,0 +1,183 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/getlantern/keyman"
+	"github.com/getlantern/yaml"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+// ValidationError describes a single problem with a Config, identified by the
+// YAML path at which it occurs (e.g. client.chainedservers.fallback-1.cert).
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Path, e.Message)
+}
+
+// ValidationErrors is the list of all problems found while validating a
+// Config.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("Invalid configuration: %v", strings.Join(msgs, "; "))
+}
+
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) addf(path string, msg string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{path, fmt.Sprintf(msg, args...)})
+}
+
+// Validate checks this Config for problems like unparseable certificates,
+// references to nonexistent masquerade sets and invalid ports. It returns
+// ValidationErrors listing all problems found, or nil if there were none.
+func (cfg *Config) Validate() error {
+	v := &validator{}
+	v.validateURL("cloudconfig", cfg.CloudConfig)
+	v.validateURL("updateserverurl", cfg.UpdateServerURL)
+	if cfg.CloudConfigCA != "" {
+		v.validateCert("cloudconfigca", cfg.CloudConfigCA)
+	}
+	if cfg.Client != nil {
+		v.validateClient("client", cfg.Client)
+	}
+	for i, ca := range cfg.TrustedCAs {
+		path := fmt.Sprintf("trustedcas[%d]", i)
+		if ca == nil {
+			v.addf(path, "empty trusted CA")
+			continue
+		}
+		v.validateCert(path+".cert", ca.Cert)
+	}
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validator) validateClient(path string, cfg *client.ClientConfig) {
+	for i, port := range cfg.ProxiedCONNECTPorts {
+		v.validatePort(fmt.Sprintf("%v.proxiedconnectports[%d]", path, i), port)
+	}
+
+	for name, s := range cfg.ChainedServers {
+		spath := fmt.Sprintf("%v.chainedservers.%v", path, name)
+		if s == nil {
+			v.addf(spath, "empty chained server")
+			continue
+		}
+		v.validateAddr(spath+".addr", s.Addr)
+		if s.Cert != "" {
+			v.validateCert(spath+".cert", s.Cert)
+		}
+		if s.QOS < 0 {
+			v.addf(spath+".qos", "must not be negative, got %d", s.QOS)
+		}
+		if s.Weight < 0 {
+			v.addf(spath+".weight", "must not be negative, got %d", s.Weight)
+		}
+	}
+
+	for i, s := range cfg.FrontedServers {
+		spath := fmt.Sprintf("%v.frontedservers[%d]", path, i)
+		if s == nil {
+			v.addf(spath, "empty fronted server")
+			continue
+		}
+		if s.Host == "" {
+			v.addf(spath+".host", "missing host")
+		}
+		v.validatePort(spath+".port", s.Port)
+		if _, found := cfg.MasqueradeSets[s.MasqueradeSet]; !found {
+			v.addf(spath+".masqueradeset", "unknown masquerade set %q", s.MasqueradeSet)
+		}
+	}
+
+	for name, masquerades := range cfg.MasqueradeSets {
+		for i, m := range masquerades {
+			mpath := fmt.Sprintf("%v.masqueradesets.%v[%d]", path, name, i)
+			if m == nil {
+				v.addf(mpath, "empty masquerade")
+				continue
+			}
+			if m.Domain == "" {
+				v.addf(mpath+".domain", "missing domain")
+			}
+			if net.ParseIP(m.IpAddress) == nil {
+				v.addf(mpath+".ipaddress", "invalid IP address %q", m.IpAddress)
+			}
+		}
+	}
+}
+
+func (v *validator) validateURL(path string, u string) {
+	if u == "" {
+		return
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		v.addf(path, "invalid URL %q: %v", u, err)
+	} else if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		v.addf(path, "URL %q must use http or https", u)
+	}
+}
+
+func (v *validator) validateCert(path string, cert string) {
+	if _, err := keyman.LoadCertificateFromPEMBytes([]byte(cert)); err != nil {
+		v.addf(path, "invalid PEM encoded certificate: %v", err)
+	}
+}
+
+func (v *validator) validateAddr(path string, addr string) {
+	host, portString, err := net.SplitHostPort(addr)
+	if err != nil {
+		v.addf(path, "invalid host:port %q: %v", addr, err)
+		return
+	}
+	if host == "" {
+		v.addf(path, "missing host in %q", addr)
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil {
+		v.addf(path, "invalid port in %q", addr)
+		return
+	}
+	v.validatePort(path, port)
+}
+
+func (v *validator) validatePort(path string, port int) {
+	if port <= 0 || port > 65535 {
+		v.addf(path, "port must be between 1 and 65535, got %d", port)
+	}
+}
+
+// ValidateFile reads the YAML config (either a local lantern-*.yaml or a cloud
+// config) at the given path and validates it.
+func ValidateFile(path string) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Unable to read config file at %v: %v", path, err)
+	}
+	cfg := &Config{}
+	if err := yaml.Unmarshal(bytes, cfg); err != nil {
+		return fmt.Errorf("Unable to parse config file at %v: %v", path, err)
+	}
+	return cfg.Validate()
+}
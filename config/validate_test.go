@@ -0,0 +1,109 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/getlantern/fronted"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+func TestValidateDefaults(t *testing.T) {
+	cfg := &Config{Client: &client.ClientConfig{}}
+	cfg.ApplyDefaults()
+	assert.NoError(t, cfg.Validate(), "Default config should be valid")
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	cfg := &Config{
+		CloudConfig: "ftp://config.getiantem.org/cloud.yaml.gz",
+		Client: &client.ClientConfig{
+			ProxiedCONNECTPorts: []int{443, 0},
+			ChainedServers: map[string]*client.ChainedServerInfo{
+				"bad": &client.ChainedServerInfo{
+					Addr: "1.2.3.4:0",
+					Cert: "not a cert",
+				},
+			},
+			FrontedServers: []*client.FrontedServerInfo{
+				&client.FrontedServerInfo{
+					Host:          "getiantem.org",
+					Port:          443,
+					MasqueradeSet: "cloudflare",
+				},
+			},
+			MasqueradeSets: map[string][]*fronted.Masquerade{
+				cloudfront: []*fronted.Masquerade{
+					&fronted.Masquerade{Domain: "example.com", IpAddress: "not an ip"},
+				},
+			},
+		},
+		TrustedCAs: []*CA{&CA{CommonName: "Bad", Cert: "garbage"}},
+	}
+
+	err := cfg.Validate()
+	if !assert.Error(t, err, "Config should be invalid") {
+		return
+	}
+	errs := err.(ValidationErrors)
+	paths := make(map[string]bool)
+	for _, e := range errs {
+		paths[e.Path] = true
+	}
+	expected := []string{
+		"cloudconfig",
+		"client.proxiedconnectports[1]",
+		"client.chainedservers.bad.addr",
+		"client.chainedservers.bad.cert",
+		"client.frontedservers[0].masqueradeset",
+		"client.masqueradesets.cloudfront[0].ipaddress",
+		"trustedcas[0].cert",
+	}
+	for _, path := range expected {
+		assert.True(t, paths[path], "Missing problem at %v", path)
+	}
+	assert.Len(t, errs, len(expected), "Unexpected number of problems: %v", errs)
+}
+
+func TestUpdateFromRejectsInvalid(t *testing.T) {
+	cfg := &Config{Client: &client.ClientConfig{}}
+	cfg.ApplyDefaults()
+	oldChainedServers := cfg.Client.ChainedServers
+	oldCloudConfig, oldUpdateServerURL := cfg.CloudConfig, cfg.UpdateServerURL
+
+	err := cfg.updateFrom([]byte(`
+cloudconfig: https://evil.example.com/cloud.yaml.gz
+updateserverurl: https://evil.example.com
+client:
+  chainedservers:
+    broken:
+      addr: 1.2.3.4:443
+      cert: "-----BEGIN CERTIFICATE-----\nbroken\n-----END CERTIFICATE-----\n"
+`))
+	assert.Error(t, err, "Invalid update should be rejected")
+	assert.Equal(t, oldChainedServers, cfg.Client.ChainedServers, "Chained servers should be unchanged")
+	assert.Equal(t, oldCloudConfig, cfg.CloudConfig, "Cloud config URL should be unchanged")
+	assert.Equal(t, oldUpdateServerURL, cfg.UpdateServerURL, "Update server should be unchanged")
+}
+
+func TestValidateFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "cloud.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		if err := os.Remove(file.Name()); err != nil {
+			log.Errorf("Could not remove file? %v", err)
+		}
+	}()
+	_, err = file.Write([]byte("client:\n  proxiedconnectports: [80, 443]\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+	assert.NoError(t, ValidateFile(file.Name()), "File should be valid")
+
+	assert.NoError(t, ioutil.WriteFile(file.Name(), []byte("client:\n  proxiedconnectports: [80, 70000]\n"), 0644))
+	assert.Error(t, ValidateFile(file.Name()), "File should be invalid")
+}
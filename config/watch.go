@@ -0,0 +1,80 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/getlantern/yaml"
+)
+
+var (
+	// errUnchanged is returned from Update mutators to indicate that there's
+	// nothing to update.
+	errUnchanged = errors.New("Config unchanged")
+)
+
+// watchForEdits watches the config file at the given path and applies any
+// edits made to it by hand. Edits go through Update, so they're published to
+// the handler passed to Run just like cloud updates. It returns a function
+// that stops watching.
+func watchForEdits(path string) func() {
+	log.Debugf("Watching %v for edits", path)
+	stop, err := watchFile(path, func() {
+		if err := applyEdits(path); err != nil && err != errUnchanged {
+			log.Errorf("Rejected edit to %v: %v", path, err)
+		}
+	})
+	if err != nil {
+		log.Errorf("Unable to watch %v for edits: %v", path, err)
+		return func() {}
+	}
+	return stop
+}
+
+// applyEdits reads the config file at the given path and, if it's valid and
+// differs from the current config, makes it the current config.
+func applyEdits(path string) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Unable to read file: %v", err)
+	}
+	return Update(func(cfg *Config) error {
+		if err := cfg.applyEdited(bytes); err != nil {
+			return err
+		}
+		log.Debugf("Applied edits to %v", path)
+		configChanged()
+		return nil
+	})
+}
+
+// applyEdited replaces cfg with the config in the given YAML, reapplying
+// overrides and defaults. It returns errUnchanged if the edited config is the
+// same as cfg and leaves cfg alone if the edited config is invalid.
+func (cfg *Config) applyEdited(bytes []byte) error {
+	edited := &Config{}
+	if err := yaml.Unmarshal(bytes, edited); err != nil {
+		return fmt.Errorf("Unable to parse YAML: %v", err)
+	}
+	edited.configDir = cfg.configDir
+	if err := edited.applyOverrides(overrideFlags); err != nil {
+		return err
+	}
+	if edited.Client.DeviceID == "" && cfg.Client != nil {
+		edited.Client.DeviceID = cfg.Client.DeviceID
+	}
+	edited.ApplyDefaults()
+	if err := edited.Validate(); err != nil {
+		return err
+	}
+	// The version is bumped every time the file is saved, including when we
+	// save after applying an edit, so we ignore it when comparing.
+	edited.Version = cfg.Version
+	if reflect.DeepEqual(edited, cfg) {
+		return errUnchanged
+	}
+	*cfg = *edited
+	return nil
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// watchFile calls onChange whenever the file at the given path is written or
+// replaced, using inotify. We watch the containing directory rather than the
+// file itself because many editors save by writing a new file and renaming it
+// over the old one. The returned function stops watching and releases the
+// inotify instance.
+func watchFile(path string, onChange func()) (func(), error) {
+	fd, err := syscall.InotifyInit()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to initialize inotify: %v", err)
+	}
+	wd, err := syscall.InotifyAddWatch(fd, filepath.Dir(path), syscall.IN_CLOSE_WRITE|syscall.IN_MOVED_TO)
+	if err != nil {
+		closeInotify(fd)
+		return nil, fmt.Errorf("Unable to watch %v: %v", filepath.Dir(path), err)
+	}
+
+	name := filepath.Base(path)
+	stopCh := make(chan struct{})
+	var stopOnce sync.Once
+	stop := func() {
+		stopOnce.Do(func() {
+			close(stopCh)
+			// Removing the watch queues an IN_IGNORED event, which wakes up the
+			// blocked read below so that it can close the inotify instance.
+			if _, err := syscall.InotifyRmWatch(fd, uint32(wd)); err != nil {
+				log.Debugf("Unable to remove inotify watch: %v", err)
+			}
+		})
+	}
+
+	go func() {
+		defer closeInotify(fd)
+		buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+		for {
+			n, err := syscall.Read(fd, buf)
+			select {
+			case <-stopCh:
+				log.Debugf("No longer watching %v", path)
+				return
+			default:
+			}
+			if err != nil {
+				log.Errorf("Error reading inotify events, no longer watching %v: %v", path, err)
+				return
+			}
+			changed := false
+			for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+				event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+				start := offset + syscall.SizeofInotifyEvent
+				end := start + int(event.Len)
+				if strings.TrimRight(string(buf[start:end]), "\x00") == name {
+					changed = true
+				}
+				offset = end
+			}
+			if changed {
+				onChange()
+			}
+		}
+	}()
+	return stop, nil
+}
+
+func closeInotify(fd int) {
+	if err := syscall.Close(fd); err != nil {
+		log.Debugf("Unable to close inotify: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+//go:build !linux
+// +build !linux
+
+package config
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	filePollInterval = 1 * time.Second
+)
+
+// watchFile calls onChange whenever the modification time or size of the file
+// at the given path changes, polling every filePollInterval. The returned
+// function stops watching.
+func watchFile(path string, onChange func()) (func(), error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	stopCh := make(chan struct{})
+	var stopOnce sync.Once
+	go func() {
+		modTime, size := fi.ModTime(), fi.Size()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-time.After(filePollInterval):
+			}
+			fi, err := os.Stat(path)
+			if err != nil {
+				log.Debugf("Unable to stat %v: %v", path, err)
+				continue
+			}
+			if fi.ModTime() != modTime || fi.Size() != size {
+				modTime, size = fi.ModTime(), fi.Size()
+				onChange()
+			}
+		}
+	}()
+	return func() {
+		stopOnce.Do(func() {
+			close(stopCh)
+		})
+	}, nil
+}
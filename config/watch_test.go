@@ -0,0 +1,107 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+func TestWatchFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Errorf("Could not remove dir? %v", err)
+		}
+	}()
+	path := filepath.Join(dir, "lantern-test.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("version: 1\n"), 0644))
+
+	changed := make(chan bool, 10)
+	stop, err := watchFile(path, func() { changed <- true })
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Write to a different file in the same directory, which shouldn't trigger
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "other.yaml"), []byte("a: b\n"), 0644))
+	// Change the size too so that polling picks it up even with coarse mtimes
+	assert.NoError(t, ioutil.WriteFile(path, []byte("version: 22\n"), 0644))
+
+	select {
+	case <-changed:
+		// good
+	case <-time.After(5 * time.Second):
+		t.Fatal("Edit to watched file not detected")
+	}
+
+	stop()
+	stop()
+	// Give the watcher time to notice that it was stopped
+	time.Sleep(50 * time.Millisecond)
+	for len(changed) > 0 {
+		<-changed
+	}
+	assert.NoError(t, ioutil.WriteFile(path, []byte("version: 333\n"), 0644))
+	select {
+	case <-changed:
+		t.Fatal("Edit detected after stopping watch")
+	case <-time.After(2 * time.Second):
+		// good
+	}
+}
+
+func TestApplyEdited(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edits")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Errorf("Could not remove dir? %v", err)
+		}
+	}()
+	cfg := &Config{configDir: dir, Client: &client.ClientConfig{}}
+	cfg.ApplyDefaults()
+
+	edit := []byte(`
+cloudconfig: http://edited/cloud.yaml.gz
+client:
+  minqos: 7
+`)
+	if !assert.NoError(t, cfg.applyEdited(edit)) {
+		return
+	}
+	assert.Equal(t, "http://edited/cloud.yaml.gz", cfg.CloudConfig)
+	assert.Equal(t, 7, cfg.Client.MinQOS)
+	assert.Equal(t, dir, cfg.configDir, "Edit should keep config dir")
+
+	cfg.Version = 5
+	assert.Equal(t, errUnchanged, cfg.applyEdited(edit), "Saving the same file again should be a no-op")
+
+	err = cfg.applyEdited([]byte(`
+cloudconfig: http://edited/cloud.yaml.gz
+client:
+  chainedservers:
+    bad:
+      addr: 1.2.3.4:0
+`))
+	if assert.Error(t, err, "Invalid edit should be rejected") {
+		assert.Contains(t, err.Error(), "client.chainedservers.bad.addr: port must be between 1 and 65535, got 0")
+	}
+	assert.Equal(t, 7, cfg.Client.MinQOS, "Rejected edit should leave config alone")
+	assert.Nil(t, cfg.Client.ChainedServers["bad"])
+
+	err = cfg.applyEdited([]byte("client: [not, a, map]"))
+	if assert.Error(t, err, "Unparseable edit should be rejected") {
+		assert.Contains(t, err.Error(), "Unable to parse YAML")
+	}
+}
@@ -0,0 +1,27 @@
+// Package dashboard provides the built-in diagnostic dashboard, a page that
+// shows what Lantern is doing using the UI services.
+package dashboard
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// Handler returns a handler that serves the dashboard. The page is embedded
+// from dashboard_pages with go-bindata.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		if req.Method != "GET" && req.Method != "HEAD" {
+			http.Error(resp, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		info, err := AssetInfo("index.html")
+		if err != nil {
+			http.Error(resp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+		resp.Header().Set("Cache-Control", "no-cache")
+		http.ServeContent(resp, req, info.Name(), info.ModTime(), bytes.NewReader(MustAsset("index.html")))
+	})
+}
@@ -0,0 +1,23 @@
+package dashboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://localhost/dashboard/", nil)
+	resp := httptest.NewRecorder()
+	Handler().ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header().Get("Content-Type"))
+	assert.Contains(t, resp.Body.String(), "Lantern: Dashboard")
+
+	req, _ = http.NewRequest("POST", "http://localhost/dashboard/", nil)
+	resp = httptest.NewRecorder()
+	Handler().ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+}
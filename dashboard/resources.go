@@ -0,0 +1,226 @@
+// Code generated by go-bindata.
+// sources:
+// src/github.com/getlantern/flashlight/dashboard_pages/index.html
+// DO NOT EDIT!
+
+package dashboard
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	var empty [0]byte
+	sx := (*reflect.StringHeader)(unsafe.Pointer(&data))
+	b := empty[:]
+	bx := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+	bx.Data = sx.Data
+	bx.Len = len(data)
+	bx.Cap = bx.Len
+	return b, nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _indexHtml = "\x3c\x21\x44\x4f\x43\x54\x59\x50\x45\x20\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x74\x6d\x6c\x3e\x0a\x20\x20\x3c\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x22\x75\x74\x66\x2d\x38\x22\x3e\x0a\x20\x20\x20\x20\x3c\x6d\x65\x74\x61\x20\x68\x74\x74\x70\x2d\x65\x71\x75\x69\x76\x3d\x22\x58\x2d\x55\x41\x2d\x43\x6f\x6d\x70\x61\x74\x69\x62\x6c\x65\x22\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x49\x45\x3d\x65\x64\x67\x65\x2c\x63\x68\x72\x6f\x6d\x65\x3d\x31\x22\x3e\x0a\x20\x20\x20\x20\x3c\x74\x69\x74\x6c\x65\x3e\x4c\x61\x6e\x74\x65\x72\x6e\x3a\x20\x44\x61\x73\x68\x62\x6f\x61\x72\x64\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x20\x20\x20\x20\x3c\x73\x74\x79\x6c\x65\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x2f\x63\x73\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x2a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x30\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x6f\x78\x2d\x73\x69\x7a\x69\x6e\x67\x3a\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x6d\x6f\x7a\x2d\x62\x6f\x78\x2d\x73\x69\x7a\x69\x6e\x67\x3a\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x77\x65\x62\x6b\x69\x74\x2d\x62\x6f\x78\x2d\x73\x69\x7a\x69\x6e\x67\x3a\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x62\x6f\x64\x79\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x66\x61\x6d\x69\x6c\x79\x3a\x20\x73\x61\x6e\x73\x2d\x73\x65\x72\x69\x66\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x31\x31\x70\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x66\x66\x66\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x32\x61\x32\x61\x32\x61\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x68\x31\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x32\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x37\x35\x43\x42\x44\x41\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x30\x2e\x32\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x68\x32\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x31\x2e\x31\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x23\x64\x64\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x31\x2e\x35\x65\x6d\x20\x30\x20\x30\x2e\x35\x65\x6d\x20\x30\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x30\x2e\x32\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x23\x66\x72\x61\x6d\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x30\x20\x61\x75\x74\x6f\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x38\x30\x25\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x78\x2d\x77\x69\x64\x74\x68\x3a\x20\x36\x30\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x74\x6f\x70\x3a\x20\x34\x30\x70\x78\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x34\x30\x70\x78\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x23\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x38\x38\x38\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x23\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x2e\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x34\x61\x34\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x74\x61\x62\x6c\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x63\x6f\x6c\x6c\x61\x70\x73\x65\x3a\x20\x63\x6f\x6c\x6c\x61\x70\x73\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x31\x30\x30\x25\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x74\x68\x2c\x20\x74\x64\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x6c\x65\x66\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x2e\x32\x65\x6d\x20\x31\x65\x6d\x20\x30\x2e\x32\x65\x6d\x20\x30\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x65\x72\x74\x69\x63\x61\x6c\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x74\x6f\x70\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x74\x61\x62\x6c\x65\x2e\x69\x6e\x66\x6f\x20\x74\x68\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x31\x32\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x6e\x6f\x72\x6d\x61\x6c\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x36\x36\x36\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x74\x61\x62\x6c\x65\x2e\x6c\x69\x73\x74\x20\x74\x68\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x23\x64\x64\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x74\x64\x2e\x6e\x75\x6d\x62\x65\x72\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x72\x69\x67\x68\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x2e\x66\x61\x69\x6c\x69\x6e\x67\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x63\x33\x33\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x2e\x68\x65\x61\x6c\x74\x68\x79\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x34\x61\x34\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x23\x65\x72\x72\x6f\x72\x73\x20\x74\x64\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x66\x61\x6d\x69\x6c\x79\x3a\x20\x6d\x6f\x6e\x6f\x73\x70\x61\x63\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x30\x2e\x39\x65\x6d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x6f\x72\x64\x2d\x62\x72\x65\x61\x6b\x3a\x20\x62\x72\x65\x61\x6b\x2d\x61\x6c\x6c\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x23\x65\x72\x72\x6f\x72\x73\x20\x74\x64\x2e\x74\x69\x6d\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x68\x69\x74\x65\x2d\x73\x70\x61\x63\x65\x3a\x20\x6e\x6f\x77\x72\x61\x70\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x6f\x72\x64\x2d\x62\x72\x65\x61\x6b\x3a\x20\x6e\x6f\x72\x6d\x61\x6c\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x2e\x65\x6d\x70\x74\x79\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x38\x38\x38\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x3c\x2f\x73\x74\x79\x6c\x65\x3e\x0a\x20\x20\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x20\x20\x3c\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x66\x72\x61\x6d\x65\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x31\x3e\x4c\x61\x6e\x74\x65\x72\x6e\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x22\x3e\x43\x6f\x6e\x6e\x65\x63\x74\x69\x6e\x67\x2e\x2e\x2e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x47\x65\x6e\x65\x72\x61\x6c\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x66\x6f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x56\x65\x72\x73\x69\x6f\x6e\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x76\x65\x72\x73\x69\x6f\x6e\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x55\x70\x74\x69\x6d\x65\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x75\x70\x74\x69\x6d\x65\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x48\x54\x54\x50\x20\x70\x72\x6f\x78\x79\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x68\x74\x74\x70\x41\x64\x64\x72\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x53\x4f\x43\x4b\x53\x20\x70\x72\x6f\x78\x79\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x73\x6f\x63\x6b\x73\x41\x64\x64\x72\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x55\x49\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x75\x69\x41\x64\x64\x72\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x50\x72\x6f\x78\x79\x20\x61\x6c\x6c\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x70\x72\x6f\x78\x79\x41\x6c\x6c\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x53\x79\x73\x74\x65\x6d\x20\x70\x72\x6f\x78\x79\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x73\x79\x73\x74\x65\x6d\x50\x72\x6f\x78\x79\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x50\x72\x6f\x78\x69\x65\x64\x20\x73\x69\x74\x65\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x70\x72\x6f\x78\x69\x65\x64\x53\x69\x74\x65\x73\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x4c\x6f\x63\x61\x74\x69\x6f\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x66\x6f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x49\x50\x20\x61\x64\x64\x72\x65\x73\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x69\x70\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x43\x6f\x75\x6e\x74\x72\x79\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x75\x6e\x74\x72\x79\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x43\x6f\x6e\x66\x69\x67\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x66\x6f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x46\x69\x6c\x65\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x6e\x66\x69\x67\x46\x69\x6c\x65\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x43\x6c\x6f\x75\x64\x20\x75\x70\x64\x61\x74\x65\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x6e\x66\x69\x67\x53\x74\x69\x63\x6b\x79\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x43\x6c\x6f\x75\x64\x20\x63\x6f\x6e\x66\x69\x67\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x6e\x66\x69\x67\x43\x6c\x6f\x75\x64\x55\x52\x4c\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x4c\x61\x73\x74\x20\x63\x68\x65\x63\x6b\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x6e\x66\x69\x67\x4c\x61\x73\x74\x43\x68\x65\x63\x6b\x65\x64\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x4c\x61\x73\x74\x20\x63\x68\x61\x6e\x67\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x6e\x66\x69\x67\x4c\x61\x73\x74\x43\x68\x61\x6e\x67\x65\x64\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x4c\x61\x73\x74\x20\x72\x65\x6a\x65\x63\x74\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x63\x6f\x6e\x66\x69\x67\x52\x65\x6a\x65\x63\x74\x69\x6f\x6e\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x54\x72\x61\x66\x66\x69\x63\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x69\x6e\x66\x6f\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x53\x65\x6e\x74\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x62\x79\x74\x65\x73\x53\x65\x6e\x74\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x52\x65\x63\x65\x69\x76\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x64\x20\x69\x64\x3d\x22\x62\x79\x74\x65\x73\x52\x65\x63\x65\x69\x76\x65\x64\x22\x3e\x3c\x2f\x74\x64\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x53\x65\x72\x76\x65\x72\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x41\x64\x64\x72\x65\x73\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x48\x65\x61\x6c\x74\x68\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x44\x69\x61\x6c\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x46\x61\x69\x6c\x75\x72\x65\x73\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x53\x65\x6e\x74\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x52\x65\x63\x65\x69\x76\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x4c\x61\x73\x74\x20\x65\x72\x72\x6f\x72\x3c\x2f\x74\x68\x3e\x3c\x2f\x74\x72\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x20\x69\x64\x3d\x22\x73\x65\x72\x76\x65\x72\x73\x22\x3e\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x52\x65\x63\x65\x6e\x74\x20\x65\x72\x72\x6f\x72\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x73\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x20\x69\x64\x3d\x22\x65\x72\x72\x6f\x72\x73\x22\x3e\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x74\x79\x70\x65\x3d\x22\x74\x65\x78\x74\x2f\x6a\x61\x76\x61\x73\x63\x72\x69\x70\x74\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x24\x28\x69\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x69\x64\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x65\x74\x54\x65\x78\x74\x28\x69\x64\x2c\x20\x74\x65\x78\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x24\x28\x69\x64\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x74\x65\x78\x74\x20\x3d\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x7c\x7c\x20\x74\x65\x78\x74\x20\x3d\x3d\x3d\x20\x6e\x75\x6c\x6c\x20\x7c\x7c\x20\x74\x65\x78\x74\x20\x3d\x3d\x3d\x20\x22\x22\x20\x3f\x20\x22\x2d\x22\x20\x3a\x20\x74\x65\x78\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x74\x65\x78\x74\x2c\x20\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x74\x64\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x74\x64\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x64\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x74\x65\x78\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x64\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x20\x3d\x20\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x6f\x77\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x74\x64\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x64\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x65\x6d\x70\x74\x79\x52\x6f\x77\x28\x74\x62\x6f\x64\x79\x2c\x20\x63\x6f\x6c\x75\x6d\x6e\x73\x2c\x20\x74\x65\x78\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x72\x6f\x77\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x74\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x74\x64\x20\x3d\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x74\x65\x78\x74\x2c\x20\x22\x65\x6d\x70\x74\x79\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x64\x2e\x63\x6f\x6c\x53\x70\x61\x6e\x20\x3d\x20\x63\x6f\x6c\x75\x6d\x6e\x73\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x62\x6f\x64\x79\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x72\x6f\x77\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x6c\x65\x61\x72\x28\x74\x62\x6f\x64\x79\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x68\x69\x6c\x65\x20\x28\x74\x62\x6f\x64\x79\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x62\x6f\x64\x79\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x74\x62\x6f\x64\x79\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x6e\x4f\x66\x66\x28\x76\x61\x6c\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x76\x61\x6c\x20\x3f\x20\x22\x6f\x6e\x22\x20\x3a\x20\x22\x6f\x66\x66\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x6e\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x75\x6e\x69\x74\x73\x20\x3d\x20\x5b\x22\x42\x22\x2c\x20\x22\x4b\x42\x22\x2c\x20\x22\x4d\x42\x22\x2c\x20\x22\x47\x42\x22\x2c\x20\x22\x54\x42\x22\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x69\x20\x3d\x20\x30\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x68\x69\x6c\x65\x20\x28\x6e\x20\x3e\x3d\x20\x31\x30\x32\x34\x20\x26\x26\x20\x69\x20\x3c\x20\x75\x6e\x69\x74\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x2d\x20\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x20\x2f\x3d\x20\x31\x30\x32\x34\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x2b\x2b\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x28\x69\x20\x3d\x3d\x3d\x20\x30\x20\x3f\x20\x6e\x20\x3a\x20\x6e\x2e\x74\x6f\x46\x69\x78\x65\x64\x28\x31\x29\x29\x20\x2b\x20\x22\x20\x22\x20\x2b\x20\x75\x6e\x69\x74\x73\x5b\x69\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x6d\x61\x74\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x73\x65\x63\x73\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x20\x3d\x20\x4d\x61\x74\x68\x2e\x66\x6c\x6f\x6f\x72\x28\x73\x65\x63\x73\x20\x2f\x20\x38\x36\x34\x30\x30\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x68\x20\x3d\x20\x4d\x61\x74\x68\x2e\x66\x6c\x6f\x6f\x72\x28\x73\x65\x63\x73\x20\x25\x20\x38\x36\x34\x30\x30\x20\x2f\x20\x33\x36\x30\x30\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6d\x20\x3d\x20\x4d\x61\x74\x68\x2e\x66\x6c\x6f\x6f\x72\x28\x73\x65\x63\x73\x20\x25\x20\x33\x36\x30\x30\x20\x2f\x20\x36\x30\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x20\x3d\x20\x73\x65\x63\x73\x20\x25\x20\x36\x30\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x64\x20\x3e\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x20\x2b\x20\x22\x64\x20\x22\x20\x2b\x20\x68\x20\x2b\x20\x22\x68\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x68\x20\x3e\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x68\x20\x2b\x20\x22\x68\x20\x22\x20\x2b\x20\x6d\x20\x2b\x20\x22\x6d\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x6d\x20\x3e\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6d\x20\x2b\x20\x22\x6d\x20\x22\x20\x2b\x20\x73\x20\x2b\x20\x22\x73\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x73\x20\x2b\x20\x22\x73\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x6f\x72\x6d\x61\x74\x54\x69\x6d\x65\x28\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6e\x65\x77\x20\x44\x61\x74\x65\x28\x74\x29\x2e\x74\x6f\x4c\x6f\x63\x61\x6c\x65\x53\x74\x72\x69\x6e\x67\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x44\x69\x61\x67\x6e\x6f\x73\x74\x69\x63\x73\x28\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x76\x65\x72\x73\x69\x6f\x6e\x22\x2c\x20\x64\x2e\x76\x65\x72\x73\x69\x6f\x6e\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x75\x70\x74\x69\x6d\x65\x22\x2c\x20\x66\x6f\x72\x6d\x61\x74\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x64\x2e\x75\x70\x74\x69\x6d\x65\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x68\x74\x74\x70\x41\x64\x64\x72\x22\x2c\x20\x64\x2e\x68\x74\x74\x70\x41\x64\x64\x72\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x73\x6f\x63\x6b\x73\x41\x64\x64\x72\x22\x2c\x20\x64\x2e\x73\x6f\x63\x6b\x73\x41\x64\x64\x72\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x75\x69\x41\x64\x64\x72\x22\x2c\x20\x64\x2e\x75\x69\x41\x64\x64\x72\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x70\x72\x6f\x78\x69\x65\x64\x53\x69\x74\x65\x73\x22\x2c\x20\x64\x2e\x70\x72\x6f\x78\x69\x65\x64\x53\x69\x74\x65\x73\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x69\x70\x22\x2c\x20\x64\x2e\x69\x70\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x75\x6e\x74\x72\x79\x22\x2c\x20\x64\x2e\x63\x6f\x75\x6e\x74\x72\x79\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x62\x79\x74\x65\x73\x53\x65\x6e\x74\x22\x2c\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x64\x2e\x62\x79\x74\x65\x73\x53\x65\x6e\x74\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x62\x79\x74\x65\x73\x52\x65\x63\x65\x69\x76\x65\x64\x22\x2c\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x64\x2e\x62\x79\x74\x65\x73\x52\x65\x63\x65\x69\x76\x65\x64\x29\x29\x3b\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x63\x66\x67\x20\x3d\x20\x64\x2e\x63\x6f\x6e\x66\x69\x67\x20\x7c\x7c\x20\x7b\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x6e\x66\x69\x67\x46\x69\x6c\x65\x22\x2c\x20\x63\x66\x67\x2e\x66\x69\x6c\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x6e\x66\x69\x67\x53\x74\x69\x63\x6b\x79\x22\x2c\x20\x63\x66\x67\x2e\x73\x74\x69\x63\x6b\x79\x20\x3f\x20\x22\x69\x67\x6e\x6f\x72\x65\x64\x20\x28\x73\x74\x69\x63\x6b\x79\x20\x63\x6f\x6e\x66\x69\x67\x29\x22\x20\x3a\x20\x22\x61\x70\x70\x6c\x69\x65\x64\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x6e\x66\x69\x67\x43\x6c\x6f\x75\x64\x55\x52\x4c\x22\x2c\x20\x63\x66\x67\x2e\x63\x6c\x6f\x75\x64\x55\x52\x4c\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x6e\x66\x69\x67\x4c\x61\x73\x74\x43\x68\x65\x63\x6b\x65\x64\x22\x2c\x20\x66\x6f\x72\x6d\x61\x74\x54\x69\x6d\x65\x28\x63\x66\x67\x2e\x6c\x61\x73\x74\x43\x68\x65\x63\x6b\x65\x64\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x6e\x66\x69\x67\x4c\x61\x73\x74\x43\x68\x61\x6e\x67\x65\x64\x22\x2c\x20\x63\x66\x67\x2e\x6c\x61\x73\x74\x43\x68\x61\x6e\x67\x65\x64\x20\x3f\x20\x66\x6f\x72\x6d\x61\x74\x54\x69\x6d\x65\x28\x63\x66\x67\x2e\x6c\x61\x73\x74\x43\x68\x61\x6e\x67\x65\x64\x29\x20\x2b\x20\x22\x20\x28\x22\x20\x2b\x20\x66\x6f\x72\x6d\x61\x74\x44\x75\x72\x61\x74\x69\x6f\x6e\x28\x63\x66\x67\x2e\x61\x67\x65\x29\x20\x2b\x20\x22\x20\x61\x67\x6f\x29\x22\x20\x3a\x20\x22\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x6a\x65\x63\x74\x69\x6f\x6e\x20\x3d\x20\x64\x2e\x63\x6f\x6e\x66\x69\x67\x52\x65\x6a\x65\x63\x74\x69\x6f\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x63\x6f\x6e\x66\x69\x67\x52\x65\x6a\x65\x63\x74\x69\x6f\x6e\x22\x2c\x20\x72\x65\x6a\x65\x63\x74\x69\x6f\x6e\x20\x3f\x20\x66\x6f\x72\x6d\x61\x74\x54\x69\x6d\x65\x28\x72\x65\x6a\x65\x63\x74\x69\x6f\x6e\x2e\x74\x69\x6d\x65\x29\x20\x2b\x20\x22\x3a\x20\x22\x20\x2b\x20\x72\x65\x6a\x65\x63\x74\x69\x6f\x6e\x2e\x65\x72\x72\x6f\x72\x20\x2b\x20\x28\x72\x65\x6a\x65\x63\x74\x69\x6f\x6e\x2e\x72\x65\x76\x65\x72\x74\x65\x64\x20\x3f\x20\x22\x20\x28\x72\x65\x76\x65\x72\x74\x65\x64\x29\x22\x20\x3a\x20\x22\x20\x28\x6b\x65\x70\x74\x2c\x20\x6e\x6f\x74\x68\x69\x6e\x67\x20\x74\x6f\x20\x72\x65\x76\x65\x72\x74\x20\x74\x6f\x29\x22\x29\x20\x3a\x20\x22\x22\x29\x3b\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x65\x72\x76\x65\x72\x73\x20\x3d\x20\x24\x28\x22\x73\x65\x72\x76\x65\x72\x73\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x28\x73\x65\x72\x76\x65\x72\x73\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x64\x2e\x73\x65\x72\x76\x65\x72\x73\x20\x7c\x7c\x20\x64\x2e\x73\x65\x72\x76\x65\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6d\x70\x74\x79\x52\x6f\x77\x28\x73\x65\x72\x76\x65\x72\x73\x2c\x20\x37\x2c\x20\x22\x4e\x6f\x20\x63\x68\x61\x69\x6e\x65\x64\x20\x73\x65\x72\x76\x65\x72\x73\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x28\x76\x61\x72\x20\x69\x20\x3d\x20\x30\x3b\x20\x69\x20\x3c\x20\x64\x2e\x73\x65\x72\x76\x65\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x3b\x20\x69\x2b\x2b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x20\x3d\x20\x64\x2e\x73\x65\x72\x76\x65\x72\x73\x5b\x69\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x72\x6f\x77\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x74\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x73\x2e\x61\x64\x64\x72\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x73\x2e\x63\x6f\x6e\x73\x65\x63\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x3e\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x22\x66\x61\x69\x6c\x69\x6e\x67\x20\x28\x22\x20\x2b\x20\x73\x2e\x63\x6f\x6e\x73\x65\x63\x46\x61\x69\x6c\x75\x72\x65\x73\x20\x2b\x20\x22\x20\x69\x6e\x20\x61\x20\x72\x6f\x77\x29\x22\x2c\x20\x22\x66\x61\x69\x6c\x69\x6e\x67\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x28\x73\x2e\x6c\x61\x73\x74\x53\x75\x63\x63\x65\x73\x73\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x22\x68\x65\x61\x6c\x74\x68\x79\x22\x2c\x20\x22\x68\x65\x61\x6c\x74\x68\x79\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x22\x75\x6e\x6b\x6e\x6f\x77\x6e\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x73\x2e\x64\x69\x61\x6c\x73\x2c\x20\x22\x6e\x75\x6d\x62\x65\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x73\x2e\x64\x69\x61\x6c\x46\x61\x69\x6c\x75\x72\x65\x73\x2c\x20\x22\x6e\x75\x6d\x62\x65\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x73\x2e\x62\x79\x74\x65\x73\x53\x65\x6e\x74\x29\x2c\x20\x22\x6e\x75\x6d\x62\x65\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x66\x6f\x72\x6d\x61\x74\x42\x79\x74\x65\x73\x28\x73\x2e\x62\x79\x74\x65\x73\x52\x65\x63\x65\x69\x76\x65\x64\x29\x2c\x20\x22\x6e\x75\x6d\x62\x65\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x72\x6f\x77\x2c\x20\x73\x2e\x6c\x61\x73\x74\x45\x72\x72\x6f\x72\x20\x7c\x7c\x20\x22\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x73\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x72\x6f\x77\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x72\x72\x6f\x72\x73\x20\x3d\x20\x24\x28\x22\x65\x72\x72\x6f\x72\x73\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x28\x65\x72\x72\x6f\x72\x73\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x64\x2e\x72\x65\x63\x65\x6e\x74\x45\x72\x72\x6f\x72\x73\x20\x7c\x7c\x20\x64\x2e\x72\x65\x63\x65\x6e\x74\x45\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6d\x70\x74\x79\x52\x6f\x77\x28\x65\x72\x72\x6f\x72\x73\x2c\x20\x32\x2c\x20\x22\x4e\x6f\x20\x65\x72\x72\x6f\x72\x73\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x4e\x65\x77\x65\x73\x74\x20\x66\x69\x72\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x28\x76\x61\x72\x20\x6a\x20\x3d\x20\x64\x2e\x72\x65\x63\x65\x6e\x74\x45\x72\x72\x6f\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x2d\x20\x31\x3b\x20\x6a\x20\x3e\x3d\x20\x30\x3b\x20\x6a\x2d\x2d\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x20\x3d\x20\x64\x2e\x72\x65\x63\x65\x6e\x74\x45\x72\x72\x6f\x72\x73\x5b\x6a\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x72\x72\x6f\x72\x52\x6f\x77\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x22\x74\x72\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x65\x72\x72\x6f\x72\x52\x6f\x77\x2c\x20\x66\x6f\x72\x6d\x61\x74\x54\x69\x6d\x65\x28\x65\x2e\x54\x69\x6d\x65\x29\x2c\x20\x22\x74\x69\x6d\x65\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x65\x6c\x6c\x28\x65\x72\x72\x6f\x72\x52\x6f\x77\x2c\x20\x65\x2e\x4d\x65\x73\x73\x61\x67\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x73\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x65\x72\x72\x6f\x72\x52\x6f\x77\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x53\x65\x74\x74\x69\x6e\x67\x73\x28\x73\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x70\x72\x6f\x78\x79\x41\x6c\x6c\x22\x2c\x20\x6f\x6e\x4f\x66\x66\x28\x73\x2e\x50\x72\x6f\x78\x79\x41\x6c\x6c\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x65\x78\x74\x28\x22\x73\x79\x73\x74\x65\x6d\x50\x72\x6f\x78\x79\x22\x2c\x20\x6f\x6e\x4f\x66\x66\x28\x73\x2e\x53\x79\x73\x74\x65\x6d\x50\x72\x6f\x78\x79\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x6e\x4d\x65\x73\x73\x61\x67\x65\x28\x64\x61\x74\x61\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6e\x76\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x72\x79\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x76\x20\x3d\x20\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x28\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x63\x61\x74\x63\x68\x20\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x65\x6e\x76\x2e\x4d\x65\x73\x73\x61\x67\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x65\x6e\x76\x2e\x54\x79\x70\x65\x20\x3d\x3d\x3d\x20\x22\x44\x69\x61\x67\x6e\x6f\x73\x74\x69\x63\x73\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x44\x69\x61\x67\x6e\x6f\x73\x74\x69\x63\x73\x28\x65\x6e\x76\x2e\x4d\x65\x73\x73\x61\x67\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x69\x66\x20\x28\x65\x6e\x76\x2e\x54\x79\x70\x65\x20\x3d\x3d\x3d\x20\x22\x53\x65\x74\x74\x69\x6e\x67\x73\x22\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x53\x65\x74\x74\x69\x6e\x67\x73\x28\x65\x6e\x76\x2e\x4d\x65\x73\x73\x61\x67\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x65\x74\x43\x6f\x6e\x6e\x65\x63\x74\x65\x64\x28\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6c\x20\x3d\x20\x24\x28\x22\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6c\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x20\x3f\x20\x22\x4c\x69\x76\x65\x22\x20\x3a\x20\x22\x44\x69\x73\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x2c\x20\x72\x65\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6e\x67\x2e\x2e\x2e\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6c\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x20\x3d\x20\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x20\x3f\x20\x22\x63\x6f\x6e\x6e\x65\x63\x74\x65\x64\x22\x20\x3a\x20\x22\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x63\x6f\x6e\x6e\x65\x63\x74\x45\x76\x65\x6e\x74\x53\x74\x72\x65\x61\x6d\x20\x69\x73\x20\x74\x68\x65\x20\x66\x61\x6c\x6c\x62\x61\x63\x6b\x20\x66\x6f\x72\x20\x62\x72\x6f\x77\x73\x65\x72\x73\x20\x74\x68\x61\x74\x20\x63\x61\x6e\x27\x74\x20\x75\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x73\x2e\x20\x54\x68\x65\x20\x62\x72\x6f\x77\x73\x65\x72\x20\x72\x65\x63\x6f\x6e\x6e\x65\x63\x74\x73\x20\x69\x74\x20\x62\x79\x20\x69\x74\x73\x65\x6c\x66\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x6f\x6e\x6e\x65\x63\x74\x45\x76\x65\x6e\x74\x53\x74\x72\x65\x61\x6d\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x73\x20\x3d\x20\x6e\x65\x77\x20\x45\x76\x65\x6e\x74\x53\x6f\x75\x72\x63\x65\x28\x22\x2f\x64\x61\x74\x61\x2f\x65\x76\x65\x6e\x74\x73\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x73\x2e\x6f\x6e\x6f\x70\x65\x6e\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x43\x6f\x6e\x6e\x65\x63\x74\x65\x64\x28\x74\x72\x75\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x73\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x43\x6f\x6e\x6e\x65\x63\x74\x65\x64\x28\x66\x61\x6c\x73\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x73\x2e\x6f\x6e\x6d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x6e\x4d\x65\x73\x73\x61\x67\x65\x28\x65\x2e\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x6f\x6e\x6e\x65\x63\x74\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x77\x69\x6e\x64\x6f\x77\x2e\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x6e\x65\x63\x74\x45\x76\x65\x6e\x74\x53\x74\x72\x65\x61\x6d\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6f\x70\x65\x6e\x65\x64\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x63\x68\x65\x6d\x65\x20\x3d\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\x3d\x20\x22\x68\x74\x74\x70\x73\x3a\x22\x20\x3f\x20\x22\x77\x73\x73\x3a\x2f\x2f\x22\x20\x3a\x20\x22\x77\x73\x3a\x2f\x2f\x22\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x77\x73\x20\x3d\x20\x6e\x65\x77\x20\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x28\x73\x63\x68\x65\x6d\x65\x20\x2b\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x6f\x73\x74\x20\x2b\x20\x22\x2f\x64\x61\x74\x61\x22\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x73\x2e\x6f\x6e\x6f\x70\x65\x6e\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x6e\x65\x64\x20\x3d\x20\x74\x72\x75\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x43\x6f\x6e\x6e\x65\x63\x74\x65\x64\x28\x74\x72\x75\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x73\x2e\x6f\x6e\x6d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x6e\x4d\x65\x73\x73\x61\x67\x65\x28\x65\x2e\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x77\x73\x2e\x6f\x6e\x63\x6c\x6f\x73\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x6f\x70\x65\x6e\x65\x64\x20\x26\x26\x20\x77\x69\x6e\x64\x6f\x77\x2e\x45\x76\x65\x6e\x74\x53\x6f\x75\x72\x63\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x54\x68\x65\x20\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x20\x6e\x65\x76\x65\x72\x20\x77\x6f\x72\x6b\x65\x64\x2c\x20\x73\x6f\x20\x73\x6f\x6d\x65\x74\x68\x69\x6e\x67\x20\x69\x6e\x20\x62\x65\x74\x77\x65\x65\x6e\x20\x64\x6f\x65\x73\x6e\x27\x74\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x73\x75\x70\x70\x6f\x72\x74\x20\x69\x74\x2e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x6e\x65\x63\x74\x45\x76\x65\x6e\x74\x53\x74\x72\x65\x61\x6d\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x43\x6f\x6e\x6e\x65\x63\x74\x65\x64\x28\x66\x61\x6c\x73\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x63\x6f\x6e\x6e\x65\x63\x74\x2c\x20\x32\x30\x30\x30\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x6e\x65\x63\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x28\x29\x3b\x0a\x20\x20\x20\x20\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x20\x20\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a"
+
+func indexHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_indexHtml,
+		"index.html",
+	)
+}
+
+func indexHtml() (*asset, error) {
+	bytes, err := indexHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "index.html", size: 10435, mode: os.FileMode(420), modTime: time.Unix(1792331974, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"index.html": indexHtml,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"index.html": &bintree{indexHtml, map[string]*bintree{
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
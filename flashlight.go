@@ -85,8 +85,10 @@ func Run(httpProxyAddr string,
 	if beforeStart(cfg) {
 		log.Debug("Preparing to start client proxy")
 		geolookup.Configure(client.Addr)
+		probation := newProbation(client)
 		cfgMutex.Lock()
 		applyClientConfig(client, cfg, proxyAll)
+		probation.applied(cfg)
 		cfgMutex.Unlock()
 
 		go func() {
@@ -94,6 +96,7 @@ func Run(httpProxyAddr string,
 				log.Debug("Applying updated configuration")
 				cfgMutex.Lock()
 				applyClientConfig(client, updated, proxyAll)
+				probation.applied(updated)
 				onConfigUpdate(updated)
 				cfgMutex.Unlock()
 				log.Debug("Applied updated configuration")
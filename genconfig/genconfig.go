@@ -56,6 +56,14 @@ var (
 	fallbacks    map[string]*client.ChainedServerInfo
 	ftVersion    string
 
+	// proxiedSitesByCountry are the sites proxied in addition to proxiedSites
+	// in particular countries, keyed by country code
+	proxiedSitesByCountry = make(map[string]filter)
+
+	// proxiedSiteExclusionsByCountry are the sites in proxiedSites that
+	// aren't proxied in particular countries, keyed by country code
+	proxiedSiteExclusionsByCountry = make(map[string]filter)
+
 	inputCh       = make(chan string)
 	masqueradesCh = make(chan *masquerade)
 	wg            sync.WaitGroup
@@ -63,6 +71,16 @@ var (
 
 type filter map[string]bool
 
+// sorted returns the entries of the filter in order.
+func (f filter) sorted() []string {
+	entries := make([]string, 0, len(f))
+	for entry := range f {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+	return entries
+}
+
 type masquerade struct {
 	Domain    string
 	IpAddress string
@@ -141,30 +159,59 @@ func loadMasquerades() {
 	masquerades = strings.Split(string(bytes), "\n")
 }
 
-// Scans the proxied site directory and stores the sites in the files found
+// Scans the proxied site directory and stores the sites in the files found.
+// Files in subdirectories named after a country code, like proxiedsites/cn,
+// contain sites that are only proxied in that country, and lines in them that
+// start with - exclude sites of the global lists in that country.
 func loadProxiedSites(path string, info os.FileInfo, err error) error {
 	if info.IsDir() {
-		// skip root directory
+		// skip directories
 		return nil
 	}
+	sites := proxiedSites
+	var exclusions filter
+	rel, err := filepath.Rel(*proxiedSitesDir, path)
+	if err != nil {
+		log.Fatalf("Unable to determine country of proxied site file at %s: %s", path, err)
+	}
+	if dir := filepath.Dir(rel); dir != "." {
+		country := strings.ToUpper(strings.Split(filepath.ToSlash(dir), "/")[0])
+		sites = countryFilter(proxiedSitesByCountry, country)
+		exclusions = countryFilter(proxiedSiteExclusionsByCountry, country)
+	}
 	proxiedSiteBytes, err := ioutil.ReadFile(path)
 	if err != nil {
-		log.Fatalf("Unable to read blacklist file at %s: %s", path, err)
+		log.Fatalf("Unable to read proxied site file at %s: %s", path, err)
 	}
 	for _, domain := range strings.Split(string(proxiedSiteBytes), "\n") {
-		// skip empty lines, comments, and *.ir sites
-		// since we're focusing on Iran with this first release, we aren't adding *.ir sites
-		// to the global proxied sites
-		// to avoid proxying sites that are already unblocked there.
-		// This is a general problem when you aren't maintaining country-specific whitelists
-		// which will be addressed in the next phase
-		if domain != "" && !strings.HasPrefix(domain, "#") && !strings.HasSuffix(domain, ".ir") {
-			proxiedSites[domain] = true
+		domain = strings.TrimSpace(domain)
+		// skip empty lines and comments
+		if domain == "" || strings.HasPrefix(domain, "#") {
+			continue
+		}
+		if strings.HasPrefix(domain, "-") {
+			if exclusions == nil {
+				log.Fatalf("Exclusion %s in %s is not in a country's directory", domain, path)
+			}
+			exclusions[strings.TrimSpace(domain[1:])] = true
+		} else {
+			sites[domain] = true
 		}
 	}
 	return err
 }
 
+// countryFilter returns the filter for the given country, adding one if
+// necessary.
+func countryFilter(byCountry map[string]filter, country string) filter {
+	f := byCountry[country]
+	if f == nil {
+		f = make(filter)
+		byCountry[country] = f
+	}
+	return f
+}
+
 func loadProxiedSitesList() {
 	if *proxiedSitesDir == "" {
 		log.Error("Please specify a proxied site directory")
@@ -343,11 +390,19 @@ func buildModel(cas map[string]*castat, masquerades []*masquerade, useFallbacks
 	}
 	sort.Sort(ByFreq(casList))
 	sort.Sort(ByDomain(masquerades))
-	ps := make([]string, 0, len(proxiedSites))
-	for site, _ := range proxiedSites {
-		ps = append(ps, site)
+	ps := proxiedSites.sorted()
+	psByCountry := make(map[string][]string, len(proxiedSitesByCountry))
+	for country, sites := range proxiedSitesByCountry {
+		if len(sites) > 0 {
+			psByCountry[country] = sites.sorted()
+		}
+	}
+	psExclusionsByCountry := make(map[string][]string, len(proxiedSiteExclusionsByCountry))
+	for country, sites := range proxiedSiteExclusionsByCountry {
+		if len(sites) > 0 {
+			psExclusionsByCountry[country] = sites.sorted()
+		}
 	}
-	sort.Strings(ps)
 	fbs := make([]map[string]interface{}, 0, len(fallbacks))
 	if useFallbacks {
 		for _, f := range fallbacks {
@@ -379,11 +434,13 @@ func buildModel(cas map[string]*castat, masquerades []*masquerade, useFallbacks
 		}
 	}
 	return map[string]interface{}{
-		"cas":          casList,
-		"masquerades":  masquerades,
-		"proxiedsites": ps,
-		"fallbacks":    fbs,
-		"ftVersion":    ftVersion,
+		"cas":                            casList,
+		"masquerades":                    masquerades,
+		"proxiedsites":                   ps,
+		"proxiedsitesbycountry":          psByCountry,
+		"proxiedsiteexclusionsbycountry": psExclusionsByCountry,
+		"fallbacks":                      fbs,
+		"ftVersion":                      ftVersion,
 	}
 }
 
@@ -419,7 +476,7 @@ func run(prg string, args ...string) (string, error) {
 	return string(out), nil
 }
 
-func base64Encode(sites []string) string {
+func base64Encode(sites interface{}) string {
 	raw, err := json.Marshal(sites)
 	if err != nil {
 		panic(fmt.Errorf("Unable to marshal proxied sites: %s", err))
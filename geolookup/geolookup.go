@@ -2,6 +2,7 @@ package geolookup
 
 import (
 	"math"
+	"sync"
 	"time"
 
 	"github.com/getlantern/eventual"
@@ -18,6 +19,9 @@ var (
 	cf             util.HTTPFetcher
 	currentGeoInfo = eventual.NewValue()
 
+	countryChangeFns   []func(country string)
+	countryChangeFnsMx sync.Mutex
+
 	waitForProxyTimeout = 1 * time.Minute
 	retryWaitMillis     = 100
 	maxRetryWait        = 30 * time.Second
@@ -71,11 +75,35 @@ func init() {
 	go run()
 }
 
+// OnCountryChange registers a function that's called with the new country
+// whenever the detected country changes, including when it's first detected.
+func OnCountryChange(fn func(country string)) {
+	countryChangeFnsMx.Lock()
+	countryChangeFns = append(countryChangeFns, fn)
+	countryChangeFnsMx.Unlock()
+}
+
 func run() {
+	country := ""
 	for _ = range refreshRequest {
 		gi := lookup()
 		log.Debug("Got new geolocation info")
 		currentGeoInfo.Set(gi)
+		if gi.city.Country.IsoCode != country {
+			country = gi.city.Country.IsoCode
+			log.Debugf("Country is now %v", country)
+			countryChanged(country)
+		}
+	}
+}
+
+func countryChanged(country string) {
+	countryChangeFnsMx.Lock()
+	fns := make([]func(country string), len(countryChangeFns))
+	copy(fns, countryChangeFns)
+	countryChangeFnsMx.Unlock()
+	for _, fn := range fns {
+		fn(country)
 	}
 }
 
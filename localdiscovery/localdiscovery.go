@@ -40,6 +40,7 @@ func Start(advertise bool, portToAdvertise string) {
 		log.Errorf("Unable to register Local Discovery service: %q", err)
 		return
 	}
+	service.SetQueuePolicy(ui.Coalesce)
 
 	addOrRemoveCb := func(peer string, peersInfo []multicast.PeerInfo) {
 		peersMutex.Lock()
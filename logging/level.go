@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/getlantern/golog"
+)
+
+const (
+	// LevelDebug logs everything. This is the default.
+	LevelDebug = "debug"
+
+	// LevelError only logs errors.
+	LevelError = "error"
+)
+
+var (
+	level   = LevelDebug
+	levelMx sync.RWMutex
+)
+
+// SetLevel sets the level at which to log, either LevelDebug or LevelError.
+func SetLevel(l string) error {
+	l = strings.ToLower(l)
+	if l != LevelDebug && l != LevelError {
+		return fmt.Errorf("Unknown log level %q, use %v or %v", l, LevelDebug, LevelError)
+	}
+	levelMx.Lock()
+	level = l
+	levelMx.Unlock()
+	golog.SetOutputs(golog.GetOutputs().ErrorOut, debugOutput())
+	log.Debugf("Log level set to %v", l)
+	return nil
+}
+
+// Level returns the current log level.
+func Level() string {
+	levelMx.RLock()
+	defer levelMx.RUnlock()
+	return level
+}
+
+// debugOutput returns the writer to which debug output goes at the current
+// log level.
+func debugOutput() io.Writer {
+	if Level() == LevelError {
+		return ioutil.Discard
+	}
+	return debugOut
+}
@@ -0,0 +1,29 @@
+package logging
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/getlantern/golog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLevel(t *testing.T) {
+	defer func() {
+		assert.NoError(t, SetLevel(LevelDebug))
+	}()
+
+	assert.Equal(t, LevelDebug, Level())
+	assert.NoError(t, SetLevel("ERROR"))
+	assert.Equal(t, LevelError, Level())
+	assert.Equal(t, ioutil.Discard, golog.GetOutputs().DebugOut, "Debug output should be discarded")
+
+	removeLoggly()
+	assert.Equal(t, ioutil.Discard, golog.GetOutputs().DebugOut, "Resetting outputs should keep level")
+
+	assert.Error(t, SetLevel("verbose"))
+	assert.Equal(t, LevelError, Level(), "Unknown level should be ignored")
+
+	assert.NoError(t, SetLevel(LevelDebug))
+	assert.Equal(t, debugOut, golog.GetOutputs().DebugOut)
+}
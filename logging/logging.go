@@ -74,9 +74,9 @@ func EnableFileLogging() error {
 	// Keep up to 5 log files
 	logFile.MaxRotation = 5
 
-	errorOut = timestamped(NonStopWriter(os.Stderr, logFile))
+	errorOut = NonStopWriter(timestamped(NonStopWriter(os.Stderr, logFile)), recentErrors)
 	debugOut = timestamped(NonStopWriter(os.Stdout, logFile))
-	golog.SetOutputs(errorOut, debugOut)
+	golog.SetOutputs(errorOut, debugOutput())
 
 	return nil
 }
@@ -140,9 +140,9 @@ func Close() error {
 }
 
 func initLogging() {
-	errorOut = timestamped(os.Stderr)
+	errorOut = NonStopWriter(timestamped(os.Stderr), recentErrors)
 	debugOut = timestamped(os.Stdout)
-	golog.SetOutputs(errorOut, debugOut)
+	golog.SetOutputs(errorOut, debugOutput())
 }
 
 // timestamped adds a timestamp to the beginning of log lines
@@ -189,11 +189,11 @@ func enableLoggly(addrFN eventual.Getter, cloudConfigCA string, instanceId strin
 }
 
 func addLoggly(logglyWriter io.Writer) {
-	golog.SetOutputs(NonStopWriter(errorOut, logglyWriter), debugOut)
+	golog.SetOutputs(NonStopWriter(errorOut, logglyWriter), debugOutput())
 }
 
 func removeLoggly() {
-	golog.SetOutputs(errorOut, debugOut)
+	golog.SetOutputs(errorOut, debugOutput())
 }
 
 func isDuplicate(msg string) bool {
@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxRecentErrors is how many of the most recent errors we keep around.
+	maxRecentErrors = 20
+)
+
+var (
+	recentErrors = &recentErrorWriter{}
+)
+
+// RecentError is an error that was logged recently.
+type RecentError struct {
+	Time    time.Time
+	Message string
+}
+
+// RecentErrors returns the most recently logged errors, oldest first.
+func RecentErrors() []RecentError {
+	return recentErrors.get()
+}
+
+// recentErrorWriter is a writer that keeps the last maxRecentErrors lines
+// written to it in a ring buffer. golog always writes each line in whole, so
+// every write is one error.
+type recentErrorWriter struct {
+	mx     sync.Mutex
+	errors []RecentError
+	next   int
+}
+
+// Write implements the method from io.Writer.
+func (w *recentErrorWriter) Write(p []byte) (int, error) {
+	e := RecentError{Time: time.Now(), Message: strings.TrimSpace(string(p))}
+	w.mx.Lock()
+	if len(w.errors) < maxRecentErrors {
+		w.errors = append(w.errors, e)
+	} else {
+		w.errors[w.next] = e
+	}
+	w.next = (w.next + 1) % maxRecentErrors
+	w.mx.Unlock()
+	return len(p), nil
+}
+
+func (w *recentErrorWriter) get() []RecentError {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	result := make([]RecentError, 0, len(w.errors))
+	if len(w.errors) == maxRecentErrors {
+		result = append(result, w.errors[w.next:]...)
+		result = append(result, w.errors[:w.next]...)
+	} else {
+		result = append(result, w.errors...)
+	}
+	return result
+}
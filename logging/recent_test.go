@@ -0,0 +1,21 @@
+package logging
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentErrors(t *testing.T) {
+	w := &recentErrorWriter{}
+	assert.Empty(t, w.get())
+	for i := 0; i < maxRecentErrors+5; i++ {
+		fmt.Fprintf(w, "error %d\n", i)
+	}
+	errors := w.get()
+	if assert.Len(t, errors, maxRecentErrors) {
+		assert.Equal(t, "error 5", errors[0].Message, "Oldest errors should have been dropped")
+		assert.Equal(t, fmt.Sprintf("error %d", maxRecentErrors+4), errors[maxRecentErrors-1].Message)
+	}
+}
@@ -0,0 +1,433 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/getlantern/appdir"
+	ps "github.com/getlantern/proxiedsites"
+
+	"github.com/getlantern/flashlight"
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/logging"
+	"github.com/getlantern/flashlight/proxiedsites"
+	"github.com/getlantern/flashlight/ui"
+)
+
+const (
+	adminAPIPrefix = "/api/v1/"
+
+	// adminTokenFile is the file in the config directory that holds the token
+	// required to use the admin API. It's regenerated on every launch.
+	adminTokenFile = "admin-token"
+)
+
+// adminAPI is a JSON REST API for scripting the things that the UI can change.
+// It's served by the UI server and described by adminOpenAPI. All endpoints
+// other than the description itself require the token in adminTokenFile to be
+// passed as a bearer token.
+type adminAPI struct {
+	token    string
+	settings *Settings
+
+	activeDelta   func() *ps.Delta
+	applyDelta    func(*ps.Delta) error
+	serverStats   func() []*client.ServerStats
+	refreshConfig func() error
+	addrs         func() (httpAddr string, socksAddr string)
+	logLevel      func() string
+	setLogLevel   func(level string) error
+	dashboardURL  func() string
+	rejection     func() *flashlight.Rejection
+	quit          func()
+}
+
+type adminStatus struct {
+	Version        string `json:"version"`
+	HTTPAddr       string `json:"httpAddr"`
+	SOCKSAddr      string `json:"socksAddr"`
+	ProxyAll       bool   `json:"proxyAll"`
+	SystemProxy    bool   `json:"systemProxy"`
+	Servers        int    `json:"servers"`
+	FailingServers int    `json:"failingServers"`
+	LogLevel       string `json:"logLevel"`
+	DashboardURL   string `json:"dashboardURL,omitempty"`
+	// ConfigRejection is the last configuration that failed its connectivity
+	// probe, if any.
+	ConfigRejection *flashlight.Rejection `json:"configRejection,omitempty"`
+}
+
+type adminLogLevel struct {
+	Level string `json:"level"`
+}
+
+type adminSettings struct {
+	ProxyAll    bool `json:"proxyAll"`
+	SystemProxy bool `json:"systemProxy"`
+	AutoReport  bool `json:"autoReport"`
+}
+
+type adminSettingsPatch struct {
+	ProxyAll    *bool `json:"proxyAll"`
+	SystemProxy *bool `json:"systemProxy"`
+	AutoReport  *bool `json:"autoReport"`
+}
+
+type adminDelta struct {
+	Additions []string `json:"additions"`
+	Deletions []string `json:"deletions"`
+}
+
+type adminServer struct {
+	Addr           string     `json:"addr"`
+	Dials          int64      `json:"dials"`
+	DialFailures   int64      `json:"dialFailures"`
+	ConsecFailures int64      `json:"consecFailures"`
+	LastSuccess    *time.Time `json:"lastSuccess,omitempty"`
+	LastFailure    *time.Time `json:"lastFailure,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	BytesSent      int64      `json:"bytesSent"`
+	BytesReceived  int64      `json:"bytesReceived"`
+}
+
+type adminVersion struct {
+	Version        string `json:"version"`
+	PackageVersion string `json:"packageVersion"`
+	RevisionDate   string `json:"revisionDate"`
+	BuildDate      string `json:"buildDate"`
+}
+
+type adminError struct {
+	Error string `json:"error"`
+}
+
+// serveAdminAPI generates a new admin token and starts serving the admin API
+// under the UI server.
+func serveAdminAPI() error {
+	dir := *configdir
+	if dir == "" {
+		dir = appdir.General("Lantern")
+	}
+	token, err := newAdminToken(filepath.Join(dir, adminTokenFile))
+	if err != nil {
+		return err
+	}
+	url := ui.Handle(adminAPIPrefix, newAdminAPI(token, settings))
+	log.Debugf("Serving admin API at %v", url)
+	return nil
+}
+
+// newAdminToken generates a random token and writes it to the given path so
+// that local scripts can read it.
+func newAdminToken(path string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("Unable to generate admin token: %v", err)
+	}
+	token := hex.EncodeToString(b)
+	if err := ioutil.WriteFile(path, []byte(token), 0600); err != nil {
+		return "", fmt.Errorf("Unable to write admin token to %v: %v", path, err)
+	}
+	return token, nil
+}
+
+func newAdminAPI(token string, settings *Settings) *adminAPI {
+	return &adminAPI{
+		token:         token,
+		settings:      settings,
+		activeDelta:   proxiedsites.ActiveDelta,
+		applyDelta:    proxiedsites.Apply,
+		serverStats:   client.GetServerStats,
+		refreshConfig: config.Refresh,
+		addrs:         proxyAddrs,
+		logLevel:      logging.Level,
+		setLogLevel:   logging.SetLevel,
+		dashboardURL: func() string {
+			if dashboardURL == "" {
+				return ""
+			}
+			return ui.WithToken(dashboardURL)
+		},
+		rejection: flashlight.LastRejection,
+		quit: func() {
+			exit(nil)
+		},
+	}
+}
+
+// proxyAddrs returns the addresses at which the HTTP and SOCKS proxies are
+// listening, or blank addresses if they're not listening yet.
+func proxyAddrs() (string, string) {
+	var httpAddr, socksAddr string
+	if addr, ok := client.Addr(0); ok {
+		httpAddr = addr.(string)
+	}
+	if addr, ok := client.Socks5Addr(0); ok {
+		socksAddr = addr.(string)
+	}
+	return httpAddr, socksAddr
+}
+
+func (a *adminAPI) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	route := strings.TrimPrefix(req.URL.Path, adminAPIPrefix)
+	if route == "openapi.json" {
+		a.serveOpenAPI(resp, req)
+		return
+	}
+	if !a.authorized(req) {
+		resp.Header().Set("WWW-Authenticate", `Bearer realm="lantern"`)
+		a.writeError(resp, http.StatusUnauthorized, "Missing or invalid admin token")
+		return
+	}
+
+	switch route {
+	case "status":
+		a.serveStatus(resp, req)
+	case "version":
+		a.serveVersion(resp, req)
+	case "settings":
+		a.serveSettings(resp, req)
+	case "proxiedsites":
+		a.serveProxiedSites(resp, req)
+	case "servers":
+		a.serveServers(resp, req)
+	case "config/refresh":
+		a.serveRefresh(resp, req)
+	case "loglevel":
+		a.serveLogLevel(resp, req)
+	case "quit":
+		a.serveQuit(resp, req)
+	default:
+		a.writeError(resp, http.StatusNotFound, fmt.Sprintf("Unknown endpoint %v", req.URL.Path))
+	}
+}
+
+func (a *adminAPI) authorized(req *http.Request) bool {
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	token := strings.TrimPrefix(auth, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1
+}
+
+func (a *adminAPI) serveOpenAPI(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET") {
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	if _, err := resp.Write([]byte(adminOpenAPI)); err != nil {
+		log.Debugf("Unable to write OpenAPI description: %v", err)
+	}
+}
+
+func (a *adminAPI) serveStatus(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET") {
+		return
+	}
+	httpAddr, socksAddr := a.addrs()
+	status := &adminStatus{
+		Version:         flashlight.Version,
+		HTTPAddr:        httpAddr,
+		SOCKSAddr:       socksAddr,
+		ProxyAll:        a.settings.GetProxyAll(),
+		SystemProxy:     a.settings.GetSystemProxy(),
+		LogLevel:        a.logLevel(),
+		DashboardURL:    a.dashboardURL(),
+		ConfigRejection: a.rejection(),
+	}
+	for _, s := range a.serverStats() {
+		status.Servers++
+		if s.ConsecFailures > 0 {
+			status.FailingServers++
+		}
+	}
+	a.writeJSON(resp, http.StatusOK, status)
+}
+
+func (a *adminAPI) serveVersion(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET") {
+		return
+	}
+	a.writeJSON(resp, http.StatusOK, &adminVersion{
+		Version:        flashlight.Version,
+		PackageVersion: flashlight.PackageVersion,
+		RevisionDate:   flashlight.RevisionDate,
+		BuildDate:      flashlight.BuildDate,
+	})
+}
+
+func (a *adminAPI) serveSettings(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET", "PATCH") {
+		return
+	}
+	if req.Method == "PATCH" {
+		patch := &adminSettingsPatch{}
+		if !a.readJSON(resp, req, patch) {
+			return
+		}
+		if patch.ProxyAll != nil {
+			a.settings.SetProxyAll(*patch.ProxyAll)
+		}
+		if patch.SystemProxy != nil {
+			a.settings.SetSystemProxy(*patch.SystemProxy)
+		}
+		if patch.AutoReport != nil {
+			a.settings.SetAutoReport(*patch.AutoReport)
+		}
+	}
+	a.writeJSON(resp, http.StatusOK, &adminSettings{
+		ProxyAll:    a.settings.GetProxyAll(),
+		SystemProxy: a.settings.GetSystemProxy(),
+		AutoReport:  a.settings.IsAutoReport(),
+	})
+}
+
+func (a *adminAPI) serveProxiedSites(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET", "PATCH") {
+		return
+	}
+	if req.Method == "GET" {
+		delta := a.activeDelta()
+		result := &adminDelta{Additions: []string{}, Deletions: []string{}}
+		if delta != nil {
+			result.Additions = append(result.Additions, delta.Additions...)
+			result.Deletions = append(result.Deletions, delta.Deletions...)
+		}
+		a.writeJSON(resp, http.StatusOK, result)
+		return
+	}
+
+	patch := &adminDelta{}
+	if !a.readJSON(resp, req, patch) {
+		return
+	}
+	if len(patch.Additions) == 0 && len(patch.Deletions) == 0 {
+		a.writeError(resp, http.StatusBadRequest, "Delta must include additions or deletions")
+		return
+	}
+	if err := a.applyDelta(&ps.Delta{Additions: patch.Additions, Deletions: patch.Deletions}); err != nil {
+		a.writeError(resp, http.StatusInternalServerError, fmt.Sprintf("Unable to apply delta: %v", err))
+		return
+	}
+	// The delta takes effect once the updated config has been published
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+func (a *adminAPI) serveServers(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET") {
+		return
+	}
+	stats := a.serverStats()
+	servers := make([]*adminServer, 0, len(stats))
+	for _, s := range stats {
+		servers = append(servers, newAdminServer(s))
+	}
+	a.writeJSON(resp, http.StatusOK, servers)
+}
+
+// newAdminServer converts the given stats into their JSON representation,
+// leaving out the times of events that haven't happened yet.
+func newAdminServer(s *client.ServerStats) *adminServer {
+	server := &adminServer{
+		Addr:           s.Addr,
+		Dials:          s.Dials,
+		DialFailures:   s.DialFailures,
+		ConsecFailures: s.ConsecFailures,
+		LastError:      s.LastError,
+		BytesSent:      s.BytesSent,
+		BytesReceived:  s.BytesReceived,
+	}
+	if !s.LastSuccess.IsZero() {
+		lastSuccess := s.LastSuccess
+		server.LastSuccess = &lastSuccess
+	}
+	if !s.LastFailure.IsZero() {
+		lastFailure := s.LastFailure
+		server.LastFailure = &lastFailure
+	}
+	return server
+}
+
+func (a *adminAPI) serveRefresh(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "POST") {
+		return
+	}
+	if err := a.refreshConfig(); err != nil {
+		a.writeError(resp, http.StatusBadGateway, fmt.Sprintf("Unable to refresh config: %v", err))
+		return
+	}
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func (a *adminAPI) serveLogLevel(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "GET", "PUT") {
+		return
+	}
+	if req.Method == "PUT" {
+		level := &adminLogLevel{}
+		if !a.readJSON(resp, req, level) {
+			return
+		}
+		if err := a.setLogLevel(level.Level); err != nil {
+			a.writeError(resp, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	a.writeJSON(resp, http.StatusOK, &adminLogLevel{a.logLevel()})
+}
+
+func (a *adminAPI) serveQuit(resp http.ResponseWriter, req *http.Request) {
+	if !a.allowMethods(resp, req, "POST") {
+		return
+	}
+	resp.WriteHeader(http.StatusAccepted)
+	// Quit in the background so that the response makes it to the client
+	go a.quit()
+}
+
+func (a *adminAPI) allowMethods(resp http.ResponseWriter, req *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if req.Method == method {
+			return true
+		}
+	}
+	resp.Header().Set("Allow", strings.Join(methods, ", "))
+	a.writeError(resp, http.StatusMethodNotAllowed, fmt.Sprintf("Method %v not allowed", req.Method))
+	return false
+}
+
+func (a *adminAPI) readJSON(resp http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		a.writeError(resp, http.StatusBadRequest, fmt.Sprintf("Unable to parse JSON: %v", err))
+		return false
+	}
+	return true
+}
+
+func (a *adminAPI) writeJSON(resp http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Errorf("Unable to marshal admin API response: %v", err)
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(status)
+	if _, err := resp.Write(b); err != nil {
+		log.Debugf("Unable to write admin API response: %v", err)
+	}
+}
+
+func (a *adminAPI) writeError(resp http.ResponseWriter, status int, msg string) {
+	a.writeJSON(resp, status, &adminError{msg})
+}
@@ -0,0 +1,209 @@
+package main
+
+// adminOpenAPI is the OpenAPI description of adminAPI, served at
+// /api/v1/openapi.json.
+const adminOpenAPI = `{
+  "openapi": "3.0.0",
+  "info": {
+    "title": "Lantern admin API",
+    "description": "Local API for scripting the settings that the Lantern UI can change. Pass the token from the admin-token file in the Lantern config directory as a bearer token.",
+    "version": "1"
+  },
+  "servers": [{"url": "/api/v1"}],
+  "security": [{"token": []}],
+  "paths": {
+    "/status": {
+      "get": {
+        "summary": "Get an overview of the running instance",
+        "responses": {
+          "200": {"description": "The status", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Status"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/version": {
+      "get": {
+        "summary": "Get the version of Lantern",
+        "responses": {
+          "200": {"description": "The version", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Version"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/settings": {
+      "get": {
+        "summary": "Get settings",
+        "responses": {
+          "200": {"description": "The settings", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Settings"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      },
+      "patch": {
+        "summary": "Change settings",
+        "description": "Only the settings included in the request are changed.",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Settings"}}}},
+        "responses": {
+          "200": {"description": "The updated settings", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Settings"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/proxiedsites": {
+      "get": {
+        "summary": "List the user's changes to the proxied sites",
+        "description": "Additions are sites proxied in addition to the default list, deletions are sites from the default list that aren't proxied.",
+        "responses": {
+          "200": {"description": "The active delta", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Delta"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      },
+      "patch": {
+        "summary": "Change the proxied sites",
+        "description": "Merges the given delta into the user's changes. The change takes effect asynchronously.",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Delta"}}}},
+        "responses": {
+          "202": {"description": "The delta was accepted"},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/servers": {
+      "get": {
+        "summary": "Get the health of and traffic through the chained servers",
+        "responses": {
+          "200": {"description": "The servers", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Server"}}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/config/refresh": {
+      "post": {
+        "summary": "Check for an updated cloud config now",
+        "responses": {
+          "204": {"description": "The config was refreshed or is unchanged"},
+          "401": {"$ref": "#/components/responses/Unauthorized"},
+          "502": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/loglevel": {
+      "get": {
+        "summary": "Get the log level",
+        "responses": {
+          "200": {"description": "The log level", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/LogLevel"}}}},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      },
+      "put": {
+        "summary": "Set the log level",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/LogLevel"}}}},
+        "responses": {
+          "200": {"description": "The updated log level", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/LogLevel"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    },
+    "/quit": {
+      "post": {
+        "summary": "Quit Lantern",
+        "responses": {
+          "202": {"description": "Lantern is quitting"},
+          "401": {"$ref": "#/components/responses/Unauthorized"}
+        }
+      }
+    }
+  },
+  "components": {
+    "securitySchemes": {
+      "token": {"type": "http", "scheme": "bearer"}
+    },
+    "responses": {
+      "BadRequest": {"description": "The request was invalid", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "Unauthorized": {"description": "The admin token was missing or invalid", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "Error": {"description": "The operation failed", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    },
+    "schemas": {
+      "Status": {
+        "type": "object",
+        "properties": {
+          "version": {"type": "string"},
+          "httpAddr": {"type": "string", "description": "Address of the HTTP proxy, blank if not listening yet"},
+          "socksAddr": {"type": "string", "description": "Address of the SOCKS5 proxy, blank if not listening yet"},
+          "proxyAll": {"type": "boolean"},
+          "systemProxy": {"type": "boolean"},
+          "servers": {"type": "integer", "description": "Number of chained servers"},
+          "failingServers": {"type": "integer", "description": "Number of chained servers whose last dial failed"},
+          "logLevel": {"type": "string"},
+          "dashboardURL": {"type": "string", "description": "URL of the diagnostic dashboard including the session token, omitted if the dashboard isn't served"},
+          "configRejection": {"$ref": "#/components/schemas/Rejection"}
+        }
+      },
+      "Rejection": {
+        "type": "object",
+        "description": "The last configuration that failed its connectivity probe, omitted if none did since Lantern started",
+        "properties": {
+          "time": {"type": "string", "format": "date-time"},
+          "error": {"type": "string", "description": "Why the probe failed"},
+          "reverted": {"type": "boolean", "description": "Whether the previous, healthy configuration was restored"},
+          "configFile": {"type": "string"},
+          "cloudURL": {"type": "string", "description": "URL the configuration was fetched from, omitted if it wasn't fetched from the cloud"}
+        }
+      },
+      "LogLevel": {
+        "type": "object",
+        "properties": {
+          "level": {"type": "string", "enum": ["debug", "error"]}
+        }
+      },
+      "Version": {
+        "type": "object",
+        "properties": {
+          "version": {"type": "string"},
+          "packageVersion": {"type": "string"},
+          "revisionDate": {"type": "string"},
+          "buildDate": {"type": "string"}
+        }
+      },
+      "Settings": {
+        "type": "object",
+        "properties": {
+          "proxyAll": {"type": "boolean", "description": "Proxy all traffic rather than just the proxied sites"},
+          "systemProxy": {"type": "boolean", "description": "Set Lantern as the system proxy"},
+          "autoReport": {"type": "boolean", "description": "Report usage and errors"}
+        }
+      },
+      "Delta": {
+        "type": "object",
+        "properties": {
+          "additions": {"type": "array", "items": {"type": "string"}},
+          "deletions": {"type": "array", "items": {"type": "string"}}
+        }
+      },
+      "Server": {
+        "type": "object",
+        "properties": {
+          "addr": {"type": "string"},
+          "dials": {"type": "integer", "description": "Connections dialed through the server, including failed ones"},
+          "dialFailures": {"type": "integer"},
+          "consecFailures": {"type": "integer", "description": "Failed dials since the last successful one"},
+          "lastSuccess": {"type": "string", "format": "date-time"},
+          "lastFailure": {"type": "string", "format": "date-time"},
+          "lastError": {"type": "string"},
+          "bytesSent": {"type": "integer"},
+          "bytesReceived": {"type": "integer"}
+        }
+      },
+      "Error": {
+        "type": "object",
+        "properties": {
+          "error": {"type": "string"}
+        }
+      }
+    }
+  }
+}
+`
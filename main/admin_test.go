@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	ps "github.com/getlantern/proxiedsites"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+const testAdminToken = "test-token"
+
+func newTestAdminAPI(t *testing.T) (*adminAPI, func()) {
+	file, err := ioutil.TempFile("", "settings.yaml")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	oldPath := path
+	path = file.Name()
+	cleanup := func() {
+		path = oldPath
+		if err := os.Remove(file.Name()); err != nil {
+			log.Debugf("Unable to remove settings file: %v", err)
+		}
+	}
+
+	api := newAdminAPI(testAdminToken, &Settings{AutoReport: true, SystemProxy: true})
+	api.activeDelta = func() *ps.Delta {
+		return &ps.Delta{Additions: []string{"example.com"}}
+	}
+	api.applyDelta = func(delta *ps.Delta) error {
+		return nil
+	}
+	api.serverStats = func() []*client.ServerStats {
+		return []*client.ServerStats{&client.ServerStats{
+			Addr:        "1.2.3.4:443",
+			Dials:       3,
+			LastSuccess: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+			BytesSent:   1024,
+		}}
+	}
+	api.refreshConfig = func() error {
+		return nil
+	}
+	api.addrs = func() (string, string) {
+		return "127.0.0.1:8787", "127.0.0.1:8788"
+	}
+	level := "debug"
+	api.logLevel = func() string {
+		return level
+	}
+	api.setLogLevel = func(l string) error {
+		if l != "debug" && l != "error" {
+			return errors.New("Unknown log level")
+		}
+		level = l
+		return nil
+	}
+	api.dashboardURL = func() string {
+		return "http://127.0.0.1:16823/dashboard/?token=abc"
+	}
+	api.quit = func() {}
+	return api, cleanup
+}
+
+func doAdminRequest(api *adminAPI, method string, route string, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, "http://localhost"+adminAPIPrefix+route, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+	resp := httptest.NewRecorder()
+	api.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestAdminAPIRequiresToken(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	req, _ := http.NewRequest("GET", "http://localhost"+adminAPIPrefix+"version", nil)
+	resp := httptest.NewRecorder()
+	api.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code, "Missing token should be rejected")
+
+	req.Header.Set("Authorization", "Bearer wrong-token")
+	resp = httptest.NewRecorder()
+	api.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code, "Wrong token should be rejected")
+
+	resp = doAdminRequest(api, "GET", "version", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"version"`)
+}
+
+func TestAdminAPISettings(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	resp := doAdminRequest(api, "GET", "settings", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"proxyAll":false,"systemProxy":true,"autoReport":true}`, resp.Body.String())
+
+	resp = doAdminRequest(api, "PATCH", "settings", `{"proxyAll":true,"autoReport":false}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"proxyAll":true,"systemProxy":true,"autoReport":false}`, resp.Body.String())
+	assert.True(t, api.settings.GetProxyAll())
+
+	resp = doAdminRequest(api, "PATCH", "settings", `not json`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	resp = doAdminRequest(api, "DELETE", "settings", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	assert.Equal(t, "GET, PATCH", resp.Header().Get("Allow"))
+}
+
+func TestAdminAPIProxiedSites(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	resp := doAdminRequest(api, "GET", "proxiedsites", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"additions":["example.com"],"deletions":[]}`, resp.Body.String())
+
+	var applied *ps.Delta
+	api.applyDelta = func(delta *ps.Delta) error {
+		applied = delta
+		return nil
+	}
+	resp = doAdminRequest(api, "PATCH", "proxiedsites", `{"additions":["getlantern.org"],"deletions":["example.com"]}`)
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	if assert.NotNil(t, applied) {
+		assert.Equal(t, []string{"getlantern.org"}, applied.Additions)
+		assert.Equal(t, []string{"example.com"}, applied.Deletions)
+	}
+
+	resp = doAdminRequest(api, "PATCH", "proxiedsites", `{}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code, "Empty delta should be rejected")
+}
+
+func TestAdminAPIServers(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	resp := doAdminRequest(api, "GET", "servers", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[{"addr":"1.2.3.4:443","dials":3,"dialFailures":0,"consecFailures":0,"lastSuccess":"2016-01-01T00:00:00Z","bytesSent":1024,"bytesReceived":0}]`, resp.Body.String())
+}
+
+func TestAdminAPIRefresh(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	resp := doAdminRequest(api, "POST", "config/refresh", "")
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	api.refreshConfig = func() error {
+		return errors.New("Unable to fetch")
+	}
+	resp = doAdminRequest(api, "POST", "config/refresh", "")
+	assert.Equal(t, http.StatusBadGateway, resp.Code)
+	assert.Contains(t, resp.Body.String(), "Unable to fetch")
+
+	resp = doAdminRequest(api, "GET", "config/refresh", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+}
+
+func TestAdminAPIStatus(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	resp := doAdminRequest(api, "GET", "status", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var status adminStatus
+	if assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &status)) {
+		assert.Equal(t, "127.0.0.1:8787", status.HTTPAddr)
+		assert.Equal(t, "127.0.0.1:8788", status.SOCKSAddr)
+		assert.Equal(t, 1, status.Servers)
+		assert.Equal(t, 0, status.FailingServers)
+		assert.Equal(t, "debug", status.LogLevel)
+		assert.Equal(t, "http://127.0.0.1:16823/dashboard/?token=abc", status.DashboardURL)
+	}
+}
+
+func TestAdminAPILogLevelAndQuit(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	resp := doAdminRequest(api, "PUT", "loglevel", `{"level":"error"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"level":"error"}`, resp.Body.String())
+	resp = doAdminRequest(api, "PUT", "loglevel", `{"level":"chatty"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	quit := make(chan bool, 1)
+	api.quit = func() {
+		quit <- true
+	}
+	resp = doAdminRequest(api, "POST", "quit", "")
+	assert.Equal(t, http.StatusAccepted, resp.Code)
+	select {
+	case <-quit:
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Should have quit")
+	}
+}
+
+func TestAdminAPIOpenAPI(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+
+	req, _ := http.NewRequest("GET", "http://localhost"+adminAPIPrefix+"openapi.json", nil)
+	resp := httptest.NewRecorder()
+	api.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code, "Description should not require token")
+
+	var description struct {
+		Paths map[string]map[string]interface{}
+	}
+	if !assert.NoError(t, json.NewDecoder(bytes.NewReader(resp.Body.Bytes())).Decode(&description)) {
+		return
+	}
+	expected := map[string][]string{
+		"/status":         []string{"get"},
+		"/version":        []string{"get"},
+		"/settings":       []string{"get", "patch"},
+		"/proxiedsites":   []string{"get", "patch"},
+		"/servers":        []string{"get"},
+		"/config/refresh": []string{"post"},
+		"/loglevel":       []string{"get", "put"},
+		"/quit":           []string{"post"},
+	}
+	assert.Len(t, description.Paths, len(expected))
+	for path, methods := range expected {
+		for _, method := range methods {
+			_, found := description.Paths[path][method]
+			assert.True(t, found, "Missing %v %v", method, path)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/getlantern/appdir"
+)
+
+const ctlUsage = `Usage: flashlight ctl [flags] <command>
+
+Controls a running instance of Lantern through its admin API.
+
+Commands:
+  status                     show the status of the running instance
+  proxyall on|off            proxy all traffic or only the proxied sites
+  sysproxy on|off            set or unset Lantern as the system proxy
+  sites list                 list the user's changes to the proxied sites
+  sites add <site>...        proxy the given sites
+  sites remove <site>...     stop proxying the given sites
+  servers                    show the health of the chained servers
+  reload-config              check for an updated cloud config now
+  loglevel [debug|error]     show or set the log level
+  quit                       quit Lantern
+
+Flags:
+`
+
+// ctlCommand is a single request to the admin API along with a function that
+// prints a successful response in a human-readable form.
+type ctlCommand struct {
+	method string
+	route  string
+	body   interface{}
+	print  func(out io.Writer, body []byte) error
+}
+
+// runCtl runs the ctl command with the given arguments (excluding "ctl"
+// itself) and returns the exit status.
+func runCtl(args []string, out io.Writer, errOut io.Writer) int {
+	flags := flag.NewFlagSet("ctl", flag.ContinueOnError)
+	flags.SetOutput(errOut)
+	addr := flags.String("uiaddr", "127.0.0.1:16823", "host:port of the UI server of the running instance")
+	dir := flags.String("configdir", "", "config directory of the running instance, used to find the admin token")
+	jsonOutput := flags.Bool("json", false, "print the responses of the admin API as JSON")
+	flags.Usage = func() {
+		fmt.Fprint(errOut, ctlUsage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	cmd, err := parseCtlCommand(flags.Args())
+	if err != nil {
+		fmt.Fprintf(errOut, "%v\n\n", err)
+		flags.Usage()
+		return 2
+	}
+
+	tokenDir := *dir
+	if tokenDir == "" {
+		tokenDir = appdir.General("Lantern")
+	}
+	token, err := ioutil.ReadFile(filepath.Join(tokenDir, adminTokenFile))
+	if err != nil {
+		fmt.Fprintf(errOut, "Unable to read admin token, is Lantern running? %v\n", err)
+		return 1
+	}
+
+	body, err := doCtlRequest(*addr, strings.TrimSpace(string(token)), cmd)
+	if err != nil {
+		fmt.Fprintf(errOut, "%v\n", err)
+		return 1
+	}
+	if *jsonOutput {
+		err = printCtlJSON(out, body)
+	} else {
+		err = cmd.print(out, body)
+	}
+	if err != nil {
+		fmt.Fprintf(errOut, "Unable to print response: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func parseCtlCommand(args []string) (*ctlCommand, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("Missing command")
+	}
+	name, args := args[0], args[1:]
+	switch name {
+	case "status":
+		if len(args) == 0 {
+			return &ctlCommand{method: "GET", route: "status", print: printCtlStatus}, nil
+		}
+	case "proxyall", "sysproxy":
+		if len(args) == 1 && (args[0] == "on" || args[0] == "off") {
+			on := args[0] == "on"
+			patch := &adminSettingsPatch{}
+			if name == "proxyall" {
+				patch.ProxyAll = &on
+			} else {
+				patch.SystemProxy = &on
+			}
+			return &ctlCommand{method: "PATCH", route: "settings", body: patch, print: printCtlSettings}, nil
+		}
+	case "sites":
+		if len(args) == 1 && args[0] == "list" {
+			return &ctlCommand{method: "GET", route: "proxiedsites", print: printCtlSites}, nil
+		}
+		if len(args) > 1 && (args[0] == "add" || args[0] == "remove") {
+			delta := &adminDelta{}
+			if args[0] == "add" {
+				delta.Additions = args[1:]
+			} else {
+				delta.Deletions = args[1:]
+			}
+			return &ctlCommand{method: "PATCH", route: "proxiedsites", body: delta, print: printCtlMessage("Updated proxied sites")}, nil
+		}
+	case "servers":
+		if len(args) == 0 {
+			return &ctlCommand{method: "GET", route: "servers", print: printCtlServers}, nil
+		}
+	case "reload-config":
+		if len(args) == 0 {
+			return &ctlCommand{method: "POST", route: "config/refresh", print: printCtlMessage("Reloaded config")}, nil
+		}
+	case "loglevel":
+		if len(args) == 0 {
+			return &ctlCommand{method: "GET", route: "loglevel", print: printCtlLogLevel}, nil
+		}
+		if len(args) == 1 {
+			return &ctlCommand{method: "PUT", route: "loglevel", body: &adminLogLevel{Level: args[0]}, print: printCtlLogLevel}, nil
+		}
+	case "quit":
+		if len(args) == 0 {
+			return &ctlCommand{method: "POST", route: "quit", print: printCtlMessage("Lantern is quitting")}, nil
+		}
+	default:
+		return nil, fmt.Errorf("Unknown command %v", name)
+	}
+	return nil, fmt.Errorf("Invalid arguments for %v: %v", name, strings.Join(args, " "))
+}
+
+// doCtlRequest sends the given command to the admin API at addr and returns
+// the body of the response.
+func doCtlRequest(addr string, token string, cmd *ctlCommand) ([]byte, error) {
+	var reqBody io.Reader
+	if cmd.body != nil {
+		b, err := json.Marshal(cmd.body)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to encode request: %v", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(cmd.method, "http://"+addr+adminAPIPrefix+cmd.route, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to build request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// Never go through the system proxy, which may well be Lantern itself
+	hc := &http.Client{
+		Transport: &http.Transport{Proxy: nil},
+		Timeout:   10 * time.Second,
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to reach Lantern at %v, is it running? %v", addr, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Debugf("Unable to close response body: %v", err)
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read response: %v", err)
+	}
+	if resp.StatusCode >= 300 {
+		apiErr := &adminError{}
+		if json.Unmarshal(body, apiErr) == nil && apiErr.Error != "" {
+			return nil, fmt.Errorf("Lantern responded with %v: %v", resp.Status, apiErr.Error)
+		}
+		return nil, fmt.Errorf("Lantern responded with %v", resp.Status)
+	}
+	return body, nil
+}
+
+func printCtlJSON(out io.Writer, body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		return err
+	}
+	indented.WriteString("\n")
+	_, err := indented.WriteTo(out)
+	return err
+}
+
+func printCtlMessage(msg string) func(io.Writer, []byte) error {
+	return func(out io.Writer, body []byte) error {
+		_, err := fmt.Fprintln(out, msg)
+		return err
+	}
+}
+
+func printCtlStatus(out io.Writer, body []byte) error {
+	status := &adminStatus{}
+	if err := json.Unmarshal(body, status); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Version:\t%v\n", status.Version)
+	fmt.Fprintf(tw, "HTTP proxy:\t%v\n", orNone(status.HTTPAddr))
+	fmt.Fprintf(tw, "SOCKS proxy:\t%v\n", orNone(status.SOCKSAddr))
+	fmt.Fprintf(tw, "Proxy all:\t%v\n", onOff(status.ProxyAll))
+	fmt.Fprintf(tw, "System proxy:\t%v\n", onOff(status.SystemProxy))
+	fmt.Fprintf(tw, "Servers:\t%d (%d failing)\n", status.Servers, status.FailingServers)
+	fmt.Fprintf(tw, "Log level:\t%v\n", status.LogLevel)
+	if status.DashboardURL != "" {
+		fmt.Fprintf(tw, "Dashboard:\t%v\n", status.DashboardURL)
+	}
+	if r := status.ConfigRejection; r != nil {
+		source := r.ConfigFile
+		if r.CloudURL != "" {
+			source = r.CloudURL
+		}
+		outcome := "no working config to revert to"
+		if r.Reverted {
+			outcome = "reverted to the previous one"
+		}
+		fmt.Fprintf(tw, "Rejected:\tconfig from %v at %v, %v\n", source, r.Time.Local().Format(time.RFC3339), outcome)
+		fmt.Fprintf(tw, "Reason:\t%v\n", r.Error)
+	}
+	return tw.Flush()
+}
+
+func printCtlSettings(out io.Writer, body []byte) error {
+	s := &adminSettings{}
+	if err := json.Unmarshal(body, s); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "Proxy all: %v\nSystem proxy: %v\n", onOff(s.ProxyAll), onOff(s.SystemProxy))
+	return err
+}
+
+func printCtlSites(out io.Writer, body []byte) error {
+	delta := &adminDelta{}
+	if err := json.Unmarshal(body, delta); err != nil {
+		return err
+	}
+	if len(delta.Additions) == 0 && len(delta.Deletions) == 0 {
+		_, err := fmt.Fprintln(out, "No changes to the default proxied sites")
+		return err
+	}
+	if len(delta.Additions) > 0 {
+		fmt.Fprintln(out, "Added:")
+		for _, site := range delta.Additions {
+			fmt.Fprintf(out, "  %v\n", site)
+		}
+	}
+	if len(delta.Deletions) > 0 {
+		fmt.Fprintln(out, "Removed:")
+		for _, site := range delta.Deletions {
+			fmt.Fprintf(out, "  %v\n", site)
+		}
+	}
+	return nil
+}
+
+func printCtlServers(out io.Writer, body []byte) error {
+	var servers []*adminServer
+	if err := json.Unmarshal(body, &servers); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "ADDRESS\tDIALS\tFAILURES\tSENT\tRECEIVED\tLAST ERROR")
+	for _, s := range servers {
+		fmt.Fprintf(tw, "%v\t%d\t%d\t%d\t%d\t%v\n", s.Addr, s.Dials, s.DialFailures, s.BytesSent, s.BytesReceived, orNone(s.LastError))
+	}
+	return tw.Flush()
+}
+
+func printCtlLogLevel(out io.Writer, body []byte) error {
+	level := &adminLogLevel{}
+	if err := json.Unmarshal(body, level); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "Log level: %v\n", level.Level)
+	return err
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
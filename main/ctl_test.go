@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCtl(t *testing.T) {
+	api, cleanup := newTestAdminAPI(t)
+	defer cleanup()
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ctl")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Debugf("Unable to remove config dir: %v", err)
+		}
+	}()
+	if !assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, adminTokenFile), []byte(testAdminToken+"\n"), 0600)) {
+		return
+	}
+
+	addr := strings.TrimPrefix(server.URL, "http://")
+	ctl := func(args ...string) (int, string, string) {
+		var out, errOut bytes.Buffer
+		status := runCtl(append([]string{"-uiaddr", addr, "-configdir", dir}, args...), &out, &errOut)
+		return status, out.String(), errOut.String()
+	}
+
+	status, out, _ := ctl("status")
+	assert.Equal(t, 0, status)
+	assert.Contains(t, out, "HTTP proxy:    127.0.0.1:8787")
+	assert.Contains(t, out, "Servers:       1 (0 failing)")
+	assert.Contains(t, out, "Dashboard:     http://127.0.0.1:16823/dashboard/?token=abc")
+
+	status, out, _ = ctl("-json", "loglevel", "error")
+	assert.Equal(t, 0, status)
+	assert.JSONEq(t, `{"level":"error"}`, out)
+
+	status, out, _ = ctl("proxyall", "on")
+	assert.Equal(t, 0, status)
+	assert.Contains(t, out, "Proxy all: on")
+	assert.True(t, api.settings.GetProxyAll())
+
+	status, out, _ = ctl("sites", "list")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "Added:\n  example.com\n", out)
+
+	status, _, errOut := ctl("loglevel", "chatty")
+	assert.Equal(t, 1, status)
+	assert.Contains(t, errOut, "400 Bad Request")
+
+	status, _, errOut = ctl("proxyall", "maybe")
+	assert.Equal(t, 2, status, "Bad arguments should be a usage error")
+	assert.Contains(t, errOut, "Invalid arguments for proxyall")
+
+	status, _, errOut = runCtlWithoutToken(addr)
+	assert.Equal(t, 1, status)
+	assert.Contains(t, errOut, "Unable to read admin token")
+}
+
+func runCtlWithoutToken(addr string) (int, string, string) {
+	var out, errOut bytes.Buffer
+	status := runCtl([]string{"-uiaddr", addr, "-configdir", filepath.Join(os.TempDir(), "nonexistent-lantern-dir"), "status"}, &out, &errOut)
+	return status, out.String(), errOut.String()
+}
+
+func TestPrintCtlStatusRejection(t *testing.T) {
+	var out bytes.Buffer
+	body := `{"version":"2.1.0","configRejection":{"time":"2016-01-01T12:00:00Z","error":"probe failed","reverted":true,"configFile":"lantern-2.1.0.yaml","cloudURL":"https://config.getiantem.org/cloud.yaml.gz"}}`
+	if !assert.NoError(t, printCtlStatus(&out, []byte(body))) {
+		return
+	}
+	assert.Contains(t, out.String(), "config from https://config.getiantem.org/cloud.yaml.gz at ")
+	assert.Contains(t, out.String(), "reverted to the previous one")
+	assert.Contains(t, out.String(), "Reason:        probe failed")
+
+	out.Reset()
+	if assert.NoError(t, printCtlStatus(&out, []byte(`{"version":"2.1.0"}`))) {
+		assert.NotContains(t, out.String(), "Rejected:")
+	}
+}
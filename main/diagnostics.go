@@ -0,0 +1,187 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/getlantern/flashlight"
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/dashboard"
+	"github.com/getlantern/flashlight/geolookup"
+	"github.com/getlantern/flashlight/logging"
+	"github.com/getlantern/flashlight/proxiedsites"
+	"github.com/getlantern/flashlight/ui"
+)
+
+const (
+	diagnosticsMessageType = `Diagnostics`
+
+	// diagnosticsInterval is how often we push a new snapshot to the UI.
+	diagnosticsInterval = 2 * time.Second
+)
+
+var (
+	// dashboardURL is the URL of the dashboard without the session token,
+	// blank until it's served.
+	dashboardURL string
+)
+
+// diagnostics publishes snapshots of Lantern's state to the UI for the
+// built-in dashboard. The Settings and ProxiedSites services provide the rest.
+type diagnostics struct {
+	started      time.Time
+	addrs        func() (httpAddr string, socksAddr string)
+	uiAddr       func() string
+	origin       func() config.Origin
+	serverStats  func() []*client.ServerStats
+	ip           func() string
+	country      func() string
+	siteCount    func() int
+	recentErrors func() []logging.RecentError
+	rejection    func() *flashlight.Rejection
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+type diagnosticsSnapshot struct {
+	Version       string                `json:"version"`
+	Uptime        int64                 `json:"uptime"`
+	HTTPAddr      string                `json:"httpAddr"`
+	SOCKSAddr     string                `json:"socksAddr"`
+	UIAddr        string                `json:"uiAddr"`
+	Config        *diagnosticsConfig    `json:"config"`
+	Servers       []*adminServer        `json:"servers"`
+	IP            string                `json:"ip"`
+	Country       string                `json:"country"`
+	BytesSent     int64                 `json:"bytesSent"`
+	BytesReceived int64                 `json:"bytesReceived"`
+	ProxiedSites  int                   `json:"proxiedSites"`
+	RecentErrors  []logging.RecentError `json:"recentErrors"`
+	// ConfigRejection is the last configuration that failed its connectivity
+	// probe, if any.
+	ConfigRejection *flashlight.Rejection `json:"configRejection,omitempty"`
+}
+
+type diagnosticsConfig struct {
+	File        string     `json:"file"`
+	Sticky      bool       `json:"sticky"`
+	CloudURL    string     `json:"cloudURL,omitempty"`
+	LastChecked *time.Time `json:"lastChecked,omitempty"`
+	LastChanged *time.Time `json:"lastChanged,omitempty"`
+	// Age is the number of seconds since the config last changed.
+	Age int64 `json:"age"`
+}
+
+func newDiagnostics() *diagnostics {
+	return &diagnostics{
+		started:     time.Now(),
+		addrs:       proxyAddrs,
+		uiAddr:      func() string { return client.UIAddr },
+		origin:      config.CurrentOrigin,
+		serverStats: client.GetServerStats,
+		ip: func() string {
+			return geolookup.GetIP(0)
+		},
+		country: func() string {
+			return geolookup.GetCountry(0)
+		},
+		siteCount:    proxiedsites.Count,
+		recentErrors: logging.RecentErrors,
+		rejection:    flashlight.LastRejection,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// serveDashboard starts publishing diagnostics to the UI and serves the
+// dashboard that shows them.
+func serveDashboard() error {
+	d := newDiagnostics()
+	if err := d.start(); err != nil {
+		return err
+	}
+	addExitFunc(d.stop)
+	dashboardURL = ui.HandlePage("/dashboard/", dashboard.Handler())
+	log.Debugf("Serving dashboard at %v", dashboardURL)
+	return nil
+}
+
+func (d *diagnostics) start() error {
+	helloFn := func(write func(interface{}) error) error {
+		return write(d.snapshot())
+	}
+	service, err := ui.Register(diagnosticsMessageType, nil, helloFn)
+	if err != nil {
+		return err
+	}
+	service.SetQueuePolicy(ui.Coalesce)
+
+	go func() {
+		ticker := time.NewTicker(diagnosticsInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.stopCh:
+				return
+			case <-ticker.C:
+				select {
+				case service.Out <- d.snapshot():
+				case <-d.stopCh:
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (d *diagnostics) stop() {
+	d.stopOnce.Do(func() {
+		close(d.stopCh)
+		ui.Unregister(diagnosticsMessageType)
+	})
+}
+
+func (d *diagnostics) snapshot() *diagnosticsSnapshot {
+	now := time.Now()
+	httpAddr, socksAddr := d.addrs()
+	snapshot := &diagnosticsSnapshot{
+		Version:         flashlight.Version,
+		Uptime:          int64(now.Sub(d.started).Seconds()),
+		HTTPAddr:        httpAddr,
+		SOCKSAddr:       socksAddr,
+		UIAddr:          d.uiAddr(),
+		Config:          newDiagnosticsConfig(d.origin(), now),
+		Servers:         []*adminServer{},
+		IP:              d.ip(),
+		Country:         d.country(),
+		ProxiedSites:    d.siteCount(),
+		RecentErrors:    d.recentErrors(),
+		ConfigRejection: d.rejection(),
+	}
+	for _, s := range d.serverStats() {
+		snapshot.Servers = append(snapshot.Servers, newAdminServer(s))
+		snapshot.BytesSent += s.BytesSent
+		snapshot.BytesReceived += s.BytesReceived
+	}
+	return snapshot
+}
+
+func newDiagnosticsConfig(origin config.Origin, now time.Time) *diagnosticsConfig {
+	cfg := &diagnosticsConfig{
+		File:     origin.FilePath,
+		Sticky:   origin.Sticky,
+		CloudURL: origin.CloudURL,
+	}
+	if !origin.LastChecked.IsZero() {
+		lastChecked := origin.LastChecked
+		cfg.LastChecked = &lastChecked
+	}
+	if !origin.LastChanged.IsZero() {
+		lastChanged := origin.LastChanged
+		cfg.LastChanged = &lastChanged
+		cfg.Age = int64(now.Sub(lastChanged).Seconds())
+	}
+	return cfg
+}
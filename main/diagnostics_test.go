@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight"
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/logging"
+)
+
+func TestDiagnosticsSnapshot(t *testing.T) {
+	changed := time.Now().Add(-1 * time.Hour)
+	d := newDiagnostics()
+	d.addrs = func() (string, string) {
+		return "127.0.0.1:8787", "127.0.0.1:8788"
+	}
+	d.uiAddr = func() string {
+		return "127.0.0.1:16823"
+	}
+	d.origin = func() config.Origin {
+		return config.Origin{FilePath: "lantern.yaml", LastChanged: changed}
+	}
+	d.serverStats = func() []*client.ServerStats {
+		return []*client.ServerStats{
+			&client.ServerStats{Addr: "1.2.3.4:443", BytesSent: 1024, BytesReceived: 4096},
+			&client.ServerStats{Addr: "5.6.7.8:443", BytesSent: 1, BytesReceived: 2, ConsecFailures: 3},
+		}
+	}
+	d.ip = func() string {
+		return "9.9.9.9"
+	}
+	d.country = func() string {
+		return "DE"
+	}
+	d.siteCount = func() int {
+		return 42
+	}
+	d.recentErrors = func() []logging.RecentError {
+		return []logging.RecentError{logging.RecentError{Time: changed, Message: "oops"}}
+	}
+	d.rejection = func() *flashlight.Rejection {
+		return &flashlight.Rejection{Time: changed, Error: "Unable to reach target", ConfigFile: "lantern.yaml"}
+	}
+
+	s := d.snapshot()
+	assert.Equal(t, "127.0.0.1:8787", s.HTTPAddr)
+	assert.Equal(t, "127.0.0.1:8788", s.SOCKSAddr)
+	assert.Equal(t, "127.0.0.1:16823", s.UIAddr)
+	assert.Equal(t, "lantern.yaml", s.Config.File)
+	assert.Nil(t, s.Config.LastChecked, "Config that was never checked should have no check time")
+	assert.InDelta(t, 3600, s.Config.Age, 5)
+	assert.Len(t, s.Servers, 2)
+	assert.EqualValues(t, 1025, s.BytesSent)
+	assert.EqualValues(t, 4098, s.BytesReceived)
+	assert.Equal(t, "9.9.9.9", s.IP)
+	assert.Equal(t, "DE", s.Country)
+	assert.Equal(t, 42, s.ProxiedSites)
+	assert.Len(t, s.RecentErrors, 1)
+	if assert.NotNil(t, s.ConfigRejection) {
+		assert.Equal(t, "Unable to reach target", s.ConfigRejection.Error)
+	}
+}
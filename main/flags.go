@@ -1,6 +1,9 @@
 package main
 
-import "flag"
+import (
+	"flag"
+	"time"
+)
 
 var (
 	addr               = flag.String("addr", "", "ip:port on which to listen for requests. When running as a client proxy, we'll listen with http, when running as a server proxy we'll listen with https (required)")
@@ -21,6 +24,12 @@ var (
 	pprofAddr          = flag.String("pprofaddr", "", "pprof address to listen on, not activate pprof if empty")
 	forceProxyAddr     = flag.String("force-proxy-addr", "", "if specified, force chained proxying to use this address instead of the configured one")
 	forceAuthToken     = flag.String("force-auth-token", "", "if specified, force chained proxying to use this auth token instead of the configured one")
+	overrides          = flag.String("overrides", "", "optional path to a YAML file whose values override the persisted config (defaults to lantern-overrides.yaml in the config directory)")
+	printConfig        = flag.Bool("print-config", false, "print the effective configuration, including the source of each value, and exit")
+	decryptFile        = flag.String("decrypt", "", "if specified, print the config or settings file at this path with its secrets decrypted and exit (for support cases)")
+	validateConfig     = flag.String("validate-config", "", "if specified, validate the config file at this path, print any problems and exit")
+	learnedHostsTTL    = flag.Duration("learned-hosts-ttl", 7*24*time.Hour, "how long to remember that a site was reached directly or found to be blocked after it was last seen")
+	wpad               = flag.Bool("wpad", false, "if true, serve the PAC file at /wpad.dat for devices on the LAN that discover their proxy settings with WPAD, with our LAN address instead of localhost. Requires -headless, since the UI server only serves the LAN then, and -addr with an address on the LAN. Devices that look up http://wpad/wpad.dat fetch it from port 80, so either use -uiaddr :80 or point them at the file with DHCP.")
 	help               = flag.Bool("help", false, "Get usage help")
 )
 
@@ -0,0 +1,428 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/getlantern/appdir"
+	"github.com/getlantern/detour"
+	"github.com/getlantern/proxiedsites"
+	"github.com/getlantern/yaml"
+
+	"github.com/getlantern/flashlight/client"
+	fproxiedsites "github.com/getlantern/flashlight/proxiedsites"
+	"github.com/getlantern/flashlight/ui"
+)
+
+const (
+	learnedHostsMessageType = `LearnedHosts`
+	learnedHostsFile        = "learnedhosts.yaml"
+
+	// learnedHostsCheckInterval is how often learned hosts are expired and
+	// saved. Newly learned hosts are only saved then too, so that a burst of
+	// them doesn't rewrite the file for every one.
+	learnedHostsCheckInterval = 1 * time.Hour
+
+	listDirect  = "direct"
+	listBlocked = "blocked"
+)
+
+var (
+	learned *learnedHosts
+
+	learnedHostsService *ui.Service
+)
+
+// learnedHosts remembers what detour learned about which sites can be reached
+// directly and which ones are blocked, so that it isn't lost on restart.
+// Direct hosts are recorded by host since that's how the PAC file matches
+// them, blocked ones by address since that's how detour tracks them.
+type learnedHosts struct {
+	Direct  map[string]*learnedHost
+	Blocked map[string]*learnedHost
+
+	path string
+	// ttl is how long a host is remembered after it was last seen
+	ttl time.Duration
+	// whitelisted are the blocked addresses that we put on detour's whitelist
+	// at startup. Detour reports every dial to them as detoured without
+	// checking whether they're still blocked, so seeing them doesn't count.
+	whitelisted map[string]bool
+	// dirty is set when there are changes that haven't been saved
+	dirty  bool
+	saveMx sync.Mutex
+	mx     sync.RWMutex
+}
+
+// learnedHost records when a host was learned. Times are in seconds since the
+// epoch.
+type learnedHost struct {
+	FirstSeen int64
+	LastSeen  int64
+	// Pinned hosts were promoted by the user and never expire.
+	Pinned bool
+}
+
+// learnedHostEntry describes a learned host to the UI.
+type learnedHostEntry struct {
+	Host      string `json:"host"`
+	FirstSeen int64  `json:"firstSeen"`
+	LastSeen  int64  `json:"lastSeen"`
+	// Expires is when the host will be forgotten unless it's seen again, 0
+	// for never.
+	Expires int64 `json:"expires"`
+	Pinned  bool  `json:"pinned"`
+}
+
+// learnedHostsList is the message with all learned hosts sent to the UI.
+type learnedHostsList struct {
+	Direct  []*learnedHostEntry `json:"direct"`
+	Blocked []*learnedHostEntry `json:"blocked"`
+}
+
+// learnedHostRequest is a message from the UI to promote or demote a learned
+// host. Promoting a direct host keeps it forever, promoting a blocked one
+// adds it to the proxied sites. Demoting a host forgets it, so that detour
+// checks it again.
+type learnedHostRequest struct {
+	Host   string `json:"host"`
+	List   string `json:"list"`
+	Action string `json:"action"`
+}
+
+// loadLearnedHosts loads the hosts learned in earlier runs from the given path
+// and forgets the ones that have expired.
+func loadLearnedHosts(path string, ttl time.Duration) *learnedHosts {
+	l := &learnedHosts{
+		Direct:      make(map[string]*learnedHost),
+		Blocked:     make(map[string]*learnedHost),
+		path:        path,
+		ttl:         ttl,
+		whitelisted: make(map[string]bool),
+	}
+	if bytes, err := ioutil.ReadFile(path); err != nil {
+		log.Debugf("Could not read learned hosts: %v", err)
+	} else if err := yaml.Unmarshal(bytes, l); err != nil {
+		log.Errorf("Could not load learned hosts from %v: %v", path, err)
+	} else {
+		log.Debugf("Loaded learned hosts from %v", path)
+	}
+	if l.Direct == nil {
+		l.Direct = make(map[string]*learnedHost)
+	}
+	if l.Blocked == nil {
+		l.Blocked = make(map[string]*learnedHost)
+	}
+	l.expire(time.Now())
+	return l
+}
+
+// startLearnedHosts loads the learned hosts from the config directory, tells
+// detour about the blocked ones and starts recording what detour learns.
+func startLearnedHosts(ttl time.Duration) error {
+	dir := *configdir
+	if dir == "" {
+		dir = appdir.General("Lantern")
+	}
+	learned = loadLearnedHosts(filepath.Join(dir, learnedHostsFile), ttl)
+	for _, addr := range learned.whitelist() {
+		detour.AddToWl(addr, false)
+	}
+	addExitFunc(learned.save)
+
+	helloFn := func(write func(interface{}) error) error {
+		return write(learned.list())
+	}
+	newMessage := func() interface{} {
+		return &learnedHostRequest{}
+	}
+	var err error
+	learnedHostsService, err = ui.RegisterHandler(learnedHostsMessageType, newMessage, helloFn, handleLearnedHost)
+	if err != nil {
+		return fmt.Errorf("Unable to register learned hosts service: %v", err)
+	}
+	learnedHostsService.SetQueuePolicy(ui.Coalesce)
+
+	detoured := make(chan string, 100)
+	client.OnDetour(func(addr string) {
+		select {
+		case detoured <- addr:
+		default:
+			// Don't hold up dialing, we'll see the address again
+		}
+	})
+	go watchLearnedHosts(detoured)
+	return nil
+}
+
+// watchLearnedHosts records the hosts that detour reaches directly or
+// detours, and periodically forgets the ones that weren't seen for a while.
+func watchLearnedHosts(detoured <-chan string) {
+	check := time.NewTicker(learnedHostsCheckInterval)
+	for {
+		var changed bool
+		select {
+		case addr := <-detour.DirectAddrCh:
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				log.Errorf("watchLearnedHosts() got malformed host:port pair %v", addr)
+				continue
+			}
+			changed = learned.observe(listDirect, host, time.Now())
+		case addr := <-detoured:
+			changed = learned.observe(listBlocked, addr, time.Now())
+		case <-check.C:
+			changed = learned.expire(time.Now())
+			learned.save()
+		}
+		if changed {
+			learned.publish()
+			// The PAC file lists both the direct and the blocked hosts
+			cyclePAC()
+		}
+	}
+}
+
+// observe records that the given host was seen on the given list. It reports
+// whether that changed the lists, as opposed to just refreshing the host.
+// Seeing a host on one list removes it from the other one.
+func (l *learnedHosts) observe(list string, host string, now time.Time) (changed bool) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	if list == listBlocked && l.whitelisted[host] {
+		// Only detour's whitelist, not the site, says that it's blocked
+		return false
+	}
+	l.dirty = true
+	hosts := l.Direct
+	if list == listDirect {
+		// The site isn't blocked (anymore)
+		for addr := range l.Blocked {
+			if hostOf(addr) == host {
+				delete(l.Blocked, addr)
+				changed = true
+			}
+		}
+	} else {
+		hosts = l.Blocked
+		// Detoured hosts are no longer bypassed in the PAC file
+		if _, found := l.Direct[hostOf(host)]; found {
+			delete(l.Direct, hostOf(host))
+			changed = true
+		}
+	}
+	if h := hosts[host]; h != nil {
+		h.LastSeen = now.Unix()
+		return changed
+	}
+	log.Debugf("Learned %v host %v", list, host)
+	hosts[host] = &learnedHost{FirstSeen: now.Unix(), LastSeen: now.Unix()}
+	return true
+}
+
+// expire forgets the hosts that weren't seen within the TTL. It reports
+// whether it forgot any hosts. Forgotten addresses that we whitelisted are
+// removed from detour's whitelist, so that detour checks whether they're still
+// blocked.
+func (l *learnedHosts) expire(now time.Time) (changed bool) {
+	l.mx.Lock()
+	changed = l.expireFrom(l.Direct, now)
+	if l.expireFrom(l.Blocked, now) {
+		changed = true
+	}
+	var unlisted []string
+	for addr := range l.whitelisted {
+		if l.Blocked[addr] == nil {
+			delete(l.whitelisted, addr)
+			unlisted = append(unlisted, addr)
+		}
+	}
+	l.mx.Unlock()
+	for _, addr := range unlisted {
+		detour.RemoveFromWl(addr)
+	}
+	return changed
+}
+
+func (l *learnedHosts) expireFrom(hosts map[string]*learnedHost, now time.Time) bool {
+	expired := false
+	for host, h := range hosts {
+		if !h.Pinned && now.Sub(time.Unix(h.LastSeen, 0)) >= l.ttl {
+			log.Debugf("Forgetting learned host %v", host)
+			delete(hosts, host)
+			l.dirty = true
+			expired = true
+		}
+	}
+	return expired
+}
+
+// whitelist marks the blocked addresses as whitelisted by us and returns them.
+func (l *learnedHosts) whitelist() []string {
+	addrs := l.hosts(listBlocked)
+	l.mx.Lock()
+	for _, addr := range addrs {
+		l.whitelisted[addr] = true
+	}
+	l.mx.Unlock()
+	return addrs
+}
+
+// hosts returns the sorted hosts on the given list.
+func (l *learnedHosts) hosts(list string) []string {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+	hosts := l.Direct
+	if list == listBlocked {
+		hosts = l.Blocked
+	}
+	result := make([]string, 0, len(hosts))
+	for host := range hosts {
+		result = append(result, host)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (l *learnedHosts) list() *learnedHostsList {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+	return &learnedHostsList{
+		Direct:  l.entries(l.Direct),
+		Blocked: l.entries(l.Blocked),
+	}
+}
+
+func (l *learnedHosts) entries(hosts map[string]*learnedHost) []*learnedHostEntry {
+	entries := make([]*learnedHostEntry, 0, len(hosts))
+	for host, h := range hosts {
+		entry := &learnedHostEntry{
+			Host:      host,
+			FirstSeen: h.FirstSeen,
+			LastSeen:  h.LastSeen,
+			Pinned:    h.Pinned,
+		}
+		if !h.Pinned {
+			entry.Expires = time.Unix(h.LastSeen, 0).Add(l.ttl).Unix()
+		}
+		entries = append(entries, entry)
+	}
+	sort.Sort(byHost(entries))
+	return entries
+}
+
+type byHost []*learnedHostEntry
+
+func (a byHost) Len() int           { return len(a) }
+func (a byHost) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byHost) Less(i, j int) bool { return a[i].Host < a[j].Host }
+
+// handleLearnedHost promotes or demotes a learned host at the request of the
+// UI, which gets the updated list of hosts afterwards.
+func handleLearnedHost(msg interface{}) (interface{}, error) {
+	req := msg.(*learnedHostRequest)
+	if req.List != listDirect && req.List != listBlocked {
+		return nil, fmt.Errorf("Unknown list %q", req.List)
+	}
+	if err := learned.apply(req); err != nil {
+		return nil, err
+	}
+	learned.save()
+	learned.publish()
+	cyclePAC()
+	return nil, nil
+}
+
+func (l *learnedHosts) apply(req *learnedHostRequest) error {
+	l.mx.Lock()
+	hosts := l.Direct
+	if req.List == listBlocked {
+		hosts = l.Blocked
+	}
+	h := hosts[req.Host]
+	if h == nil {
+		l.mx.Unlock()
+		return fmt.Errorf("%v isn't a learned %v host", req.Host, req.List)
+	}
+	switch req.Action {
+	case "promote":
+		if req.List == listDirect {
+			log.Debugf("Keeping direct host %v", req.Host)
+			h.Pinned = true
+			l.dirty = true
+			l.mx.Unlock()
+			return nil
+		}
+		// Proxied sites are proxied from the start, without detour
+		delete(hosts, req.Host)
+		delete(l.whitelisted, req.Host)
+		l.dirty = true
+		l.mx.Unlock()
+		site := req.Host
+		if host, port, err := net.SplitHostPort(req.Host); err == nil && (port == "80" || port == "443") {
+			site = host
+		}
+		log.Debugf("Adding blocked host %v to proxied sites as %v", req.Host, site)
+		if err := fproxiedsites.Apply(&proxiedsites.Delta{Additions: []string{site}}); err != nil {
+			return fmt.Errorf("Unable to proxy %v: %v", site, err)
+		}
+		return nil
+	case "demote":
+		log.Debugf("Forgetting %v host %v", req.List, req.Host)
+		delete(hosts, req.Host)
+		if req.List == listBlocked {
+			delete(l.whitelisted, req.Host)
+		}
+		l.dirty = true
+		l.mx.Unlock()
+		if req.List == listBlocked {
+			detour.RemoveFromWl(req.Host)
+		}
+		return nil
+	}
+	l.mx.Unlock()
+	return fmt.Errorf("Unknown action %q", req.Action)
+}
+
+// save writes the learned hosts to disk if they changed.
+func (l *learnedHosts) save() {
+	l.saveMx.Lock()
+	defer l.saveMx.Unlock()
+	l.mx.Lock()
+	if !l.dirty {
+		l.mx.Unlock()
+		return
+	}
+	bytes, err := yaml.Marshal(l)
+	l.dirty = false
+	l.mx.Unlock()
+	if err != nil {
+		log.Errorf("Could not create yaml from learned hosts: %v", err)
+	} else if err := ioutil.WriteFile(l.path, bytes, 0600); err != nil {
+		log.Errorf("Could not write learned hosts file: %v", err)
+	} else if err := os.Chmod(l.path, 0600); err != nil {
+		log.Errorf("Could not restrict permissions on learned hosts file: %v", err)
+	}
+}
+
+func (l *learnedHosts) publish() {
+	if learnedHostsService != nil {
+		learnedHostsService.Out <- l.list()
+	}
+}
+
+// hostOf returns the host of an address, or the address itself if it has no
+// port.
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
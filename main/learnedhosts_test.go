@@ -0,0 +1,78 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLearnedHosts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "learnedhosts")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, learnedHostsFile)
+
+	now := time.Now()
+	l := loadLearnedHosts(path, time.Hour)
+	assert.True(t, l.observe(listDirect, "direct.com", now))
+	assert.False(t, l.observe(listDirect, "direct.com", now.Add(time.Minute)), "Seeing a host again should only refresh it")
+	l.observe(listDirect, "old.com", now.Add(-50*time.Minute))
+	l.observe(listDirect, "blocked.com", now)
+	assert.True(t, l.observe(listBlocked, "blocked.com:443", now))
+	assert.Equal(t, []string{"direct.com", "old.com"}, l.hosts(listDirect), "Blocked host should no longer be direct")
+	assert.Equal(t, []string{"blocked.com:443"}, l.hosts(listBlocked))
+	l.save()
+
+	// Reloading forgets hosts that weren't seen within the TTL
+	l = loadLearnedHosts(path, 45*time.Minute)
+	assert.Equal(t, []string{"direct.com"}, l.hosts(listDirect))
+	assert.Equal(t, []string{"blocked.com:443"}, l.hosts(listBlocked))
+	list := l.list()
+	if assert.Len(t, list.Direct, 1) {
+		assert.Equal(t, now.Unix(), list.Direct[0].FirstSeen)
+		assert.Equal(t, now.Add(time.Minute).Add(45*time.Minute).Unix(), list.Direct[0].Expires)
+	}
+
+	assert.NoError(t, l.apply(&learnedHostRequest{Host: "direct.com", List: listDirect, Action: "promote"}))
+	assert.NoError(t, l.apply(&learnedHostRequest{Host: "blocked.com:443", List: listBlocked, Action: "demote"}))
+	assert.Error(t, l.apply(&learnedHostRequest{Host: "unknown.com", List: listDirect, Action: "promote"}))
+	assert.False(t, l.expire(now.Add(24*time.Hour)), "Promoted host should never expire")
+	assert.Equal(t, []string{"direct.com"}, l.hosts(listDirect))
+	assert.Empty(t, l.hosts(listBlocked))
+
+	l.observe(listDirect, "blocked.com", now)
+	l.observe(listBlocked, "blocked.com:80", now)
+	l.observe(listDirect, "blocked.com", now)
+	assert.Empty(t, l.hosts(listBlocked), "Host reached directly should no longer be blocked")
+}
+
+func TestWhitelistedHostsExpire(t *testing.T) {
+	dir, err := ioutil.TempDir("", "learnedhosts")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, learnedHostsFile)
+
+	now := time.Now()
+	l := loadLearnedHosts(path, time.Hour)
+	l.observe(listBlocked, "blocked.com:443", now)
+	l.save()
+
+	l = loadLearnedHosts(path, time.Hour)
+	assert.Equal(t, []string{"blocked.com:443"}, l.whitelist())
+	assert.False(t, l.observe(listBlocked, "blocked.com:443", now.Add(30*time.Minute)))
+	assert.False(t, l.dirty, "Dialing a whitelisted address shouldn't refresh it")
+	assert.True(t, l.expire(now.Add(time.Hour)), "Whitelisted address should expire when it was last seen before startup")
+	assert.Empty(t, l.hosts(listBlocked))
+	assert.Empty(t, l.whitelisted)
+
+	assert.True(t, l.observe(listBlocked, "blocked.com:443", now.Add(2*time.Hour)), "Address detoured again after expiring should be learned again")
+	assert.Equal(t, now.Add(2*time.Hour).Unix(), l.Blocked["blocked.com:443"].FirstSeen)
+}
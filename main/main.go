@@ -4,6 +4,8 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math/rand"
 	"net/http"
 	_ "net/http/pprof"
@@ -13,6 +15,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/getlantern/appdir"
 	"github.com/getlantern/eventual"
 	"github.com/getlantern/golog"
 	"github.com/getlantern/i18n"
@@ -23,8 +26,11 @@ import (
 	"github.com/getlantern/flashlight/autoupdate"
 	"github.com/getlantern/flashlight/client"
 	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/geolookup"
 	"github.com/getlantern/flashlight/logging"
 	"github.com/getlantern/flashlight/proxiedsites"
+	"github.com/getlantern/flashlight/secret"
+	"github.com/getlantern/flashlight/status"
 	"github.com/getlantern/flashlight/ui"
 
 	"github.com/mitchellh/panicwrap"
@@ -70,6 +76,12 @@ func logPanic(msg string) {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "ctl" {
+		// ctl talks to an already running instance, so it doesn't need any of
+		// the setup below
+		os.Exit(runCtl(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// panicwrap works by re-executing the running program (retaining arguments,
 	// environmental variables, etc.) and monitoring the stderr of the program.
 	exitStatus, err := panicwrap.BasicWrap(
@@ -93,6 +105,18 @@ func main() {
 
 	parseFlags()
 
+	if *validateConfig != "" {
+		os.Exit(doValidateConfig(*validateConfig))
+	}
+
+	if *printConfig {
+		os.Exit(doPrintConfig())
+	}
+
+	if *decryptFile != "" {
+		os.Exit(doDecrypt(*decryptFile))
+	}
+
 	if *pprofAddr != "" {
 		go func() {
 			log.Debugf("Starting pprof page at http://%s/debug/pprof", *pprofAddr)
@@ -132,6 +156,12 @@ func doMain() error {
 		return err
 	}
 
+	if *wpad {
+		if err := checkWPAD(*addr, *uiaddr, !showui); err != nil {
+			return err
+		}
+	}
+
 	// Schedule cleanup actions
 	handleSignals()
 	addExitFunc(func() {
@@ -234,13 +264,27 @@ func beforeStart(cfg *config.Config) bool {
 		return false
 	}
 	client.UIAddr = actualUIAddr
+	client.SetForceProxied(proxiedsites.Match)
+	// Cycle the PAC file so that browser picks up the new sites
+	proxiedsites.OnChange(cyclePAC)
+	geolookup.OnCountryChange(func(country string) {
+		proxiedsites.CountryChanged()
+	})
+	if err := serveAdminAPI(); err != nil {
+		log.Errorf("Unable to serve admin API: %v", err)
+	}
+	if err := serveDashboard(); err != nil {
+		log.Errorf("Unable to serve dashboard: %v", err)
+	}
 
 	// Only run analytics once on startup.
 	if settings.IsAutoReport() {
 		stopAnalytics := analytics.Start(cfg, flashlight.Version)
 		addExitFunc(stopAnalytics)
 	}
-	watchDirectAddrs()
+	if err := startLearnedHosts(*learnedHostsTTL); err != nil {
+		log.Errorf("Unable to start learning hosts: %v", err)
+	}
 
 	return true
 }
@@ -248,6 +292,9 @@ func beforeStart(cfg *config.Config) bool {
 func afterStart(cfg *config.Config) {
 	onConfigUpdate(cfg)
 	ServePACFile()
+	if *wpad {
+		serveWPAD()
+	}
 	if settings.GetSystemProxy() {
 		pacOn()
 	}
@@ -270,9 +317,11 @@ func onConfigUpdate(cfg *config.Config) {
 }
 
 func i18nInit() {
-	i18n.SetMessagesFunc(func(filename string) ([]byte, error) {
+	messages := func(filename string) ([]byte, error) {
 		return ui.Translations.Get(filename)
-	})
+	}
+	i18n.SetMessagesFunc(messages)
+	status.SetMessagesFunc(messages)
 	if err := i18n.UseOSLocale(); err != nil {
 		log.Debugf("i18n.UseOSLocale: %q", err)
 	}
@@ -294,12 +343,84 @@ func parseFlags() {
 	_ = flag.CommandLine.Parse(args)
 }
 
+// doValidateConfig validates the config file at the given path, printing all
+// problems found to stderr. It returns the process exit status.
+func doValidateConfig(path string) int {
+	err := config.ValidateFile(path)
+	if err == nil {
+		fmt.Printf("%v is valid\n", path)
+		return 0
+	}
+	if errs, ok := err.(config.ValidationErrors); ok {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		fmt.Fprintf(os.Stderr, "%v has %d problem(s)\n", path, len(errs))
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	return 1
+}
+
+// doPrintConfig prints the effective configuration along with the layer
+// (default, bootstrap, file, overrides, env or flag) each value came from. It
+// returns the process exit status.
+func doPrintConfig() int {
+	if err := describeConfig(os.Stdout, *configdir, flagsAsMap()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// describeConfig writes the description of the configuration in configDir to w
+// without changing anything on disk.
+func describeConfig(w io.Writer, configDir string, flags map[string]interface{}) error {
+	cfg, err := config.Preview(flashlight.PackageVersion, configDir, flags)
+	if err != nil {
+		return fmt.Errorf("Unable to read configuration: %v", err)
+	}
+	_, err = fmt.Fprint(w, config.Describe(cfg))
+	return err
+}
+
+// doDecrypt prints the given config or settings file with all encrypted
+// secrets decrypted using this installation's key. It returns the process exit
+// status.
+func doDecrypt(path string) int {
+	dir := *configdir
+	if dir == "" {
+		dir = appdir.General("Lantern")
+	}
+	if err := secret.Load(dir); err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to load key from %v: %v\n", dir, err)
+		return 1
+	}
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to read %v: %v\n", path, err)
+		return 1
+	}
+	decrypted, err := secret.DecryptYAML(bytes)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to decrypt %v: %v\n", path, err)
+		return 1
+	}
+	fmt.Print(string(decrypted))
+	return 0
+}
+
 // showExistingUi triggers an existing Lantern running on the same system to
 // open a browser to the Lantern start page.
 func showExistingUi(addr string) error {
 	url := "http://" + addr + "/startup"
 	log.Debugf("Hitting local URL: %v", url)
-	resp, err := http.Get(url)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(ui.StartupHeader, "true")
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		log.Debugf("Could not hit local lantern")
 		if resp.Body != nil {
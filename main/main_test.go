@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight"
+)
+
+type fileState struct {
+	contents string
+	mode     os.FileMode
+	modTime  time.Time
+}
+
+func dirState(t *testing.T, dir string) map[string]fileState {
+	state := make(map[string]fileState)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		state[path] = fileState{string(b), info.Mode(), info.ModTime()}
+		return nil
+	})
+	assert.NoError(t, err)
+	return state
+}
+
+func TestPrintConfigLeavesDirUnchanged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "printconfig")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// An old file with plaintext secrets that Init would migrate and encrypt
+	old := `
+cloudconfig: http://file/cloud.yaml.gz
+client:
+  deviceid: my-device
+  chainedservers:
+    fallback-1.2.3.4:
+      addr: 1.2.3.4:443
+      authtoken: plaintext-token
+`
+	oldPath := filepath.Join(dir, "lantern-1.6.0.yaml")
+	if !assert.NoError(t, ioutil.WriteFile(oldPath, []byte(old), 0600)) {
+		return
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "lantern-overrides.yaml"), []byte("client:\n  minqos: 3\n"), 0644))
+	before := dirState(t, dir)
+
+	var out bytes.Buffer
+	if !assert.NoError(t, describeConfig(&out, dir, map[string]interface{}{"cpuprofile": "flag.prof"})) {
+		return
+	}
+	described := out.String()
+	assert.Contains(t, described, `cloudconfig = "http://file/cloud.yaml.gz" [file]`)
+	assert.Contains(t, described, `client.deviceid = "my-device" [file]`, "Device id should come from file")
+	assert.Contains(t, described, "client.minqos = 3 [overrides")
+	assert.Contains(t, described, `cpuprofile = "flag.prof" [flag (-cpuprofile)]`)
+	assert.NotContains(t, described, "plaintext-token")
+
+	assert.Equal(t, before, dirState(t, dir), "Printing config should not touch the config dir")
+	_, err = os.Stat(filepath.Join(dir, "lantern-"+flashlight.PackageVersion+".yaml"))
+	assert.True(t, os.IsNotExist(err), "Printing config should not create a config file")
+}
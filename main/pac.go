@@ -1,6 +1,9 @@
 package main
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -12,19 +15,18 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/getlantern/detour"
 	"github.com/getlantern/filepersist"
 	"github.com/getlantern/pac"
 
 	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/proxiedsites"
 	"github.com/getlantern/flashlight/ui"
 )
 
 var (
-	isPacOn     = int32(0)
-	pacURL      string
-	directHosts = make(map[string]bool)
-	cfgMutex    sync.RWMutex
+	isPacOn  = int32(0)
+	pacURL   string
+	cfgMutex sync.RWMutex
 )
 
 func ServePACFile() {
@@ -35,13 +37,33 @@ func ServePACFile() {
 	}
 }
 
+// servePACFile serves the PAC file with an ETag, so that browsers that check
+// it again only download it when it changed.
 func servePACFile(resp http.ResponseWriter, req *http.Request) {
 	log.Trace("Serving PAC file")
+	cfgMutex.RLock()
+	params := currentPACParams()
+	cfgMutex.RUnlock()
+	servePAC(resp, req, params)
+}
+
+func servePAC(resp http.ResponseWriter, req *http.Request, params *pacParams) {
+	b := bytes.NewBuffer(nil)
+	if err := genPAC(b, params); err != nil {
+		log.Errorf("Unable to generate PAC file: %v", err)
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	etag := fmt.Sprintf(`"%x"`, sha1.Sum(b.Bytes()))
+	resp.Header().Set("ETag", etag)
+	resp.Header().Set("Cache-Control", "no-cache")
+	if req.Header.Get("If-None-Match") == etag {
+		resp.WriteHeader(http.StatusNotModified)
+		return
+	}
 	resp.Header().Set("Content-Type", "application/x-ns-proxy-autoconfig")
 	resp.WriteHeader(http.StatusOK)
-	cfgMutex.RLock()
-	defer cfgMutex.RUnlock()
-	if _, err := genPACFile(resp); err != nil {
+	if _, err := resp.Write(b.Bytes()); err != nil {
 		log.Debugf("Error writing response: %v", err)
 	}
 }
@@ -71,79 +93,158 @@ func setUpPacTool() error {
 	return nil
 }
 
-func genPACFile(w io.Writer) (int, error) {
-	hostsString := "[]"
-	// only bypass sites if proxy all option is unset
-	if !settings.GetProxyAll() {
-		log.Trace("Not proxying all")
-		var hosts []string
-		for k, v := range directHosts {
-			if v {
-				hosts = append(hosts, k)
-			}
-		}
-		hostsString = "['" + strings.Join(hosts, "', '") + "']"
-	} else {
-		log.Trace("Proxying all")
-	}
+// pacParams are the client's routing decisions that the PAC file mirrors.
+type pacParams struct {
+	// proxyAddr and socksAddr are where the client listens for HTTP and SOCKS5
+	// connections. socksAddr is blank if the client doesn't.
+	proxyAddr string
+	socksAddr string
+	// proxyAll sends everything but local addresses through Lantern.
+	proxyAll bool
+	// directByDefault only sends proxied sites and sites that are known to be
+	// blocked through Lantern, instead of letting detour decide about the rest.
+	directByDefault bool
+	// proxiedSites is a JavaScript function isProxiedSite(host, port) that
+	// matches proxied sites just like when dialing.
+	proxiedSites string
+	// directHosts are hosts that detour found to be reachable directly.
+	directHosts []string
+	// blockedAddrs are host:port addresses that detour found to be blocked.
+	blockedAddrs []string
+}
 
-	formatter :=
-		`var bypassDomains = %s;
-		function FindProxyForURL(url, host) {
-			if (isPlainHostName(host) // including localhost
-			|| shExpMatch(host, "*.local")) {
-				return "DIRECT";
-			}
-			// only checks plain IP addresses to avoid leaking domain name
-			if (/^[0-9.]+$/.test(host)) {
-				if (isInNet(host, "10.0.0.0", "255.0.0.0") ||
-				isInNet(host, "172.16.0.0",  "255.240.0.0") ||
-				isInNet(host, "192.168.0.0",  "255.255.0.0") ||
-				isInNet(host, "127.0.0.0", "255.255.255.0")) {
-					return "DIRECT";
-				}
-			}
-			// Lantern desktop version proxies only http(s) and ws(s)
-			if (url.substring(0, 4) != 'http' && (url.substring(0, 2) != 'ws')) {
-				return "DIRECT";
-			}
-			for (var d in bypassDomains) {
-				if (host == bypassDomains[d]) {
-					return "DIRECT";
-				}
-			}
-			return "PROXY %s; DIRECT";
-		}`
+// currentPACParams returns the current routing decisions, waiting for the
+// client to listen if it doesn't yet.
+func currentPACParams() *pacParams {
 	proxyAddr, ok := client.Addr(5 * time.Minute)
 	if !ok {
 		panic("Unable to get proxy address within 5 minutes")
 	}
-	proxyAddrString := proxyAddr.(string)
-	log.Tracef("Setting proxy address to %v", proxyAddrString)
-	return fmt.Fprintf(w, formatter, hostsString, proxyAddrString)
+	params := &pacParams{
+		proxyAddr:       proxyAddr.(string),
+		proxyAll:        settings.GetProxyAll(),
+		directByDefault: settings.GetDirectByDefault(),
+	}
+	if socksAddr, ok := client.Socks5Addr(0); ok {
+		params.socksAddr = socksAddr.(string)
+	}
+	if !params.proxyAll {
+		params.proxiedSites = proxiedsites.PACFunction("isProxiedSite")
+		if learned != nil {
+			params.directHosts = learned.hosts(listDirect)
+			params.blockedAddrs = learned.hosts(listBlocked)
+		}
+	}
+	log.Tracef("Setting proxy address to %v", params.proxyAddr)
+	return params
 }
 
-// watchDirectAddrs adds any site that has accessed directly without error to PAC file
-func watchDirectAddrs() {
-	go func() {
-		for {
-			addr := <-detour.DirectAddrCh
-			host, _, err := net.SplitHostPort(addr)
-			if err != nil {
-				panic("watchDirectAddrs() got malformated host:port pair")
-			}
-			addDirectHost(host)
+// genPAC writes a PAC file for the given routing decisions. Hosts are looked
+// up in objects rather than compared one by one so that large lists stay
+// fast.
+func genPAC(w io.Writer, params *pacParams) error {
+	lantern := "PROXY " + params.proxyAddr
+	if params.socksAddr != "" {
+		lantern += "; SOCKS5 " + params.socksAddr
+	}
+	lantern += "; DIRECT"
+
+	directHosts := make(map[string]bool, len(params.directHosts))
+	for _, host := range params.directHosts {
+		directHosts[strings.ToLower(host)] = true
+	}
+	blockedHosts := make(map[string][]string)
+	for _, addr := range params.blockedAddrs {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			log.Debugf("Not including malformed address %v in PAC file", addr)
+			continue
 		}
-	}()
+		host = strings.ToLower(host)
+		blockedHosts[host] = append(blockedHosts[host], port)
+	}
+	proxiedSites := params.proxiedSites
+	if proxiedSites == "" {
+		proxiedSites = "function isProxiedSite(host, port) { return false; }"
+	}
+	byDefault := "lantern"
+	if params.directByDefault {
+		byDefault = `"DIRECT"`
+	}
+
+	formatter :=
+		`var lantern = %[1]s;
+var proxyAll = %[2]t;
+var directHosts = %[3]s;
+var blockedHosts = %[4]s;
+%[5]s
+function hasKey(table, key) {
+	return Object.prototype.hasOwnProperty.call(table, key);
+}
+function portOf(url) {
+	var m = /^[a-z]+:\/\/[^\/?#]*:([0-9]+)(?:[\/?#]|$)/i.exec(url);
+	if (m) {
+		return String(parseInt(m[1], 10));
+	}
+	return url.substring(0, 6) == 'https:' || url.substring(0, 4) == 'wss:' ? "443" : "80";
+}
+function FindProxyForURL(url, host) {
+	host = host.toLowerCase();
+	// Lantern serves its UI through the proxy
+	if (host == %[6]s) {
+		return lantern;
+	}
+	if (isPlainHostName(host) // including localhost
+	|| shExpMatch(host, "*.local")) {
+		return "DIRECT";
+	}
+	// only checks plain IP addresses to avoid leaking domain name
+	if (/^[0-9.]+$/.test(host)) {
+		if (isInNet(host, "10.0.0.0", "255.0.0.0") ||
+		isInNet(host, "172.16.0.0",  "255.240.0.0") ||
+		isInNet(host, "192.168.0.0",  "255.255.0.0") ||
+		isInNet(host, "127.0.0.0", "255.255.255.0")) {
+			return "DIRECT";
+		}
+	}
+	// Lantern desktop version proxies only http(s) and ws(s)
+	if (url.substring(0, 4) != 'http' && (url.substring(0, 2) != 'ws')) {
+		return "DIRECT";
+	}
+	if (proxyAll) {
+		return lantern;
+	}
+	var port = portOf(url);
+	if (isProxiedSite(host, port)) {
+		return lantern;
+	}
+	if (hasKey(blockedHosts, host) && blockedHosts[host].indexOf(port) >= 0) {
+		return lantern;
+	}
+	if (hasKey(directHosts, host)) {
+		return "DIRECT";
+	}
+	return %[7]s;
+}
+`
+	_, err := fmt.Fprintf(w, formatter,
+		mustJSON(lantern),
+		params.proxyAll,
+		mustJSON(directHosts),
+		mustJSON(blockedHosts),
+		proxiedSites,
+		mustJSON(client.LanternSpecialDomain),
+		byDefault)
+	return err
 }
 
-func addDirectHost(host string) {
-	cfgMutex.Lock()
-	defer cfgMutex.Unlock()
-	if !directHosts[host] {
-		directHosts[host] = true
-		cyclePAC()
+func mustJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		// Only ever called with strings and maps of them
+		panic(fmt.Sprintf("Unable to encode %v: %v", v, err))
 	}
+	return string(b)
 }
 
 func pacOn() {
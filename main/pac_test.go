@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/robertkrimen/otto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/proxiedsites"
+)
+
+// pacRuntime implements the functions that browsers provide to PAC files.
+const pacRuntime = `
+function isPlainHostName(host) {
+	return host.indexOf(".") < 0;
+}
+function shExpMatch(str, exp) {
+	var re = exp.replace(/[.+^${}()|[\]\\]/g, "\\$&").replace(/\*/g, ".*").replace(/\?/g, ".");
+	return new RegExp("^" + re + "$").test(str);
+}
+function isInNet(host, pattern, mask) {
+	function toInt(ip) {
+		var parts = ip.split(".");
+		return ((parts[0] << 24) | (parts[1] << 16) | (parts[2] << 8) | parts[3]) >>> 0;
+	}
+	return ((toInt(host) & toInt(mask)) >>> 0) == ((toInt(pattern) & toInt(mask)) >>> 0);
+}
+`
+
+// findProxies evaluates the given PAC file and returns what it returns for
+// each of the given URLs.
+func findProxies(t *testing.T, pac string, urls []string) []string {
+	vm := otto.New()
+	if _, err := vm.Run(pacRuntime + pac); !assert.NoError(t, err, "Unable to evaluate PAC file") {
+		return nil
+	}
+	results := make([]string, 0, len(urls))
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		host := parsed.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		result, err := vm.Call("FindProxyForURL", nil, u, host)
+		if !assert.NoError(t, err, "Unable to find proxy for %v", u) {
+			return nil
+		}
+		results = append(results, result.String())
+	}
+	return results
+}
+
+func TestGenPAC(t *testing.T) {
+	params := &pacParams{
+		proxyAddr:    "127.0.0.1:8787",
+		socksAddr:    "127.0.0.1:8788",
+		proxiedSites: proxiedsites.NewMatcher([]string{"*.proxied.com", "port.com:8080"}).PACFunction("isProxiedSite"),
+		directHosts:  []string{"direct.com", "Mixed.com"},
+		blockedAddrs: []string{"blocked.com:443"},
+	}
+	urls := []string{
+		"http://www.proxied.com/page",
+		"http://port.com:8080/",
+		"http://port.com/",
+		"https://blocked.com/",
+		"http://blocked.com/",
+		"http://direct.com/",
+		"http://www.direct.com/",
+		"http://mixed.com/",
+		"http://other.com/",
+		"http://localhost:8080/",
+		"http://192.168.1.1/",
+		"ftp://other.com/",
+		"http://ui.lantern.io/proxysite",
+	}
+	lantern := "PROXY 127.0.0.1:8787; SOCKS5 127.0.0.1:8788; DIRECT"
+
+	b := bytes.NewBuffer(nil)
+	if !assert.NoError(t, genPAC(b, params)) {
+		return
+	}
+	assert.Equal(t, []string{
+		lantern,
+		lantern,
+		lantern,
+		lantern,
+		lantern,
+		"DIRECT",
+		lantern,
+		"DIRECT",
+		lantern,
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		lantern,
+	}, findProxies(t, b.String(), urls), "By default, detour should decide about unknown sites")
+
+	params.directByDefault = true
+	b.Reset()
+	if !assert.NoError(t, genPAC(b, params)) {
+		return
+	}
+	assert.Equal(t, []string{
+		lantern,
+		lantern,
+		"DIRECT",
+		lantern,
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		"DIRECT",
+		lantern,
+	}, findProxies(t, b.String(), urls), "Only listed sites should be proxied when direct by default")
+
+	params = &pacParams{proxyAddr: "127.0.0.1:8787", proxyAll: true}
+	b.Reset()
+	if !assert.NoError(t, genPAC(b, params)) {
+		return
+	}
+	assert.Equal(t, []string{"PROXY 127.0.0.1:8787; DIRECT", "DIRECT", "DIRECT"},
+		findProxies(t, b.String(), []string{"http://direct.com/", "http://localhost/", "http://10.1.2.3/"}),
+		"Should proxy everything but local addresses when proxying all")
+}
+
+func TestServePACETag(t *testing.T) {
+	params := &pacParams{proxyAddr: "127.0.0.1:8787"}
+	req, _ := http.NewRequest("GET", "http://localhost/proxy_on.pac", nil)
+	resp := httptest.NewRecorder()
+	servePAC(resp, req, params)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/x-ns-proxy-autoconfig", resp.Header().Get("Content-Type"))
+	etag := resp.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req.Header.Set("If-None-Match", etag)
+	resp = httptest.NewRecorder()
+	servePAC(resp, req, params)
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+	assert.Empty(t, resp.Body.String())
+
+	params.directByDefault = true
+	resp = httptest.NewRecorder()
+	servePAC(resp, req, params)
+	assert.Equal(t, http.StatusOK, resp.Code, "Changed PAC file should be served again")
+	assert.NotEqual(t, etag, resp.Header().Get("ETag"))
+}
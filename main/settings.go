@@ -1,8 +1,10 @@
 package main
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"path/filepath"
 	"sync"
 
@@ -34,6 +36,9 @@ type Settings struct {
 	AutoLaunch   bool
 	ProxyAll     bool
 	SystemProxy  bool
+	// DirectByDefault makes the PAC file send sites directly unless they're
+	// proxied sites or known to be blocked, instead of letting detour decide.
+	DirectByDefault bool
 
 	sync.RWMutex
 }
@@ -77,7 +82,6 @@ func LoadSettings(version, revisionDate, buildDate string) *Settings {
 			log.Errorf("Unable to register settings service: %q", err)
 			return
 		}
-		go settings.read()
 	})
 	return settings
 }
@@ -87,6 +91,17 @@ type msg struct {
 	RedirectTo string
 }
 
+// settingsUpdate is a message from the UI changing one or more settings, and
+// the reply to it with all of their current values.
+type settingsUpdate struct {
+	AutoReport  *bool `json:"autoReport,omitempty"`
+	AutoLaunch  *bool `json:"autoLaunch,omitempty"`
+	ProxyAll    *bool `json:"proxyAll,omitempty"`
+	SystemProxy *bool `json:"systemProxy,omitempty"`
+
+	DirectByDefault *bool `json:"directByDefault,omitempty"`
+}
+
 // start the settings service that synchronizes Lantern's configuration with every UI client
 func (s *Settings) start() error {
 	var err error
@@ -98,27 +113,51 @@ func (s *Settings) start() error {
 		defer s.Unlock()
 		return write(&msg{Settings: s})
 	}
-	service, err = ui.Register(messageType, nil, helloFn)
+	newMessage := func() interface{} {
+		return &settingsUpdate{}
+	}
+	service, err = ui.RegisterHandler(messageType, newMessage, helloFn, s.handle)
 	return err
 }
 
-func (s *Settings) read() {
-	log.Debugf("Reading settings messages!!")
-	for message := range service.In {
-		log.Debugf("Read settings message!! %v", message)
-		msg := (message).(map[string]interface{})
-
-		if autoReport, ok := msg["autoReport"].(bool); ok {
-			s.SetAutoReport(autoReport)
-		} else if proxyAll, ok := msg["proxyAll"].(bool); ok {
-			s.SetProxyAll(proxyAll)
-		} else if autoLaunch, ok := msg["autoLaunch"].(bool); ok {
-			s.SetAutoLaunch(autoLaunch)
-		} else if systemProxy, ok := msg["systemProxy"].(bool); ok {
-			log.Debugf("Setting system proxy")
-			s.SetSystemProxy(systemProxy)
-		}
+// handle applies a settingsUpdate from the UI and replies with the current
+// settings.
+func (s *Settings) handle(message interface{}) (interface{}, error) {
+	log.Debugf("Read settings message %v", message)
+	update := message.(*settingsUpdate)
+	if update.AutoReport == nil && update.AutoLaunch == nil && update.ProxyAll == nil && update.SystemProxy == nil && update.DirectByDefault == nil {
+		return nil, fmt.Errorf("Settings message must include at least one known setting")
+	}
+
+	if update.AutoReport != nil {
+		s.SetAutoReport(*update.AutoReport)
+	}
+	if update.ProxyAll != nil {
+		s.SetProxyAll(*update.ProxyAll)
+	}
+	if update.AutoLaunch != nil {
+		s.SetAutoLaunch(*update.AutoLaunch)
 	}
+	if update.SystemProxy != nil {
+		log.Debugf("Setting system proxy")
+		s.SetSystemProxy(*update.SystemProxy)
+	}
+	if update.DirectByDefault != nil {
+		s.SetDirectByDefault(*update.DirectByDefault)
+	}
+
+	// Copy the values since the reply is marshaled without holding the lock
+	s.RLock()
+	autoReport, autoLaunch, proxyAll, systemProxy := s.AutoReport, s.AutoLaunch, s.ProxyAll, s.SystemProxy
+	directByDefault := s.DirectByDefault
+	s.RUnlock()
+	return &settingsUpdate{
+		AutoReport:      &autoReport,
+		AutoLaunch:      &autoLaunch,
+		ProxyAll:        &proxyAll,
+		SystemProxy:     &systemProxy,
+		DirectByDefault: &directByDefault,
+	}, nil
 }
 
 // Save saves settings to disk.
@@ -128,8 +167,11 @@ func (s *Settings) save() {
 	defer s.Unlock()
 	if bytes, err := yaml.Marshal(s); err != nil {
 		log.Errorf("Could not create yaml from settings %v", err)
-	} else if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+	} else if err := ioutil.WriteFile(path, bytes, 0600); err != nil {
 		log.Errorf("Could not write settings file %v", err)
+	} else if err := os.Chmod(path, 0600); err != nil {
+		// Settings files written by older versions were world readable
+		log.Errorf("Could not restrict permissions on settings file %v", err)
 	} else {
 		log.Debugf("Saved settings to %s with contents %v", path, string(bytes))
 	}
@@ -151,6 +193,24 @@ func (s *Settings) SetProxyAll(proxyAll bool) {
 	cyclePAC()
 }
 
+// GetDirectByDefault returns whether the PAC file only sends proxied sites
+// and blocked sites through Lantern.
+func (s *Settings) GetDirectByDefault() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.DirectByDefault
+}
+
+// SetDirectByDefault sets whether the PAC file only sends proxied sites and
+// blocked sites through Lantern.
+func (s *Settings) SetDirectByDefault(directByDefault bool) {
+	s.Lock()
+	defer s.unlockAndSave()
+	s.DirectByDefault = directByDefault
+	// Cycle the PAC file so that browser picks up changes
+	cyclePAC()
+}
+
 // IsAutoReport returns whether or not to auto-report debugging and analytics data.
 func (s *Settings) IsAutoReport() bool {
 	s.RLock()
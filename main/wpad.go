@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/getlantern/flashlight/ui"
+)
+
+// checkWPAD checks that devices on the LAN can use the WPAD file, given the
+// address at which the proxy listens, the one at which the UI server listens,
+// and whether the UI server accepts requests from the LAN.
+func checkWPAD(proxyAddr string, uiAddr string, remoteUI bool) error {
+	if !remoteUI {
+		return fmt.Errorf("-wpad requires -headless, since the UI server only serves the LAN when running headless")
+	}
+	if proxyAddr == "" {
+		return fmt.Errorf("-wpad requires -addr with an address on the LAN, since the proxy listens on localhost by default")
+	}
+	if isLoopback(proxyAddr) {
+		return fmt.Errorf("-wpad requires -addr with an address on the LAN, devices can't reach the proxy at %v", proxyAddr)
+	}
+	if _, port, err := net.SplitHostPort(uiAddr); err != nil {
+		return fmt.Errorf("Unable to parse UI address %v: %v", uiAddr, err)
+	} else if port != "80" {
+		log.Debugf("Serving WPAD file on port %v, devices that look it up at http://wpad/wpad.dat need DHCP to point them at it", port)
+	}
+	return nil
+}
+
+// serveWPAD serves the PAC file at /wpad.dat, so that devices on the LAN that
+// discover their proxy settings with WPAD use Lantern running as a gateway.
+func serveWPAD() {
+	url := ui.Handle(ui.WPADPath, http.HandlerFunc(serveWPADFile))
+	log.Debugf("Serving WPAD file at %v", url)
+}
+
+func serveWPADFile(resp http.ResponseWriter, req *http.Request) {
+	log.Trace("Serving WPAD file")
+	lanIP, err := lanIPFor(req.RemoteAddr)
+	if err != nil {
+		log.Errorf("Unable to serve WPAD file: %v", err)
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	cfgMutex.RLock()
+	params := currentPACParams()
+	cfgMutex.RUnlock()
+	if params.proxyAddr, err = withHost(params.proxyAddr, lanIP); err != nil {
+		log.Errorf("Unable to serve WPAD file: %v", err)
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if params.socksAddr != "" {
+		if params.socksAddr, err = withHost(params.socksAddr, lanIP); err != nil {
+			log.Debugf("Not including SOCKS5 proxy in WPAD file: %v", err)
+			params.socksAddr = ""
+		}
+	}
+	servePAC(resp, req, params)
+}
+
+// lanIPFor returns the IP of our interface through which the given remote
+// address is reached, which is our address on the LAN for devices on it.
+func lanIPFor(remoteAddr string) (string, error) {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse remote address %v: %v", remoteAddr, err)
+	}
+	// Connecting a UDP socket picks the interface without sending anything
+	conn, err := net.Dial("udp", net.JoinHostPort(host, "9"))
+	if err != nil {
+		return "", fmt.Errorf("Unable to find interface for %v: %v", host, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Debugf("Unable to close UDP socket: %v", err)
+		}
+	}()
+	return conn.LocalAddr().(*net.UDPAddr).IP.String(), nil
+}
+
+// withHost returns the address at which a device reaches us through our
+// interface with the given IP, given the address where we listen. If we listen
+// on all interfaces, that's the given IP, otherwise it's the address where we
+// listen. Devices on the LAN can't reach us if we only listen on loopback.
+func withHost(addr string, ip string) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse address %v: %v", addr, err)
+	}
+	if isLoopback(addr) && !net.ParseIP(ip).IsLoopback() {
+		return "", fmt.Errorf("Listening at %v, which %v can't reach", addr, ip)
+	}
+	if parsed := net.ParseIP(host); host != "" && (parsed == nil || !parsed.IsUnspecified()) {
+		return addr, nil
+	}
+	return net.JoinHostPort(ip, port), nil
+}
+
+// isLoopback checks whether the given address where we listen is only
+// reachable through loopback.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if strings.ToLower(host) == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWPADAddresses(t *testing.T) {
+	ip, err := lanIPFor("127.0.0.1:52345")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "127.0.0.1", ip, "Should reach loopback through loopback")
+	}
+	_, err = lanIPFor("garbage")
+	assert.Error(t, err)
+
+	addr, err := withHost("0.0.0.0:8787", "192.168.1.2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "192.168.1.2:8787", addr)
+	}
+	_, err = withHost("127.0.0.1:8787", "192.168.1.2")
+	assert.Error(t, err, "LAN can't reach proxy on loopback")
+	_, err = withHost("localhost:8788", "192.168.1.2")
+	assert.Error(t, err, "LAN can't reach proxy on localhost")
+	addr, err = withHost("[::]:8787", "fd00::2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "[fd00::2]:8787", addr)
+	}
+	addr, err = withHost(":8787", "192.168.1.2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "192.168.1.2:8787", addr)
+	}
+	addr, err = withHost("192.168.1.2:8787", "10.0.0.5")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "192.168.1.2:8787", addr, "Should keep the address we listen at if it's a specific one")
+	}
+	_, err = withHost("8787", "192.168.1.2")
+	assert.Error(t, err)
+}
+
+func TestCheckWPAD(t *testing.T) {
+	assert.NoError(t, checkWPAD(":8787", "0.0.0.0:80", true))
+	assert.NoError(t, checkWPAD("192.168.1.2:8787", "127.0.0.1:16823", true))
+	assert.Error(t, checkWPAD(":8787", "127.0.0.1:16823", false), "UI server should have to serve the LAN")
+	assert.Error(t, checkWPAD("", "127.0.0.1:16823", true), "Proxy should have to listen on the LAN")
+	assert.Error(t, checkWPAD("127.0.0.1:8787", "127.0.0.1:16823", true), "Proxy should have to listen on the LAN")
+	assert.Error(t, checkWPAD("localhost:8787", "127.0.0.1:16823", true), "Proxy should have to listen on the LAN")
+	assert.Error(t, checkWPAD(":8787", "16823", true))
+}
@@ -0,0 +1,150 @@
+package flashlight
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/config"
+)
+
+var (
+	// probationWindow is how long a newly applied configuration has to prove
+	// that it can reach the internet before we roll it back.
+	probationWindow = 1 * time.Minute
+
+	lastRejection   *Rejection
+	lastRejectionMx sync.RWMutex
+)
+
+// Rejection describes a configuration that failed its connectivity probe.
+type Rejection struct {
+	// Time is when the configuration was rejected.
+	Time time.Time `json:"time"`
+	// Error is why the probe failed.
+	Error string `json:"error"`
+	// Reverted indicates whether we went back to the previous configuration,
+	// which we can only do if it was known to be healthy.
+	Reverted bool `json:"reverted"`
+	// ConfigFile and CloudURL are where the rejected configuration came from,
+	// CloudURL blank if it wasn't fetched from the cloud.
+	ConfigFile string `json:"configFile"`
+	CloudURL   string `json:"cloudURL,omitempty"`
+}
+
+// LastRejection returns the most recent configuration that failed its
+// connectivity probe, or nil if none did since Lantern started.
+func LastRejection() *Rejection {
+	lastRejectionMx.RLock()
+	defer lastRejectionMx.RUnlock()
+	return lastRejection
+}
+
+func setLastRejection(r *Rejection) {
+	lastRejectionMx.Lock()
+	lastRejection = r
+	lastRejectionMx.Unlock()
+}
+
+// probation puts every newly applied configuration on probation by probing
+// connectivity through the new balancer. If the probe fails while the
+// previously applied configuration was known to be healthy, the previous
+// client configuration is restored.
+type probation struct {
+	// probe checks connectivity with the applied configuration
+	probe func(timeout time.Duration) error
+	// revert restores the client configuration of the given config
+	revert func(previous *config.Config)
+	// origin returns where the applied configuration came from
+	origin func() config.Origin
+	// rejected is called when a configuration fails its probe
+	rejected func(r *Rejection)
+
+	mx      sync.Mutex
+	current *config.Config
+	// lastGood is the most recently applied configuration that passed the
+	// probe, or nil if we don't have one we can revert to.
+	lastGood *config.Config
+}
+
+func newProbation(client *client.Client) *probation {
+	return &probation{
+		probe:    client.Probe,
+		revert:   revertTo,
+		origin:   config.CurrentOrigin,
+		rejected: setLastRejection,
+	}
+}
+
+// applied is called after cfg has been applied to the client. It starts
+// probing connectivity in the background.
+func (p *probation) applied(cfg *config.Config) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.current = cfg
+	if p.lastGood != nil && sameConnectivity(p.lastGood, cfg) {
+		log.Trace("Client configuration unchanged, no need to probe")
+		p.lastGood = cfg
+		return
+	}
+	go p.doProbe(cfg, p.lastGood, p.origin())
+}
+
+func (p *probation) doProbe(cfg *config.Config, previous *config.Config, origin config.Origin) {
+	log.Debugf("Probing connectivity with updated configuration for up to %v", probationWindow)
+	err := p.probe(probationWindow)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	if p.current != cfg {
+		log.Debug("Configuration was superseded while probing, ignoring probe result")
+		return
+	}
+	if err == nil {
+		log.Debug("Updated configuration passed connectivity probe")
+		p.lastGood = cfg
+		return
+	}
+	rejection := &Rejection{
+		Time:       time.Now(),
+		Error:      err.Error(),
+		Reverted:   previous != nil,
+		ConfigFile: origin.FilePath,
+		CloudURL:   origin.CloudURL,
+	}
+	p.rejected(rejection)
+	if previous == nil {
+		log.Errorf("Configuration failed connectivity probe and there is no healthy configuration to revert to: %v", err)
+		return
+	}
+
+	// Clear lastGood so that if the reverted configuration fails its probe too
+	// (e.g. because the network is down entirely), we don't keep flipping back
+	// and forth between configurations.
+	p.lastGood = nil
+	log.Errorf("Rejected updated configuration that failed connectivity probe, reverting to previous configuration: %v", err)
+	go p.revert(previous)
+}
+
+// revertTo restores the client configuration and trusted CAs from previous.
+// The reverted configuration is applied through the normal config.Run update
+// handler and is then itself put on probation.
+func revertTo(previous *config.Config) {
+	err := config.Update(func(cfg *config.Config) error {
+		clientCfg := *previous.Client
+		clientCfg.DeviceID = cfg.Client.DeviceID
+		cfg.Client = &clientCfg
+		cfg.TrustedCAs = previous.TrustedCAs
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Unable to revert to previous configuration: %v", err)
+	}
+}
+
+// sameConnectivity returns true if a and b would result in the same
+// connectivity, meaning that there's no need to probe b if a is healthy.
+func sameConnectivity(a *config.Config, b *config.Config) bool {
+	return reflect.DeepEqual(a.Client, b.Client) && reflect.DeepEqual(a.TrustedCAs, b.TrustedCAs)
+}
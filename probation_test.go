@@ -0,0 +1,115 @@
+package flashlight
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/config"
+)
+
+func testConfig(server string) *config.Config {
+	return &config.Config{
+		Client: &client.ClientConfig{
+			ChainedServers: map[string]*client.ChainedServerInfo{
+				server: &client.ChainedServerInfo{Addr: server},
+			},
+		},
+	}
+}
+
+func newTestProbation(results chan error) (*probation, chan *config.Config, chan *Rejection) {
+	reverted := make(chan *config.Config, 10)
+	rejected := make(chan *Rejection, 10)
+	p := &probation{
+		probe: func(timeout time.Duration) error {
+			return <-results
+		},
+		revert: func(previous *config.Config) {
+			reverted <- previous
+		},
+		origin: func() config.Origin {
+			return config.Origin{CloudURL: "https://config.example.com/cloud.yaml.gz"}
+		},
+		rejected: func(r *Rejection) {
+			rejected <- r
+		},
+	}
+	return p, reverted, rejected
+}
+
+func (p *probation) good() *config.Config {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	return p.lastGood
+}
+
+func waitFor(t *testing.T, condition func() bool, msg string) {
+	for i := 0; i < 200; i++ {
+		if condition() {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal(msg)
+}
+
+func TestProbationRevertsFailedConfig(t *testing.T) {
+	results := make(chan error)
+	p, reverted, rejected := newTestProbation(results)
+
+	good := testConfig("1.1.1.1:443")
+	p.applied(good)
+	results <- nil
+	waitFor(t, func() bool { return p.good() == good }, "Passing config should become the last good one")
+
+	sameAsGood := testConfig("1.1.1.1:443")
+	p.applied(sameAsGood)
+	assert.Equal(t, sameAsGood, p.good(), "Config with unchanged connectivity should become the last good one without probing")
+
+	bad := testConfig("2.2.2.2:443")
+	p.applied(bad)
+	results <- fmt.Errorf("Unable to reach target")
+	select {
+	case previous := <-reverted:
+		assert.Equal(t, sameAsGood, previous, "Should revert to last good config")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Failing config should be reverted")
+	}
+	r := <-rejected
+	assert.Equal(t, "Unable to reach target", r.Error)
+	assert.True(t, r.Reverted)
+	assert.Equal(t, "https://config.example.com/cloud.yaml.gz", r.CloudURL)
+	assert.False(t, r.Time.IsZero())
+	assert.Nil(t, p.good())
+
+	// The reverted config fails too, for example because the network is down,
+	// which mustn't make us flip back to the bad config.
+	p.applied(testConfig("1.1.1.1:443"))
+	results <- fmt.Errorf("Network down")
+	r = <-rejected
+	assert.False(t, r.Reverted, "Should not revert without a healthy config")
+	select {
+	case previous := <-reverted:
+		t.Fatalf("Should not have reverted to %v", previous)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestProbationIgnoresSupersededConfig(t *testing.T) {
+	results := make(chan error, 1)
+	p, reverted, rejected := newTestProbation(results)
+	good := testConfig("1.1.1.1:443")
+	p.lastGood = good
+	superseded := testConfig("2.2.2.2:443")
+	p.current = testConfig("3.3.3.3:443")
+
+	results <- fmt.Errorf("Unable to reach target")
+	p.doProbe(superseded, good, config.Origin{})
+	assert.Equal(t, good, p.good(), "Last good config should be kept")
+	assert.Len(t, reverted, 0, "Result for superseded config should be ignored")
+	assert.Len(t, rejected, 0, "Superseded config should not be reported as rejected")
+}
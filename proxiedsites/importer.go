@@ -0,0 +1,325 @@
+package proxiedsites
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/getlantern/proxiedsites"
+)
+
+// Formats of lists of sites that can be imported.
+const (
+	// FormatAuto detects the format of the list.
+	FormatAuto = ""
+	// FormatGFWList is the AutoProxy/AdBlock syntax of gfwlist, either plain
+	// or base64 encoded.
+	FormatGFWList = "gfwlist"
+	// FormatHosts is the format of hosts files, with lines like
+	// "0.0.0.0 example.com".
+	FormatHosts = "hosts"
+	// FormatDomains is one proxied site per line, in the syntax of Matcher.
+	FormatDomains = "domains"
+)
+
+var (
+	// hostsFileLocalNames are the names that hosts files map to local
+	// addresses, which we never proxy.
+	hostsFileLocalNames = map[string]bool{
+		"localhost":             true,
+		"localhost.localdomain": true,
+		"local":                 true,
+		"broadcasthost":         true,
+		"ip6-localhost":         true,
+		"ip6-loopback":          true,
+		"ip6-localnet":          true,
+		"ip6-mcastprefix":       true,
+		"ip6-allnodes":          true,
+		"ip6-allrouters":        true,
+		"ip6-allhosts":          true,
+		"0.0.0.0":               true,
+	}
+)
+
+// UnsupportedRule is a rule in an imported list that can't be expressed as a
+// proxied site.
+type UnsupportedRule struct {
+	Line   int    `json:"line"`
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+// ImportResult is the outcome of importing a list of sites. The sites that the
+// list proxies are the additions of Delta and the ones that it exempts from
+// being proxied are the deletions.
+type ImportResult struct {
+	Format      string              `json:"format"`
+	Delta       *proxiedsites.Delta `json:"delta"`
+	Unsupported []*UnsupportedRule  `json:"unsupported"`
+}
+
+// Import parses a list of sites in the given format, one of the Format
+// constants. Rules that can't be expressed as proxied sites are reported in
+// the result's Unsupported rather than dropped silently.
+func Import(data []byte, format string) (*ImportResult, error) {
+	if format == FormatAuto {
+		format = detectFormat(data)
+	}
+	im := &importer{
+		additions: make(map[string]bool),
+		deletions: make(map[string]bool),
+		result:    &ImportResult{Format: format, Unsupported: []*UnsupportedRule{}},
+	}
+	switch format {
+	case FormatGFWList:
+		if decoded, ok := decodeGFWList(data); ok {
+			data = decoded
+		}
+		im.parse(data, im.gfwListRule)
+	case FormatHosts:
+		im.parse(data, im.hostsRule)
+	case FormatDomains:
+		im.parse(data, im.domainsRule)
+	default:
+		return nil, fmt.Errorf("Unknown format %q", format)
+	}
+	im.result.Delta = &proxiedsites.Delta{
+		Additions: sortedKeys(im.additions),
+		Deletions: sortedKeys(im.deletions),
+	}
+	return im.result, nil
+}
+
+// detectFormat guesses the format of a list from its content.
+func detectFormat(data []byte) string {
+	if _, ok := decodeGFWList(data); ok {
+		return FormatGFWList
+	}
+	lines, hostsLines := 0, 0
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[AutoProxy") || strings.HasPrefix(line, "||") || strings.HasPrefix(line, "@@") {
+			return FormatGFWList
+		}
+		lines++
+		if fields := strings.Fields(line); len(fields) >= 2 && net.ParseIP(fields[0]) != nil {
+			hostsLines++
+		}
+	}
+	if lines > 0 && hostsLines*2 > lines {
+		return FormatHosts
+	}
+	return FormatDomains
+}
+
+// decodeGFWList decodes a base64 encoded gfwlist, reporting whether data was
+// one.
+func decodeGFWList(data []byte) ([]byte, bool) {
+	compact := bytes.Join(bytes.Fields(data), nil)
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(compact)))
+	n, err := base64.StdEncoding.Decode(decoded, compact)
+	if err != nil || !bytes.HasPrefix(bytes.TrimSpace(decoded[:n]), []byte("[AutoProxy")) {
+		return nil, false
+	}
+	return decoded[:n], true
+}
+
+type importer struct {
+	additions map[string]bool
+	deletions map[string]bool
+	result    *ImportResult
+}
+
+// parse passes each line of data to ruleFn, which returns the reason that the
+// rule isn't supported, if it isn't.
+func (im *importer) parse(data []byte, ruleFn func(rule string) string) {
+	for i, line := range strings.Split(string(data), "\n") {
+		rule := strings.TrimSpace(line)
+		if rule == "" {
+			continue
+		}
+		if reason := ruleFn(rule); reason != "" {
+			im.result.Unsupported = append(im.result.Unsupported, &UnsupportedRule{
+				Line:   i + 1,
+				Rule:   rule,
+				Reason: reason,
+			})
+		}
+	}
+}
+
+// gfwListRule handles a rule in AutoProxy syntax. Those rules match URLs, so
+// only the ones that amount to matching a domain are supported. Exception
+// rules, which start with @@, become deletions.
+func (im *importer) gfwListRule(rule string) string {
+	if strings.HasPrefix(rule, "!") || strings.HasPrefix(rule, "[") {
+		// Comment or header
+		return ""
+	}
+	sites := im.additions
+	if strings.HasPrefix(rule, "@@") {
+		sites = im.deletions
+		rule = rule[2:]
+	}
+	if strings.Contains(rule, "$") {
+		return "Rule options aren't supported"
+	}
+
+	switch {
+	case strings.HasPrefix(rule, "||"):
+		// The domain and its subdomains
+		host, reason := gfwListHost(rule[2:])
+		if reason != "" {
+			return reason
+		}
+		sites["*."+host] = true
+	case strings.HasPrefix(rule, "|"):
+		// URLs that start with the given one
+		u, err := url.Parse(rule[1:])
+		if err != nil || u.Host == "" {
+			return "Not a URL"
+		}
+		if (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			return "Only whole sites can be proxied, not individual pages"
+		}
+		host, port := u.Host, ""
+		if h, p, err := net.SplitHostPort(u.Host); err == nil {
+			host, port = h, p
+		}
+		host = normalizeHost(host)
+		if !validHost(host) {
+			return "Not a domain"
+		}
+		if port != "" {
+			host = net.JoinHostPort(host, port)
+		}
+		sites[host] = true
+	case strings.HasPrefix(rule, "/") && strings.HasSuffix(rule, "/") && len(rule) > 1:
+		return "Regular expressions that match URLs aren't supported"
+	default:
+		// Keyword rules match any URL that contains them. Those that look like
+		// a domain are taken to mean the domain and its subdomains.
+		host, reason := gfwListHost(strings.TrimPrefix(rule, "."))
+		if reason != "" {
+			return reason
+		}
+		sites["*."+host] = true
+	}
+	return ""
+}
+
+// gfwListHost extracts the domain from the rest of a domain rule, which may
+// end in a separator.
+func gfwListHost(rest string) (string, string) {
+	rest = strings.TrimPrefix(rest, "*.")
+	if i := strings.IndexAny(rest, "/^"); i >= 0 {
+		if strings.Trim(rest[i:], "/^*") != "" {
+			return "", "Only whole sites can be proxied, not individual pages"
+		}
+		rest = rest[:i]
+	}
+	if strings.Contains(rest, "*") {
+		return "", "Wildcards are only supported at the start of a domain"
+	}
+	host := normalizeHost(rest)
+	if !validHost(host) {
+		return "", "Not a domain"
+	}
+	return host, ""
+}
+
+// hostsRule handles a line of a hosts file. Every host name that's mapped to
+// an address is proxied, whichever the address is.
+func (im *importer) hostsRule(rule string) string {
+	if i := strings.Index(rule, "#"); i >= 0 {
+		rule = strings.TrimSpace(rule[:i])
+		if rule == "" {
+			return ""
+		}
+	}
+	fields := strings.Fields(rule)
+	if len(fields) < 2 {
+		return "Expected an IP address followed by host names"
+	}
+	if net.ParseIP(fields[0]) == nil {
+		return "Not an IP address"
+	}
+	var invalid []string
+	for _, name := range fields[1:] {
+		host := normalizeHost(name)
+		if hostsFileLocalNames[host] {
+			continue
+		}
+		if !validHost(host) {
+			invalid = append(invalid, name)
+			continue
+		}
+		im.additions[host] = true
+	}
+	if len(invalid) > 0 {
+		return fmt.Sprintf("Not a domain: %v", strings.Join(invalid, ", "))
+	}
+	return ""
+}
+
+// domainsRule handles a line of a plain list of sites, which may use all of
+// the patterns that Matcher supports.
+func (im *importer) domainsRule(rule string) string {
+	if strings.HasPrefix(rule, "#") {
+		return ""
+	}
+	if i := strings.Index(rule, " #"); i >= 0 {
+		rule = strings.TrimSpace(rule[:i])
+	}
+	if err := checkPattern(rule); err != nil {
+		return err.Error()
+	}
+	im.additions[rule] = true
+	return ""
+}
+
+// checkPattern checks whether the given pattern is valid for a Matcher.
+func checkPattern(pattern string) error {
+	m := &Matcher{
+		hosts:    make(map[string]portSet),
+		suffixes: make(map[string]portSet),
+	}
+	return m.add(pattern, make(map[string]*regexPattern))
+}
+
+// validHost checks whether host is a lowercase domain name or IP address.
+func validHost(host string) bool {
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	if host == "" || len(host) > 253 || !strings.Contains(host, ".") {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,102 @@
+package proxiedsites
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGFWList = `[AutoProxy 0.2.9]
+! Comment
+||blocked.com
+||wild.example.org^
+|http://85.17.73.31/
+|https://secure.com:8443
+|http://pages.com/some/page.html
+.keyword.net
+keyword.info
+@@||allowed.blocked.com
+/^https?:\/\/[^\/]+blogspot\.(.*)/
+||options.com$third-party
+partial*.com
+nodots
+`
+
+func TestImportGFWList(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testGFWList))
+	// gfwlist is published with line breaks in the base64
+	encoded = encoded[:40] + "\n" + encoded[40:]
+
+	for _, data := range []string{testGFWList, encoded} {
+		result, err := Import([]byte(data), FormatAuto)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, FormatGFWList, result.Format)
+		assert.Equal(t, []string{
+			"*.blocked.com",
+			"*.keyword.info",
+			"*.keyword.net",
+			"*.wild.example.org",
+			"85.17.73.31",
+			"secure.com:8443",
+		}, result.Delta.Additions)
+		assert.Equal(t, []string{"*.allowed.blocked.com"}, result.Delta.Deletions)
+
+		unsupported := make(map[int]string)
+		for _, rule := range result.Unsupported {
+			unsupported[rule.Line] = rule.Rule
+		}
+		assert.Equal(t, map[int]string{
+			7:  "|http://pages.com/some/page.html",
+			11: `/^https?:\/\/[^\/]+blogspot\.(.*)/`,
+			12: "||options.com$third-party",
+			13: "partial*.com",
+			14: "nodots",
+		}, unsupported)
+	}
+}
+
+func TestImportHosts(t *testing.T) {
+	data := `# Blocked sites
+127.0.0.1 localhost
+::1 localhost ip6-localhost
+0.0.0.0 0.0.0.0
+0.0.0.0 ads.example.com tracker.example.com # trailing comment
+10.0.0.1 Intranet.Example.com. bad_host!
+not-an-ip example.net
+`
+	result, err := Import([]byte(data), FormatAuto)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, FormatHosts, result.Format)
+	assert.Equal(t, []string{"ads.example.com", "intranet.example.com", "tracker.example.com"}, result.Delta.Additions)
+	assert.Empty(t, result.Delta.Deletions)
+	if assert.Len(t, result.Unsupported, 2) {
+		assert.Equal(t, 6, result.Unsupported[0].Line)
+		assert.Equal(t, 7, result.Unsupported[1].Line)
+	}
+}
+
+func TestImportDomains(t *testing.T) {
+	data := `# My sites
+example.com
+*.wild.com:*
+/^mail\./ # regex
+bad.com:http
+`
+	result, err := Import([]byte(data), FormatAuto)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, FormatDomains, result.Format)
+	assert.Equal(t, []string{"*.wild.com:*", "/^mail\\./", "example.com"}, result.Delta.Additions)
+	if assert.Len(t, result.Unsupported, 1) {
+		assert.Equal(t, "bad.com:http", result.Unsupported[0].Rule)
+	}
+
+	_, err = Import([]byte(data), "unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,276 @@
+package proxiedsites
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// anyPort in a pattern matches every port.
+	anyPort = "*"
+)
+
+var (
+	// defaultPorts are the ports that patterns without an explicit port match.
+	defaultPorts = []string{"80", "443"}
+)
+
+// Matcher decides whether addresses belong to a set of proxied sites. Each
+// site is a pattern of one of these forms:
+//
+//	example.com          the host itself on ports 80 and 443
+//	*.example.com        the domain and all of its subdomains
+//	example.com:8080     an explicit port, or :* for any port
+//	/^mail\.example\./   a regular expression that the host must match
+//
+// Ports can follow any of these, as in "*.example.com:*" or "/example/:8443".
+// The same Matcher is used when dialing and when generating the PAC file, so
+// a site is routed the same way whichever path its traffic takes.
+type Matcher struct {
+	hosts    map[string]portSet
+	suffixes map[string]portSet
+	regexes  []*regexPattern
+}
+
+// portSet is the set of ports that a pattern matches, which includes anyPort
+// if it matches all of them.
+type portSet map[string]bool
+
+func (ps portSet) matches(port string) bool {
+	return ps[anyPort] || ps[port]
+}
+
+func (ps portSet) add(ports []string) portSet {
+	if ps == nil {
+		ps = make(portSet, len(ports))
+	}
+	for _, port := range ports {
+		ps[port] = true
+	}
+	return ps
+}
+
+func (ps portSet) list() []string {
+	ports := make([]string, 0, len(ps))
+	for port := range ps {
+		ports = append(ports, port)
+	}
+	sort.Strings(ports)
+	return ports
+}
+
+type regexPattern struct {
+	source string
+	re     *regexp.Regexp
+	ports  portSet
+}
+
+// NewMatcher builds a Matcher for the given patterns. Invalid patterns are
+// logged and skipped, so that one typo doesn't stop the other sites from
+// being proxied.
+func NewMatcher(patterns []string) *Matcher {
+	m := &Matcher{
+		hosts:    make(map[string]portSet),
+		suffixes: make(map[string]portSet),
+	}
+	regexes := make(map[string]*regexPattern)
+	for _, pattern := range patterns {
+		if err := m.add(pattern, regexes); err != nil {
+			log.Errorf("Ignoring proxied site %q: %v", pattern, err)
+		}
+	}
+	sources := make([]string, 0, len(regexes))
+	for source := range regexes {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		m.regexes = append(m.regexes, regexes[source])
+	}
+	return m
+}
+
+func (m *Matcher) add(pattern string, regexes map[string]*regexPattern) error {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return fmt.Errorf("Empty pattern")
+	}
+
+	if pattern[0] == '/' {
+		end := strings.LastIndex(pattern, "/")
+		if end == 0 {
+			return fmt.Errorf("Regular expression isn't terminated with /")
+		}
+		ports, err := parsePorts(pattern[end+1:])
+		if err != nil {
+			return err
+		}
+		source := pattern[1:end]
+		rp := regexes[source]
+		if rp == nil {
+			re, err := regexp.Compile(source)
+			if err != nil {
+				return fmt.Errorf("Unable to compile regular expression: %v", err)
+			}
+			rp = &regexPattern{source: source, re: re}
+			regexes[source] = rp
+		}
+		rp.ports = rp.ports.add(ports)
+		return nil
+	}
+
+	host, portSpec := pattern, ""
+	if h, p, err := net.SplitHostPort(pattern); err == nil {
+		host, portSpec = h, ":"+p
+	}
+	ports, err := parsePorts(portSpec)
+	if err != nil {
+		return err
+	}
+	host = normalizeHost(host)
+	if strings.HasPrefix(host, "*.") {
+		domain := host[2:]
+		if domain == "" || strings.Contains(domain, "*") {
+			return fmt.Errorf("Invalid wildcard")
+		}
+		m.suffixes[domain] = m.suffixes[domain].add(ports)
+		return nil
+	}
+	if host == "" || strings.Contains(host, "*") {
+		return fmt.Errorf("Invalid host")
+	}
+	m.hosts[host] = m.hosts[host].add(ports)
+	return nil
+}
+
+// parsePorts parses the port part of a pattern, which is either blank for
+// the default ports or a colon followed by a port number or *.
+func parsePorts(spec string) ([]string, error) {
+	if spec == "" {
+		return defaultPorts, nil
+	}
+	if spec[0] != ':' {
+		return nil, fmt.Errorf("Expected :port after pattern, got %q", spec)
+	}
+	port := spec[1:]
+	if port == anyPort {
+		return []string{anyPort}, nil
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return nil, fmt.Errorf("Invalid port %q", port)
+	}
+	return []string{strconv.Itoa(n)}, nil
+}
+
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// Match checks whether the given host:port address belongs to one of the
+// proxied sites. An address without a port only matches patterns for any
+// port.
+func (m *Matcher) Match(addr string) bool {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		host, port = addr, ""
+	}
+	host = normalizeHost(host)
+
+	if m.hosts[host].matches(port) {
+		return true
+	}
+	for domain := host; ; {
+		if m.suffixes[domain].matches(port) {
+			return true
+		}
+		i := strings.Index(domain, ".")
+		if i < 0 {
+			break
+		}
+		domain = domain[i+1:]
+	}
+	for _, rp := range m.regexes {
+		if rp.ports.matches(port) && rp.re.MatchString(host) {
+			return true
+		}
+	}
+	return false
+}
+
+// PACFunction returns the JavaScript source of a PAC function with the given
+// name that takes a host and a port and does what Match does.
+// Regular expressions are compiled in the browser. The common (?i) flag is
+// translated, and any that the browser can't compile are skipped rather than
+// breaking the whole PAC file.
+func (m *Matcher) PACFunction(name string) string {
+	hosts := make(map[string][]string, len(m.hosts))
+	for host, ports := range m.hosts {
+		hosts[host] = ports.list()
+	}
+	suffixes := make(map[string][]string, len(m.suffixes))
+	for domain, ports := range m.suffixes {
+		suffixes[domain] = ports.list()
+	}
+
+	b := bytes.NewBuffer(nil)
+	fmt.Fprintf(b, "var %sHosts = %s;\n", name, mustJSON(hosts))
+	fmt.Fprintf(b, "var %sSuffixes = %s;\n", name, mustJSON(suffixes))
+	fmt.Fprintf(b, "var %sRegexes = [];\n", name)
+	for _, rp := range m.regexes {
+		source, flags := rp.source, ""
+		if strings.HasPrefix(source, "(?i)") {
+			source, flags = source[4:], "i"
+		}
+		fmt.Fprintf(b, "try { %sRegexes.push([new RegExp(%s, %s), %s]); } catch (e) {}\n",
+			name, mustJSON(source), mustJSON(flags), mustJSON(rp.ports.list()))
+	}
+	fmt.Fprintf(b, `function %[1]s(host, port) {
+	function portMatches(ports) {
+		return ports && (ports.indexOf("*") >= 0 || ports.indexOf(port) >= 0);
+	}
+	function lookup(table, key) {
+		return Object.prototype.hasOwnProperty.call(table, key) ? table[key] : null;
+	}
+	host = host.toLowerCase();
+	if (host.charAt(host.length - 1) == ".") {
+		host = host.substring(0, host.length - 1);
+	}
+	if (portMatches(lookup(%[1]sHosts, host))) {
+		return true;
+	}
+	for (var domain = host; ; ) {
+		if (portMatches(lookup(%[1]sSuffixes, domain))) {
+			return true;
+		}
+		var i = domain.indexOf(".");
+		if (i < 0) {
+			break;
+		}
+		domain = domain.substring(i + 1);
+	}
+	for (var r = 0; r < %[1]sRegexes.length; r++) {
+		if (portMatches(%[1]sRegexes[r][1]) && %[1]sRegexes[r][0].test(host)) {
+			return true;
+		}
+	}
+	return false;
+}
+`, name)
+	return b.String()
+}
+
+func mustJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		// Only ever called with strings, slices and maps of them
+		panic(fmt.Sprintf("Unable to encode %v: %v", v, err))
+	}
+	return string(b)
+}
@@ -0,0 +1,77 @@
+package proxiedsites
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getlantern/proxiedsites"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testPatterns = []string{
+		"example.com",
+		"*.wild.com",
+		"ports.com:8080",
+		"anyport.com:*",
+		"/^mail[0-9]*\\.regex\\.com$/",
+		"/(?i)^CASE\\./:8443",
+		"bad.com:http",
+		"/unterminated",
+		"*.",
+	}
+
+	testAddrs = map[string]bool{
+		"example.com:80":        true,
+		"example.com:443":       true,
+		"EXAMPLE.com.:443":      true,
+		"example.com:8080":      false,
+		"www.example.com:80":    false,
+		"wild.com:443":          true,
+		"a.b.wild.com:80":       true,
+		"notwild.com:80":        false,
+		"wild.com.evil.com:80":  false,
+		"ports.com:8080":        true,
+		"ports.com:80":          false,
+		"anyport.com:22":        true,
+		"anyport.com":           true,
+		"mail.regex.com:443":    true,
+		"mail42.regex.com:80":   true,
+		"www.regex.com:80":      false,
+		"case.example.org:8443": true,
+		"case.example.org:443":  false,
+		"bad.com:80":            false,
+	}
+)
+
+func TestMatcher(t *testing.T) {
+	m := NewMatcher(testPatterns)
+	for addr, expected := range testAddrs {
+		assert.Equal(t, expected, m.Match(addr), addr)
+	}
+}
+
+func TestPACFunction(t *testing.T) {
+	js := NewMatcher(testPatterns).PACFunction("isProxiedSite")
+	assert.True(t, strings.Contains(js, "function isProxiedSite(host, port)"))
+	assert.True(t, strings.Contains(js, `"*.wild.com"`) || strings.Contains(js, `"wild.com"`))
+	assert.True(t, strings.Contains(js, `new RegExp("^CASE\\.", "i")`), "Should translate (?i) to a flag")
+	assert.False(t, strings.Contains(js, "bad.com"), "Should skip invalid patterns")
+}
+
+func TestMatch(t *testing.T) {
+	updateSites(&proxiedsites.Config{
+		Cloud: []string{"*.a.com"},
+		Delta: &proxiedsites.Delta{Additions: []string{"b.com:*"}},
+	})
+	assert.True(t, Match("www.a.com:443"))
+	assert.True(t, Match("b.com:5222"))
+	assert.False(t, Match("c.com:443"))
+	assert.False(t, updateSites(&proxiedsites.Config{
+		Cloud: []string{"b.com:*"},
+		Delta: &proxiedsites.Delta{Additions: []string{"*.a.com"}},
+	}), "Same sites shouldn't count as a change")
+	assert.True(t, updateSites(&proxiedsites.Config{Cloud: []string{"c.com"}}))
+	assert.True(t, Match("c.com:443"))
+	assert.False(t, Match("b.com:5222"))
+}
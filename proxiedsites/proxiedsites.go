@@ -3,18 +3,30 @@ package proxiedsites
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/getlantern/detour"
 	"github.com/getlantern/golog"
 	"github.com/getlantern/proxiedsites"
 
 	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/geolookup"
+	"github.com/getlantern/flashlight/status"
 	"github.com/getlantern/flashlight/ui"
 )
 
 const (
 	messageType = `ProxiedSites`
+
+	// proxySitePath is where error pages submit the request to proxy a site.
+	proxySitePath = "/proxysite"
+
+	// proxySiteTimeout is how long we wait for a site to be proxied before
+	// reloading its page anyway.
+	proxySiteTimeout = 5 * time.Second
 )
 
 var (
@@ -23,15 +35,99 @@ var (
 	service    *ui.Service
 	PACURL     string
 	startMutex sync.Mutex
+	// lastCfg is the most recent config, which is applied again when the
+	// country changes
+	lastCfg *config.ProxiedSitesConfig
+	// inactiveSites lists the scheduled sites that were inactive when lastCfg
+	// was applied
+	inactiveSites string
+
+	// country returns the country whose proxied sites apply, blank if it's
+	// not known yet
+	country = func() string {
+		return geolookup.GetCountry(0)
+	}
+
+	// updateConfig and removeFromWl are how we change the config and detour's
+	// whitelist, replaced in tests
+	updateConfig = config.Update
+	removeFromWl = detour.RemoveFromWl
+
+	// sites are the sites that are currently proxied
+	sites   = make(map[string]bool)
+	sitesMx sync.RWMutex
+	// matcher matches addresses against sites
+	matcher = NewMatcher(nil)
+	// configured is closed and replaced whenever sites changes
+	configured = make(chan struct{})
+	// schedules are the schedules of the sites that the user added
+	schedules map[string]*config.SiteSchedule
+
+	// changeHandlers are called whenever the proxied sites change
+	changeHandlers   []func()
+	changeHandlersMx sync.Mutex
 )
 
-func Configure(cfg *proxiedsites.Config) {
-	delta := proxiedsites.Configure(cfg)
+// siteUpdate is a delta of proxied sites along with the schedules of the
+// added sites that are only proxied for a while.
+type siteUpdate struct {
+	proxiedsites.Delta
+	Schedules map[string]*config.SiteSchedule
+}
+
+// OnChange registers a function that's called whenever the set of proxied
+// sites changes, whether because of a new config, a new country or a
+// schedule.
+func OnChange(fn func()) {
+	changeHandlersMx.Lock()
+	changeHandlers = append(changeHandlers, fn)
+	changeHandlersMx.Unlock()
+}
+
+// Configure applies the global proxied sites and the ones for the country
+// we're in, and reports whether the set of proxied sites changed.
+func Configure(cfg *config.ProxiedSitesConfig) bool {
 	startMutex.Lock()
+	defer startMutex.Unlock()
+	lastCfg = cfg
+	return configure(cfg, time.Now())
+}
 
+// CountryChanged applies the proxied sites for the country we're in now and
+// reports whether the set of proxied sites changed.
+func CountryChanged() bool {
+	startMutex.Lock()
+	defer startMutex.Unlock()
+	if lastCfg == nil {
+		// Not configured yet
+		return false
+	}
+	return configure(lastCfg, time.Now())
+}
+
+// configure applies the given config as of the given time, which determines
+// which scheduled sites are proxied.
+func configure(globalCfg *config.ProxiedSitesConfig, now time.Time) bool {
+	currentCountry := country()
+	detourCountry := currentCountry
+	if detourCountry == "" {
+		// Until we know better, use IR since it has all detection rules
+		detourCountry = "IR"
+	}
+	detour.SetCountry(detourCountry)
+	log.Debugf("Applying proxied sites for country %q", currentCountry)
+	cfg := globalCfg.ForCountry(currentCountry)
+	cfg.Delta = globalCfg.ScheduledDelta(now)
+	inactiveSites = strings.Join(globalCfg.InactiveSites(now), "\n")
+	delta := proxiedsites.Configure(cfg)
 	if delta != nil {
 		updateDetour(delta)
 	}
+	changed := updateSites(cfg)
+	sitesMx.Lock()
+	schedules = globalCfg.Schedules
+	sitesMx.Unlock()
+	updateSubscriptions(globalCfg)
 	if service == nil {
 		// Initializing service.
 		if err := start(); err != nil {
@@ -41,7 +137,7 @@ func Configure(cfg *proxiedsites.Config) {
 		// Sending delta.
 		message := ui.Envelope{
 			EnvelopeType: ui.EnvelopeType{messageType},
-			Message:      delta,
+			Message:      &siteUpdate{Delta: *delta, Schedules: globalCfg.Schedules},
 		}
 		b, err := json.Marshal(message)
 
@@ -51,56 +147,251 @@ func Configure(cfg *proxiedsites.Config) {
 			service.Out <- b
 		}
 	}
-
-	startMutex.Unlock()
+	if changed {
+		changeHandlersMx.Lock()
+		handlers := changeHandlers
+		changeHandlersMx.Unlock()
+		for _, fn := range handlers {
+			fn()
+		}
+	}
+	return changed
 }
 
 func updateDetour(delta *proxiedsites.Delta) {
-	// TODO: subscribe changes of geolookup and set country accordingly
-	// safe to hardcode here as IR has all detection rules
-	detour.SetCountry("IR")
-
-	// for simplicity, detour matches whitelist using host:port string
-	// so we add ports to each proxiedsites
+	// Proxied sites bypass detour altogether (see Match), but detour may have
+	// whitelisted a deleted site on its own, so we forget about it. Detour
+	// matches its whitelist using host:port strings, so this only works for
+	// plain hosts.
 	for _, v := range delta.Deletions {
-		detour.RemoveFromWl(v + ":80")
-		detour.RemoveFromWl(v + ":443")
-	}
-	for _, v := range delta.Additions {
-		detour.AddToWl(v+":80", true)
-		detour.AddToWl(v+":443", true)
+		if strings.ContainsAny(v, "*/:") {
+			continue
+		}
+		for _, port := range defaultPorts {
+			removeFromWl(v + ":" + port)
+		}
 	}
 }
 
 func start() (err error) {
 	newMessage := func() interface{} {
-		return &proxiedsites.Delta{}
+		return &siteUpdate{}
 	}
 
 	// Registering a websocket service.
 	helloFn := func(write func(interface{}) error) error {
-		return write(proxiedsites.ActiveDelta())
+		update := &siteUpdate{}
+		if delta := proxiedsites.ActiveDelta(); delta != nil {
+			update.Delta = *delta
+		}
+		sitesMx.RLock()
+		update.Schedules = schedules
+		sitesMx.RUnlock()
+		return write(update)
 	}
 
-	if service, err = ui.Register(messageType, newMessage, helloFn); err != nil {
+	if service, err = ui.RegisterHandler(messageType, newMessage, helloFn, handle); err != nil {
 		return fmt.Errorf("Unable to register channel: %q", err)
 	}
 
-	// Initializing reader.
-	go read()
+	status.EnableProxySite(ui.HandleCrossOrigin(proxySitePath, http.HandlerFunc(serveProxySite)))
+	go sweep()
 
-	return nil
+	return startImport()
 }
 
-func read() {
-	for msg := range service.In {
-		err := config.Update(func(updated *config.Config) error {
-			log.Debugf("Applying update from UI")
-			updated.ProxiedSites.Delta.Merge(msg.(*proxiedsites.Delta))
-			return nil
-		})
-		if err != nil {
-			log.Debugf("Error applying update from UI: %v", err)
+// handle applies a delta from the UI. The UI gets the updated delta through
+// Configure once it's taken effect, so the reply is empty.
+func handle(msg interface{}) (interface{}, error) {
+	log.Debugf("Applying update from UI")
+	update := msg.(*siteUpdate)
+	if len(update.Additions) == 0 && len(update.Deletions) == 0 {
+		return nil, fmt.Errorf("Delta must include additions or deletions")
+	}
+	if err := ApplyScheduled(&update.Delta, update.Schedules); err != nil {
+		return nil, fmt.Errorf("Unable to apply update: %v", err)
+	}
+	return nil, nil
+}
+
+// Apply merges the given delta into the user's proxied sites. The change
+// takes effect once the updated config is published through Configure.
+func Apply(delta *proxiedsites.Delta) error {
+	return ApplyScheduled(delta, nil)
+}
+
+// ApplyScheduled is like Apply but only proxies the added sites that have a
+// schedule while their schedule is active. Added sites without a schedule
+// are proxied all the time, even if they used to have one. Since schedules
+// only limit when the user's additions are proxied, it rejects schedules for
+// sites that the delta doesn't add or that the cloud lists proxy anyway.
+func ApplyScheduled(delta *proxiedsites.Delta, schedules map[string]*config.SiteSchedule) error {
+	added := make(map[string]bool, len(delta.Additions))
+	for _, site := range delta.Additions {
+		added[site] = true
+	}
+	for site, schedule := range schedules {
+		if schedule == nil {
+			continue
+		}
+		if !added[site] {
+			return fmt.Errorf("Unable to schedule %v, which isn't being added", site)
 		}
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("Invalid schedule for %v: %v", site, err)
+		}
+	}
+	return updateConfig(func(updated *config.Config) error {
+		psc := updated.ProxiedSites
+		if len(schedules) > 0 {
+			for _, site := range psc.ForCountry(country()).Cloud {
+				if schedules[site] != nil {
+					return fmt.Errorf("Unable to schedule %v, which the cloud or subscribed lists always proxy", site)
+				}
+			}
+		}
+		psc.Delta.Merge(delta)
+		if psc.Schedules == nil {
+			psc.Schedules = make(map[string]*config.SiteSchedule)
+		}
+		for _, site := range delta.Deletions {
+			delete(psc.Schedules, site)
+		}
+		for _, site := range delta.Additions {
+			if schedule := schedules[site]; schedule != nil {
+				psc.Schedules[site] = schedule
+			} else {
+				delete(psc.Schedules, site)
+			}
+		}
+		return nil
+	})
+}
+
+// ActiveDelta returns the difference between the cloud proxied sites and the
+// ones the user is currently proxying.
+func ActiveDelta() *proxiedsites.Delta {
+	return proxiedsites.ActiveDelta()
+}
+
+// Count returns the number of sites that are currently proxied.
+func Count() int {
+	sitesMx.RLock()
+	defer sitesMx.RUnlock()
+	return len(sites)
+}
+
+// IsProxied checks whether the given site is currently proxied.
+func IsProxied(site string) bool {
+	sitesMx.RLock()
+	defer sitesMx.RUnlock()
+	return sites[site]
+}
+
+// Match checks whether traffic to the given host:port address should always
+// be proxied because it belongs to one of the proxied sites, see Matcher.
+func Match(addr string) bool {
+	sitesMx.RLock()
+	m := matcher
+	sitesMx.RUnlock()
+	return m.Match(addr)
+}
+
+// PACFunction returns the JavaScript source of a PAC function with the given
+// name that matches the proxied sites like Match does.
+func PACFunction(name string) string {
+	sitesMx.RLock()
+	m := matcher
+	sitesMx.RUnlock()
+	return m.PACFunction(name)
+}
+
+// updateSites records the sites that are proxied with the given config, which
+// are the cloud sites plus the user's additions minus the user's deletions,
+// and reports whether they changed.
+func updateSites(cfg *proxiedsites.Config) bool {
+	updated := make(map[string]bool, len(cfg.Cloud))
+	for _, site := range cfg.Cloud {
+		updated[site] = true
+	}
+	if cfg.Delta != nil {
+		for _, site := range cfg.Delta.Additions {
+			updated[site] = true
+		}
+		for _, site := range cfg.Delta.Deletions {
+			delete(updated, site)
+		}
+	}
+	patterns := make([]string, 0, len(updated))
+	for site := range updated {
+		patterns = append(patterns, site)
+	}
+	updatedMatcher := NewMatcher(patterns)
+
+	sitesMx.Lock()
+	changed := !sameSites(sites, updated)
+	sites = updated
+	matcher = updatedMatcher
+	close(configured)
+	configured = make(chan struct{})
+	sitesMx.Unlock()
+	return changed
+}
+
+func sameSites(a map[string]bool, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for site := range a {
+		if !b[site] {
+			return false
+		}
+	}
+	return true
+}
+
+// waitUntilProxied waits up to the given timeout for the given site to be
+// proxied and reports whether it is.
+func waitUntilProxied(site string, timeout time.Duration) bool {
+	deadline := time.After(timeout)
+	for {
+		sitesMx.RLock()
+		proxied := sites[site]
+		ch := configured
+		sitesMx.RUnlock()
+		if proxied {
+			return true
+		}
+		select {
+		case <-ch:
+		case <-deadline:
+			return false
+		}
+	}
+}
+
+// serveProxySite adds the site of an error page to the user's proxied sites
+// and then sends the browser back to the page. The site is identified by the
+// nonce that the page was generated with, which also ensures that only the
+// error page itself can make this request.
+func serveProxySite(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		http.Error(resp, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	site, u, ok := status.RedeemNonce(req.FormValue("nonce"))
+	if !ok {
+		http.Error(resp, "This page has expired, please reload it and try again", http.StatusForbidden)
+		return
+	}
+	log.Debugf("Proxying %v at the user's request", site)
+	if err := Apply(&proxiedsites.Delta{Additions: []string{site}}); err != nil {
+		log.Errorf("Unable to proxy %v: %v", site, err)
+		http.Error(resp, "Unable to proxy site", http.StatusInternalServerError)
+		return
+	}
+	if !waitUntilProxied(site, proxySiteTimeout) {
+		log.Debugf("%v not proxied after %v, reloading anyway", site, proxySiteTimeout)
 	}
+	http.Redirect(resp, req, u, http.StatusSeeOther)
 }
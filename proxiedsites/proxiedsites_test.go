@@ -0,0 +1,27 @@
+package proxiedsites
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getlantern/proxiedsites"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitUntilProxied(t *testing.T) {
+	updateSites(&proxiedsites.Config{
+		Cloud: []string{"a.com", "b.com"},
+		Delta: &proxiedsites.Delta{Additions: []string{"c.com"}, Deletions: []string{"b.com"}},
+	})
+	assert.Equal(t, 2, Count())
+	assert.True(t, IsProxied("a.com"))
+	assert.False(t, IsProxied("b.com"), "Deleted site should not be proxied")
+	assert.True(t, IsProxied("c.com"))
+
+	assert.False(t, waitUntilProxied("d.com", 50*time.Millisecond))
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		updateSites(&proxiedsites.Config{Cloud: []string{"a.com", "d.com"}})
+	}()
+	assert.True(t, waitUntilProxied("d.com", 5*time.Second), "Should notice site being added")
+}
@@ -0,0 +1,65 @@
+package proxiedsites
+
+import (
+	"strings"
+	"time"
+
+	"github.com/getlantern/flashlight/config"
+)
+
+// sweepInterval is how often we check whether scheduled sites started or
+// stopped being proxied. Schedules have a granularity of a minute.
+const sweepInterval = 1 * time.Minute
+
+// sweep periodically applies the schedules of the sites that the user added.
+func sweep() {
+	for {
+		time.Sleep(sweepInterval)
+		sweepAt(time.Now())
+	}
+}
+
+// sweepAt applies the config again if a scheduled site started or stopped
+// being proxied, which publishes the delta to the UI and detour, and removes
+// expired sites from the config for good.
+func sweepAt(now time.Time) {
+	startMutex.Lock()
+	if lastCfg == nil {
+		startMutex.Unlock()
+		return
+	}
+	if strings.Join(lastCfg.InactiveSites(now), "\n") != inactiveSites {
+		log.Debugf("Schedule of proxied sites changed")
+		configure(lastCfg, now)
+	}
+	expired := lastCfg.ExpiredSites(now)
+	startMutex.Unlock()
+
+	if len(expired) == 0 {
+		return
+	}
+	log.Debugf("Removing expired proxied sites: %v", expired)
+	err := updateConfig(func(updated *config.Config) error {
+		psc := updated.ProxiedSites
+		remove := make(map[string]bool, len(expired))
+		for _, site := range expired {
+			if schedule := psc.Schedules[site]; schedule != nil && schedule.Expired(now) {
+				remove[site] = true
+				delete(psc.Schedules, site)
+			}
+		}
+		if psc.Delta != nil {
+			additions := make([]string, 0, len(psc.Delta.Additions))
+			for _, site := range psc.Delta.Additions {
+				if !remove[site] {
+					additions = append(additions, site)
+				}
+			}
+			psc.Delta.Additions = additions
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Unable to remove expired proxied sites: %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package proxiedsites
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/getlantern/proxiedsites"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/ui"
+)
+
+// scheduleTest replaces the config, the UI and detour's whitelist with fakes
+// and applies the given proxied sites as of the given time.
+type scheduleTest struct {
+	cfg     *config.Config
+	out     chan interface{}
+	removed []string
+	restore func()
+}
+
+func newScheduleTest(psc *config.ProxiedSitesConfig, now time.Time) *scheduleTest {
+	st := &scheduleTest{
+		cfg: &config.Config{ProxiedSites: psc},
+		out: make(chan interface{}, 10),
+	}
+	oldUpdateConfig, oldRemoveFromWl, oldCountry, oldService := updateConfig, removeFromWl, country, service
+	st.restore = func() {
+		updateConfig, removeFromWl, country, service = oldUpdateConfig, oldRemoveFromWl, oldCountry, oldService
+		startMutex.Lock()
+		lastCfg = nil
+		startMutex.Unlock()
+	}
+	// Like config.Update followed by main applying the updated config
+	updateConfig = func(mutate func(*config.Config) error) error {
+		if err := mutate(st.cfg); err != nil {
+			return err
+		}
+		startMutex.Lock()
+		lastCfg = st.cfg.ProxiedSites
+		configure(lastCfg, now)
+		startMutex.Unlock()
+		return nil
+	}
+	removeFromWl = func(addr string) {
+		st.removed = append(st.removed, addr)
+	}
+	country = func() string {
+		return ""
+	}
+	service = &ui.Service{Out: st.out}
+
+	startMutex.Lock()
+	lastCfg = psc
+	configure(psc, now)
+	startMutex.Unlock()
+	st.drain()
+	return st
+}
+
+// drain returns the deltas that were published to the UI.
+func (st *scheduleTest) drain() []*siteUpdate {
+	var updates []*siteUpdate
+	for {
+		select {
+		case msg := <-st.out:
+			envelope := &struct{ Message *siteUpdate }{}
+			if err := json.Unmarshal(msg.([]byte), envelope); err == nil {
+				updates = append(updates, envelope.Message)
+			}
+		default:
+			return updates
+		}
+	}
+}
+
+func TestSweepClosesWindow(t *testing.T) {
+	open := time.Date(2026, 1, 5, 9, 30, 0, 0, time.Local)
+	st := newScheduleTest(&config.ProxiedSitesConfig{
+		Cloud: []string{"a.com"},
+		Delta: &proxiedsites.Delta{Additions: []string{"work.com"}, Deletions: []string{}},
+		Schedules: map[string]*config.SiteSchedule{
+			"work.com": &config.SiteSchedule{Windows: []*config.TimeWindow{&config.TimeWindow{Start: "09:00", End: "10:00"}}},
+		},
+	}, open)
+	defer st.restore()
+	assert.True(t, IsProxied("work.com"), "Site should be proxied during its window")
+
+	sweepAt(open.Add(10 * time.Minute))
+	assert.Empty(t, st.drain(), "Nothing should change while the window is open")
+	assert.Empty(t, st.removed)
+
+	sweepAt(open.Add(31 * time.Minute))
+	assert.False(t, IsProxied("work.com"), "Site should not be proxied after its window")
+	assert.True(t, IsProxied("a.com"))
+	assert.Equal(t, []string{"work.com:80", "work.com:443"}, st.removed, "Detour should forget about the site")
+	updates := st.drain()
+	if assert.Len(t, updates, 1, "UI should get the removal") {
+		assert.Equal(t, []string{"work.com"}, updates[0].Deletions)
+		assert.NotNil(t, updates[0].Schedules["work.com"], "UI should still know the schedule")
+	}
+	assert.Equal(t, []string{"work.com"}, st.cfg.ProxiedSites.Delta.Additions, "Site should stay in the config for its next window")
+}
+
+func TestSweepRemovesExpiredSites(t *testing.T) {
+	now := time.Date(2026, 1, 5, 9, 30, 0, 0, time.Local)
+	st := newScheduleTest(&config.ProxiedSitesConfig{
+		Delta: &proxiedsites.Delta{Additions: []string{"keep.com", "temp.com"}, Deletions: []string{}},
+		Schedules: map[string]*config.SiteSchedule{
+			"temp.com": &config.SiteSchedule{Expires: now.Add(time.Hour).Unix()},
+		},
+	}, now)
+	defer st.restore()
+	assert.True(t, IsProxied("temp.com"))
+
+	sweepAt(now.Add(2 * time.Hour))
+	assert.False(t, IsProxied("temp.com"), "Expired site should not be proxied")
+	assert.True(t, IsProxied("keep.com"))
+	assert.Equal(t, []string{"keep.com"}, st.cfg.ProxiedSites.Delta.Additions, "Expired site should be removed from the config")
+	assert.Empty(t, st.cfg.ProxiedSites.Schedules, "Expired schedule should be removed from the config")
+	assert.Contains(t, st.removed, "temp.com:443")
+}
+
+func TestApplyScheduled(t *testing.T) {
+	now := time.Now()
+	st := newScheduleTest(&config.ProxiedSitesConfig{
+		Cloud: []string{"cloud.com"},
+		Delta: &proxiedsites.Delta{Additions: []string{}, Deletions: []string{}},
+	}, now)
+	defer st.restore()
+	daily := &config.SiteSchedule{Windows: []*config.TimeWindow{&config.TimeWindow{Start: "00:00", End: "00:00"}}}
+
+	err := ApplyScheduled(&proxiedsites.Delta{Additions: []string{"a.com"}}, map[string]*config.SiteSchedule{"b.com": daily})
+	assert.Error(t, err, "Should reject schedule for site that isn't being added")
+	err = ApplyScheduled(&proxiedsites.Delta{Additions: []string{"cloud.com"}}, map[string]*config.SiteSchedule{"cloud.com": daily})
+	assert.Error(t, err, "Should reject schedule for site in cloud list")
+	bad := &config.SiteSchedule{Windows: []*config.TimeWindow{&config.TimeWindow{Start: "9am", End: "17:00"}}}
+	err = ApplyScheduled(&proxiedsites.Delta{Additions: []string{"a.com"}}, map[string]*config.SiteSchedule{"a.com": bad})
+	assert.Error(t, err, "Should reject invalid schedule")
+	assert.Empty(t, st.cfg.ProxiedSites.Delta.Additions, "Rejected updates should leave config unchanged")
+	assert.Empty(t, st.cfg.ProxiedSites.Schedules, "Rejected updates should leave config unchanged")
+
+	err = ApplyScheduled(&proxiedsites.Delta{Additions: []string{"a.com"}}, map[string]*config.SiteSchedule{"a.com": daily})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"a.com"}, st.cfg.ProxiedSites.Delta.Additions)
+		assert.Equal(t, daily, st.cfg.ProxiedSites.Schedules["a.com"])
+		assert.True(t, IsProxied("a.com"))
+	}
+}
@@ -0,0 +1,341 @@
+package proxiedsites
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/getlantern/flashlight/client"
+	"github.com/getlantern/flashlight/config"
+	"github.com/getlantern/flashlight/ui"
+	"github.com/getlantern/flashlight/util"
+)
+
+const (
+	importMessageType = `ProxiedSitesImport`
+
+	// subscriptionRefreshInterval is how often we fetch subscribed lists.
+	subscriptionRefreshInterval = 24 * time.Hour
+
+	// subscriptionCheckInterval is how often we check whether any subscribed
+	// lists are due to be fetched.
+	subscriptionCheckInterval = 1 * time.Hour
+
+	// maxImportSize is the largest list we import. gfwlist, one of the
+	// largest lists around, is about 150 KB.
+	maxImportSize = 10 * 1024 * 1024
+)
+
+var (
+	importService *ui.Service
+
+	// fetcher fetches subscribed lists that have a fronted URL through
+	// Lantern and domain fronting
+	fetcher util.HTTPFetcher = util.NewChainedAndFronted(client.Addr)
+
+	// chainedFetcher fetches subscribed lists that don't have a fronted URL,
+	// which can only be fetched through Lantern
+	chainedFetcher = util.NewChained(client.Addr)
+
+	// subscriptions are the current subscriptions along with what happened
+	// when we last fetched them, keyed by URL
+	subscriptions   = make(map[string]*subscriptionStatus)
+	subscriptionsMx sync.Mutex
+)
+
+// importRequest is a message from the UI to import a list of sites.
+type importRequest struct {
+	// Data is the content of a list that the user uploaded, which is merged
+	// into the user's proxied sites once.
+	Data string `json:"data"`
+	// URL is the URL of a list to subscribe to.
+	URL string `json:"url"`
+	// FrontedURL is an optional domain-fronted URL of the same list, see
+	// config.ProxiedSitesSubscription.
+	FrontedURL string `json:"frontedUrl"`
+	// Unsubscribe unsubscribes from the list at URL instead.
+	Unsubscribe bool `json:"unsubscribe"`
+	// Format is the format of the list, blank to detect it.
+	Format string `json:"format"`
+}
+
+// subscriptionStatus describes a subscribed list to the UI.
+type subscriptionStatus struct {
+	URL         string             `json:"url"`
+	Format      string             `json:"format"`
+	Sites       int                `json:"sites"`
+	Exemptions  int                `json:"exemptions"`
+	LastFetched int64              `json:"lastFetched"`
+	Error       string             `json:"error,omitempty"`
+	Unsupported []*UnsupportedRule `json:"unsupported"`
+}
+
+func startImport() (err error) {
+	newMessage := func() interface{} {
+		return &importRequest{}
+	}
+	helloFn := func(write func(interface{}) error) error {
+		return write(subscriptionStatuses())
+	}
+	if importService, err = ui.RegisterHandler(importMessageType, newMessage, helloFn, handleImport); err != nil {
+		return fmt.Errorf("Unable to register channel: %q", err)
+	}
+	importService.SetQueuePolicy(ui.Coalesce)
+
+	go refreshSubscriptions()
+	return nil
+}
+
+// handleImport imports an uploaded list or changes a subscription. The reply
+// to an upload or subscription is the ImportResult.
+func handleImport(msg interface{}) (interface{}, error) {
+	req := msg.(*importRequest)
+	switch {
+	case req.URL != "" && req.Unsubscribe:
+		log.Debugf("Unsubscribing from %v", req.URL)
+		return nil, updateConfig(func(updated *config.Config) error {
+			var subs []*config.ProxiedSitesSubscription
+			for _, sub := range updated.ProxiedSites.Subscriptions {
+				if sub.URL != req.URL {
+					subs = append(subs, sub)
+				}
+			}
+			updated.ProxiedSites.Subscriptions = subs
+			return nil
+		})
+	case req.URL != "":
+		return subscribe(req.URL, req.FrontedURL, req.Format)
+	case req.Data != "":
+		if len(req.Data) > maxImportSize {
+			return nil, fmt.Errorf("List is larger than %d bytes", maxImportSize)
+		}
+		result, err := Import([]byte(req.Data), req.Format)
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("Importing %d sites and %d exemptions, %d rules unsupported",
+			len(result.Delta.Additions), len(result.Delta.Deletions), len(result.Unsupported))
+		if len(result.Delta.Additions) > 0 || len(result.Delta.Deletions) > 0 {
+			if err := Apply(result.Delta); err != nil {
+				return nil, fmt.Errorf("Unable to apply imported sites: %v", err)
+			}
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("Request must include a list or a URL")
+}
+
+// subscribe fetches the list at the given URL and, if that works, subscribes
+// to it.
+func subscribe(u string, frontedURL string, format string) (*ImportResult, error) {
+	if !isHTTPURL(u) {
+		return nil, fmt.Errorf("Not an HTTP URL: %v", u)
+	}
+	if frontedURL != "" && !isHTTPURL(frontedURL) {
+		return nil, fmt.Errorf("Not an HTTP URL: %v", frontedURL)
+	}
+	sub := &config.ProxiedSitesSubscription{URL: u, FrontedURL: frontedURL, Format: format}
+	result, err := fetchSubscription(sub)
+	if err != nil {
+		forgetUnsubscribed()
+		return nil, err
+	}
+	log.Debugf("Subscribing to %v", u)
+	err = updateConfig(func(updated *config.Config) error {
+		if existing := updated.ProxiedSites.Subscription(u); existing != nil {
+			existing.FrontedURL = frontedURL
+			existing.Format = format
+			sub = existing
+		} else {
+			updated.ProxiedSites.Subscriptions = append(updated.ProxiedSites.Subscriptions, sub)
+		}
+		sub.Delta = result.Delta
+		sub.LastFetched = time.Now().Unix()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to save subscription: %v", err)
+	}
+	return result, nil
+}
+
+func isHTTPURL(u string) bool {
+	parsed, err := url.Parse(u)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// refreshSubscriptions periodically fetches the subscribed lists that are
+// due.
+func refreshSubscriptions() {
+	for {
+		for _, sub := range dueSubscriptions(time.Now()) {
+			result, err := fetchSubscription(sub)
+			if err != nil {
+				log.Errorf("Unable to refresh proxied sites from %v: %v", sub.URL, err)
+				continue
+			}
+			err = updateConfig(func(updated *config.Config) error {
+				current := updated.ProxiedSites.Subscription(sub.URL)
+				if current == nil {
+					return fmt.Errorf("No longer subscribed to %v", sub.URL)
+				}
+				current.Delta = result.Delta
+				current.LastFetched = time.Now().Unix()
+				return nil
+			})
+			if err != nil {
+				log.Debugf("Unable to save proxied sites from %v: %v", sub.URL, err)
+			}
+		}
+		time.Sleep(subscriptionCheckInterval)
+	}
+}
+
+// dueSubscriptions returns copies of the subscriptions that haven't been
+// fetched for subscriptionRefreshInterval.
+func dueSubscriptions(now time.Time) []*config.ProxiedSitesSubscription {
+	startMutex.Lock()
+	defer startMutex.Unlock()
+	if lastCfg == nil {
+		return nil
+	}
+	var due []*config.ProxiedSitesSubscription
+	for _, sub := range lastCfg.Subscriptions {
+		if now.Sub(time.Unix(sub.LastFetched, 0)) >= subscriptionRefreshInterval {
+			copied := *sub
+			due = append(due, &copied)
+		}
+	}
+	return due
+}
+
+// fetchSubscription fetches and imports the given subscription's list,
+// recording the outcome for the UI.
+func fetchSubscription(sub *config.ProxiedSitesSubscription) (*ImportResult, error) {
+	result, err := doFetchSubscription(sub)
+	subscriptionsMx.Lock()
+	status := &subscriptionStatus{URL: sub.URL, Format: sub.Format, Unsupported: []*UnsupportedRule{}}
+	if existing := subscriptions[sub.URL]; existing != nil {
+		// Keep what we know about the sites we got last time
+		copied := *existing
+		status = &copied
+		status.Error = ""
+	}
+	if err != nil {
+		status.Error = err.Error()
+	} else {
+		status.Format = result.Format
+		status.Unsupported = result.Unsupported
+		if len(result.Unsupported) > 0 {
+			log.Debugf("%d rules from %v aren't supported", len(result.Unsupported), sub.URL)
+		}
+	}
+	subscriptions[sub.URL] = status
+	subscriptionsMx.Unlock()
+	publishSubscriptions()
+	return result, err
+}
+
+func doFetchSubscription(sub *config.ProxiedSitesSubscription) (*ImportResult, error) {
+	req, err := http.NewRequest("GET", sub.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to construct request for %v: %v", sub.URL, err)
+	}
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Close = true
+
+	// Lists that aren't published behind a CDN we front through can only be
+	// fetched through Lantern.
+	f := chainedFetcher
+	if sub.FrontedURL != "" {
+		req.Header.Set("Lantern-Fronted-URL", sub.FrontedURL)
+		f = fetcher
+	}
+	resp, err := f.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to fetch %v: %v", sub.URL, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Debugf("Error closing response body: %v", err)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected response status: %d", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxImportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read %v: %v", sub.URL, err)
+	}
+	if len(data) > maxImportSize {
+		return nil, fmt.Errorf("List at %v is larger than %d bytes", sub.URL, maxImportSize)
+	}
+	return Import(data, sub.Format)
+}
+
+// updateSubscriptions records the subscriptions of a new config.
+func updateSubscriptions(cfg *config.ProxiedSitesConfig) {
+	subscriptionsMx.Lock()
+	updated := make(map[string]*subscriptionStatus, len(cfg.Subscriptions))
+	for _, sub := range cfg.Subscriptions {
+		status := subscriptions[sub.URL]
+		if status == nil {
+			status = &subscriptionStatus{URL: sub.URL, Format: sub.Format, Unsupported: []*UnsupportedRule{}}
+		}
+		status.LastFetched = sub.LastFetched
+		status.Sites, status.Exemptions = 0, 0
+		if sub.Delta != nil {
+			status.Sites = len(sub.Delta.Additions)
+			status.Exemptions = len(sub.Delta.Deletions)
+		}
+		updated[sub.URL] = status
+	}
+	subscriptions = updated
+	subscriptionsMx.Unlock()
+	publishSubscriptions()
+}
+
+// forgetUnsubscribed forgets about lists that we fetched but didn't subscribe
+// to.
+func forgetUnsubscribed() {
+	startMutex.Lock()
+	cfg := lastCfg
+	startMutex.Unlock()
+	subscriptionsMx.Lock()
+	for url := range subscriptions {
+		if cfg == nil || cfg.Subscription(url) == nil {
+			delete(subscriptions, url)
+		}
+	}
+	subscriptionsMx.Unlock()
+	publishSubscriptions()
+}
+
+func subscriptionStatuses() []*subscriptionStatus {
+	subscriptionsMx.Lock()
+	defer subscriptionsMx.Unlock()
+	statuses := make([]*subscriptionStatus, 0, len(subscriptions))
+	for _, url := range sortedStatusKeys(subscriptions) {
+		copied := *subscriptions[url]
+		statuses = append(statuses, &copied)
+	}
+	return statuses
+}
+
+func publishSubscriptions() {
+	if importService != nil {
+		importService.Out <- subscriptionStatuses()
+	}
+}
+
+func sortedStatusKeys(m map[string]*subscriptionStatus) []string {
+	keys := make(map[string]bool, len(m))
+	for key := range m {
+		keys[key] = true
+	}
+	return sortedKeys(keys)
+}
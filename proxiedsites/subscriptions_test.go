@@ -0,0 +1,85 @@
+package proxiedsites
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/getlantern/flashlight/config"
+)
+
+type fetcherFunc func(req *http.Request) (*http.Response, error)
+
+func (f fetcherFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestFetchSubscription(t *testing.T) {
+	oldFetcher := fetcher
+	oldChainedFetcher := chainedFetcher
+	defer func() {
+		fetcher = oldFetcher
+		chainedFetcher = oldChainedFetcher
+	}()
+	lists := map[string]string{
+		"http://lists.example.com/hosts": "0.0.0.0 a.com\n0.0.0.0 b.com\nnonsense\n",
+		"http://lists.example.com/big":   string(make([]byte, maxImportSize+1)),
+	}
+	serve := func(req *http.Request) (*http.Response, error) {
+		list, found := lists[req.URL.String()]
+		if !found {
+			return nil, fmt.Errorf("Not found")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(list)),
+		}, nil
+	}
+	var fronted []string
+	fetcher = fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		fronted = append(fronted, req.Header.Get("Lantern-Fronted-URL"))
+		return serve(req)
+	})
+	chainedFetcher = fetcherFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Empty(t, req.Header.Get("Lantern-Fronted-URL"), "Lists without a fronted URL shouldn't be fronted")
+		return serve(req)
+	})
+
+	sub := &config.ProxiedSitesSubscription{URL: "http://lists.example.com/hosts"}
+	result, err := fetchSubscription(sub)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"a.com", "b.com"}, result.Delta.Additions)
+	assert.Len(t, result.Unsupported, 1)
+	assert.Empty(t, fronted, "Lists without a fronted URL should only be fetched through Lantern")
+
+	_, err = fetchSubscription(&config.ProxiedSitesSubscription{URL: "http://lists.example.com/hosts", FrontedURL: "http://fronted.example.com/hosts"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"http://fronted.example.com/hosts"}, fronted, "Should front lists with a fronted URL")
+
+	_, err = fetchSubscription(&config.ProxiedSitesSubscription{URL: "http://lists.example.com/big"})
+	assert.Error(t, err, "Should refuse lists that are too big")
+	_, err = fetchSubscription(&config.ProxiedSitesSubscription{URL: "http://lists.example.com/missing"})
+	assert.Error(t, err)
+
+	sub.Delta = result.Delta
+	sub.LastFetched = 1000
+	updateSubscriptions(&config.ProxiedSitesConfig{
+		Subscriptions: []*config.ProxiedSitesSubscription{sub},
+	})
+	statuses := subscriptionStatuses()
+	if assert.Len(t, statuses, 1, "Should only list subscribed lists") {
+		status := statuses[0]
+		assert.Equal(t, sub.URL, status.URL)
+		assert.Equal(t, FormatHosts, status.Format)
+		assert.Equal(t, 2, status.Sites)
+		assert.Equal(t, int64(1000), status.LastFetched)
+		assert.Len(t, status.Unsupported, 1)
+		assert.Empty(t, status.Error)
+	}
+}
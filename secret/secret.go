@@ -0,0 +1,211 @@
+// Package secret encrypts sensitive values like auth tokens before they are
+// written to disk, using a key that is unique to each installation.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getlantern/golog"
+	"github.com/getlantern/yaml"
+)
+
+const (
+	// KeyFileName is the name of the file in the config directory that holds
+	// the per-install encryption key.
+	KeyFileName = ".lantern-key"
+
+	// prefix identifies encrypted values. Values without it are treated as
+	// plaintext, which allows transparently migrating existing files.
+	prefix = "enc:v1:"
+
+	keySize = 32
+)
+
+var (
+	log = golog.LoggerFor("flashlight.secret")
+
+	key   []byte
+	keyMx sync.RWMutex
+)
+
+// Configure loads the encryption key from the key file in the given
+// directory, creating a new random key if there isn't one yet. created
+// indicates whether a new key was created, in which case existing files
+// likely still contain plaintext values.
+func Configure(dir string) (created bool, err error) {
+	err = Load(dir)
+	if err == nil {
+		return false, nil
+	}
+	if !os.IsNotExist(err) {
+		return false, err
+	}
+
+	path := filepath.Join(dir, KeyFileName)
+	log.Debugf("Creating new encryption key at %v", path)
+	k := make([]byte, keySize)
+	if _, err := rand.Read(k); err != nil {
+		return false, fmt.Errorf("Unable to generate key: %v", err)
+	}
+	// O_EXCL makes sure we never overwrite a key that was concurrently created
+	// by another process, which would make its encrypted values unreadable.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return false, fmt.Errorf("Unable to create key file %v: %v", path, err)
+	}
+	_, err = f.Write([]byte(base64.StdEncoding.EncodeToString(k)))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return false, fmt.Errorf("Unable to write key file %v: %v", path, err)
+	}
+	setKey(k)
+	return true, nil
+}
+
+// Load loads the encryption key from the key file in the given directory. If
+// there is no key file, it returns an error for which os.IsNotExist is true.
+func Load(dir string) error {
+	path := filepath.Join(dir, KeyFileName)
+	encoded, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	k, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil || len(k) != keySize {
+		return fmt.Errorf("Invalid key in %v", path)
+	}
+	setKey(k)
+	return nil
+}
+
+func setKey(k []byte) {
+	keyMx.Lock()
+	key = k
+	keyMx.Unlock()
+}
+
+func getKey() []byte {
+	keyMx.RLock()
+	defer keyMx.RUnlock()
+	return key
+}
+
+func newGCM(k []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// IsEncrypted returns true if the given value was produced by Encrypt.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt encrypts the given value. Empty and already encrypted values are
+// returned as is, as is everything if Configure hasn't been called.
+func Encrypt(value string) (string, error) {
+	k := getKey()
+	if k == nil || value == "" || IsEncrypted(value) {
+		return value, nil
+	}
+	gcm, err := newGCM(k)
+	if err != nil {
+		return "", fmt.Errorf("Unable to initialize cipher: %v", err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("Unable to generate nonce: %v", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(value), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value produced by Encrypt. Plaintext values are returned
+// as is.
+func Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	k := getKey()
+	if k == nil {
+		return "", fmt.Errorf("Encrypted value found but no key configured")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil {
+		return "", fmt.Errorf("Unable to decode encrypted value: %v", err)
+	}
+	gcm, err := newGCM(k)
+	if err != nil {
+		return "", fmt.Errorf("Unable to initialize cipher: %v", err)
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("Encrypted value too short")
+	}
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("Unable to decrypt value, was it encrypted with a different key? %v", err)
+	}
+	return string(plain), nil
+}
+
+// MustEncrypt is like Encrypt but logs errors and falls back to returning the
+// plaintext value, for use in places where failing isn't an option.
+func MustEncrypt(value string) string {
+	encrypted, err := Encrypt(value)
+	if err != nil {
+		log.Errorf("Unable to encrypt value, storing as plaintext: %v", err)
+		return value
+	}
+	return encrypted
+}
+
+// DecryptYAML decrypts all encrypted values in the given YAML document, which
+// is useful for inspecting config and settings files in support cases.
+func DecryptYAML(b []byte) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("Unable to parse YAML: %v", err)
+	}
+	decrypted, err := decryptAll(doc)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(decrypted)
+}
+
+func decryptAll(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case string:
+		return Decrypt(t)
+	case map[interface{}]interface{}:
+		for key, value := range t {
+			decrypted, err := decryptAll(value)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", key, err)
+			}
+			t[key] = decrypted
+		}
+	case []interface{}:
+		for i, value := range t {
+			decrypted, err := decryptAll(value)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %v", i, err)
+			}
+			t[i] = decrypted
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,80 @@
+package secret
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func withKeyDir(t *testing.T, fn func(dir string)) {
+	dir, err := ioutil.TempDir("", "secret")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		setKey(nil)
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("Unable to remove temp dir: %v", err)
+		}
+	}()
+	fn(dir)
+}
+
+func TestConfigure(t *testing.T) {
+	withKeyDir(t, func(dir string) {
+		created, err := Configure(dir)
+		assert.NoError(t, err)
+		assert.True(t, created, "Should have created key")
+		fi, err := os.Stat(filepath.Join(dir, KeyFileName))
+		if assert.NoError(t, err) {
+			assert.Equal(t, os.FileMode(0600), fi.Mode().Perm(), "Key file should only be readable by owner")
+		}
+		encrypted, err := Encrypt("token")
+		assert.NoError(t, err)
+
+		created, err = Configure(dir)
+		assert.NoError(t, err)
+		assert.False(t, created, "Should have reused key")
+		decrypted, err := Decrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "token", decrypted, "Should decrypt with reloaded key")
+	})
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	plain, err := Encrypt("token")
+	assert.NoError(t, err)
+	assert.Equal(t, "token", plain, "Without a key, values should be left alone")
+
+	withKeyDir(t, func(dir string) {
+		_, err := Configure(dir)
+		if !assert.NoError(t, err) {
+			return
+		}
+		encrypted, err := Encrypt("token")
+		assert.NoError(t, err)
+		assert.True(t, IsEncrypted(encrypted))
+		again, err := Encrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, encrypted, again, "Should not double encrypt")
+
+		decrypted, err := Decrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "token", decrypted)
+
+		plain, err := Decrypt("plaintext")
+		assert.NoError(t, err)
+		assert.Equal(t, "plaintext", plain, "Plaintext should pass through")
+
+		_, err = Decrypt(prefix + "garbage")
+		assert.Error(t, err)
+
+		doc := "client:\n  deviceid: " + encrypted + "\n  ports: [80, 443]\n"
+		out, err := DecryptYAML([]byte(doc))
+		assert.NoError(t, err)
+		assert.Equal(t, "client:\n  deviceid: token\n  ports:\n  - 80\n  - 443\n", string(out))
+	})
+}
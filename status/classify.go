@@ -0,0 +1,158 @@
+package status
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ErrorClass is a kind of failure to access a page, along with what we tell
+// the user about it.
+type ErrorClass struct {
+	// Name identifies the class in JSON responses.
+	Name string
+	// StatusCode is the HTTP status with which to respond.
+	StatusCode int
+	// Title is the heading of the error page.
+	Title string
+	// Summary explains what happened. %s is replaced with the server name.
+	Summary string
+	// Suggestions are things the user can do about it, most useful first.
+	Suggestions []string
+}
+
+var (
+	// ErrDNS means that the server's name couldn't be resolved.
+	ErrDNS = &ErrorClass{
+		Name:       "dns",
+		StatusCode: http.StatusBadGateway,
+		Title:      "Server not found",
+		Summary:    "Lantern could not find the server at %s.",
+		Suggestions: []string{
+			"Check the address for typing mistakes.",
+			"The domain may not exist anymore, or its registration may have expired.",
+		},
+	}
+
+	// ErrTLS means that a secure connection couldn't be established, for
+	// example because a certificate didn't match the one we expected.
+	ErrTLS = &ErrorClass{
+		Name:       "tls",
+		StatusCode: http.StatusBadGateway,
+		Title:      "Secure connection failed",
+		Summary:    "Lantern could not establish a secure connection to %s.",
+		Suggestions: []string{
+			"Someone on your network may be interfering with your connection. Try a different network.",
+			"Make sure that your computer's date and time are correct.",
+			"If this keeps happening, update Lantern.",
+		},
+	}
+
+	// ErrTimeout means that dialing or waiting for a response took too long.
+	ErrTimeout = &ErrorClass{
+		Name:       "timeout",
+		StatusCode: http.StatusGatewayTimeout,
+		Title:      "Connection timed out",
+		Summary:    "The server at %s took too long to respond.",
+		Suggestions: []string{
+			"The site may be busy or temporarily unavailable. Try again in a few moments.",
+			"Check your network connection.",
+		},
+	}
+
+	// ErrNoBalancer means that there was no Lantern server to send the
+	// request through.
+	ErrNoBalancer = &ErrorClass{
+		Name:       "nobalancer",
+		StatusCode: http.StatusBadGateway,
+		Title:      "Lantern is not connected",
+		Summary:    "Lantern could not connect to any of its servers to reach %s.",
+		Suggestions: []string{
+			"Lantern may still be starting up. Wait a moment and try again.",
+			"Check your network connection.",
+			"If this keeps happening, restart Lantern.",
+		},
+	}
+
+	// ErrReset means that the connection was closed before we got a
+	// response.
+	ErrReset = &ErrorClass{
+		Name:       "reset",
+		StatusCode: http.StatusBadGateway,
+		Title:      "Connection interrupted",
+		Summary:    "The connection to %s was interrupted.",
+		Suggestions: []string{
+			"Try again.",
+			"If this keeps happening, your network may be interfering with Lantern. Try a different network.",
+		},
+	}
+
+	// ErrBlocked means that the Lantern server refused to connect to the
+	// site.
+	ErrBlocked = &ErrorClass{
+		Name:       "blocked",
+		StatusCode: http.StatusForbidden,
+		Title:      "Access not allowed",
+		Summary:    "Lantern's servers do not allow access to %s.",
+		Suggestions: []string{
+			"Some sites and ports can't be reached through Lantern. Try turning Lantern off to access the site directly.",
+		},
+	}
+
+	// ErrUnknown is any other error.
+	ErrUnknown = &ErrorClass{
+		Name:       "unknown",
+		StatusCode: http.StatusBadGateway,
+		Title:      "Error accessing page",
+		Summary:    "Lantern could not access the server at %s.",
+		Suggestions: []string{
+			"Did you make a mistake when typing the domain? Check the url and try again.",
+			"Are you unable to browse other sites? Check your network connection and DNS server settings.",
+			"Is your computer or network protected by a firewall or proxy? Incorrect settings can interfere with Web browsing.",
+		},
+	}
+)
+
+// errorClasses are all of the error classes, for listing their messages.
+var errorClasses = []*ErrorClass{ErrDNS, ErrTLS, ErrTimeout, ErrNoBalancer, ErrReset, ErrBlocked, ErrUnknown}
+
+// Classify determines the class of the given error. Errors from the chained
+// servers generally only reach us as text, so apart from a few well-known
+// types this goes by the error message.
+func Classify(err error) *ErrorClass {
+	if err == nil {
+		return ErrUnknown
+	}
+	msg := strings.ToLower(err.Error())
+	contains := func(substrs ...string) bool {
+		for _, s := range substrs {
+			if strings.Contains(msg, s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case contains("no dialers", "unable to get balancer", "no balancer", "no chained servers"):
+		return ErrNoBalancer
+	case contains("forbidden", "blocked"):
+		return ErrBlocked
+	}
+	if _, ok := err.(*net.DNSError); ok || contains("no such host", "server misbehaving") {
+		return ErrDNS
+	}
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		return ErrTimeout
+	}
+	switch {
+	case contains("x509:", "tls:", "certificate", "handshake failure"):
+		return ErrTLS
+	case contains("timeout", "timed out"):
+		return ErrTimeout
+	case err == io.EOF || err == io.ErrUnexpectedEOF || contains("connection reset", "broken pipe", "eof"):
+		return ErrReset
+	}
+	return ErrUnknown
+}
@@ -0,0 +1,36 @@
+package status
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "dial tcp 1.2.3.4:443: i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassify(t *testing.T) {
+	cases := map[error]*ErrorClass{
+		&net.DNSError{Err: "no such host", Name: "nonexistent.example"}:  ErrDNS,
+		errors.New("dial tcp: lookup nonexistent.example: no such host"): ErrDNS,
+		errors.New("Server's certificate didn't match expected!"):        ErrTLS,
+		errors.New("x509: certificate signed by unknown authority"):      ErrTLS,
+		timeoutError{}: ErrTimeout,
+		errors.New("net/http: TLS handshake timeout"):                ErrTimeout,
+		errors.New("No dialers left to try on pass 2"):               ErrNoBalancer,
+		errors.New("read tcp 1.2.3.4:443: connection reset by peer"): ErrReset,
+		io.ErrUnexpectedEOF:                            ErrReset,
+		errors.New("Unable to CONNECT: 403 Forbidden"): ErrBlocked,
+		errors.New("something else entirely"):          ErrUnknown,
+		nil:                                            ErrUnknown,
+	}
+	for err, expected := range cases {
+		assert.Equal(t, expected.Name, Classify(err).Name, "%v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/getlantern/golog"
+	"github.com/getlantern/jibber_jabber"
+)
+
+const (
+	// statusKeyPrefix is the prefix of the keys in the locale files that
+	// translate the status pages. locale/en_US.json lists all of them, with
+	// their English texts, for translators to add to the locale files that
+	// lantern-ui serves from ui.Translations.
+	statusKeyPrefix = "STATUS_"
+)
+
+var (
+	log = golog.LoggerFor("flashlight.status")
+
+	// messagesFn reads locale files, see SetMessagesFunc.
+	messagesFn   func(filename string) ([]byte, error)
+	messagesFnMx sync.RWMutex
+
+	// locales caches the messages of the locale files we've read, nil for
+	// locales that don't translate the status pages.
+	locales   = make(map[string]map[string]string)
+	localesMx sync.Mutex
+
+	osLocale     string
+	osLocaleOnce sync.Once
+
+	// rtlLanguages are the languages that are written right to left.
+	rtlLanguages = map[string]bool{
+		"ar": true,
+		"fa": true,
+		"he": true,
+		"ur": true,
+	}
+
+	// defaultMessages are the English texts of the status pages other than the
+	// ones of the error classes.
+	defaultMessages = map[string]string{
+		"STATUS_PAGE_TITLE":    "Lantern: Error Accessing Page",
+		"STATUS_ERROR_MESSAGE": "Does the error message %s mean something to you?",
+		"STATUS_TRY_AGAIN":     "Try again",
+		"STATUS_PROXY_SITE":    "Proxy this site",
+	}
+)
+
+// SetMessagesFunc sets the function with which to read locale files like
+// "fa_IR.json". It's the same function that's given to i18n, so the status
+// pages are translated along with the rest of Lantern.
+func SetMessagesFunc(fn func(filename string) ([]byte, error)) {
+	messagesFnMx.Lock()
+	messagesFn = fn
+	messagesFnMx.Unlock()
+	localesMx.Lock()
+	locales = make(map[string]map[string]string)
+	localesMx.Unlock()
+}
+
+// translator translates the status pages into one locale, falling back to
+// English for missing messages.
+type translator struct {
+	lang     string
+	messages map[string]string
+}
+
+// translatorFor returns a translator for the language that the browser that
+// sent the given request prefers, or else the language of the OS.
+func translatorFor(req *http.Request) *translator {
+	candidates := acceptedLanguages(req.Header.Get("Accept-Language"))
+	osLocaleOnce.Do(func() {
+		var err error
+		if osLocale, err = jibber_jabber.DetectIETF(); err != nil {
+			log.Debugf("Unable to detect OS locale: %v", err)
+		}
+	})
+	if osLocale != "" {
+		candidates = append(candidates, osLocale)
+	}
+	for _, candidate := range candidates {
+		for _, locale := range localeFiles(candidate) {
+			if messages := loadLocale(locale); messages != nil {
+				return &translator{lang: strings.SplitN(locale, "_", 2)[0], messages: messages}
+			}
+		}
+	}
+	return &translator{lang: "en"}
+}
+
+// acceptedLanguages parses an Accept-Language header into the accepted
+// language tags, most preferred first.
+func acceptedLanguages(header string) []string {
+	var langs acceptedLanguageList
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if parsed, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q > 0 {
+			langs = append(langs, &acceptedLanguage{tag, q})
+		}
+	}
+	// Stable, so that tags with equal quality keep their order
+	sort.Stable(langs)
+	tags := make([]string, 0, len(langs))
+	for _, lang := range langs {
+		tags = append(tags, lang.tag)
+	}
+	return tags
+}
+
+type acceptedLanguage struct {
+	tag string
+	q   float64
+}
+
+// acceptedLanguageList sorts accepted languages by quality, highest first.
+type acceptedLanguageList []*acceptedLanguage
+
+func (l acceptedLanguageList) Len() int           { return len(l) }
+func (l acceptedLanguageList) Less(i, j int) bool { return l[i].q > l[j].q }
+func (l acceptedLanguageList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// localeFiles returns the names of the locale files to try for the given
+// language tag, like "fa_IR" and then "fa" for "fa-ir".
+func localeFiles(tag string) []string {
+	parts := strings.SplitN(strings.Replace(tag, "-", "_", -1), "_", 2)
+	lang := strings.ToLower(parts[0])
+	if len(parts) == 1 {
+		return []string{lang}
+	}
+	return []string{lang + "_" + strings.ToUpper(parts[1]), lang}
+}
+
+// loadLocale reads the messages of the given locale, returning nil if there's
+// no locale file or it doesn't translate the status pages.
+func loadLocale(locale string) map[string]string {
+	localesMx.Lock()
+	defer localesMx.Unlock()
+	if messages, found := locales[locale]; found {
+		return messages
+	}
+	messagesFnMx.RLock()
+	fn := messagesFn
+	messagesFnMx.RUnlock()
+	var messages map[string]string
+	if fn != nil {
+		messages = readLocale(fn, locale)
+	}
+	locales[locale] = messages
+	return messages
+}
+
+func readLocale(fn func(filename string) ([]byte, error), locale string) map[string]string {
+	b, err := fn(locale + ".json")
+	if err != nil {
+		return nil
+	}
+	all := make(map[string]interface{})
+	if err := json.Unmarshal(b, &all); err != nil {
+		log.Debugf("Unable to parse locale file for %v: %v", locale, err)
+		return nil
+	}
+	messages := make(map[string]string)
+	for key, val := range all {
+		if s, ok := val.(string); ok && strings.HasPrefix(key, statusKeyPrefix) {
+			messages[key] = s
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages
+}
+
+// dir returns the direction in which the translator's language is written,
+// for the dir attribute of HTML elements.
+func (tr *translator) dir() string {
+	if rtlLanguages[tr.lang] {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// T translates the message with the given key, using the given English text
+// if there's no translation.
+func (tr *translator) T(key string, english string) string {
+	if s, ok := tr.messages[key]; ok && s != "" {
+		return s
+	}
+	return english
+}
+
+// messageT translates one of defaultMessages.
+func (tr *translator) messageT(key string) string {
+	return tr.T(key, defaultMessages[key])
+}
+
+// classT translates the texts of the given error class about the given
+// server.
+func (tr *translator) classT(class *ErrorClass, server string) (title string, summary string, suggestions []string) {
+	prefix := classKeyPrefix(class)
+	title = tr.T(prefix+"TITLE", class.Title)
+	summary = fmt.Sprintf(tr.T(prefix+"SUMMARY", class.Summary), server)
+	for i, suggestion := range class.Suggestions {
+		suggestions = append(suggestions, tr.T(fmt.Sprintf("%vSUGGESTION_%d", prefix, i+1), suggestion))
+	}
+	return
+}
+
+// classKeyPrefix returns the prefix of the keys of the messages of the given
+// error class, like STATUS_DNS_ for ErrDNS.
+func classKeyPrefix(class *ErrorClass) string {
+	return statusKeyPrefix + strings.ToUpper(class.Name) + "_"
+}
+
+// englishMessages returns all of the messages of the status pages keyed by
+// the keys with which locale files translate them, which is what
+// locale/en_US.json lists for translators.
+func englishMessages() map[string]string {
+	messages := make(map[string]string)
+	for key, english := range defaultMessages {
+		messages[key] = english
+	}
+	for _, class := range errorClasses {
+		prefix := classKeyPrefix(class)
+		messages[prefix+"TITLE"] = class.Title
+		messages[prefix+"SUMMARY"] = class.Summary
+		for i, suggestion := range class.Suggestions {
+			messages[fmt.Sprintf("%vSUGGESTION_%d", prefix, i+1)] = suggestion
+		}
+	}
+	return messages
+}
+
+// funcs returns the functions with which templates translate their texts.
+func (tr *translator) funcs() template.FuncMap {
+	return template.FuncMap{
+		"t": tr.messageT,
+		// tcode translates a message that contains code, which is
+		// substituted for its %s.
+		"tcode": func(key string, code string) template.HTML {
+			parts := strings.SplitN(tr.messageT(key), "%s", 2)
+			html := template.HTMLEscapeString(parts[0])
+			if len(parts) == 2 {
+				html += "<code>" + template.HTMLEscapeString(code) + "</code>" + template.HTMLEscapeString(parts[1])
+			}
+			return template.HTML(html)
+		},
+	}
+}
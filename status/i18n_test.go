@@ -0,0 +1,79 @@
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptedLanguages(t *testing.T) {
+	assert.Equal(t, []string{"fa-IR", "fa", "en-US", "en"}, acceptedLanguages("fa-IR,fa;q=0.9,en-US;q=0.8,en;q=0.7"))
+	assert.Equal(t, []string{"ar", "de", "fr"}, acceptedLanguages("de;q=0.5, ar, fr;q=0.5, *;q=0.1, es;q=0"))
+	assert.Empty(t, acceptedLanguages(""))
+	assert.Equal(t, []string{"fa_IR", "fa"}, localeFiles("fa-ir"))
+	assert.Equal(t, []string{"ar"}, localeFiles("AR"))
+}
+
+func TestEnglishMessagesListed(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.Join("locale", "en_US.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	listed := make(map[string]string)
+	if assert.NoError(t, json.Unmarshal(b, &listed)) {
+		assert.Equal(t, englishMessages(), listed, "locale/en_US.json should list all messages of the status pages")
+	}
+}
+
+func TestLocalizedErrorPage(t *testing.T) {
+	SetMessagesFunc(func(filename string) ([]byte, error) {
+		switch filename {
+		case "fa_IR.json":
+			return []byte(`{"TRAY_QUIT": "خروج", "STATUS_TRY_AGAIN": "دوباره امتحان کنید", "STATUS_DNS_TITLE": "سرور پیدا نشد", "STATUS_DNS_SUMMARY": "لنترن نتوانست سرور %s را پیدا کند."}`), nil
+		case "de.json":
+			// Doesn't translate the status pages yet
+			return []byte(`{"TRAY_QUIT": "Beenden"}`), nil
+		}
+		return nil, fmt.Errorf("No locale file %v", filename)
+	})
+	defer SetMessagesFunc(nil)
+	osLocaleOnce.Do(func() {})
+	oldOSLocale := osLocale
+	osLocale = ""
+	defer func() {
+		osLocale = oldOSLocale
+	}()
+
+	page := func(acceptLanguage string) string {
+		req, _ := http.NewRequest("GET", "http://example.com/", nil)
+		req.Header.Set("Accept-Language", acceptLanguage)
+		b, err := ErrorAccessingPage(req, errors.New("no such host"))
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		return string(b)
+	}
+
+	fa := page("fa-IR,fa;q=0.9,en;q=0.8")
+	assert.Contains(t, fa, `dir="rtl"`)
+	assert.Contains(t, fa, `lang="fa"`)
+	assert.Contains(t, fa, "سرور پیدا نشد")
+	assert.Contains(t, fa, "لنترن نتوانست سرور example.com را پیدا کند.")
+	assert.Contains(t, fa, "دوباره امتحان کنید")
+	assert.Contains(t, fa, ErrDNS.Suggestions[0], "Untranslated messages should be in English")
+
+	de := page("de-DE,de;q=0.9")
+	assert.Contains(t, de, `dir="ltr"`)
+	assert.Contains(t, de, `lang="en"`, "Locale without status translations should fall back to English")
+	assert.Contains(t, de, ErrDNS.Title)
+
+	osLocale = "fa-IR"
+	assert.Contains(t, page(""), "سرور پیدا نشد", "Should fall back to OS locale")
+	assert.Contains(t, page("de"), "سرور پیدا نشد", "Should fall back to OS locale")
+}
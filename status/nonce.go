@@ -0,0 +1,73 @@
+package status
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// nonceTTL is how long the action on an error page stays usable.
+	nonceTTL = 1 * time.Hour
+
+	// maxNonces limits how many nonces we remember.
+	maxNonces = 1000
+)
+
+var (
+	nonces   = make(map[string]*pageNonce)
+	noncesMx sync.Mutex
+)
+
+// pageNonce records the page for which a nonce was generated.
+type pageNonce struct {
+	host    string
+	url     string
+	expires time.Time
+}
+
+// newNonce generates a nonce for the error page of the given host, shown in
+// place of the given URL.
+func newNonce(host string, url string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("Unable to generate nonce: %v", err)
+	}
+	nonce := hex.EncodeToString(b)
+
+	now := time.Now()
+	noncesMx.Lock()
+	defer noncesMx.Unlock()
+	for n, pn := range nonces {
+		if now.After(pn.expires) {
+			delete(nonces, n)
+		}
+	}
+	for n := range nonces {
+		if len(nonces) < maxNonces {
+			break
+		}
+		delete(nonces, n)
+	}
+	nonces[nonce] = &pageNonce{host: host, url: url, expires: now.Add(nonceTTL)}
+	return nonce, nil
+}
+
+// RedeemNonce checks a nonce submitted from an error page and returns the
+// host and URL of the page it was generated for. Each nonce can only be
+// redeemed once.
+func RedeemNonce(nonce string) (host string, url string, ok bool) {
+	noncesMx.Lock()
+	defer noncesMx.Unlock()
+	pn, found := nonces[nonce]
+	if !found {
+		return "", "", false
+	}
+	delete(nonces, nonce)
+	if time.Now().After(pn.expires) {
+		return "", "", false
+	}
+	return pn.host, pn.url, true
+}
@@ -2,14 +2,41 @@ package status
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"html/template"
+	"net"
+	"net/http"
 	"strings"
+	"sync/atomic"
+)
+
+var (
+	// proxySiteURL is the URL to which error pages submit the request to
+	// proxy the site, if enabled.
+	proxySiteURL atomic.Value
 )
 
 type errorAccesingPageT struct {
+	Lang         string
+	Dir          string
 	ServerName   string
 	ErrorMessage string
+	Class        string
+	Title        string
+	Summary      string
+	Suggestions  []string
+	ProxySiteURL string
+	Nonce        string
+}
+
+type errorAccessingPageJSON struct {
+	Error       string   `json:"error"`
+	Class       string   `json:"class"`
+	Server      string   `json:"server"`
+	Title       string   `json:"title"`
+	Summary     string   `json:"summary"`
+	Suggestions []string `json:"suggestions"`
 }
 
 func normalizeError(err error) string {
@@ -20,8 +47,18 @@ func normalizeError(err error) string {
 	return ""
 }
 
-// ErrorAccessingPage creates and returns a generic "error accessing page" error.
-func ErrorAccessingPage(server string, errMessage error) ([]byte, error) {
+// EnableProxySite makes error pages offer to proxy the site that failed. The
+// offer is a form that posts a nonce to the given URL, which can look up the
+// site with RedeemNonce.
+func EnableProxySite(url string) {
+	proxySiteURL.Store(url)
+}
+
+// ErrorAccessingPage creates and returns an "error accessing page" page for
+// the given request that explains the class of the given error and suggests
+// what to do about it. The page is in the language that the browser prefers,
+// if we have translations for it.
+func ErrorAccessingPage(req *http.Request, errMessage error) ([]byte, error) {
 	var err error
 	var buf []byte
 	var tmpl *template.Template
@@ -30,20 +67,35 @@ func ErrorAccessingPage(server string, errMessage error) ([]byte, error) {
 		errMessage = errors.New("Unknown error.")
 	}
 
+	tr := translatorFor(req)
 	buf, err = Asset("generic_error.html")
 
 	if err != nil {
 		return nil, err
 	}
 
-	tmpl, err = template.New("status_error").Parse(string(buf))
+	tmpl, err = template.New("status_error").Funcs(tr.funcs()).Parse(string(buf))
 	if err != nil {
 		return nil, err
 	}
 
+	class := Classify(errMessage)
 	data := errorAccesingPageT{
-		ServerName:   server,
+		Lang:         tr.lang,
+		Dir:          tr.dir(),
+		ServerName:   req.Host,
 		ErrorMessage: normalizeError(errMessage),
+		Class:        class.Name,
+	}
+	data.Title, data.Summary, data.Suggestions = tr.classT(class, req.Host)
+
+	// Only offer to proxy the site if we can reload the page afterwards
+	if u, _ := proxySiteURL.Load().(string); u != "" && req.Method == "GET" {
+		data.Nonce, err = newNonce(hostWithoutPort(req.Host), req.URL.String())
+		if err != nil {
+			return nil, err
+		}
+		data.ProxySiteURL = u
 	}
 
 	out := bytes.NewBuffer(nil)
@@ -54,3 +106,28 @@ func ErrorAccessingPage(server string, errMessage error) ([]byte, error) {
 
 	return out.Bytes(), nil
 }
+
+// ErrorAccessingPageJSON is like ErrorAccessingPage, for clients that don't
+// want HTML.
+func ErrorAccessingPageJSON(req *http.Request, errMessage error) ([]byte, error) {
+	if errMessage == nil {
+		errMessage = errors.New("Unknown error.")
+	}
+	class := Classify(errMessage)
+	title, summary, suggestions := translatorFor(req).classT(class, req.Host)
+	return json.Marshal(&errorAccessingPageJSON{
+		Error:       normalizeError(errMessage),
+		Class:       class.Name,
+		Server:      req.Host,
+		Title:       title,
+		Summary:     summary,
+		Suggestions: suggestions,
+	})
+}
+
+func hostWithoutPort(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
@@ -0,0 +1,69 @@
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorAccessingPage(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://nonexistent.example/page", nil)
+	page, err := ErrorAccessingPage(req, errors.New("dial tcp: lookup nonexistent.example: no such host\nmore details"))
+	if assert.NoError(t, err) {
+		html := string(page)
+		assert.Contains(t, html, ErrDNS.Title)
+		assert.Contains(t, html, "Lantern could not find the server at nonexistent.example.")
+		assert.Contains(t, html, "Try again")
+		assert.False(t, strings.Contains(html, "more details"), "Should only show first line of error")
+		assert.False(t, strings.Contains(html, "Proxy this site"), "Should not offer to proxy site unless enabled")
+	}
+
+	req, _ = http.NewRequest("GET", "http://example.com/", nil)
+	b, err := ErrorAccessingPageJSON(req, timeoutError{})
+	if assert.NoError(t, err) {
+		var resp errorAccessingPageJSON
+		if assert.NoError(t, json.Unmarshal(b, &resp)) {
+			assert.Equal(t, "timeout", resp.Class)
+			assert.Equal(t, "example.com", resp.Server)
+			assert.Equal(t, "dial tcp 1.2.3.4:443: i/o timeout", resp.Error)
+			assert.NotEmpty(t, resp.Suggestions)
+		}
+	}
+}
+
+func TestProxySite(t *testing.T) {
+	EnableProxySite("http://ui.lantern.io/proxysite")
+	defer EnableProxySite("")
+
+	req, _ := http.NewRequest("GET", "http://example.com:8080/some/page?q=1", nil)
+	page, err := ErrorAccessingPage(req, errors.New("connection reset by peer"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Contains(t, string(page), `action="http://ui.lantern.io/proxysite"`)
+	match := regexp.MustCompile(`name="nonce" value="([0-9a-f]+)"`).FindSubmatch(page)
+	if !assert.NotNil(t, match, "Page should include nonce") {
+		return
+	}
+	nonce := string(match[1])
+
+	host, u, ok := RedeemNonce(nonce)
+	assert.True(t, ok)
+	assert.Equal(t, "example.com", host)
+	assert.Equal(t, "http://example.com:8080/some/page?q=1", u)
+	_, _, ok = RedeemNonce(nonce)
+	assert.False(t, ok, "Nonce should only work once")
+	_, _, ok = RedeemNonce("bogus")
+	assert.False(t, ok)
+
+	req, _ = http.NewRequest("POST", "http://example.com/form", nil)
+	page, err = ErrorAccessingPage(req, errors.New("connection reset by peer"))
+	if assert.NoError(t, err) {
+		assert.False(t, strings.Contains(string(page), "Proxy this site"), "Should not offer to proxy site for requests we can't reload")
+	}
+}
@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/getlantern/flashlight/client"
+)
+
+const (
+	// tokenParam is the query parameter with which the browser passes the
+	// session token when the UI is first opened.
+	tokenParam = "token"
+
+	// tokenCookie is the cookie in which the browser keeps the session token
+	// after that, so that the UI doesn't need to pass it around itself.
+	tokenCookie = "lantern-ui-token"
+
+	// WPADPath is where devices that discover their proxy settings with WPAD
+	// look for the PAC file.
+	WPADPath = "/wpad.dat"
+)
+
+var (
+	// sessionToken is a random token generated on every launch. The websocket
+	// only accepts browsers that present it, which they get from Show or
+	// WithToken.
+	sessionToken string
+
+	// remoteAllowed indicates whether the UI server accepts connections on all
+	// interfaces rather than just the loopback interface.
+	remoteAllowed bool
+
+	// crossOriginPaths are the paths registered with HandleCrossOrigin.
+	crossOriginPaths   = make(map[string]bool)
+	crossOriginPathsMx sync.RWMutex
+)
+
+// newSessionToken generates a new random session token.
+func newSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("Unable to generate session token: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// WithToken adds the session token to the given URL of a page served by the
+// UI server, so that the browser opening it is authorized to use the UI
+// services. The page has to be registered with HandlePage.
+func WithToken(u string) string {
+	sep := "?"
+	if strings.Contains(u, "?") {
+		sep = "&"
+	}
+	return u + sep + tokenParam + "=" + url.QueryEscape(sessionToken)
+}
+
+// hasToken checks whether the request carries the session token, either as a
+// query parameter or in the token cookie.
+func hasToken(req *http.Request) bool {
+	if sessionToken == "" {
+		return false
+	}
+	token := req.URL.Query().Get(tokenParam)
+	if token == "" {
+		cookie, err := req.Cookie(tokenCookie)
+		if err != nil {
+			return false
+		}
+		token = cookie.Value
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(sessionToken)) == 1
+}
+
+// allowedHost checks whether the given host (with or without port) is one
+// under which the UI server is legitimately reached. Anything else, in
+// particular an arbitrary domain that resolves to the loopback address, is
+// a sign of a DNS rebinding attack.
+func allowedHost(host string) bool {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	if hostname == "localhost" || hostname == client.LanternSpecialDomain {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(hostname, "[]"))
+	if ip == nil {
+		return false
+	}
+	// An attacker can't get the browser to send IP addresses as the Host, so
+	// when we listen on all interfaces any of them is fine.
+	return remoteAllowed || ip.IsLoopback()
+}
+
+// allowedWPADHost checks whether the given host is one under which devices on
+// the LAN fetch the WPAD file, like wpad.example.lan. Those are only accepted
+// when we listen on all interfaces, since they can't be told apart from DNS
+// rebinding.
+func allowedWPADHost(host string) bool {
+	if !remoteAllowed {
+		return false
+	}
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	hostname = strings.ToLower(hostname)
+	return hostname == "wpad" || strings.HasPrefix(hostname, "wpad.")
+}
+
+// allowedOrigin checks whether the given Origin header, if present, is that of
+// a page served by the UI server.
+func allowedOrigin(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return allowedHost(u.Host)
+}
+
+// checkOrigin is used as the websocket.Upgrader's CheckOrigin. Browsers always
+// send an Origin on websocket handshakes, so unlike for plain requests it's
+// required.
+func checkOrigin(req *http.Request) bool {
+	origin := req.Header.Get("Origin")
+	return origin != "" && allowedOrigin(origin)
+}
+
+// protect wraps the given handler to reject requests with a Host or Origin
+// other than those of the UI server. Paths registered with HandleCrossOrigin
+// only need the right Host, and WPADPath can also be reached under WPAD's
+// host names.
+func protect(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		if !allowedHost(req.Host) && !(req.URL.Path == WPADPath && allowedWPADHost(req.Host)) {
+			log.Debugf("Rejecting request to %v with Host %v", req.URL.Path, req.Host)
+			http.Error(resp, "Forbidden", http.StatusForbidden)
+			return
+		}
+		if !allowedOrigin(req.Header.Get("Origin")) && !isCrossOrigin(req.URL.Path) {
+			log.Debugf("Rejecting request to %v from Origin %v", req.URL.Path, req.Header.Get("Origin"))
+			http.Error(resp, "Forbidden", http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(resp, req)
+	})
+}
+
+// allowCrossOrigin allows requests to the given path from any Origin.
+func allowCrossOrigin(p string) {
+	crossOriginPathsMx.Lock()
+	crossOriginPaths[p] = true
+	crossOriginPathsMx.Unlock()
+}
+
+func isCrossOrigin(p string) bool {
+	crossOriginPathsMx.RLock()
+	defer crossOriginPathsMx.RUnlock()
+	return crossOriginPaths[p]
+}
+
+// acceptToken wraps the handler of a page that uses the UI services. When the
+// browser passes the session token in the query, it's moved into a cookie and
+// the browser is redirected to the same page without it, so that the token
+// doesn't stay in the address bar or the browser history.
+func acceptToken(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get(tokenParam) == "" {
+			h.ServeHTTP(resp, req)
+			return
+		}
+		if !hasToken(req) {
+			http.Error(resp, "Invalid token", http.StatusForbidden)
+			return
+		}
+		// Set the cookie by hand because the http.Cookie of the Go version
+		// we build with doesn't know about SameSite.
+		resp.Header().Add("Set-Cookie", fmt.Sprintf("%v=%v; Path=/; HttpOnly; SameSite=Strict", tokenCookie, sessionToken))
+		q := req.URL.Query()
+		q.Del(tokenParam)
+		u := *req.URL
+		u.RawQuery = q.Encode()
+		http.Redirect(resp, req, u.RequestURI(), http.StatusFound)
+	})
+}
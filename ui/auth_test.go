@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowedHost(t *testing.T) {
+	remoteAllowed = false
+	for _, host := range []string{"127.0.0.1:16823", "localhost:16823", "LOCALHOST", "ui.lantern.io", "[::1]:16823"} {
+		assert.True(t, allowedHost(host), host)
+	}
+	for _, host := range []string{"evil.com:16823", "127.0.0.1.evil.com", "192.168.1.2:16823", ""} {
+		assert.False(t, allowedHost(host), host)
+	}
+	remoteAllowed = true
+	defer func() {
+		remoteAllowed = false
+	}()
+	assert.True(t, allowedHost("192.168.1.2:16823"), "Should allow any IP when listening on all interfaces")
+	assert.False(t, allowedHost("evil.com:16823"), "Should not allow names when listening on all interfaces")
+}
+
+func TestProtect(t *testing.T) {
+	h := protect(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.WriteHeader(http.StatusOK)
+	}))
+	check := func(host string, origin string) int {
+		req, _ := http.NewRequest("GET", "http://"+host+"/proxy_on.pac", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, req)
+		return resp.Code
+	}
+	assert.Equal(t, http.StatusOK, check("127.0.0.1:16823", ""))
+	assert.Equal(t, http.StatusOK, check("127.0.0.1:16823", "http://127.0.0.1:16823"))
+	assert.Equal(t, http.StatusForbidden, check("rebound.evil.com:16823", ""), "DNS rebinding should be rejected")
+	assert.Equal(t, http.StatusForbidden, check("127.0.0.1:16823", "http://evil.com"), "Foreign origin should be rejected")
+
+	allowCrossOrigin("/proxy_on.pac")
+	defer func() {
+		crossOriginPaths = make(map[string]bool)
+	}()
+	assert.Equal(t, http.StatusOK, check("127.0.0.1:16823", "http://evil.com"), "Foreign origin should be allowed for cross origin path")
+	assert.Equal(t, http.StatusForbidden, check("rebound.evil.com:16823", "http://evil.com"), "DNS rebinding should still be rejected for cross origin path")
+}
+
+func TestProtectWPAD(t *testing.T) {
+	h := protect(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.WriteHeader(http.StatusOK)
+	}))
+	check := func(host string, path string) int {
+		req, _ := http.NewRequest("GET", "http://"+host+path, nil)
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, req)
+		return resp.Code
+	}
+	assert.Equal(t, http.StatusForbidden, check("wpad.example.lan", WPADPath), "WPAD host should be rejected when only listening on loopback")
+
+	remoteAllowed = true
+	defer func() {
+		remoteAllowed = false
+	}()
+	assert.Equal(t, http.StatusOK, check("wpad.example.lan", WPADPath))
+	assert.Equal(t, http.StatusOK, check("WPAD:80", WPADPath))
+	assert.Equal(t, http.StatusForbidden, check("wpad.example.lan", "/"), "WPAD host should only be allowed for WPAD file")
+	assert.Equal(t, http.StatusForbidden, check("notwpad.example.lan", WPADPath))
+}
+
+func TestToken(t *testing.T) {
+	var err error
+	sessionToken, err = newSessionToken()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() {
+		sessionToken = ""
+	}()
+
+	req, _ := http.NewRequest("GET", WithToken("http://127.0.0.1:16823/"), nil)
+	assert.True(t, hasToken(req))
+	req, _ = http.NewRequest("GET", "http://127.0.0.1:16823/data?token=wrong", nil)
+	assert.False(t, hasToken(req))
+
+	files := http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.WriteHeader(http.StatusOK)
+	})
+	req, _ = http.NewRequest("GET", WithToken("http://127.0.0.1:16823/"), nil)
+	resp := httptest.NewRecorder()
+	acceptToken(files).ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusFound, resp.Code)
+	assert.Equal(t, "/", resp.Header().Get("Location"), "Token should be removed from URL")
+
+	req, _ = http.NewRequest("GET", "http://127.0.0.1:16823/data", nil)
+	assert.False(t, hasToken(req))
+	req.Header.Set("Cookie", resp.Header().Get("Set-Cookie"))
+	assert.True(t, hasToken(req), "Cookie should carry token")
+}
@@ -7,5 +7,11 @@ type EnvelopeType struct {
 // Envelope is a struct that wraps messages and associates them with a type.
 type Envelope struct {
 	EnvelopeType
+	// ID optionally identifies a request from the UI. The reply to the request
+	// carries the same ID.
+	ID      string `json:",omitempty"`
 	Message interface{}
+	// Error is set on replies to requests that failed, in which case there's
+	// no Message.
+	Error string `json:",omitempty"`
 }
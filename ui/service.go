@@ -4,10 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"sync/atomic"
 )
 
 type helloFnType func(func(interface{}) error) error
 
+// Handler handles a message from the UI, decoded into the value returned by
+// the service's newMessage function. The returned reply, or the error if it's
+// not nil, is sent back to the UI if the message was a request, meaning that
+// it had an ID.
+type Handler func(msg interface{}) (reply interface{}, err error)
+
 type Service struct {
 	Type       string
 	In         <-chan interface{}
@@ -17,6 +24,24 @@ type Service struct {
 	stopCh     chan bool
 	newMessage func() interface{}
 	helloFn    helloFnType
+	handler    Handler
+	requests   chan *request
+	// policy is the QueuePolicy for messages sent to Out, accessed atomically
+	policy int32
+}
+
+// request is a message from the UI waiting to be handled by a Handler.
+type request struct {
+	id  string
+	msg interface{}
+}
+
+// incomingEnvelope is an Envelope from the UI whose message hasn't been
+// decoded yet.
+type incomingEnvelope struct {
+	EnvelopeType
+	ID      string
+	Message json.RawMessage
 }
 
 var (
@@ -40,12 +65,76 @@ func (s *Service) write() {
 				log.Error(err)
 				continue
 			}
-			defaultUIChannel.Out <- b
+			defaultUIChannel.send(&outgoing{key: s.Type, policy: s.queuePolicy(), b: b})
 		}
 	}
 }
 
+// SetQueuePolicy sets what happens to the messages sent to Out when a browser
+// window doesn't keep up with them. The default is Queue.
+func (s *Service) SetQueuePolicy(policy QueuePolicy) {
+	atomic.StoreInt32(&s.policy, int32(policy))
+}
+
+func (s *Service) queuePolicy() QueuePolicy {
+	return QueuePolicy(atomic.LoadInt32(&s.policy))
+}
+
+// handle passes requests to the service's handler one at a time and replies
+// to them.
+func (s *Service) handle() {
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case req := <-s.requests:
+			reply, err := s.handler(req.msg)
+			if err != nil {
+				log.Debugf("Error handling %v message: %v", s.Type, err)
+			}
+			if req.id != "" {
+				s.reply(req.id, reply, err)
+			}
+		}
+	}
+}
+
+// reply sends the reply to the request with the given ID to the UI. Replies go
+// to all connected browser windows, which ignore the ones to requests they
+// didn't send.
+func (s *Service) reply(id string, msg interface{}, replyErr error) {
+	env := &Envelope{
+		EnvelopeType: EnvelopeType{s.Type},
+		ID:           id,
+		Message:      msg,
+	}
+	if replyErr != nil {
+		env.Message = nil
+		env.Error = replyErr.Error()
+	}
+	b, err := json.Marshal(env)
+	if err != nil {
+		log.Errorf("Unable to marshal reply to %v request: %v", s.Type, err)
+		return
+	}
+	// Replies are never coalesced or dropped since the UI waits for them
+	defaultUIChannel.send(&outgoing{b: b})
+}
+
+// Register registers a service that exchanges messages of the given type with
+// the UI. Messages from the UI are decoded into the value returned by
+// newMessage, or into generic JSON values if it's nil, and delivered on the
+// service's In channel. If the message was a request, the UI gets an empty
+// reply once it's been delivered. helloFn, if not nil, is called to greet
+// every browser window that connects.
 func Register(t string, newMessage func() interface{}, helloFn helloFnType) (*Service, error) {
+	return RegisterHandler(t, newMessage, helloFn, nil)
+}
+
+// RegisterHandler is like Register, except that messages from the UI are
+// passed to the given handler instead of In, and the UI gets the handler's
+// reply or error.
+func RegisterHandler(t string, newMessage func() interface{}, helloFn helloFnType, handler Handler) (*Service, error) {
 	log.Tracef("Registering UI service %s", t)
 	mu.Lock()
 
@@ -67,18 +156,22 @@ func Register(t string, newMessage func() interface{}, helloFn helloFnType) (*Se
 		stopCh:     make(chan bool),
 		newMessage: newMessage,
 		helloFn:    helloFn,
+		handler:    handler,
+		requests:   make(chan *request, 100),
 	}
 	s.In, s.Out = s.in, s.out
 
-	// Sending existent clients the hello message of the new service.
+	// Sending existent clients the hello message of the new service. They're
+	// sent after releasing mu because new connections hold the UIChannel's
+	// lock while they acquire mu to get their hello messages.
+	var hellos [][]byte
 	if helloFn != nil {
 		err := helloFn(func(msg interface{}) error {
 			b, err := newEnvelope(s.Type, msg)
 			if err != nil {
 				return err
 			}
-			log.Tracef("Sending initial message to existent clients")
-			defaultUIChannel.Out <- b
+			hellos = append(hellos, b)
 			return nil
 		})
 		if err != nil {
@@ -90,15 +183,26 @@ func Register(t string, newMessage func() interface{}, helloFn helloFnType) (*Se
 	services[t] = s
 	mu.Unlock()
 
+	for _, b := range hellos {
+		log.Tracef("Sending initial message to existent clients")
+		defaultUIChannel.send(&outgoing{b: b})
+	}
+
 	log.Tracef("Registered UI service %s", t)
 	go s.write()
+	if handler != nil {
+		go s.handle()
+	}
 	return s, nil
 }
 
 func Unregister(t string) {
 	log.Tracef("Unregistering service: %v", t)
+	mu.Lock()
+	defer mu.Unlock()
 	if services[t] != nil {
-		services[t].stopCh <- true
+		// Closing rather than sending stops both write and handle
+		close(services[t].stopCh)
 		delete(services, t)
 	}
 }
@@ -131,37 +235,66 @@ func start() {
 	go read()
 
 	log.Debugf("Accepting websocket connections at: %s", defaultUIChannel.URL)
+	log.Debugf("Accepting event streams at: %s/events", defaultUIChannel.URL)
 }
 
 func read() {
 	// Reading from the combined input.
 	for b := range defaultUIChannel.In {
 		log.Tracef("Got incoming message from UI for %v", defaultUIChannel.URL)
-		// Determining message type.
-		var envType EnvelopeType
-		err := json.Unmarshal(b, &envType)
-
+		env := &incomingEnvelope{}
+		err := json.Unmarshal(b, env)
 		if err != nil {
 			log.Errorf("Unable to parse JSON update from browser: %q", err)
 			continue
 		}
 
 		// Delegating response to the service that registered with the given type.
-		if services[envType.Type] == nil {
-			log.Errorf("Message type %v belongs to an unknown service.", envType.Type)
+		mu.RLock()
+		s := services[env.Type]
+		mu.RUnlock()
+		if s == nil {
+			log.Errorf("Message type %v belongs to an unknown service.", env.Type)
 			continue
 		}
 
-		env := &Envelope{}
-		err = json.Unmarshal(b, env)
+		msg, err := s.decode(env.Message)
 		if err != nil {
-			log.Errorf("Unable to unmarshal message of type %v: %v", envType.Type, err)
+			log.Errorf("Unable to unmarshal message of type %v: %v", env.Type, err)
+			if env.ID != "" {
+				s.reply(env.ID, nil, err)
+			}
 			continue
 		}
-		log.Tracef("Forwarding message: %v", env)
+		log.Tracef("Forwarding message: %v", msg)
 		// Pass this message and continue reading another one.
-		services[env.Type].in <- env.Message
+		if s.handler != nil {
+			s.requests <- &request{id: env.ID, msg: msg}
+			continue
+		}
+		s.in <- msg
+		if env.ID != "" {
+			s.reply(env.ID, nil, nil)
+		}
+	}
+}
+
+// decode decodes a message from the UI into the value returned by the
+// service's newMessage, if it has one.
+func (s *Service) decode(raw json.RawMessage) (interface{}, error) {
+	if len(raw) == 0 {
+		raw = json.RawMessage("null")
+	}
+	if s.newMessage == nil {
+		var msg interface{}
+		err := json.Unmarshal(raw, &msg)
+		return msg, err
+	}
+	msg := s.newMessage()
+	if err := json.Unmarshal(raw, msg); err != nil {
+		return nil, fmt.Errorf("Invalid %v message: %v", s.Type, err)
 	}
+	return msg, nil
 }
 
 func newEnvelope(t string, msg interface{}) ([]byte, error) {
@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRequest struct {
+	Name string
+}
+
+// newTestUIChannel sets up a defaultUIChannel with a single connection whose
+// queue the test reads from directly.
+func newTestUIChannel() (chan []byte, *uiconn) {
+	in := make(chan []byte, 100)
+	out := make(chan []byte, 100)
+	defaultUIChannel = &UIChannel{In: in, in: in, Out: out, out: out, conns: make(map[int]*uiconn)}
+	conn := newUIConn(1, defaultUIChannel, nil)
+	defaultUIChannel.conns[conn.id] = conn
+	go read()
+	return in, conn
+}
+
+func nextReply(t *testing.T, conn *uiconn) *Envelope {
+	select {
+	case <-conn.queued:
+		env := &Envelope{}
+		if assert.NoError(t, json.Unmarshal(conn.dequeue().b, env)) {
+			return env
+		}
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "No reply")
+	}
+	return &Envelope{}
+}
+
+func TestRequestReply(t *testing.T) {
+	in, conn := newTestUIChannel()
+	defer func() {
+		close(in)
+		defaultUIChannel = nil
+	}()
+
+	newMessage := func() interface{} {
+		return &testRequest{}
+	}
+	handler := func(msg interface{}) (interface{}, error) {
+		req := msg.(*testRequest)
+		if req.Name == "" {
+			return nil, fmt.Errorf("Name is required")
+		}
+		return "Hello " + req.Name, nil
+	}
+	_, err := RegisterHandler("Greeter", newMessage, nil, handler)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer Unregister("Greeter")
+	plain, err := Register("Plain", nil, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer Unregister("Plain")
+
+	in <- []byte(`{"Type":"Greeter","ID":"1","Message":{"Name":"UI"}}`)
+	reply := nextReply(t, conn)
+	assert.Equal(t, "Greeter", reply.Type)
+	assert.Equal(t, "1", reply.ID)
+	assert.Equal(t, "Hello UI", reply.Message)
+	assert.Empty(t, reply.Error)
+
+	in <- []byte(`{"Type":"Greeter","ID":"2","Message":{}}`)
+	reply = nextReply(t, conn)
+	assert.Equal(t, "2", reply.ID)
+	assert.Equal(t, "Name is required", reply.Error, "Should get validation error")
+	assert.Nil(t, reply.Message)
+
+	in <- []byte(`{"Type":"Greeter","ID":"3","Message":{"Name":5}}`)
+	reply = nextReply(t, conn)
+	assert.Equal(t, "3", reply.ID)
+	assert.Contains(t, reply.Error, "Invalid Greeter message", "Should get decoding error")
+
+	in <- []byte(`{"Type":"Plain","ID":"4","Message":{"a":true}}`)
+	select {
+	case msg := <-plain.In:
+		assert.Equal(t, map[string]interface{}{"a": true}, msg)
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Message should have been delivered on In")
+	}
+	reply = nextReply(t, conn)
+	assert.Equal(t, "4", reply.ID, "Should get acknowledgement")
+	assert.Empty(t, reply.Error)
+}
@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// sseHeader starts the response to a request for the event stream. We
+	// write it ourselves since we hijack the connection.
+	sseHeader = "HTTP/1.1 200 OK\r\n" +
+		"Content-Type: text/event-stream\r\n" +
+		"Cache-Control: no-cache\r\n" +
+		"Connection: close\r\n" +
+		"\r\n" +
+		// Have the browser reconnect quickly if the stream is interrupted
+		"retry: 2000\n\n"
+)
+
+// handleSSE serves the UIChannel at the given path over Server-Sent Events,
+// for browsers and webviews that can't use websockets. Messages to the UI are
+// streamed as events from <p>/events, with the same hello messages that a
+// websocket gets on connect, and the UI posts its messages to <p>/send.
+func (c *UIChannel) handleSSE(p string, onConnect ConnectFunc) {
+	r.HandleFunc(p+"/events", func(resp http.ResponseWriter, req *http.Request) {
+		c.serveEvents(resp, req, onConnect)
+	})
+	r.HandleFunc(p+"/send", c.serveSend)
+}
+
+func (c *UIChannel) serveEvents(resp http.ResponseWriter, req *http.Request, onConnect ConnectFunc) {
+	if req.Method != "GET" {
+		http.Error(resp, "Method not allowed", 405)
+		return
+	}
+	if !hasToken(req) {
+		log.Debugf("Rejecting event stream for %v without valid session token", c.URL)
+		http.Error(resp, "Forbidden", http.StatusForbidden)
+		return
+	}
+	// Hijack the connection so that we can set write deadlines on it
+	hj, ok := resp.(http.Hijacker)
+	if !ok {
+		http.Error(resp, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	conn, buffered, err := hj.Hijack()
+	if err != nil {
+		log.Errorf("Unable to hijack connection for event stream: %v", err)
+		return
+	}
+	t := &sseTransport{conn: conn}
+	if err := t.write([]byte(sseHeader), time.Now().Add(writeTimeout)); err != nil {
+		log.Debugf("Unable to start event stream: %v", err)
+		if err := conn.Close(); err != nil {
+			log.Debugf("Error closing event stream: %v", err)
+		}
+		return
+	}
+
+	log.Tracef("Started event stream for %v", c.URL)
+	uc := c.connect(t, onConnect)
+	if uc == nil {
+		return
+	}
+	t.waitForClose(uc, buffered.Reader)
+}
+
+// serveSend accepts a single message from a UI that uses the event stream.
+func (c *UIChannel) serveSend(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		http.Error(resp, "Method not allowed", 405)
+		return
+	}
+	if !hasToken(req) {
+		log.Debugf("Rejecting message for %v without valid session token", c.URL)
+		http.Error(resp, "Forbidden", http.StatusForbidden)
+		return
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(req.Body, maxIncomingMessageSize+1))
+	if err != nil {
+		log.Debugf("Error reading message from UI: %v", err)
+		http.Error(resp, "Unable to read message", http.StatusBadRequest)
+		return
+	}
+	if len(b) > maxIncomingMessageSize {
+		http.Error(resp, "Message too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	log.Tracef("Read posted message: %q", b)
+	c.in <- b
+	// Replies to requests come through the event stream
+	resp.WriteHeader(http.StatusAccepted)
+}
+
+// sseTransport is a transport over a Server-Sent Events stream.
+type sseTransport struct {
+	conn net.Conn
+}
+
+func (t *sseTransport) writeMessage(b []byte, deadline time.Time) error {
+	var event bytes.Buffer
+	// Each line of the message needs its own data field
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		event.WriteString("data: ")
+		event.Write(line)
+		event.WriteString("\n")
+	}
+	event.WriteString("\n")
+	return t.write(event.Bytes(), deadline)
+}
+
+func (t *sseTransport) write(b []byte, deadline time.Time) error {
+	if err := t.conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	_, err := t.conn.Write(b)
+	return err
+}
+
+func (t *sseTransport) close() error {
+	return t.conn.Close()
+}
+
+// waitForClose waits for the browser to close the event stream and then
+// removes the connection. The browser doesn't send anything after its
+// request, so anything it does send is discarded.
+func (t *sseTransport) waitForClose(conn *uiconn, br *bufio.Reader) {
+	if _, err := io.Copy(ioutil.Discard, br); err != nil {
+		log.Tracef("Event stream closed: %v", err)
+	}
+	conn.c.remove(conn)
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSE(t *testing.T) {
+	oldToken := sessionToken
+	sessionToken = "test-token"
+	defer func() {
+		sessionToken = oldToken
+	}()
+
+	c := newUIChannel("test")
+	defer c.Close()
+	c.handleSSE("/ssetest", func(write func([]byte) error) error {
+		return write([]byte(`{"Type":"Hello"}`))
+	})
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/ssetest/events")
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, "Should require token")
+		resp.Body.Close()
+	}
+
+	resp, err = http.Get(server.URL + "/ssetest/events?token=test-token")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	events := bufio.NewReader(resp.Body)
+	nextEvent := func() string {
+		var event []string
+		for {
+			line, err := events.ReadString('\n')
+			if !assert.NoError(t, err) {
+				return ""
+			}
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" && len(event) > 0 {
+				return strings.Join(event, "\n")
+			}
+			if strings.HasPrefix(line, "data: ") {
+				event = append(event, strings.TrimPrefix(line, "data: "))
+			}
+		}
+	}
+	assert.Equal(t, `{"Type":"Hello"}`, nextEvent(), "Should replay hello messages on connect")
+	c.Out <- []byte("two\nlines")
+	assert.Equal(t, "two\nlines", nextEvent())
+
+	post, err := http.Post(server.URL+"/ssetest/send?token=test-token", "application/json", strings.NewReader(`{"Type":"Settings"}`))
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusAccepted, post.StatusCode)
+		post.Body.Close()
+	}
+	select {
+	case b := <-c.In:
+		assert.Equal(t, `{"Type":"Settings"}`, string(b))
+	case <-time.After(1 * time.Second):
+		assert.Fail(t, "Posted message should have been received")
+	}
+
+	post, err = http.Post(server.URL+"/ssetest/send", "application/json", strings.NewReader(`{"Type":"Settings"}`))
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusForbidden, post.StatusCode, "Should require token")
+		post.Body.Close()
+	}
+}
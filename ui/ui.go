@@ -21,6 +21,11 @@ import (
 
 const (
 	LocalUIDir = "../../../lantern-ui/app"
+
+	// StartupHeader has to be set on requests to /startup. Web pages can't set
+	// custom headers on cross-origin requests without a CORS preflight, which
+	// we never allow, so this keeps them from popping up the UI.
+	StartupHeader = "X-Lantern-Startup"
 )
 
 var (
@@ -70,6 +75,23 @@ func Handle(p string, handler http.Handler) string {
 	return uiaddr + p
 }
 
+// HandlePage is like Handle, for pages that use the UI services. Browsers
+// opening the page at the URL from WithToken are authorized to use them.
+func HandlePage(p string, handler http.Handler) string {
+	return Handle(p, acceptToken(handler))
+}
+
+// HandleCrossOrigin is like Handle, for endpoints to which pages that the
+// proxy serves in place of other sites, like the error pages, submit forms.
+// Since requests to it are accepted from any Origin, the handler has to
+// protect itself against CSRF, for example with a nonce. It returns the URL of
+// the endpoint through the proxy, which is what those pages can reach.
+func HandleCrossOrigin(p string, handler http.Handler) string {
+	allowCrossOrigin(p)
+	r.Handle(p, handler)
+	return proxiedUIAddr + p
+}
+
 func Start(requestedAddr string, allowRemote bool, extUrl string) (string, error) {
 	addr, err := net.ResolveTCPAddr("tcp4", requestedAddr)
 	if err != nil {
@@ -77,6 +99,10 @@ func Start(requestedAddr string, allowRemote bool, extUrl string) (string, error
 	}
 
 	externalUrl = extUrl
+	remoteAllowed = allowRemote
+	if sessionToken, err = newSessionToken(); err != nil {
+		return "", err
+	}
 	if allowRemote {
 		// If we want to allow remote connections, we have to bind all interfaces
 		addr = &net.TCPAddr{Port: addr.Port}
@@ -88,6 +114,10 @@ func Start(requestedAddr string, allowRemote bool, extUrl string) (string, error
 	// This allows a second Lantern running on the system to trigger the existing
 	// Lantern to show the UI, or at least try to
 	handler := func(resp http.ResponseWriter, req *http.Request) {
+		if req.Header.Get(StartupHeader) == "" {
+			http.Error(resp, "Forbidden", http.StatusForbidden)
+			return
+		}
 		// If we're allowing remote, we're in practice not showing the UI on this
 		// typically headless system, so don't allow triggering of the UI.
 		if !allowRemote {
@@ -96,10 +126,10 @@ func Start(requestedAddr string, allowRemote bool, extUrl string) (string, error
 		resp.WriteHeader(http.StatusOK)
 	}
 	r.Handle("/startup", http.HandlerFunc(handler))
-	r.Handle("/", http.FileServer(fs))
+	r.Handle("/", acceptToken(http.FileServer(fs)))
 
 	server = &http.Server{
-		Handler:  r,
+		Handler:  protect(r),
 		ErrorLog: log.AsStdLogger(),
 	}
 	go func() {
@@ -125,6 +155,9 @@ func Start(requestedAddr string, allowRemote bool, extUrl string) (string, error
 	return l.Addr().String(), nil
 }
 
+// PreferProxiedUI sets whether to prefer showing the UI through the proxy. It
+// returns the URL at which to show the UI from now on, including the session
+// token, and whether that changed.
 func PreferProxiedUI(val bool) (newAddr string, addrChanged bool) {
 	previousPreferredUIAddr := getPreferredUIAddr()
 	updated := int32(0)
@@ -133,7 +166,7 @@ func PreferProxiedUI(val bool) (newAddr string, addrChanged bool) {
 	}
 	atomic.StoreInt32(&preferProxiedUI, updated)
 	newPreferredUIAddr := getPreferredUIAddr()
-	return newPreferredUIAddr, newPreferredUIAddr != previousPreferredUIAddr
+	return WithToken(newPreferredUIAddr + "/"), newPreferredUIAddr != previousPreferredUIAddr
 }
 
 func shouldPreferProxiedUI() bool {
@@ -153,10 +186,11 @@ func getPreferredUIAddr() string {
 // *listening* at this point as long as Start is correctly called prior
 // to this method. It may not be reading yet, but since we're the only
 // ones reading from those incoming sockets the fact that reading starts
-// asynchronously is not a problem.
+// asynchronously is not a problem. The browser is given the session token so
+// that the UI can connect to the websocket.
 func Show() {
 	go func() {
-		addr := getPreferredUIAddr()
+		addr := WithToken(getPreferredUIAddr() + "/")
 		err := open.Run(addr)
 		if err != nil {
 			log.Errorf("Error opening page to `%v`: %v", addr, err)
@@ -1,27 +1,77 @@
 package ui
 
 import (
+	"errors"
 	"io"
 	"net/http"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
 
 const (
-	// Determines the chunking size of messages used by gorilla
-	MaxMessageSize = 1024
+	// Determines the chunking size of messages used by gorilla. Larger
+	// messages are simply split into multiple frames.
+	bufferSize = 4096
+
+	// maxIncomingMessageSize is the largest message we accept from the UI.
+	maxIncomingMessageSize = 1024 * 1024
+
+	// sendQueueSize is the number of messages that may be waiting to be
+	// written to a single browser window.
+	sendQueueSize = 100
+
+	// writeTimeout is how long we wait for a browser window to accept a
+	// message before giving up on it.
+	writeTimeout = 10 * time.Second
 )
 
 var (
-	upgrader = &websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: MaxMessageSize}
+	upgrader = &websocket.Upgrader{
+		ReadBufferSize:    bufferSize,
+		WriteBufferSize:   bufferSize,
+		CheckOrigin:       checkOrigin,
+		EnableCompression: true,
+	}
+
+	errQueueFull = errors.New("Send queue is full")
+)
+
+// QueuePolicy determines what happens to messages of a given type when a
+// browser window doesn't keep up with the messages sent to it.
+type QueuePolicy int
+
+const (
+	// Queue queues every message. A browser window that falls more than
+	// sendQueueSize messages behind is disconnected. The UI then reconnects
+	// and catches up through the hello messages.
+	Queue QueuePolicy = iota
+
+	// Coalesce replaces a message of the same type that's still waiting to be
+	// written with the new one. Only use it for services whose every message
+	// carries their full state, like the hello message does, since browser
+	// windows that fall behind never see the replaced messages.
+	Coalesce
+
+	// Drop drops messages for browser windows whose queue is full.
+	Drop
 )
 
+// outgoing is a message waiting to be written to browser windows.
+type outgoing struct {
+	// key identifies messages that replace each other under Coalesce
+	key    string
+	policy QueuePolicy
+	b      []byte
+}
+
 // UIChannel represents a data channel to/from the UI. UIChannel will have one
 // underlying websocket connection for each connected browser window. All
 // messages from any browser window are available via In and all messages sent
-// to Out will be published to all browser windows.
+// to Out will be published to all browser windows. Each browser window has its
+// own send queue, so a slow one doesn't hold up the others or the senders.
 type UIChannel struct {
 	URL string
 	In  <-chan []byte
@@ -30,7 +80,7 @@ type UIChannel struct {
 	in     chan []byte
 	out    chan []byte
 	nextId int
-	conns  map[int]*wsconn
+	conns  map[int]*uiconn
 	m      sync.Mutex
 }
 
@@ -38,8 +88,11 @@ type ConnectFunc func(write func([]byte) error) error
 
 // NewChannel establishes a new channel to the UI at the given path. When the UI
 // connects to this path, we will establish a websocket to the UI to carry
-// messages for this UIChannel. The given onConnect function is called anytime
-// that the UI connects.
+// messages for this UIChannel. Only pages served by the UI server that were
+// opened with the session token (see Show) may connect. Browsers that can't
+// use websockets can use the Server-Sent Events endpoints instead (see
+// handleSSE). The given onConnect function is called anytime that the UI
+// connects.
 func NewChannel(p string, onConnect ConnectFunc) *UIChannel {
 	c := newUIChannel(path.Join(uiaddr, p))
 
@@ -51,40 +104,29 @@ func NewChannel(p string, onConnect ConnectFunc) *UIChannel {
 			http.Error(resp, "Method not allowed", 405)
 			return
 		}
+		if !hasToken(req) {
+			log.Debugf("Rejecting connection to %v without valid session token", c.URL)
+			http.Error(resp, "Forbidden", http.StatusForbidden)
+			return
+		}
 		// Upgrade with a HTTP request returns a websocket connection
 		ws, err := upgrader.Upgrade(resp, req, nil)
 		if err != nil {
 			log.Errorf("Unable to upgrade %v to websocket: %v", p, err)
 			return
 		}
+		ws.SetReadLimit(maxIncomingMessageSize)
 
 		log.Tracef("Upgraded to websocket at %v", c.URL)
-		c.m.Lock()
-		if onConnect != nil {
-			err = onConnect(func(b []byte) error {
-				log.Tracef("Writing initial message: %q", b)
-				return ws.WriteMessage(websocket.TextMessage, b)
-			})
-			if err != nil {
-				log.Errorf("Error processing onConnect, disconnecting websocket: %v", err)
-				if err := ws.Close(); err != nil {
-					log.Debugf("Error closing WebSockets connection: %s", err)
-				}
-				c.m.Unlock()
-				return
-			}
-		}
-		c.nextId += 1
-		conn := &wsconn{
-			id: c.nextId,
-			c:  c,
-			ws: ws,
+		t := &wsTransport{ws}
+		conn := c.connect(t, onConnect)
+		if conn == nil {
+			return
 		}
-		c.conns[conn.id] = conn
-		c.m.Unlock()
 		log.Tracef("About to read from connection to %v", c.URL)
-		conn.read()
+		t.read(conn)
 	})
+	c.handleSSE(p, onConnect)
 
 	return c
 }
@@ -100,65 +142,214 @@ func newUIChannel(url string) *UIChannel {
 		Out:    out,
 		out:    out,
 		nextId: 0,
-		conns:  make(map[int]*wsconn),
+		conns:  make(map[int]*uiconn),
 	}
 
 	go c.write()
 	return c
 }
 
+// connect adds a connection over the given transport, queueing the messages
+// written by onConnect before any others. It returns nil if onConnect failed.
+func (c *UIChannel) connect(t transport, onConnect ConnectFunc) *uiconn {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.nextId += 1
+	conn := newUIConn(c.nextId, c, t)
+	if onConnect != nil {
+		err := onConnect(func(b []byte) error {
+			log.Tracef("Queueing initial message: %q", b)
+			if !conn.enqueue(&outgoing{b: b}) {
+				return errQueueFull
+			}
+			return nil
+		})
+		if err != nil {
+			log.Errorf("Error processing onConnect, disconnecting UI: %v", err)
+			conn.close()
+			return nil
+		}
+	}
+	c.conns[conn.id] = conn
+	go conn.write()
+	return conn
+}
+
 func (c *UIChannel) write() {
 	defer func() {
-		log.Tracef("Closing all websockets to %v", c.URL)
+		log.Tracef("Closing all connections to %v", c.URL)
 		c.m.Lock()
 		for _, conn := range c.conns {
-			if err := conn.ws.Close(); err != nil {
-				log.Debugf("Error closing WebSockets connection", err)
-			}
+			conn.close()
 			delete(c.conns, conn.id)
 		}
 		c.m.Unlock()
 	}()
 
 	for msg := range c.out {
-		c.m.Lock()
-		for _, conn := range c.conns {
-			err := conn.ws.WriteMessage(websocket.TextMessage, msg)
-			if err != nil {
-				log.Debugf("Error writing to UI %v for: %v", err, c.URL)
-				delete(c.conns, conn.id)
-			}
+		c.send(&outgoing{b: msg})
+	}
+}
+
+// send queues the given message for all browser windows. It never blocks on
+// writing to them.
+func (c *UIChannel) send(msg *outgoing) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for _, conn := range c.conns {
+		if !conn.enqueue(msg) {
+			log.Debugf("UI at %v isn't keeping up, disconnecting it", c.URL)
+			conn.close()
+			delete(c.conns, conn.id)
 		}
-		c.m.Unlock()
 	}
 }
 
+// remove closes and forgets the given connection.
+func (c *UIChannel) remove(conn *uiconn) {
+	c.m.Lock()
+	delete(c.conns, conn.id)
+	c.m.Unlock()
+	conn.close()
+}
+
 func (c *UIChannel) Close() {
 	log.Tracef("Closing channel")
 	close(c.out)
 }
 
-// wsconn ties a websocket.Conn to a UIChannel
-type wsconn struct {
-	id int
-	c  *UIChannel
+// transport is the connection to a single browser window.
+type transport interface {
+	// writeMessage writes a single message, failing if it takes beyond the
+	// given deadline.
+	writeMessage(b []byte, deadline time.Time) error
+
+	close() error
+}
+
+// wsTransport is a transport over a websocket.
+type wsTransport struct {
 	ws *websocket.Conn
 }
 
-func (c *wsconn) read() {
+func (t *wsTransport) writeMessage(b []byte, deadline time.Time) error {
+	if err := t.ws.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	return t.ws.WriteMessage(websocket.TextMessage, b)
+}
+
+func (t *wsTransport) close() error {
+	return t.ws.Close()
+}
+
+// read reads messages from the websocket into the UIChannel until it fails.
+func (t *wsTransport) read(conn *uiconn) {
 	for {
-		_, b, err := c.ws.ReadMessage()
+		_, b, err := t.ws.ReadMessage()
 		log.Tracef("Read message: %q", b)
 		if err != nil {
 			if err != io.EOF {
 				log.Debugf("Error reading from UI: %v", err)
 			}
-			if err := c.ws.Close(); err != nil {
-				log.Debugf("Error closing WebSockets connection", err)
-			}
+			conn.c.remove(conn)
 			return
 		}
 		log.Tracef("Sending to channel...")
-		c.c.in <- b
+		conn.c.in <- b
+	}
+}
+
+// uiconn ties a transport to a UIChannel
+type uiconn struct {
+	id int
+	c  *UIChannel
+	t  transport
+
+	mx        sync.Mutex
+	queue     []*outgoing
+	queued    chan bool
+	done      chan bool
+	closeOnce sync.Once
+}
+
+func newUIConn(id int, c *UIChannel, t transport) *uiconn {
+	return &uiconn{
+		id:     id,
+		c:      c,
+		t:      t,
+		queued: make(chan bool, 1),
+		done:   make(chan bool),
+	}
+}
+
+// enqueue queues the given message according to its policy. It returns false
+// if the queue is full and the connection should be given up on.
+func (c *uiconn) enqueue(msg *outgoing) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if msg.policy == Coalesce {
+		for i, queued := range c.queue {
+			if queued.policy == Coalesce && queued.key == msg.key {
+				c.queue[i] = msg
+				return true
+			}
+		}
+	}
+	if len(c.queue) >= sendQueueSize {
+		if msg.policy == Drop {
+			log.Tracef("Dropping message for slow UI: %q", msg.b)
+			return true
+		}
+		return false
+	}
+	c.queue = append(c.queue, msg)
+	select {
+	case c.queued <- true:
+	default:
+		// The writer has already been notified
+	}
+	return true
+}
+
+// dequeue removes and returns the oldest queued message, or nil if there's
+// none.
+func (c *uiconn) dequeue() *outgoing {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if len(c.queue) == 0 {
+		return nil
+	}
+	msg := c.queue[0]
+	c.queue[0] = nil
+	c.queue = c.queue[1:]
+	return msg
+}
+
+// write writes queued messages to the transport until the connection is
+// closed.
+func (c *uiconn) write() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.queued:
+		}
+		for msg := c.dequeue(); msg != nil; msg = c.dequeue() {
+			if err := c.t.writeMessage(msg.b, time.Now().Add(writeTimeout)); err != nil {
+				log.Debugf("Error writing to UI %v for: %v", err, c.c.URL)
+				c.c.remove(c)
+				return
+			}
+		}
 	}
 }
+
+func (c *uiconn) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		if err := c.t.close(); err != nil {
+			log.Debugf("Error closing connection to UI: %v", err)
+		}
+	})
+}
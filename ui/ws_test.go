@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"bytes"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueuePolicies(t *testing.T) {
+	conn := newUIConn(1, nil, nil)
+	assert.True(t, conn.enqueue(&outgoing{key: "Peers", policy: Coalesce, b: []byte("a")}))
+	assert.True(t, conn.enqueue(&outgoing{key: "Settings", b: []byte("b")}))
+	assert.True(t, conn.enqueue(&outgoing{key: "Peers", policy: Coalesce, b: []byte("c")}))
+	assert.Len(t, conn.queue, 2, "Should have coalesced")
+	assert.Equal(t, "c", string(conn.dequeue().b), "Coalesced message should keep its place in the queue")
+	assert.Equal(t, "b", string(conn.dequeue().b))
+	assert.Nil(t, conn.dequeue())
+
+	for i := 0; i < sendQueueSize; i++ {
+		assert.True(t, conn.enqueue(&outgoing{b: []byte("x")}))
+	}
+	assert.True(t, conn.enqueue(&outgoing{policy: Drop, b: []byte("d")}), "Full queue should drop droppable message")
+	assert.Len(t, conn.queue, sendQueueSize)
+	assert.False(t, conn.enqueue(&outgoing{b: []byte("q")}), "Full queue should give up on connection")
+}
+
+// newTestChannel starts a server whose websockets are connected to a new
+// UIChannel.
+func newTestChannel(t *testing.T, onConnect ConnectFunc) (*UIChannel, *httptest.Server) {
+	c := newUIChannel("test")
+	server := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		ws, err := upgrader.Upgrade(resp, req, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		ws.SetReadLimit(maxIncomingMessageSize)
+		wt := &wsTransport{ws}
+		if conn := c.connect(wt, onConnect); conn != nil {
+			wt.read(conn)
+		}
+	}))
+	return c, server
+}
+
+func dialTestChannel(t *testing.T, server *httptest.Server) *websocket.Conn {
+	dialer := &websocket.Dialer{EnableCompression: true}
+	ws, _, err := dialer.Dial(strings.Replace(server.URL, "http", "ws", 1), http.Header{"Origin": []string{server.URL}})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return ws
+}
+
+func TestLargeCompressedMessages(t *testing.T) {
+	c, server := newTestChannel(t, func(write func([]byte) error) error {
+		return write([]byte("hello"))
+	})
+	defer server.Close()
+	defer c.Close()
+	ws := dialTestChannel(t, server)
+	defer ws.Close()
+
+	_, b, err := ws.ReadMessage()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hello", string(b), "Should get hello message first")
+	}
+
+	large := bytes.Repeat([]byte("lantern "), 64*1024)
+	c.Out <- large
+	_, b, err = ws.ReadMessage()
+	if assert.NoError(t, err) {
+		assert.Equal(t, large, b, "Should be able to send messages larger than the buffer")
+	}
+
+	if assert.NoError(t, ws.WriteMessage(websocket.TextMessage, large)) {
+		select {
+		case b := <-c.In:
+			assert.Equal(t, large, b, "Should be able to receive messages larger than the buffer")
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Didn't receive message")
+		}
+	}
+}
+
+func TestSlowConnectionDoesNotBlock(t *testing.T) {
+	c, server := newTestChannel(t, nil)
+	defer server.Close()
+	defer c.Close()
+
+	slow := dialTestChannel(t, server)
+	defer slow.Close()
+	fast := dialTestChannel(t, server)
+	defer fast.Close()
+	// Wait for both connections to be added
+	for i := 0; i < 100; i++ {
+		c.m.Lock()
+		n := len(c.conns)
+		c.m.Unlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Random data so that compression doesn't help fill the socket buffers
+	msg := make([]byte, 64*1024)
+	for i := range msg {
+		msg[i] = byte(rand.Intn(256))
+	}
+	batch := sendQueueSize / 2
+	count := batch * 10
+
+	received := make(chan bool, count)
+	go func() {
+		for {
+			if _, _, err := fast.ReadMessage(); err != nil {
+				return
+			}
+			received <- true
+		}
+	}()
+
+	// Send in batches that the fast connection keeps up with, while the slow
+	// one's socket buffers and then its queue fill up
+	for i := 0; i < count; i += batch {
+		start := time.Now()
+		for j := 0; j < batch; j++ {
+			c.Out <- msg
+		}
+		assert.True(t, time.Now().Sub(start) < 1*time.Second, "Sending should not be blocked by the slow connection")
+		for j := 0; j < batch; j++ {
+			select {
+			case <-received:
+			case <-time.After(5 * time.Second):
+				assert.Fail(t, "Fast connection should get all messages")
+				return
+			}
+		}
+	}
+
+	// The slow connection fell too far behind and should have been dropped
+	c.m.Lock()
+	assert.Len(t, c.conns, 1)
+	c.m.Unlock()
+}
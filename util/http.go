@@ -70,6 +70,12 @@ func (cf *chainedAndFronted) Do(req *http.Request) (*http.Response, error) {
 	return resp, err
 }
 
+// NewChained creates a new HTTPFetcher that only uses chained servers, for
+// resources that can't be reached through domain fronting.
+func NewChained(proxyAddrFN eventual.Getter) HTTPFetcher {
+	return &chainedFetcher{proxyAddrFN}
+}
+
 type chainedFetcher struct {
 	proxyAddrFN eventual.Getter
 }